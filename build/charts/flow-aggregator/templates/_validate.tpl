@@ -1,7 +1,7 @@
 {{- define "validateReplicas" -}}
   {{- if eq .Values.mode "Aggregate"}}
-    {{- if gt (int .Values.replicas) 1 }}
-      {{- fail "Flow-aggregator can only have at most 1 replica in 'Aggregate' mode." }}
+    {{- if and (gt (int .Values.replicas) 1) (not .Values.agentFlowCollectorSharding) }}
+      {{- fail "Flow-aggregator can only have more than 1 replica in 'Aggregate' mode if agentFlowCollectorSharding is set to true." }}
     {{- end }}
   {{- end }}
 {{- end }}
@@ -501,7 +501,7 @@ func run(o *Options) error {
 	}
 	var l7Reconciler *l7engine.Reconciler
 	if l7NetworkPolicyEnabled || l7FlowExporterEnabled {
-		l7Reconciler = l7engine.NewReconciler(ofClient)
+		l7Reconciler = l7engine.NewReconciler(ofClient, ifaceStore)
 	}
 	networkPolicyController, err := networkpolicy.NewNetworkPolicyController(
 		antreaClientProvider,
@@ -708,6 +708,7 @@ func run(o *Options) error {
 		flowExporterOptions := &flowexporteroptions.FlowExporterOptions{
 			FlowCollectorAddr:      o.flowCollectorAddr,
 			FlowCollectorProto:     o.flowCollectorProto,
+			FlowCollectorSharding:  o.config.FlowExporter.FlowCollectorSharding,
 			ActiveFlowTimeout:      o.activeFlowTimeout,
 			IdleFlowTimeout:        o.idleFlowTimeout,
 			StaleConnectionTimeout: o.staleConnectionTimeout,
@@ -869,6 +870,9 @@ func run(o *Options) error {
 	}
 
 	go networkPolicyController.Run(stopCh)
+	if l7NetworkPolicyEnabled {
+		go l7Reconciler.Run(stopCh)
+	}
 	if o.enableEgress {
 		go egressController.Run(stopCh)
 	}
@@ -289,6 +289,11 @@ func (o *Options) validateFlowExporterConfig() error {
 		}
 		o.flowCollectorAddr = net.JoinHostPort(host, port)
 		o.flowCollectorProto = proto
+		if o.config.FlowExporter.FlowCollectorSharding {
+			if ns, _ := k8s.SplitNamespacedName(host); ns == "" {
+				return fmt.Errorf("flowCollectorSharding requires flowCollectorAddr to be a Service reference (<Service namespace>/<Service name>)")
+			}
+		}
 
 		// Parse the given flowPollInterval config
 		if o.config.FlowExporter.FlowPollInterval != "" {
@@ -178,7 +178,7 @@ func run(o *Options) error {
 	egressGroupStore := egressstore.NewEgressGroupStore()
 	groupStore := store.NewGroupStore()
 	groupEntityIndex := grouping.NewGroupEntityIndex()
-	groupEntityController := grouping.NewGroupEntityController(groupEntityIndex, podInformer, namespaceInformer, eeInformer)
+	groupEntityController := grouping.NewGroupEntityController(groupEntityIndex, podInformer, namespaceInformer, eeInformer, serviceInformer)
 	labelIdentityIndex := labelidentity.NewLabelIdentityIndex()
 	networkPolicyController := networkpolicy.NewNetworkPolicyController(client,
 		crdClient,
@@ -213,9 +213,25 @@ func run(o *Options) error {
 		bundleCollectionController = supportbundlecollection.NewSupportBundleCollectionController(client, crdClient, bundleCollectionInformer, nodeInformer, externalNodeInformer, bundleCollectionStore)
 	}
 
+	// statsAggregator takes stats summaries from antrea-agents, aggregates them, and serves the Stats APIs with the
+	// aggregated data. For now it's only used for NetworkPolicy stats.
+	var statsAggregator *stats.Aggregator
+	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
+		var ruleMetricsRecorder stats.RuleMetricsRecorder
+		if *o.config.EnablePrometheusMetrics && o.config.NetworkPolicyRuleMetrics.Enable {
+			ruleMetricsRecorder = metrics.NewNetworkPolicyRuleMetrics(acnpInformer.Lister(), annpInformer.Lister(), o.config.NetworkPolicyRuleMetrics.MaxRules)
+		}
+		statsAggregator = stats.NewAggregator(networkPolicyInformer, acnpInformer, annpInformer, ruleMetricsRecorder)
+	}
+
 	var networkPolicyStatusController *networkpolicy.StatusController
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
-		networkPolicyStatusController = networkpolicy.NewStatusController(crdClient, networkPolicyStore, acnpInformer, annpInformer)
+		// The rule stats are used to report the hits of the rules of policies in Audit mode.
+		var ruleStatsProvider networkpolicy.RuleStatsProvider
+		if statsAggregator != nil {
+			ruleStatsProvider = statsAggregator
+		}
+		networkPolicyStatusController = networkpolicy.NewStatusController(crdClient, networkPolicyStore, acnpInformer, annpInformer, ruleStatsProvider)
 	}
 
 	endpointQuerier := networkpolicy.NewEndpointQuerier(networkPolicyController)
@@ -263,13 +279,6 @@ func run(o *Options) error {
 		traceflowController = traceflow.NewTraceflowController(crdClient, podInformer, tfInformer)
 	}
 
-	// statsAggregator takes stats summaries from antrea-agents, aggregates them, and serves the Stats APIs with the
-	// aggregated data. For now it's only used for NetworkPolicy stats.
-	var statsAggregator *stats.Aggregator
-	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
-		statsAggregator = stats.NewAggregator(networkPolicyInformer, acnpInformer, annpInformer)
-	}
-
 	cipherSuites, err := cipher.GenerateCipherSuitesList(o.config.TLSCipherSuites)
 	if err != nil {
 		return fmt.Errorf("error generating Cipher Suite list: %v", err)
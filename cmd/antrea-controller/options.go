@@ -46,6 +46,8 @@ const (
 	ipamIPv6MaskLo      = 64
 	ipamIPv6MaskHi      = 126
 	ipamIPv6MaskDefault = 64
+
+	defaultNetworkPolicyRuleMetricsMaxRules = 1000
 )
 
 type Options struct {
@@ -105,6 +107,15 @@ func (o *Options) validate(args []string) error {
 		klog.InfoS("Multicluster feature gate is disabled. Multicluster.EnableStretchedNetworkPolicy is ignored")
 	}
 
+	if o.config.NetworkPolicyRuleMetrics.Enable {
+		if o.config.NetworkPolicyRuleMetrics.MaxRules < 0 {
+			return fmt.Errorf("networkPolicyRuleMetrics.maxRules %d is invalid, it must not be negative", o.config.NetworkPolicyRuleMetrics.MaxRules)
+		}
+		if !features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) || !*o.config.EnablePrometheusMetrics {
+			klog.InfoS("NetworkPolicyStats feature gate or Prometheus metrics is disabled. NetworkPolicyRuleMetrics.Enable is ignored")
+		}
+	}
+
 	return nil
 }
 
@@ -216,6 +227,9 @@ func (o *Options) setDefaults() {
 	if o.config.ClientConnection.Burst == 0 {
 		o.config.ClientConnection.Burst = defaultClientBurst
 	}
+	if o.config.NetworkPolicyRuleMetrics.MaxRules == 0 {
+		o.config.NetworkPolicyRuleMetrics.MaxRules = defaultNetworkPolicyRuleMetricsMaxRules
+	}
 }
 
 func ptrBool(value bool) *bool {
@@ -44,6 +44,7 @@ import (
 	mcv1alpha2 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha2"
 	"antrea.io/antrea/multicluster/controllers/multicluster/common"
 	"antrea.io/antrea/multicluster/controllers/multicluster/commonarea"
+	"antrea.io/antrea/pkg/controller/grouping"
 )
 
 // LabelIdentityReconciler watches relevant Pod and Namespace events in the member cluster,
@@ -107,7 +108,7 @@ func (r *LabelIdentityReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		klog.ErrorS(err, "Cannot get corresponding Namespace of the Pod", "pod", req.NamespacedName)
 		return ctrl.Result{}, err
 	}
-	normalizedLabel := GetNormalizedLabel(ns.Labels, pod.Labels, ns.Name)
+	normalizedLabel := GetNormalizedLabel(ns.Labels, GetPodIdentityLabels(&pod), ns.Name)
 	r.onPodCreateOrUpdate(req.NamespacedName.String(), normalizedLabel)
 	return ctrl.Result{}, nil
 }
@@ -355,6 +356,21 @@ func (r *LabelIdentityReconciler) getLabelIdentityResourceExport(name, normalize
 	}
 }
 
+// GetPodIdentityLabels returns the labels of a Pod used to compute its LabelIdentity. In addition to the labels of the
+// Pod, they include the ServiceAccount of the Pod, with the same custom label used by the Antrea Controller to select
+// Pods by ServiceAccount, so that Pods in other clusters can be selected by their workload identity.
+func GetPodIdentityLabels(pod *v1.Pod) map[string]string {
+	if pod.Spec.ServiceAccountName == "" {
+		return pod.Labels
+	}
+	podLabels := make(map[string]string, len(pod.Labels)+1)
+	for k, v := range pod.Labels {
+		podLabels[k] = v
+	}
+	podLabels[grouping.CustomLabelKeyPrefix+grouping.CustomLabelKeyServiceAccount] = pod.Spec.ServiceAccountName
+	return podLabels
+}
+
 func GetNormalizedLabel(nsLabels, podLabels map[string]string, ns string) string {
 	if _, ok := nsLabels[v1.LabelMetadataName]; !ok {
 		// NamespaceDefaultLabelName is supported from K8s v1.21. For K8s versions before v1.21,
@@ -359,3 +359,16 @@ func TestClusterSetMapFunc_LabelIdentity(t *testing.T) {
 	assert.Equal(t, 0, len(r.labelToPodsCache))
 	assert.Equal(t, 0, len(r.labelToPodsCache))
 }
+
+func TestGetPodIdentityLabels(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "pod", Labels: map[string]string{"purpose": "test"}},
+	}
+	assert.Equal(t, map[string]string{"purpose": "test"}, GetPodIdentityLabels(pod))
+	pod.Spec.ServiceAccountName = "sa"
+	assert.Equal(t, map[string]string{"purpose": "test", "internal.antrea.io/service-account": "sa"}, GetPodIdentityLabels(pod))
+	// The labels of the Pod must not be modified.
+	assert.Equal(t, map[string]string{"purpose": "test"}, pod.Labels)
+	assert.Equal(t, "ns:kubernetes.io/metadata.name=test-ns&pod:internal.antrea.io/service-account=sa,purpose=test",
+		GetNormalizedLabel(map[string]string{}, GetPodIdentityLabels(pod), "test-ns"))
+}
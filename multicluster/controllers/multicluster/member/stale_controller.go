@@ -330,7 +330,7 @@ func (c *StaleResCleanupController) cleanUpLabelIdentityResourceExports(ctx cont
 		if !ok {
 			continue
 		}
-		normalizedLabel := podNSlabel + "&pod:" + labels.Set(p.Labels).String()
+		normalizedLabel := podNSlabel + "&pod:" + labels.Set(GetPodIdentityLabels(&p)).String()
 		delete(staleResExpItems, normalizedLabel)
 	}
 	for _, r := range staleResExpItems {
@@ -56,6 +56,8 @@ const (
 	L7RedirectTargetPortName = "antrea-l7-tap0"
 	L7RedirectReturnPortName = "antrea-l7-tap1"
 	L7SuricataSocketPath     = "/var/run/suricata/suricata_eve.socket"
+	// L7SuricataAlertSocketPath is the path of the socket used to receive alerts from Suricata.
+	L7SuricataAlertSocketPath = "/var/run/suricata/suricata_alert.socket"
 )
 
 const (
@@ -250,13 +250,20 @@ func getNetworkPolicyInfo(pktIn *ofctrl.PacketIn, packet *binding.Packet, c *Con
 		}
 	}
 
+	// Get audit mark, if traffic matched a rule of a policy in Audit mode, disposition log should be prefixed with
+	// "Audit", e.g. "AuditDrop" or "AuditAllow".
+	isAudit := isAuditPacket(matchers)
+	if isAudit {
+		ob.disposition = auditDisposition + ob.disposition
+	}
+
 	// Get K8s default deny action, if traffic is default deny, no conjunction could be matched.
 	if match = getMatchRegField(matchers, openflow.APDenyRegMark.GetField()); match != nil {
 		apDenyRegVal, err := getInfoInReg(match, openflow.APDenyRegMark.GetField().GetRange().ToNXRange())
 		if err != nil {
 			return fmt.Errorf("received error while unloading deny mark from reg: %v", err)
 		}
-		isK8sDefaultDeny := (apDenyRegVal == 0) && (disposition == openflow.DispositionDrop || disposition == openflow.DispositionRej)
+		isK8sDefaultDeny := !isAudit && (apDenyRegVal == 0) && (disposition == openflow.DispositionDrop || disposition == openflow.DispositionRej)
 		if isK8sDefaultDeny {
 			// For K8s NetworkPolicy implicit drop action, we cannot get Namespace/name.
 			ob.npRef = string(v1beta2.K8sNetworkPolicy)
@@ -233,6 +233,8 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 	dropCNPDispositionData := []byte{0x11, 0x00, 0x0c, 0x11}
 	dropK8sDispositionData := []byte{0x11, 0x00, 0x08, 0x11}
 	redirectDispositionData := []byte{0x11, 0x10, 0x00, 0x11}
+	auditDropDispositionData := []byte{0x11, 0x00, 0x88, 0x11}
+	auditAllowDispositionData := []byte{0x11, 0x00, 0x80, 0x11}
 	// use 4 bytes of data for the conjunction identifier, this will be used for one of
 	// the following registers depending on the test case:
 	// openflow.APConjIDField, openflow.TFEgressConjIDField, openflow.TFIngressConjIDField
@@ -361,6 +363,44 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 				logLabel:     nullPlaceholder,
 			},
 		},
+		{
+			name:    "ANNP Audit Drop",
+			tableID: openflow.AntreaPolicyIngressAuditTable.GetID(),
+			expectedCalls: func(mockClient *openflowtesting.MockClientMockRecorder) {
+				mockClient.GetPolicyInfoFromConjunction(gomock.Any()).Return(
+					true, testANNPRef, testPriority, testRule, testLogLabel)
+			},
+			dispositionData: auditDropDispositionData,
+			wantOb: &logInfo{
+				tableName:    openflow.AntreaPolicyIngressAuditTable.GetName(),
+				disposition:  auditDisposition + actionDrop,
+				npRef:        testANNPRef.ToString(),
+				ofPriority:   testPriority,
+				ruleName:     testRule,
+				direction:    "Ingress",
+				appliedToRef: "default/destPod",
+				logLabel:     testLogLabel,
+			},
+		},
+		{
+			name:    "ANNP Audit Allow Egress",
+			tableID: openflow.AntreaPolicyEgressAuditTable.GetID(),
+			expectedCalls: func(mockClient *openflowtesting.MockClientMockRecorder) {
+				mockClient.GetPolicyInfoFromConjunction(gomock.Any()).Return(
+					true, testANNPRef, testPriority, testRule, testLogLabel)
+			},
+			dispositionData: auditAllowDispositionData,
+			wantOb: &logInfo{
+				tableName:    openflow.AntreaPolicyEgressAuditTable.GetName(),
+				disposition:  auditDisposition + actionAllow,
+				npRef:        testANNPRef.ToString(),
+				ofPriority:   testPriority,
+				ruleName:     testRule,
+				direction:    "Egress",
+				appliedToRef: "default/srcPod",
+				logLabel:     testLogLabel,
+			},
+		},
 		{
 			name:    "ANNP Redirect",
 			tableID: openflow.AntreaPolicyIngressRuleTable.GetID(),
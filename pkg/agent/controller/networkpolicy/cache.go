@@ -100,6 +100,18 @@ type rule struct {
 	EnableLogging bool
 	// LogLabel is a string associated to the NetworkPolicy rule. Used for logging.
 	LogLabel string
+	// EnforcementMode of the NetworkPolicy to which this rule belongs. Empty for K8s NetworkPolicy.
+	EnforcementMode crdv1beta1.PolicyEnforcementMode
+	// RateLimit of this rule. nil if the traffic matched by the rule is not rate limited.
+	RateLimit *v1beta.RuleRateLimit
+	// RejectResponse of this rule. Empty for the default reject response.
+	RejectResponse crdv1beta1.RejectResponseType
+}
+
+// isAudit returns whether the rule belongs to an Antrea-native policy in Audit mode, in which case
+// the rule is not enforced, whatever its action, and matching traffic is only logged.
+func (r *rule) isAudit() bool {
+	return r.Action != nil && r.EnforcementMode == crdv1beta1.PolicyEnforcementModeAudit
 }
 
 func (r *rule) Less(r2 *rule) bool {
@@ -717,6 +729,9 @@ func toRule(r *v1beta.NetworkPolicyRule, policy *v1beta.NetworkPolicy, maxPriori
 		SourceRef:       policy.SourceRef,
 		EnableLogging:   r.EnableLogging,
 		LogLabel:        r.LogLabel,
+		EnforcementMode: policy.EnforcementMode,
+		RateLimit:       r.RateLimit,
+		RejectResponse:  r.RejectResponse,
 	}
 	rule.ID = hashRule(rule)
 	rule.PolicyName = policy.Name
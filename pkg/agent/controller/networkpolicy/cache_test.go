@@ -29,6 +29,7 @@ import (
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/util/channel"
 	"antrea.io/antrea/pkg/util/k8s"
 )
@@ -220,6 +221,31 @@ func newAddressGroupPodMember(name, namespace string, ips ...string) *v1beta2.Gr
 	return &v1beta2.GroupMember{Pod: pod, IPs: ipAddrs}
 }
 
+func TestRuleIsAudit(t *testing.T) {
+	allow, drop, reject, pass := crdv1beta1.RuleActionAllow, crdv1beta1.RuleActionDrop, crdv1beta1.RuleActionReject, crdv1beta1.RuleActionPass
+	tests := []struct {
+		name            string
+		action          *crdv1beta1.RuleAction
+		enforcementMode crdv1beta1.PolicyEnforcementMode
+		want            bool
+	}{
+		{"k8s rule", nil, "", false},
+		{"drop rule in enforce mode", &drop, crdv1beta1.PolicyEnforcementModeEnforce, false},
+		{"drop rule in default mode", &drop, "", false},
+		{"drop rule in audit mode", &drop, crdv1beta1.PolicyEnforcementModeAudit, true},
+		{"reject rule in audit mode", &reject, crdv1beta1.PolicyEnforcementModeAudit, true},
+		{"allow rule in enforce mode", &allow, crdv1beta1.PolicyEnforcementModeEnforce, false},
+		{"allow rule in audit mode", &allow, crdv1beta1.PolicyEnforcementModeAudit, true},
+		{"pass rule in audit mode", &pass, crdv1beta1.PolicyEnforcementModeAudit, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &rule{Action: tt.action, EnforcementMode: tt.enforcementMode}
+			assert.Equal(t, tt.want, r.isAudit())
+		})
+	}
+}
+
 func TestRuleCacheAddAddressGroup(t *testing.T) {
 	rule1 := &rule{
 		ID:   "rule1",
@@ -22,16 +22,19 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/miekg/dns"
 	"github.com/spf13/afero"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/openflow"
 	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	"antrea.io/antrea/pkg/util/logdir"
@@ -45,15 +48,59 @@ const (
 
 	tenantConfigsDir = "/etc/suricata"
 	tenantRulesDir   = "/etc/suricata/rules"
+	defaultRulesFile = "antrea-default.rules"
 
 	suricataCommandSocket = "/var/run/suricata/suricata-command.socket"
 
 	protocolHTTP = "http"
 	protocolTLS  = "tls"
+	protocolDNS  = "dns"
+	// Kafka requests are matched in the TCP payload as the L7 engine has no Kafka parser.
+	protocolKafka = "kafka"
+	// protocolHTTP1 matches HTTP/1 only, while protocolHTTP matches both HTTP/1 and HTTP/2.
+	protocolHTTP1 = "http1"
+	// gRPC requests are matched by the HTTP/2 parser of Suricata.
+	protocolHTTP2 = "http2"
+
+	grpcContentType = "application/grpc"
+
+	kafkaAPIKeyProduce  = 0
+	kafkaAPIKeyFetch    = 1
+	kafkaAPIKeyMetadata = 3
+	// kafkaRequestKeywords matches Kafka produce, fetch and metadata requests, whose API key follows
+	// the 4-byte length of a request.
+	kafkaRequestKeywords = `flow: to_server, established; pcre:"/^.{4}\x00[\x00\x01\x03]/s";`
+	// kafkaSupportedRequestPCRE matches Kafka produce, fetch, metadata and API versions (API key 18) requests.
+	kafkaSupportedRequestPCRE = `/^.{4}\x00[\x00\x01\x03\x12]/s`
+	// kafkaOtherRequestPCRE matches the other Kafka requests whose length is less than 16MB.
+	kafkaOtherRequestPCRE = `/^\x00.{3}\x00[\x02\x04-\x11\x13-\x7f]\x00/s`
+	// kafkaFlowbit is set on the flows in which a Kafka request has been seen, so that the segments of these flows
+	// which don't start with a request, e.g. the continuation of a large produce request, are not rejected by the
+	// default reject rule.
+	kafkaFlowbit = "antrea_kafka"
+
+	ipProtocolTCP = 6
+	ipProtocolUDP = 17
 
 	scCmdOK = "OK"
 )
 
+// kafkaTopicLayout describes the location of the topics array in a range of versions of a Kafka request.
+type kafkaTopicLayout struct {
+	apiKey     int
+	minVersion int
+	maxVersion int
+	// flexible is true if the request uses a request header with tagged fields, compact strings and compact arrays.
+	flexible bool
+	// transactionalID is true if the request header is followed by a transactional ID.
+	transactionalID bool
+	// topicsOffset is the number of bytes between the end of the request header, or the transactional ID, and the
+	// topics array.
+	topicsOffset int
+	// topicID is true if the name of a topic is preceded by its 16-byte ID.
+	topicID bool
+}
+
 type scCmdRet struct {
 	Message string `json:"message"`
 	Return  string `json:"return"`
@@ -63,8 +110,44 @@ var (
 	// Declared as a variable for testing.
 	defaultFS = afero.NewOsFs()
 
+	kafkaAPIKeys = map[string]int{
+		"produce":  kafkaAPIKeyProduce,
+		"fetch":    kafkaAPIKeyFetch,
+		"metadata": kafkaAPIKeyMetadata,
+	}
+
+	// kafkaTopicLayouts describes the location of the topics array in the supported versions of Kafka produce, fetch
+	// and metadata requests. Fetch requests of version 13 or later identify topics by IDs instead of names, hence they
+	// cannot be matched by topic.
+	kafkaTopicLayouts = []kafkaTopicLayout{
+		// acks (2), timeout (4).
+		{apiKey: kafkaAPIKeyProduce, minVersion: 0, maxVersion: 2, topicsOffset: 6},
+		{apiKey: kafkaAPIKeyProduce, minVersion: 3, maxVersion: 8, transactionalID: true, topicsOffset: 6},
+		{apiKey: kafkaAPIKeyProduce, minVersion: 9, maxVersion: 12, flexible: true, transactionalID: true, topicsOffset: 6},
+		// replica ID (4), max wait (4), min bytes (4).
+		{apiKey: kafkaAPIKeyFetch, minVersion: 0, maxVersion: 2, topicsOffset: 12},
+		// Followed by max bytes (4) since version 3, isolation level (1) since version 4, session ID (4) and epoch (4)
+		// since version 7.
+		{apiKey: kafkaAPIKeyFetch, minVersion: 3, maxVersion: 3, topicsOffset: 16},
+		{apiKey: kafkaAPIKeyFetch, minVersion: 4, maxVersion: 6, topicsOffset: 17},
+		{apiKey: kafkaAPIKeyFetch, minVersion: 7, maxVersion: 11, topicsOffset: 25},
+		{apiKey: kafkaAPIKeyFetch, minVersion: 12, maxVersion: 12, flexible: true, topicsOffset: 25},
+		// The topics array directly follows the request header.
+		{apiKey: kafkaAPIKeyMetadata, minVersion: 0, maxVersion: 8},
+		{apiKey: kafkaAPIKeyMetadata, minVersion: 9, maxVersion: 9, flexible: true},
+		{apiKey: kafkaAPIKeyMetadata, minVersion: 10, maxVersion: 13, flexible: true, topicID: true},
+	}
+
+	// The rules of the default tenant, which inspects the traffic mirrored by the L7 flow exporter. As the L7 engine
+	// cannot log Kafka events, an alert is generated for every Kafka request, whose payload is parsed by the L7 flow
+	// exporter.
+	defaultRulesData = fmt.Sprintf(`alert tcp any any -> any any (msg: "Kafka request"; %s metadata: antrea_l7_protocol %s; sid: 1;)
+`, kafkaRequestKeywords, protocolKafka)
+
 	// Create the config file /etc/suricata/antrea.yaml for Antrea which will be included in the default Suricata config file
-	// /etc/suricata/suricata.yaml. Two event logs in the config serve alert gilogging and http event logging purposes respectively.
+	// /etc/suricata/suricata.yaml. The first two event logs in the config serve alert logging and http event logging purposes
+	// respectively. The second one also sends the alerts of Kafka requests for L7 flow export. The third one sends alerts to
+	// Antrea, so that it can respond to rejected HTTP requests and DNS queries.
 	suricataAntreaConfigData = fmt.Sprintf(`%%YAML 1.1
 ---
 outputs:
@@ -85,6 +168,7 @@ outputs:
             extended: yes
         - tls:
             extended: yes
+        - dns
   - eve-log:
       enabled: yes
       filetype: unix_stream
@@ -97,6 +181,22 @@ outputs:
       types:
         - http:
             extended: yes
+        - alert:
+            payload: yes
+            metadata: yes
+  - eve-log:
+      enabled: yes
+      filetype: unix_stream
+      filename: %[4]s
+      pcap-file: false
+      community-id: false
+      community-id-seed: 0
+      xff:
+        enabled: no
+      types:
+        - alert:
+            packet: yes
+            metadata: yes
 af-packet:
   - interface: %[2]s
     threads: auto
@@ -121,7 +221,10 @@ af-packet:
 multi-detect:
   enabled: yes
   selector: vlan
-`, config.L7SuricataSocketPath, config.L7RedirectTargetPortName, config.L7RedirectReturnPortName)
+default-rule-path: %[5]s
+rule-files:
+  - %[6]s
+`, config.L7SuricataSocketPath, config.L7RedirectTargetPortName, config.L7RedirectReturnPortName, config.L7SuricataAlertSocketPath, tenantRulesDir, defaultRulesFile)
 )
 
 type threadSafeSet[T comparable] struct {
@@ -157,11 +260,13 @@ type Reconciler struct {
 
 	ofClient openflow.Client
 
+	responder *responder
+
 	startSuricataOnce     utilsync.OnceWithNoError
 	initializeL7FlowsOnce utilsync.OnceWithNoError
 }
 
-func NewReconciler(ofClient openflow.Client) *Reconciler {
+func NewReconciler(ofClient openflow.Client, ifaceStore interfacestore.InterfaceStore) *Reconciler {
 	return &Reconciler{
 		suricataScFn:    suricataSc,
 		startSuricataFn: startSuricata,
@@ -171,20 +276,66 @@ func NewReconciler(ofClient openflow.Client) *Reconciler {
 		suricataTenantHandlerCache: &threadSafeSet[uint32]{
 			cached: sets.New[uint32](),
 		},
-		ofClient: ofClient,
+		ofClient:  ofClient,
+		responder: newResponder(ofClient, ifaceStore),
 	}
 }
 
-func generateTenantRulesData(policyName string, protoKeywords map[string]sets.Set[string]) *bytes.Buffer {
+// Run runs the components of the Reconciler which process the events of the application-aware engine.
+func (r *Reconciler) Run(stopCh <-chan struct{}) {
+	r.responder.Run(stopCh)
+}
+
+func generateTenantRulesData(policyName string, protoKeywords map[string]sets.Set[string], kafkaProtocols []*v1beta.KafkaProtocol, rejectStatusCode int32, dnsRejectResponseCode string) *bytes.Buffer {
 	rulesData := bytes.NewBuffer(nil)
 	sid := 1
 
-	// Generate default reject rule.
-	allKeywords := fmt.Sprintf(`msg: "Reject by %s"; flow: to_server, established; sid: %d;`, policyName, sid)
+	// Generate default reject rule. If Kafka requests are allowed, the Kafka flows are excluded from it, as their
+	// requests are matched by the Kafka rules below.
+	rejectKeywords := "flow: to_server, established;"
+	if len(kafkaProtocols) != 0 {
+		rejectKeywords = fmt.Sprintf(`%s flowbits: isnotset, %s; pcre:!"%s";`, rejectKeywords, kafkaFlowbit, kafkaSupportedRequestPCRE)
+	}
+	allKeywords := fmt.Sprintf(`msg: "Reject by %s"; %s sid: %d;`, policyName, rejectKeywords, sid)
 	rule := fmt.Sprintf("reject ip any any -> any any (%s)\n", allKeywords)
 	rulesData.WriteString(rule)
 	sid++
 
+	// Generate the rule to drop HTTP requests which should be responded with a specific status code. As drop rules take
+	// precedence over reject rules, these requests are not rejected with TCP resets, instead the alerts of the rule are
+	// processed by the responder, which sends the HTTP responses to the clients.
+	if rejectStatusCode != 0 {
+		allKeywords = fmt.Sprintf(`msg: "Reject by %s"; flow: to_server, established; metadata: %s %d; sid: %d;`, policyName, rejectStatusCodeMetadataKey, rejectStatusCode, sid)
+		rule = fmt.Sprintf("drop %s any any -> any any (%s)\n", protocolHTTP1, allKeywords)
+		rulesData.WriteString(rule)
+		sid++
+	}
+	// Generate the rule to drop DNS queries, which are responded with the given response code by the responder. Unlike
+	// the default reject rule, it also matches DNS queries over UDP, which are not sent in established flows.
+	if dnsRejectResponseCode != "" {
+		allKeywords = fmt.Sprintf(`msg: "Reject by %s"; flow: to_server; metadata: %s %s; sid: %d;`, policyName, rejectDNSResponseCodeMetadataKey, dnsRejectResponseCode, sid)
+		rule = fmt.Sprintf("drop %s any any -> any any (%s)\n", protocolDNS, allKeywords)
+		rulesData.WriteString(rule)
+		sid++
+	}
+	// Generate the rules for Kafka requests. As a pass rule matching a TCP packet passes the whole flow, including the
+	// subsequent requests, Kafka requests are not allowed by pass rules. Instead, every request which is not allowed is
+	// rejected, including the requests other than produce, fetch, metadata and API versions requests. API versions
+	// requests, which are sent by all Kafka clients to negotiate the versions of other requests, are never rejected.
+	if len(kafkaProtocols) != 0 {
+		allKeywords = fmt.Sprintf(`msg: "Kafka request by %s"; flow: to_server, established; pcre:"%s"; flowbits: set, %s; noalert; sid: %d;`, policyName, kafkaSupportedRequestPCRE, kafkaFlowbit, sid)
+		rule = fmt.Sprintf("alert tcp any any -> any any (%s)\n", allKeywords)
+		rulesData.WriteString(rule)
+		sid++
+		kafkaRejectKeywords := append([]string{fmt.Sprintf(`flow: to_server, established; flowbits: isset, %s; pcre:"%s";`, kafkaFlowbit, kafkaOtherRequestPCRE)}, convertProtocolKafka(kafkaProtocols)...)
+		for _, keywords := range kafkaRejectKeywords {
+			allKeywords = fmt.Sprintf(`msg: "Reject by %s"; %s sid: %d;`, policyName, keywords, sid)
+			rule = fmt.Sprintf("reject tcp any any -> any any (%s)\n", allKeywords)
+			rulesData.WriteString(rule)
+			sid++
+		}
+	}
+
 	// Generate rules.
 	for proto, keywordsSet := range protoKeywords {
 		for keywords := range keywordsSet {
@@ -241,13 +392,32 @@ func convertContent(content string) string {
 		endsWith = ""
 		content = content[:len(content)-1]
 	}
-	return fmt.Sprintf(`content:"%s";%s%s`, content, startsWith, endsWith)
+	return fmt.Sprintf(`content:"%s";%s%s`, escapeContent(content), startsWith, endsWith)
+}
+
+// contentEscaper replaces the characters which have a special meaning in a Suricata content with their hex
+// representation, "|" delimiting hex bytes, and ";", "\" and '"' delimiting or escaping keyword values.
+var contentEscaper = strings.NewReplacer(`|`, `|7c|`, `;`, `|3b|`, `\`, `|5c|`, `"`, `|22|`)
+
+// escapeContent escapes a string so that it can be matched literally in a Suricata content.
+func escapeContent(content string) string {
+	return contentEscaper.Replace(content)
+}
+
+// convertPCREContent escapes a string so that it can be matched literally in a PCRE delimited by slashes.
+func convertPCREContent(content string) string {
+	return strings.ReplaceAll(regexp.QuoteMeta(content), "/", `\/`)
 }
 
 func convertProtocolHTTP(http *v1beta.HTTPProtocol) string {
 	var keywords []string
 	if http.Path != "" {
-		keywords = append(keywords, fmt.Sprintf("http.uri; %s", convertContent(http.Path)))
+		path := http.Path
+		// The URI contains the query string, which must follow the path if query parameters are matched.
+		if len(http.QueryParams) != 0 && !strings.HasSuffix(path, "*") {
+			path += "?*"
+		}
+		keywords = append(keywords, fmt.Sprintf("http.uri; %s", convertContent(path)))
 	}
 	if http.Method != "" {
 		keywords = append(keywords, fmt.Sprintf(`http.method; content:"%s";`, http.Method))
@@ -255,6 +425,30 @@ func convertProtocolHTTP(http *v1beta.HTTPProtocol) string {
 	if http.Host != "" {
 		keywords = append(keywords, fmt.Sprintf("http.host; %s", convertContent(http.Host)))
 	}
+	if http.UserAgent != "" {
+		keywords = append(keywords, fmt.Sprintf("http.user_agent; %s", convertContent(http.UserAgent)))
+	}
+	// The normalized header buffer contains a "<name>: <value>\r\n" line for each header. Header names are
+	// case-insensitive.
+	for _, header := range http.Headers {
+		name := convertPCREContent(header.Name)
+		switch {
+		case header.Value != "":
+			keywords = append(keywords, fmt.Sprintf(`http.header; pcre:"/(?:^|\r\n)(?i:%s): %s(?:\r\n|$)/";`, name, convertPCREContent(header.Value)))
+		case header.Regex != "":
+			keywords = append(keywords, fmt.Sprintf(`http.header; pcre:"/(?:^|\r\n)(?i:%s): (?:%s)(?:\r\n|$)/";`, name, header.Regex))
+		default:
+			keywords = append(keywords, fmt.Sprintf(`http.header; pcre:"/(?:^|\r\n)(?i:%s):/";`, name))
+		}
+	}
+	for _, param := range http.QueryParams {
+		name := convertPCREContent(param.Name)
+		if param.Value != "" {
+			keywords = append(keywords, fmt.Sprintf(`http.uri; pcre:"/[?&]%s=%s(?:&|$)/";`, name, convertPCREContent(param.Value)))
+		} else {
+			keywords = append(keywords, fmt.Sprintf(`http.uri; pcre:"/[?&]%s(?:[=&]|$)/";`, name))
+		}
+	}
 	return strings.Join(keywords, " ")
 }
 
@@ -266,6 +460,182 @@ func convertProtocolTLS(tls *v1beta.TLSProtocol) string {
 	return strings.Join(keywords, " ")
 }
 
+// convertProtocolGRPC converts a gRPC protocol to Suricata keywords. A gRPC request is an HTTP/2 POST
+// request whose path is "/<service>/<method>", and whose metadata are carried in HTTP/2 headers.
+func convertProtocolGRPC(grpc *v1beta.GRPCProtocol) string {
+	keywords := []string{fmt.Sprintf(`http.request_header; content:"content-type: %s"; startswith;`, grpcContentType)}
+	switch {
+	case grpc.Service != "" && grpc.Method != "":
+		keywords = append(keywords, fmt.Sprintf(`http.uri; content:"/%s/%s"; startswith; endswith;`, grpc.Service, grpc.Method))
+	case grpc.Service != "":
+		keywords = append(keywords, fmt.Sprintf(`http.uri; content:"/%s/"; startswith;`, grpc.Service))
+	case grpc.Method != "":
+		keywords = append(keywords, fmt.Sprintf(`http.uri; content:"/%s"; endswith;`, grpc.Method))
+	}
+	for _, m := range grpc.Metadata {
+		// HTTP/2 header names are always in lowercase.
+		name := strings.ToLower(m.Name)
+		if m.Value == "" {
+			keywords = append(keywords, fmt.Sprintf(`http.request_header; content:"%s: "; startswith;`, name))
+		} else {
+			keywords = append(keywords, fmt.Sprintf(`http.request_header; content:"%s: %s"; startswith; endswith;`, name, escapeContent(m.Value)))
+		}
+	}
+	return strings.Join(keywords, " ")
+}
+
+// convertProtocolDNS converts a DNS protocol to Suricata keywords. As the L7 engine cannot match the record type of a
+// DNS query, it is matched in the payload, in which the type follows the query name at the beginning of the question
+// section. The question section starts at offset 12 of a DNS message, which is preceded by a 2-byte length field when
+// sent over TCP, hence different keywords are returned for UDP and TCP if record types are matched.
+func convertProtocolDNS(dnsProtocol *v1beta.DNSProtocol) []string {
+	var nameKeywords string
+	if dnsProtocol.QueryName != "" {
+		nameKeywords = fmt.Sprintf("dns.query; %s nocase;", convertContent(strings.TrimSuffix(dnsProtocol.QueryName, ".")))
+	}
+	if len(dnsProtocol.RecordTypes) == 0 {
+		return []string{nameKeywords}
+	}
+	recordTypes := make([]string, 0, len(dnsProtocol.RecordTypes))
+	for _, t := range dnsProtocol.RecordTypes {
+		recordType := dns.StringToType[t]
+		recordTypes = append(recordTypes, fmt.Sprintf(`\x%02x\x%02x`, recordType>>8, recordType&0xff))
+	}
+	// The query name in the question section is a sequence of non-empty labels terminated by a zero byte.
+	typeKeywords := func(ipProto, offset int) string {
+		keywords := fmt.Sprintf(`ip_proto:%d; pcre:"/^.{%d}[^\x00]*\x00(?:%s)/s";`, ipProto, offset, strings.Join(recordTypes, "|"))
+		if nameKeywords != "" {
+			keywords += " " + nameKeywords
+		}
+		return keywords
+	}
+	return []string{typeKeywords(ipProtocolUDP, 12), typeKeywords(ipProtocolTCP, 14)}
+}
+
+// convertProtocolKafka converts the Kafka protocols of a rule to the keywords of the rules rejecting the Kafka produce,
+// fetch and metadata requests which are not allowed by any of them. A Kafka request starts with a 4-byte length,
+// followed by a 2-byte API key, a 2-byte API version, a 4-byte correlation ID and a client ID prefixed with a 2-byte
+// length. If a type of requests is only allowed for some topics, the topics array of the requests is matched at its
+// offset, which depends on the API key and version of the request, and a request is rejected unless its only topic is
+// one of them. The requests which cannot be matched by topic, e.g. newer versions of requests, are rejected.
+func convertProtocolKafka(kafkaProtocols []*v1beta.KafkaProtocol) []string {
+	allAPIKeys := []int{kafkaAPIKeyProduce, kafkaAPIKeyFetch, kafkaAPIKeyMetadata}
+	allTopicsAPIKeys := sets.New[int]()
+	apiKeyTopics := make(map[int]sets.Set[string])
+	for _, kafka := range kafkaProtocols {
+		apiKeys := allAPIKeys
+		if kafka.APIKey != "" {
+			apiKeys = []int{kafkaAPIKeys[kafka.APIKey]}
+		}
+		for _, apiKey := range apiKeys {
+			if kafka.Topic == "" {
+				allTopicsAPIKeys.Insert(apiKey)
+				continue
+			}
+			if _, ok := apiKeyTopics[apiKey]; !ok {
+				apiKeyTopics[apiKey] = sets.New[string]()
+			}
+			apiKeyTopics[apiKey].Insert(kafka.Topic)
+		}
+	}
+	var keywords []string
+	for _, apiKey := range allAPIKeys {
+		if allTopicsAPIKeys.Has(apiKey) {
+			continue
+		}
+		apiKeyKeywords := fmt.Sprintf(`flow: to_server, established; content:"|00 %02x|"; offset: 4; depth: 2;`, apiKey)
+		topics, ok := apiKeyTopics[apiKey]
+		if !ok {
+			keywords = append(keywords, apiKeyKeywords)
+			continue
+		}
+		maxVersion := 0
+		for _, layout := range kafkaTopicLayouts {
+			if layout.apiKey == apiKey {
+				maxVersion = max(maxVersion, layout.maxVersion)
+			}
+		}
+		// The requests of newer versions, and the requests with a null client ID, cannot be matched by topic.
+		keywords = append(keywords,
+			fmt.Sprintf(`%s byte_test: 2, >, %d, 6;`, apiKeyKeywords, maxVersion),
+			fmt.Sprintf(`%s content:"|ff ff|"; offset: 12; depth: 2;`, apiKeyKeywords),
+		)
+		for _, layout := range kafkaTopicLayouts {
+			if layout.apiKey == apiKey {
+				keywords = append(keywords, convertKafkaTopicLayout(apiKeyKeywords, layout, sets.List(topics))...)
+			}
+		}
+	}
+	return keywords
+}
+
+// convertKafkaTopicLayout returns the keywords of the rules rejecting the requests of the given layout, unless their
+// topics array has a single topic, which is one of the given topics.
+func convertKafkaTopicLayout(apiKeyKeywords string, layout kafkaTopicLayout, topics []string) []string {
+	headerKeywords := apiKeyKeywords
+	if layout.minVersion == layout.maxVersion {
+		headerKeywords += fmt.Sprintf(" byte_test: 2, =, %d, 6;", layout.minVersion)
+	} else {
+		if layout.minVersion > 0 {
+			headerKeywords += fmt.Sprintf(" byte_test: 2, >=, %d, 6;", layout.minVersion)
+		}
+		headerKeywords += fmt.Sprintf(" byte_test: 2, <=, %d, 6;", layout.maxVersion)
+	}
+	headerKeywords += " byte_jump: 2, 12;"
+	var keywords []string
+	topicsCount := `\x00\x00\x00\x01`
+	if layout.flexible {
+		// The client ID is followed by the tagged fields of the request header, which must be empty.
+		keywords = append(keywords, headerKeywords+" byte_test: 1, >, 0, 0, relative;")
+		headerKeywords += ` content:"|00|"; distance: 0; within: 1;`
+		// The length of a compact array is encoded as an unsigned varint of the length plus 1.
+		topicsCount = `\x02`
+	}
+	topicsPrefix := ""
+	if layout.topicsOffset > 0 {
+		topicsPrefix = fmt.Sprintf(".{%d}", layout.topicsOffset)
+	}
+	topicID := ""
+	if layout.topicID {
+		topicID = ".{16}"
+	}
+	topicNames := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		topicNames = append(topicNames, convertKafkaString(topic, layout.flexible))
+	}
+	topicsKeywords := fmt.Sprintf(`pcre:!"/^%s%s%s(?:%s)/sR";`, topicsPrefix, topicsCount, topicID, strings.Join(topicNames, "|"))
+	if !layout.transactionalID {
+		return append(keywords, fmt.Sprintf("%s %s", headerKeywords, topicsKeywords))
+	}
+	// The transactional ID is a nullable string, which is either null or skipped according to its length.
+	if layout.flexible {
+		return append(keywords,
+			fmt.Sprintf(`%s content:"|00|"; distance: 0; within: 1; %s`, headerKeywords, topicsKeywords),
+			fmt.Sprintf(`%s byte_test: 1, >, 0, 0, relative; byte_test: 1, <, 128, 0, relative; byte_jump: 1, 0, relative, post_offset -1; %s`, headerKeywords, topicsKeywords),
+			// A transactional ID whose length is encoded in more than 1 byte cannot be skipped.
+			fmt.Sprintf(`%s byte_test: 1, >=, 128, 0, relative;`, headerKeywords),
+		)
+	}
+	return append(keywords,
+		fmt.Sprintf(`%s content:"|ff ff|"; distance: 0; within: 2; %s`, headerKeywords, topicsKeywords),
+		fmt.Sprintf(`%s byte_jump: 2, 0, relative; %s`, headerKeywords, topicsKeywords),
+	)
+}
+
+// convertKafkaString returns a PCRE matching a Kafka string, which is prefixed with a 2-byte length, or with an
+// unsigned varint of the length plus 1 if it is compact.
+func convertKafkaString(str string, compact bool) string {
+	length := len(str)
+	if !compact {
+		return fmt.Sprintf(`\x%02x\x%02x%s`, length>>8, length&0xff, convertPCREContent(str))
+	}
+	length++
+	if length < 0x80 {
+		return fmt.Sprintf(`\x%02x%s`, length, convertPCREContent(str))
+	}
+	return fmt.Sprintf(`\x%02x\x%02x%s`, length&0x7f|0x80, length>>7, convertPCREContent(str))
+}
+
 func (r *Reconciler) StartSuricataOnce() error {
 	return r.startSuricataOnce.Do(r.startSuricata)
 }
@@ -292,8 +662,15 @@ func (r *Reconciler) AddRule(ruleID, policyName string, vlanID uint32, l7Protoco
 
 	// Generate the keyword part used in Suricata rules.
 	protoKeywords := make(map[string]sets.Set[string])
+	var kafkaProtocols []*v1beta.KafkaProtocol
+	var rejectStatusCode int32
+	var dnsRejectResponseCode string
 	for _, protocol := range l7Protocols {
 		if protocol.HTTP != nil {
+			// The reject status code is validated to be the same in all HTTP protocols of a rule.
+			if protocol.HTTP.RejectStatusCode != 0 {
+				rejectStatusCode = protocol.HTTP.RejectStatusCode
+			}
 			httpKeywords := convertProtocolHTTP(protocol.HTTP)
 			if _, ok := protoKeywords[protocolHTTP]; !ok {
 				protoKeywords[protocolHTTP] = sets.New[string]()
@@ -307,12 +684,30 @@ func (r *Reconciler) AddRule(ruleID, policyName string, vlanID uint32, l7Protoco
 			}
 			protoKeywords[protocolTLS].Insert(tlsKeywords)
 		}
+		if protocol.GRPC != nil {
+			grpcKeywords := convertProtocolGRPC(protocol.GRPC)
+			if _, ok := protoKeywords[protocolHTTP2]; !ok {
+				protoKeywords[protocolHTTP2] = sets.New[string]()
+			}
+			protoKeywords[protocolHTTP2].Insert(grpcKeywords)
+		}
+		if protocol.DNS != nil {
+			// The reject response code is validated to be the same in all DNS protocols of a rule.
+			dnsRejectResponseCode = protocol.DNS.RejectResponseCode
+			if _, ok := protoKeywords[protocolDNS]; !ok {
+				protoKeywords[protocolDNS] = sets.New[string]()
+			}
+			protoKeywords[protocolDNS].Insert(convertProtocolDNS(protocol.DNS)...)
+		}
+		if protocol.Kafka != nil {
+			kafkaProtocols = append(kafkaProtocols, protocol.Kafka)
+		}
 	}
 
 	klog.InfoS("Reconciling L7 rule", "RuleID", ruleID, "PolicyName", policyName)
 	// Write the Suricata rules to file.
 	rulesPath := generateTenantRulesPath(vlanID)
-	rulesData := generateTenantRulesData(policyName, protoKeywords)
+	rulesData := generateTenantRulesData(policyName, protoKeywords, kafkaProtocols, rejectStatusCode, dnsRejectResponseCode)
 	if err := writeConfigFile(rulesPath, rulesData); err != nil {
 		return fmt.Errorf("failed to write Suricata rules data to file %s for L7 rule %s of %s, err: %w", rulesPath, ruleID, policyName, err)
 	}
@@ -487,6 +882,15 @@ func (r *Reconciler) startSuricata() error {
 		return fmt.Errorf("failed to write Suricata config file %s: %w", antreaSuricataConfigPath, err)
 	}
 
+	// Write the rules of the default tenant.
+	if err = defaultFS.MkdirAll(tenantRulesDir, 0755); err != nil {
+		return fmt.Errorf("failed to create Suricata rule directory %s: %w", tenantRulesDir, err)
+	}
+	defaultRulesPath := filepath.Join(tenantRulesDir, defaultRulesFile)
+	if err = writeConfigFile(defaultRulesPath, bytes.NewBufferString(defaultRulesData)); err != nil {
+		return fmt.Errorf("failed to write Suricata rules file %s: %w", defaultRulesPath, err)
+	}
+
 	// Open the default Suricata config file /etc/suricata/suricata.yaml.
 	f, err = defaultFS.OpenFile(defaultSuricataConfigPath, os.O_APPEND|os.O_WRONLY, 0600)
 	if err != nil {
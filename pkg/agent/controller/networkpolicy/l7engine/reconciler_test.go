@@ -15,7 +15,13 @@
 package l7engine
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -87,6 +93,52 @@ func TestConvertProtocolHTTP(t *testing.T) {
 			},
 			expected: `http.host; content:".foo.";`,
 		},
+		{
+			name: "with user agent",
+			http: &v1beta.HTTPProtocol{
+				UserAgent: "curl/*",
+			},
+			expected: `http.user_agent; content:"curl/"; startswith;`,
+		},
+		{
+			name: "with user agent containing special characters",
+			http: &v1beta.HTTPProtocol{
+				UserAgent: "foo|bar*",
+			},
+			expected: `http.user_agent; content:"foo|7c|bar"; startswith;`,
+		},
+		{
+			name: "with headers",
+			http: &v1beta.HTTPProtocol{
+				Headers: []v1beta.HTTPHeaderMatch{
+					{Name: "X-Tenant", Value: "a.b"},
+					{Name: "Accept", Regex: `text\.(html|plain)`},
+					{Name: "Authorization"},
+				},
+			},
+			expected: `http.header; pcre:"/(?:^|\r\n)(?i:X-Tenant): a\.b(?:\r\n|$)/"; ` +
+				`http.header; pcre:"/(?:^|\r\n)(?i:Accept): (?:text\.(html|plain))(?:\r\n|$)/"; ` +
+				`http.header; pcre:"/(?:^|\r\n)(?i:Authorization):/";`,
+		},
+		{
+			name: "with path, query params",
+			http: &v1beta.HTTPProtocol{
+				Path: "/search",
+				QueryParams: []v1beta.HTTPQueryParamMatch{
+					{Name: "q", Value: "a/b"},
+					{Name: "debug"},
+				},
+			},
+			expected: `http.uri; content:"/search?"; startswith; http.uri; pcre:"/[?&]q=a\/b(?:&|$)/"; http.uri; pcre:"/[?&]debug(?:[=&]|$)/";`,
+		},
+		{
+			name: "with path prefix, query params",
+			http: &v1beta.HTTPProtocol{
+				Path:        "/api/*",
+				QueryParams: []v1beta.HTTPQueryParamMatch{{Name: "page", Value: "1"}},
+			},
+			expected: `http.uri; content:"/api/"; startswith; http.uri; pcre:"/[?&]page=1(?:&|$)/";`,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -121,6 +173,467 @@ func TestConvertProtocolTLS(t *testing.T) {
 	}
 }
 
+func TestConvertProtocolGRPC(t *testing.T) {
+	testCases := []struct {
+		name     string
+		grpc     *v1beta.GRPCProtocol
+		expected string
+	}{
+		{
+			name:     "without service,method,metadata",
+			grpc:     &v1beta.GRPCProtocol{},
+			expected: `http.request_header; content:"content-type: application/grpc"; startswith;`,
+		},
+		{
+			name: "with service,method",
+			grpc: &v1beta.GRPCProtocol{
+				Service: "payments.Ledger",
+				Method:  "Read",
+			},
+			expected: `http.request_header; content:"content-type: application/grpc"; startswith; http.uri; content:"/payments.Ledger/Read"; startswith; endswith;`,
+		},
+		{
+			name: "with service",
+			grpc: &v1beta.GRPCProtocol{
+				Service: "payments.Ledger",
+			},
+			expected: `http.request_header; content:"content-type: application/grpc"; startswith; http.uri; content:"/payments.Ledger/"; startswith;`,
+		},
+		{
+			name: "with method,metadata",
+			grpc: &v1beta.GRPCProtocol{
+				Method: "Read",
+				Metadata: []v1beta.GRPCMetadataMatch{
+					{Name: "X-Tenant-ID", Value: "foo"},
+					{Name: "authorization"},
+				},
+			},
+			expected: `http.request_header; content:"content-type: application/grpc"; startswith; http.uri; content:"/Read"; endswith; http.request_header; content:"x-tenant-id: foo"; startswith; endswith; http.request_header; content:"authorization: "; startswith;`,
+		},
+		{
+			name: "with metadata value containing special characters",
+			grpc: &v1beta.GRPCProtocol{
+				Metadata: []v1beta.GRPCMetadataMatch{
+					{Name: "x-route", Value: "a|b"},
+				},
+			},
+			expected: `http.request_header; content:"content-type: application/grpc"; startswith; http.request_header; content:"x-route: a|7c|b"; startswith; endswith;`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolGRPC(tc.grpc))
+		})
+	}
+}
+
+func TestConvertProtocolDNS(t *testing.T) {
+	testCases := []struct {
+		name     string
+		dns      *v1beta.DNSProtocol
+		expected []string
+	}{
+		{
+			name:     "without query name,record types",
+			dns:      &v1beta.DNSProtocol{},
+			expected: []string{""},
+		},
+		{
+			name: "with query name suffix",
+			dns: &v1beta.DNSProtocol{
+				QueryName: "*.example.com",
+			},
+			expected: []string{`dns.query; content:".example.com"; endswith; nocase;`},
+		},
+		{
+			name: "with record types",
+			dns: &v1beta.DNSProtocol{
+				RecordTypes: []string{"TXT"},
+			},
+			expected: []string{
+				`ip_proto:17; pcre:"/^.{12}[^\x00]*\x00(?:\x00\x10)/s";`,
+				`ip_proto:6; pcre:"/^.{14}[^\x00]*\x00(?:\x00\x10)/s";`,
+			},
+		},
+		{
+			name: "with exact query name,record types",
+			dns: &v1beta.DNSProtocol{
+				QueryName:   "example.com.",
+				RecordTypes: []string{"A", "AAAA"},
+			},
+			expected: []string{
+				`ip_proto:17; pcre:"/^.{12}[^\x00]*\x00(?:\x00\x01|\x00\x1c)/s"; dns.query; content:"example.com"; startswith; endswith; nocase;`,
+				`ip_proto:6; pcre:"/^.{14}[^\x00]*\x00(?:\x00\x01|\x00\x1c)/s"; dns.query; content:"example.com"; startswith; endswith; nocase;`,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolDNS(tc.dns))
+		})
+	}
+}
+
+func TestConvertProtocolKafka(t *testing.T) {
+	testCases := []struct {
+		name     string
+		kafka    []*v1beta.KafkaProtocol
+		expected []string
+	}{
+		{
+			name:     "without API key,topic",
+			kafka:    []*v1beta.KafkaProtocol{{}},
+			expected: nil,
+		},
+		{
+			name:  "with API key",
+			kafka: []*v1beta.KafkaProtocol{{APIKey: "metadata"}},
+			expected: []string{
+				`flow: to_server, established; content:"|00 00|"; offset: 4; depth: 2;`,
+				`flow: to_server, established; content:"|00 01|"; offset: 4; depth: 2;`,
+			},
+		},
+		{
+			name: "with API key,topic",
+			kafka: []*v1beta.KafkaProtocol{
+				{APIKey: "produce", Topic: "orders"},
+				{APIKey: "produce", Topic: "audit.log"},
+				{APIKey: "metadata"},
+			},
+			expected: []string{
+				`flow: to_server, established; content:"|00 00|"; offset: 4; depth: 2; byte_test: 2, >, 12, 6;`,
+				`flow: to_server, established; content:"|00 00|"; offset: 4; depth: 2; content:"|ff ff|"; offset: 12; depth: 2;`,
+				`flow: to_server, established; content:"|00 00|"; offset: 4; depth: 2; byte_test: 2, <=, 2, 6; byte_jump: 2, 12; pcre:!"/^.{6}\x00\x00\x00\x01(?:\x00\x09audit\.log|\x00\x06orders)/sR";`,
+				`flow: to_server, established; content:"|00 00|"; offset: 4; depth: 2; byte_test: 2, >=, 3, 6; byte_test: 2, <=, 8, 6; byte_jump: 2, 12; content:"|ff ff|"; distance: 0; within: 2; pcre:!"/^.{6}\x00\x00\x00\x01(?:\x00\x09audit\.log|\x00\x06orders)/sR";`,
+				`flow: to_server, established; content:"|00 00|"; offset: 4; depth: 2; byte_test: 2, >=, 3, 6; byte_test: 2, <=, 8, 6; byte_jump: 2, 12; byte_jump: 2, 0, relative; pcre:!"/^.{6}\x00\x00\x00\x01(?:\x00\x09audit\.log|\x00\x06orders)/sR";`,
+				`flow: to_server, established; content:"|00 00|"; offset: 4; depth: 2; byte_test: 2, >=, 9, 6; byte_test: 2, <=, 12, 6; byte_jump: 2, 12; byte_test: 1, >, 0, 0, relative;`,
+				`flow: to_server, established; content:"|00 00|"; offset: 4; depth: 2; byte_test: 2, >=, 9, 6; byte_test: 2, <=, 12, 6; byte_jump: 2, 12; content:"|00|"; distance: 0; within: 1; content:"|00|"; distance: 0; within: 1; pcre:!"/^.{6}\x02(?:\x0aaudit\.log|\x07orders)/sR";`,
+				`flow: to_server, established; content:"|00 00|"; offset: 4; depth: 2; byte_test: 2, >=, 9, 6; byte_test: 2, <=, 12, 6; byte_jump: 2, 12; content:"|00|"; distance: 0; within: 1; byte_test: 1, >, 0, 0, relative; byte_test: 1, <, 128, 0, relative; byte_jump: 1, 0, relative, post_offset -1; pcre:!"/^.{6}\x02(?:\x0aaudit\.log|\x07orders)/sR";`,
+				`flow: to_server, established; content:"|00 00|"; offset: 4; depth: 2; byte_test: 2, >=, 9, 6; byte_test: 2, <=, 12, 6; byte_jump: 2, 12; content:"|00|"; distance: 0; within: 1; byte_test: 1, >=, 128, 0, relative;`,
+				`flow: to_server, established; content:"|00 01|"; offset: 4; depth: 2;`,
+			},
+		},
+		{
+			name: "with long topic",
+			kafka: []*v1beta.KafkaProtocol{
+				{APIKey: "metadata", Topic: strings.Repeat("a", 200)},
+				{APIKey: "produce"},
+				{APIKey: "fetch"},
+			},
+			expected: []string{
+				`flow: to_server, established; content:"|00 03|"; offset: 4; depth: 2; byte_test: 2, >, 13, 6;`,
+				`flow: to_server, established; content:"|00 03|"; offset: 4; depth: 2; content:"|ff ff|"; offset: 12; depth: 2;`,
+				`flow: to_server, established; content:"|00 03|"; offset: 4; depth: 2; byte_test: 2, <=, 8, 6; byte_jump: 2, 12; pcre:!"/^\x00\x00\x00\x01(?:\x00\xc8` + strings.Repeat("a", 200) + `)/sR";`,
+				`flow: to_server, established; content:"|00 03|"; offset: 4; depth: 2; byte_test: 2, =, 9, 6; byte_jump: 2, 12; byte_test: 1, >, 0, 0, relative;`,
+				`flow: to_server, established; content:"|00 03|"; offset: 4; depth: 2; byte_test: 2, =, 9, 6; byte_jump: 2, 12; content:"|00|"; distance: 0; within: 1; pcre:!"/^\x02(?:\xc9\x01` + strings.Repeat("a", 200) + `)/sR";`,
+				`flow: to_server, established; content:"|00 03|"; offset: 4; depth: 2; byte_test: 2, >=, 10, 6; byte_test: 2, <=, 13, 6; byte_jump: 2, 12; byte_test: 1, >, 0, 0, relative;`,
+				`flow: to_server, established; content:"|00 03|"; offset: 4; depth: 2; byte_test: 2, >=, 10, 6; byte_test: 2, <=, 13, 6; byte_jump: 2, 12; content:"|00|"; distance: 0; within: 1; pcre:!"/^\x02.{16}(?:\xc9\x01` + strings.Repeat("a", 200) + `)/sR";`,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolKafka(tc.kafka))
+		})
+	}
+}
+
+// kafkaRequest returns a Kafka request with the given API key and version, whose header is followed by body.
+func kafkaRequest(apiKey, version uint16, flexible bool, body ...[]byte) []byte {
+	request := binary.BigEndian.AppendUint16(nil, apiKey)
+	request = binary.BigEndian.AppendUint16(request, version)
+	// Correlation ID and client ID.
+	request = binary.BigEndian.AppendUint32(request, 1)
+	request = kafkaString(request, "client", false)
+	if flexible {
+		// Empty tagged fields.
+		request = append(request, 0)
+	}
+	request = append(request, bytes.Join(body, nil)...)
+	return append(binary.BigEndian.AppendUint32(nil, uint32(len(request))), request...)
+}
+
+func kafkaString(b []byte, str string, compact bool) []byte {
+	if compact {
+		b = append(b, byte(len(str)+1))
+	} else {
+		b = binary.BigEndian.AppendUint16(b, uint16(len(str)))
+	}
+	return append(b, str...)
+}
+
+// kafkaProduceTopics returns the topics array of a produce request, with an empty partition for each topic.
+func kafkaProduceTopics(compact bool, topics ...string) []byte {
+	var b []byte
+	if compact {
+		b = append(b, byte(len(topics)+1))
+	} else {
+		b = binary.BigEndian.AppendUint32(b, uint32(len(topics)))
+	}
+	for _, topic := range topics {
+		b = kafkaString(b, topic, compact)
+		// Partitions count, index and records.
+		if compact {
+			b = append(b, 2, 0, 0, 0, 0, 0, 0)
+		} else {
+			b = append(b, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0)
+		}
+	}
+	return b
+}
+
+// matchSuricataRule evaluates the options of a Suricata rule against the TCP payload of a packet, and returns whether
+// the rule matches the packet and the flowbits set by the rule. Only the keywords used by the rules of Kafka requests
+// are supported, and the payload is expected to only have ASCII bytes, as PCREs are evaluated by the Go regexp package.
+func matchSuricataRule(t *testing.T, rule string, payload []byte, flowbits sets.Set[string]) (bool, []string) {
+	var options [][2]string
+	for _, option := range strings.Split(rule[strings.Index(rule, "(")+1:strings.LastIndex(rule, ")")], ";") {
+		if option = strings.TrimSpace(option); option != "" {
+			name, value, _ := strings.Cut(option, ":")
+			options = append(options, [2]string{name, strings.TrimSpace(value)})
+		}
+	}
+	atoi := func(s string) int {
+		i, err := strconv.Atoi(strings.TrimSpace(s))
+		require.NoError(t, err)
+		return i
+	}
+	readUint := func(at, n int) (int, bool) {
+		if at < 0 || at+n > len(payload) {
+			return 0, false
+		}
+		v := 0
+		for _, b := range payload[at : at+n] {
+			v = v<<8 | int(b)
+		}
+		return v, true
+	}
+	var setFlowbits []string
+	pos := 0
+	for i := 0; i < len(options); i++ {
+		name, value := options[i][0], options[i][1]
+		negated := strings.HasPrefix(value, "!")
+		value = strings.TrimPrefix(value, "!")
+		switch name {
+		case "msg", "flow", "noalert", "sid":
+		case "flowbits":
+			op, bit, _ := strings.Cut(value, ", ")
+			switch op {
+			case "set":
+				setFlowbits = append(setFlowbits, bit)
+			case "isset", "isnotset":
+				if flowbits.Has(bit) != (op == "isset") {
+					return false, nil
+				}
+			}
+		case "content":
+			var pattern []byte
+			for j, part := range strings.Split(strings.Trim(value, `"`), "|") {
+				if j%2 == 0 {
+					pattern = append(pattern, part...)
+					continue
+				}
+				for _, hex := range strings.Fields(part) {
+					b, err := strconv.ParseUint(hex, 16, 8)
+					require.NoError(t, err)
+					pattern = append(pattern, byte(b))
+				}
+			}
+			modifiers := map[string]int{}
+			for ; i+1 < len(options) && slices.Contains([]string{"offset", "depth", "distance", "within"}, options[i+1][0]); i++ {
+				modifiers[options[i+1][0]] = atoi(options[i+1][1])
+			}
+			start, end := modifiers["offset"], len(payload)
+			if depth, ok := modifiers["depth"]; ok {
+				end = start + depth
+			}
+			if within, ok := modifiers["within"]; ok {
+				start, end = pos+modifiers["distance"], pos+within
+			}
+			end = min(end, len(payload))
+			index := -1
+			if start <= end {
+				index = bytes.Index(payload[start:end], pattern)
+			}
+			if (index >= 0) == negated {
+				return false, nil
+			}
+			if !negated {
+				pos = start + index + len(pattern)
+			}
+		case "byte_test", "byte_jump":
+			args := strings.Split(value, ",")
+			n := atoi(args[0])
+			argsOffset := 3
+			if name == "byte_jump" {
+				argsOffset = 1
+			}
+			at := atoi(args[argsOffset])
+			postOffset := 0
+			for _, arg := range args[argsOffset+1:] {
+				arg = strings.TrimSpace(arg)
+				if arg == "relative" {
+					at += pos
+				} else if after, ok := strings.CutPrefix(arg, "post_offset "); ok {
+					postOffset = atoi(after)
+				}
+			}
+			v, ok := readUint(at, n)
+			if !ok {
+				return false, nil
+			}
+			if name == "byte_jump" {
+				if pos = at + n + v + postOffset; pos > len(payload) {
+					return false, nil
+				}
+				continue
+			}
+			expected := atoi(args[2])
+			var result bool
+			switch strings.TrimSpace(args[1]) {
+			case "=":
+				result = v == expected
+			case ">":
+				result = v > expected
+			case "<":
+				result = v < expected
+			case ">=":
+				result = v >= expected
+			case "<=":
+				result = v <= expected
+			}
+			if !result {
+				return false, nil
+			}
+		case "pcre":
+			value = strings.Trim(value, `"`)
+			flagsIndex := strings.LastIndex(value, "/")
+			re := regexp.MustCompile("(?s)" + value[1:flagsIndex])
+			subjectOffset := 0
+			if strings.Contains(value[flagsIndex:], "R") {
+				subjectOffset = pos
+			}
+			loc := re.FindIndex(payload[subjectOffset:])
+			if (loc != nil) == negated {
+				return false, nil
+			}
+			if !negated {
+				pos = subjectOffset + loc[1]
+			}
+		default:
+			t.Fatalf("Unsupported keyword %s in rule %s", name, rule)
+		}
+	}
+	return true, setFlowbits
+}
+
+func TestKafkaRequestsInSameConnection(t *testing.T) {
+	// The policy of the Kafka example in the documentation.
+	kafkaProtocols := []*v1beta.KafkaProtocol{
+		{APIKey: "produce", Topic: "orders"},
+		{APIKey: "metadata"},
+	}
+	rules := strings.Split(strings.TrimSpace(generateTenantRulesData("AntreaNetworkPolicy:test-l7", map[string]sets.Set[string]{}, kafkaProtocols, 0, "").String()), "\n")
+	for _, rule := range rules {
+		// A pass rule would pass all the subsequent requests of the connection.
+		require.False(t, strings.HasPrefix(rule, "pass "), "Unexpected pass rule %s", rule)
+	}
+
+	produceV2 := func(topics ...string) []byte {
+		// acks and timeout.
+		return kafkaRequest(0, 2, false, []byte{0, 1, 0, 0, 0x75, 0x30}, kafkaProduceTopics(false, topics...))
+	}
+	fetchV4 := kafkaRequest(1, 4, false, make([]byte, 17), kafkaProduceTopics(false, "orders"))
+	metadataV9 := kafkaRequest(3, 9, true, kafkaString([]byte{2}, "payments", true), []byte{1, 0, 0, 0})
+	apiVersionsV3 := kafkaRequest(18, 3, true, kafkaString(nil, "client", true), kafkaString(nil, "1.0", true), []byte{0})
+	findCoordinatorV1 := kafkaRequest(10, 1, false, kafkaString(nil, "group", false), []byte{0})
+	testCases := []struct {
+		name     string
+		payloads [][]byte
+		// expected is whether each payload is rejected.
+		expected []bool
+	}{
+		{
+			name: "allowed request followed by denied request",
+			payloads: [][]byte{
+				apiVersionsV3,
+				metadataV9,
+				produceV2("orders"),
+				produceV2("payments"),
+			},
+			expected: []bool{false, false, false, true},
+		},
+		{
+			name: "request with multiple topics",
+			payloads: [][]byte{
+				produceV2("orders"),
+				produceV2("orders", "payments"),
+				produceV2("payments", "orders"),
+			},
+			expected: []bool{false, true, true},
+		},
+		{
+			name: "transactional and flexible produce requests",
+			payloads: [][]byte{
+				kafkaRequest(0, 3, false, []byte{0xff, 0xff, 0, 1, 0, 0, 0x75, 0x30}, kafkaProduceTopics(false, "orders")),
+				kafkaRequest(0, 3, false, kafkaString(nil, "tx", false), []byte{0, 1, 0, 0, 0x75, 0x30}, kafkaProduceTopics(false, "orders")),
+				kafkaRequest(0, 9, true, []byte{0, 0, 1, 0, 0, 0x75, 0x30}, kafkaProduceTopics(true, "orders")),
+				kafkaRequest(0, 9, true, kafkaString(nil, "tx", true), []byte{0, 1, 0, 0, 0x75, 0x30}, kafkaProduceTopics(true, "orders")),
+				kafkaRequest(0, 9, true, kafkaString(nil, "tx", true), []byte{0, 1, 0, 0, 0x75, 0x30}, kafkaProduceTopics(true, "payments")),
+				kafkaRequest(0, 13, true, []byte{0, 0, 1, 0, 0, 0x75, 0x30}, kafkaProduceTopics(true, "orders")),
+			},
+			expected: []bool{false, false, false, false, true, true},
+		},
+		{
+			name: "continuation of allowed request followed by denied request",
+			payloads: [][]byte{
+				produceV2("orders"),
+				bytes.Repeat([]byte("record"), 100),
+				fetchV4,
+			},
+			expected: []bool{false, false, true},
+		},
+		{
+			name: "other requests",
+			payloads: [][]byte{
+				findCoordinatorV1,
+				apiVersionsV3,
+				findCoordinatorV1,
+			},
+			expected: []bool{true, false, true},
+		},
+		{
+			name: "non-Kafka requests",
+			payloads: [][]byte{
+				[]byte("GET / HTTP/1.1\r\nHost: kafka\r\n\r\n"),
+			},
+			expected: []bool{true},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flowbits := sets.New[string]()
+			for i, payload := range tc.payloads {
+				rejected := false
+				var setFlowbits []string
+				for _, rule := range rules {
+					matched, bits := matchSuricataRule(t, rule, payload, flowbits)
+					if !matched {
+						continue
+					}
+					setFlowbits = append(setFlowbits, bits...)
+					if strings.HasPrefix(rule, "reject ") {
+						rejected = true
+					}
+				}
+				assert.Equal(t, tc.expected[i], rejected, "Unexpected result for payload %d", i)
+				// The flowbits set by a packet are only checked for the subsequent packets.
+				flowbits.Insert(setFlowbits...)
+			}
+		})
+	}
+}
+
 func TestStartSuricata(t *testing.T) {
 	defaultFS = afero.NewMemMapFs()
 	defer func() {
@@ -130,7 +643,7 @@ func TestStartSuricata(t *testing.T) {
 	_, err := defaultFS.Create(defaultSuricataConfigPath)
 	assert.NoError(t, err)
 
-	fe := NewReconciler(nil)
+	fe := NewReconciler(nil, nil)
 	fs := newFakeSuricata()
 	fe.suricataScFn = fs.suricataScFunc
 	fe.startSuricataFn = fs.startSuricataFn
@@ -144,6 +657,10 @@ func TestStartSuricata(t *testing.T) {
 	ok, err = afero.FileContainsBytes(defaultFS, defaultSuricataConfigPath, []byte("include: /etc/suricata/antrea.yaml"))
 	assert.NoError(t, err)
 	assert.True(t, ok)
+
+	ok, err = afero.FileContainsBytes(defaultFS, "/etc/suricata/rules/antrea-default.rules", []byte(defaultRulesData))
+	assert.NoError(t, err)
+	assert.True(t, ok)
 }
 
 func TestRuleLifecycle(t *testing.T) {
@@ -177,6 +694,85 @@ func TestRuleLifecycle(t *testing.T) {
 			expectedRules:        `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; http.uri; content:"/index.html"; startswith; endswith; http.method; content:"GET"; http.host; content:"www.google.com"; startswith; endswith; sid: 2;)`,
 			expectedUpdatedRules: `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; sid: 2;)`,
 		},
+		{
+			name: "protocol HTTP with reject status code",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					HTTP: &v1beta.HTTPProtocol{
+						Method:           "GET",
+						RejectStatusCode: 403,
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					HTTP: &v1beta.HTTPProtocol{},
+				},
+			},
+			expectedRules: `drop http1 any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server, established; metadata: antrea_reject_status_code 403; sid: 2;)` + "\n" +
+				`pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; http.method; content:"GET"; sid: 3;)`,
+			expectedUpdatedRules: `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; sid: 2;)`,
+		},
+		{
+			name: "protocol DNS",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					DNS: &v1beta.DNSProtocol{
+						QueryName:          "*.example.com",
+						RejectResponseCode: "NXDOMAIN",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					DNS: &v1beta.DNSProtocol{
+						RejectResponseCode: "REFUSED",
+					},
+				},
+			},
+			expectedRules: `drop dns any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server; metadata: antrea_reject_dns_response_code NXDOMAIN; sid: 2;)` + "\n" +
+				`pass dns any any -> any any (msg: "Allow dns by AntreaNetworkPolicy:test-l7"; dns.query; content:".example.com"; endswith; nocase; sid: 3;)`,
+			expectedUpdatedRules: `pass dns any any -> any any (msg: "Allow dns by AntreaNetworkPolicy:test-l7"; sid: 3;)`,
+		},
+		{
+			name: "protocol Kafka",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					Kafka: &v1beta.KafkaProtocol{
+						APIKey: "produce",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					Kafka: &v1beta.KafkaProtocol{},
+				},
+			},
+			expectedRules: `reject ip any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server, established; flowbits: isnotset, antrea_kafka; pcre:!"/^.{4}\x00[\x00\x01\x03\x12]/s"; sid: 1;)` + "\n" +
+				`alert tcp any any -> any any (msg: "Kafka request by AntreaNetworkPolicy:test-l7"; flow: to_server, established; pcre:"/^.{4}\x00[\x00\x01\x03\x12]/s"; flowbits: set, antrea_kafka; noalert; sid: 2;)` + "\n" +
+				`reject tcp any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server, established; flowbits: isset, antrea_kafka; pcre:"/^\x00.{3}\x00[\x02\x04-\x11\x13-\x7f]\x00/s"; sid: 3;)` + "\n" +
+				`reject tcp any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server, established; content:"|00 01|"; offset: 4; depth: 2; sid: 4;)` + "\n" +
+				`reject tcp any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server, established; content:"|00 03|"; offset: 4; depth: 2; sid: 5;)`,
+			expectedUpdatedRules: `reject tcp any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server, established; flowbits: isset, antrea_kafka; pcre:"/^\x00.{3}\x00[\x02\x04-\x11\x13-\x7f]\x00/s"; sid: 3;)`,
+		},
+		{
+			name: "protocol gRPC",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					GRPC: &v1beta.GRPCProtocol{
+						Service: "payments.Ledger",
+						Method:  "Read",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					GRPC: &v1beta.GRPCProtocol{},
+				},
+			},
+			expectedRules:        `pass http2 any any -> any any (msg: "Allow http2 by AntreaNetworkPolicy:test-l7"; http.request_header; content:"content-type: application/grpc"; startswith; http.uri; content:"/payments.Ledger/Read"; startswith; endswith; sid: 2;)`,
+			expectedUpdatedRules: `pass http2 any any -> any any (msg: "Allow http2 by AntreaNetworkPolicy:test-l7"; http.request_header; content:"content-type: application/grpc"; startswith; sid: 2;)`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -191,7 +787,7 @@ func TestRuleLifecycle(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			mockOfClient := oftesting.NewMockClient(ctrl)
-			fe := NewReconciler(mockOfClient)
+			fe := NewReconciler(mockOfClient, nil)
 			fs := newFakeSuricata()
 			fe.suricataScFn = fs.suricataScFunc
 			fe.startSuricataFn = fs.startSuricataFn
@@ -239,7 +835,7 @@ func TestRuleLifecycle(t *testing.T) {
 func TestInitializeL7FlowsOnce(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockOfClient := oftesting.NewMockClient(ctrl)
-	fe := NewReconciler(mockOfClient)
+	fe := NewReconciler(mockOfClient, nil)
 
 	mockOfClient.EXPECT().InstallL7NetworkPolicyFlows().Return(fmt.Errorf("error"))
 	mockOfClient.EXPECT().InstallL7NetworkPolicyFlows().Return(nil)
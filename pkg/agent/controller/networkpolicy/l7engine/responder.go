@@ -0,0 +1,372 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/miekg/dns"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/openflow"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+)
+
+const (
+	// rejectStatusCodeMetadataKey is the key of the metadata carrying the status code of the HTTP response, in the
+	// Suricata rules which drop the HTTP requests to respond to.
+	rejectStatusCodeMetadataKey = "antrea_reject_status_code"
+	// rejectDNSResponseCodeMetadataKey is the key of the metadata carrying the response code of the DNS response, in
+	// the Suricata rules which drop the DNS queries to respond to.
+	rejectDNSResponseCodeMetadataKey = "antrea_reject_dns_response_code"
+
+	tcpFin uint8 = 0b000001
+	tcpRst uint8 = 0b000100
+	tcpPsh uint8 = 0b001000
+	tcpAck uint8 = 0b010000
+)
+
+// alertEvent holds the Suricata alert event JSON values used to respond to rejected requests.
+// See https://docs.suricata.io/en/latest/output/eve/eve-json-format.html#event-type-alert
+type alertEvent struct {
+	EventType string `json:"event_type"`
+	Alert     struct {
+		SignatureID int64               `json:"signature_id"`
+		Metadata    map[string][]string `json:"metadata"`
+	} `json:"alert"`
+	// Packet is the Ethernet frame which triggered the alert.
+	Packet []byte `json:"packet"`
+}
+
+// rejectedRequest holds the headers and the payload of the packet carrying a rejected request.
+type rejectedRequest struct {
+	srcMAC  net.HardwareAddr
+	dstMAC  net.HardwareAddr
+	srcIP   net.IP
+	dstIP   net.IP
+	isIPv6  bool
+	isUDP   bool
+	srcPort uint16
+	dstPort uint16
+	seqNum  uint32
+	ackNum  uint32
+	winSize uint16
+	payload []byte
+}
+
+// responder receives the alerts of the Suricata rules which drop requests rejected by L7 NetworkPolicy rules with a
+// specific response, i.e. HTTP requests rejected with a status code and DNS queries. For each of these requests, it
+// sends the response to the client, and resets the TCP connection to the server if any. The packets are injected in
+// the OVS pipeline as if they were returned by Suricata.
+type responder struct {
+	ofClient        openflow.Client
+	ifaceStore      interfacestore.InterfaceStore
+	alertSocketPath string
+}
+
+func newResponder(ofClient openflow.Client, ifaceStore interfacestore.InterfaceStore) *responder {
+	return &responder{
+		ofClient:        ofClient,
+		ifaceStore:      ifaceStore,
+		alertSocketPath: config.L7SuricataAlertSocketPath,
+	}
+}
+
+func (r *responder) Run(stopCh <-chan struct{}) {
+	wait.Until(func() {
+		r.listenAndAcceptConn(stopCh)
+	}, 5*time.Second, stopCh)
+}
+
+func (r *responder) listenAndAcceptConn(stopCh <-chan struct{}) {
+	// Remove stale connections
+	if err := os.Remove(r.alertSocketPath); err != nil && !os.IsNotExist(err) {
+		klog.V(2).ErrorS(err, "Failed to remove stale socket")
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(r.alertSocketPath), 0750); err != nil {
+		klog.ErrorS(err, "Failed to create directory", "dir", filepath.Dir(r.alertSocketPath))
+		return
+	}
+	listener, err := net.Listen("unix", r.alertSocketPath)
+	if err != nil {
+		klog.ErrorS(err, "Failed to listen on Suricata alert socket")
+		return
+	}
+	var wg sync.WaitGroup
+	// Wait for all goroutines (accept + all connection handlers) to return.
+	// The call to Wait() needs to happen after the listener is closed.
+	defer wg.Wait()
+	defer listener.Close()
+	errCh := make(chan error, 1)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				klog.ErrorS(err, "Error accepting Suricata alert connection")
+				errCh <- err
+				return
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				r.handleClientConnection(conn)
+			}()
+		}
+	}()
+	select {
+	case <-stopCh:
+	case <-errCh:
+	}
+}
+
+func (r *responder) handleClientConnection(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		buffer, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			klog.ErrorS(err, "Error reading Suricata alert")
+			return
+		}
+		// An error when responding to a request must not prevent responding to the following requests.
+		if err := r.processAlert(buffer); err != nil {
+			klog.ErrorS(err, "Error responding to rejected request")
+		}
+	}
+}
+
+func (r *responder) processAlert(data []byte) error {
+	var event alertEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("error parsing JSON data %v", data)
+	}
+	if event.EventType != "alert" {
+		return nil
+	}
+	if values := event.Alert.Metadata[rejectStatusCodeMetadataKey]; len(values) != 0 {
+		statusCode, err := strconv.Atoi(values[0])
+		if err != nil {
+			return fmt.Errorf("invalid reject status code %q in alert of Suricata rule %d", values[0], event.Alert.SignatureID)
+		}
+		request, err := parseRejectedRequest(event.Packet)
+		if err != nil {
+			return fmt.Errorf("error parsing packet in alert of Suricata rule %d: %w", event.Alert.SignatureID, err)
+		}
+		return r.respondTCP(request, buildHTTPResponse(statusCode))
+	}
+	if values := event.Alert.Metadata[rejectDNSResponseCodeMetadataKey]; len(values) != 0 {
+		responseCode, ok := dns.StringToRcode[values[0]]
+		if !ok {
+			return fmt.Errorf("invalid reject DNS response code %q in alert of Suricata rule %d", values[0], event.Alert.SignatureID)
+		}
+		request, err := parseRejectedRequest(event.Packet)
+		if err != nil {
+			return fmt.Errorf("error parsing packet in alert of Suricata rule %d: %w", event.Alert.SignatureID, err)
+		}
+		return r.respondDNS(request, responseCode)
+	}
+	return nil
+}
+
+// parseRejectedRequest parses the Ethernet frame carrying a rejected request. The frame may be tagged with the VLAN ID
+// of the L7 NetworkPolicy rule.
+func parseRejectedRequest(frame []byte) (*rejectedRequest, error) {
+	packet := gopacket.NewPacket(frame, layers.LayerTypeEthernet, gopacket.DecodeOptions{Lazy: true, NoCopy: true})
+	ethLayer, ok := packet.Layer(layers.LayerTypeEthernet).(*layers.Ethernet)
+	if !ok {
+		return nil, fmt.Errorf("no Ethernet header in packet")
+	}
+	request := &rejectedRequest{
+		srcMAC: ethLayer.SrcMAC,
+		dstMAC: ethLayer.DstMAC,
+	}
+	if ipLayer, ok := packet.Layer(layers.LayerTypeIPv4).(*layers.IPv4); ok {
+		request.srcIP, request.dstIP = ipLayer.SrcIP, ipLayer.DstIP
+	} else if ipv6Layer, ok := packet.Layer(layers.LayerTypeIPv6).(*layers.IPv6); ok {
+		request.srcIP, request.dstIP = ipv6Layer.SrcIP, ipv6Layer.DstIP
+		request.isIPv6 = true
+	} else {
+		return nil, fmt.Errorf("no IP header in packet")
+	}
+	if udpLayer, ok := packet.Layer(layers.LayerTypeUDP).(*layers.UDP); ok {
+		request.isUDP = true
+		request.srcPort = uint16(udpLayer.SrcPort)
+		request.dstPort = uint16(udpLayer.DstPort)
+		request.payload = udpLayer.Payload
+		return request, nil
+	}
+	tcpLayer, ok := packet.Layer(layers.LayerTypeTCP).(*layers.TCP)
+	if !ok {
+		return nil, fmt.Errorf("no TCP or UDP header in packet")
+	}
+	request.srcPort = uint16(tcpLayer.SrcPort)
+	request.dstPort = uint16(tcpLayer.DstPort)
+	request.seqNum = tcpLayer.Seq
+	request.ackNum = tcpLayer.Ack
+	request.winSize = tcpLayer.Window
+	request.payload = tcpLayer.Payload
+	return request, nil
+}
+
+// buildHTTPResponse builds an HTTP response with the given status code, which closes the connection.
+func buildHTTPResponse(statusCode int) []byte {
+	statusText := http.StatusText(statusCode)
+	body := fmt.Sprintf("%d %s\n", statusCode, statusText)
+	return []byte(fmt.Sprintf("HTTP/1.1 %d %s\r\nContent-Type: text/plain; charset=utf-8\r\nContent-Length: %d\r\nConnection: close\r\n\r\n%s",
+		statusCode, statusText, len(body), body))
+}
+
+// buildDNSResponse builds a DNS response with the given response code to a DNS query. The query is preceded by a
+// 2-byte length field if it is sent over TCP, in which case the response is also preceded by its length.
+func buildDNSResponse(query []byte, responseCode int, isTCP bool) ([]byte, error) {
+	if isTCP {
+		if len(query) < 2 {
+			return nil, fmt.Errorf("DNS message over TCP is too short")
+		}
+		query = query[2:]
+	}
+	queryMsg := &dns.Msg{}
+	if err := queryMsg.Unpack(query); err != nil {
+		return nil, fmt.Errorf("error unpacking DNS query: %w", err)
+	}
+	responseMsg := &dns.Msg{}
+	responseMsg.SetRcode(queryMsg, responseCode)
+	response, err := responseMsg.Pack()
+	if err != nil {
+		return nil, fmt.Errorf("error packing DNS response: %w", err)
+	}
+	if isTCP {
+		response = append(binary.BigEndian.AppendUint16(nil, uint16(len(response))), response...)
+	}
+	return response, nil
+}
+
+// getReturnPortAndMutateFunc returns the port from which the responses are sent, and the function to mutate them.
+func (r *responder) getReturnPortAndMutateFunc() (uint32, func(binding.PacketOutBuilder) binding.PacketOutBuilder, error) {
+	returnPort, ok := r.ifaceStore.GetInterfaceByName(config.L7RedirectReturnPortName)
+	if !ok {
+		return 0, nil, fmt.Errorf("interface %s not found", config.L7RedirectReturnPortName)
+	}
+	// Mark the packets as returned by the application-aware engine, and resubmit them to the stage where the returned
+	// packets are processed.
+	tableID := openflow.ConntrackTable.GetID()
+	mutatePacketOut := func(packetOutBuilder binding.PacketOutBuilder) binding.PacketOutBuilder {
+		return packetOutBuilder.AddLoadRegMark(openflow.FromL7NPReturnRegMark).AddResubmitAction(nil, &tableID)
+	}
+	return uint32(returnPort.OFPort), mutatePacketOut, nil
+}
+
+// respondDNS sends a DNS response with the given response code to the client of a rejected DNS query.
+func (r *responder) respondDNS(request *rejectedRequest, responseCode int) error {
+	response, err := buildDNSResponse(request.payload, responseCode, !request.isUDP)
+	if err != nil {
+		return err
+	}
+	if !request.isUDP {
+		return r.respondTCP(request, response)
+	}
+	inPort, mutatePacketOut, err := r.getReturnPortAndMutateFunc()
+	if err != nil {
+		return err
+	}
+	// Send the DNS response to the client, as if it was sent by the server.
+	if err := r.ofClient.SendUDPPacketOut(
+		request.dstMAC.String(),
+		request.srcMAC.String(),
+		request.dstIP.String(),
+		request.srcIP.String(),
+		inPort,
+		0,
+		request.isIPv6,
+		request.dstPort,
+		request.srcPort,
+		response,
+		mutatePacketOut); err != nil {
+		return fmt.Errorf("failed to send DNS response: %w", err)
+	}
+	return nil
+}
+
+// respondTCP sends a response to the client of a rejected request over TCP, and resets the connection to the server.
+func (r *responder) respondTCP(request *rejectedRequest, response []byte) error {
+	inPort, mutatePacketOut, err := r.getReturnPortAndMutateFunc()
+	if err != nil {
+		return err
+	}
+	// Send the response to the client, as if it was sent by the server. The response acknowledges the request and
+	// closes the connection.
+	if err := r.ofClient.SendTCPPacketOut(
+		request.dstMAC.String(),
+		request.srcMAC.String(),
+		request.dstIP.String(),
+		request.srcIP.String(),
+		inPort,
+		0,
+		request.isIPv6,
+		request.dstPort,
+		request.srcPort,
+		request.ackNum,
+		request.seqNum+uint32(len(request.payload)),
+		0,
+		tcpPsh|tcpAck|tcpFin,
+		request.winSize,
+		response,
+		mutatePacketOut); err != nil {
+		return fmt.Errorf("failed to send response: %w", err)
+	}
+	// Reset the connection to the server, which never receives the request.
+	if err := r.ofClient.SendTCPPacketOut(
+		request.srcMAC.String(),
+		request.dstMAC.String(),
+		request.srcIP.String(),
+		request.dstIP.String(),
+		inPort,
+		0,
+		request.isIPv6,
+		request.srcPort,
+		request.dstPort,
+		request.seqNum,
+		request.ackNum,
+		0,
+		tcpRst|tcpAck,
+		0,
+		nil,
+		mutatePacketOut); err != nil {
+		return fmt.Errorf("failed to send TCP reset to server: %w", err)
+	}
+	return nil
+}
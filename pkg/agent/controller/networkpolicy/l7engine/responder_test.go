@@ -0,0 +1,330 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	oftesting "antrea.io/antrea/pkg/agent/openflow/testing"
+)
+
+var (
+	clientMAC, _ = net.ParseMAC("aa:bb:cc:dd:ee:01")
+	serverMAC, _ = net.ParseMAC("aa:bb:cc:dd:ee:02")
+	httpRequest  = []byte("GET /admin HTTP/1.1\r\nHost: www.example.com\r\n\r\n")
+)
+
+func newDNSQuery(t *testing.T) []byte {
+	msg := &dns.Msg{}
+	msg.SetQuestion("www.example.com.", dns.TypeTXT)
+	msg.Id = 1234
+	query, err := msg.Pack()
+	require.NoError(t, err)
+	return query
+}
+
+func buildRequestFrame(t *testing.T, isIPv6, isUDP bool, vlanID uint16, payload []byte) []byte {
+	eth := &layers.Ethernet{
+		SrcMAC:       clientMAC,
+		DstMAC:       serverMAC,
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	if isIPv6 {
+		eth.EthernetType = layers.EthernetTypeIPv6
+	}
+	var networkLayer gopacket.NetworkLayer
+	var serializableLayers []gopacket.SerializableLayer
+	if vlanID != 0 {
+		dot1q := &layers.Dot1Q{VLANIdentifier: vlanID, Type: eth.EthernetType}
+		eth.EthernetType = layers.EthernetTypeDot1Q
+		serializableLayers = append(serializableLayers, eth, dot1q)
+	} else {
+		serializableLayers = append(serializableLayers, eth)
+	}
+	ipProtocol := layers.IPProtocolTCP
+	if isUDP {
+		ipProtocol = layers.IPProtocolUDP
+	}
+	if isIPv6 {
+		ip := &layers.IPv6{
+			Version:    6,
+			HopLimit:   64,
+			NextHeader: ipProtocol,
+			SrcIP:      net.ParseIP("fd00::1"),
+			DstIP:      net.ParseIP("fd00::2"),
+		}
+		networkLayer = ip
+		serializableLayers = append(serializableLayers, ip)
+	} else {
+		ip := &layers.IPv4{
+			Version:  4,
+			TTL:      64,
+			Protocol: ipProtocol,
+			SrcIP:    net.ParseIP("10.10.0.1").To4(),
+			DstIP:    net.ParseIP("10.10.0.2").To4(),
+		}
+		networkLayer = ip
+		serializableLayers = append(serializableLayers, ip)
+	}
+	if isUDP {
+		udp := &layers.UDP{
+			SrcPort: 40000,
+			DstPort: 53,
+		}
+		require.NoError(t, udp.SetNetworkLayerForChecksum(networkLayer))
+		serializableLayers = append(serializableLayers, udp)
+	} else {
+		tcp := &layers.TCP{
+			SrcPort: 40000,
+			DstPort: 80,
+			Seq:     1000,
+			Ack:     2000,
+			PSH:     true,
+			ACK:     true,
+			Window:  512,
+		}
+		require.NoError(t, tcp.SetNetworkLayerForChecksum(networkLayer))
+		serializableLayers = append(serializableLayers, tcp)
+	}
+	serializableLayers = append(serializableLayers, gopacket.Payload(payload))
+	buffer := gopacket.NewSerializeBuffer()
+	require.NoError(t, gopacket.SerializeLayers(buffer, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}, serializableLayers...))
+	return buffer.Bytes()
+}
+
+func TestBuildHTTPResponse(t *testing.T) {
+	expected := "HTTP/1.1 403 Forbidden\r\nContent-Type: text/plain; charset=utf-8\r\nContent-Length: 14\r\nConnection: close\r\n\r\n403 Forbidden\n"
+	assert.Equal(t, expected, string(buildHTTPResponse(403)))
+}
+
+func TestBuildDNSResponse(t *testing.T) {
+	query := newDNSQuery(t)
+	for _, isTCP := range []bool{false, true} {
+		request := query
+		if isTCP {
+			request = append(binary.BigEndian.AppendUint16(nil, uint16(len(query))), query...)
+		}
+		response, err := buildDNSResponse(request, dns.RcodeRefused, isTCP)
+		require.NoError(t, err)
+		if isTCP {
+			assert.Equal(t, uint16(len(response)-2), binary.BigEndian.Uint16(response))
+			response = response[2:]
+		}
+		msg := &dns.Msg{}
+		require.NoError(t, msg.Unpack(response))
+		assert.True(t, msg.Response)
+		assert.Equal(t, uint16(1234), msg.Id)
+		assert.Equal(t, dns.RcodeRefused, msg.Rcode)
+		assert.Equal(t, []dns.Question{{Name: "www.example.com.", Qtype: dns.TypeTXT, Qclass: dns.ClassINET}}, msg.Question)
+		assert.Empty(t, msg.Answer)
+	}
+
+	_, err := buildDNSResponse([]byte{0x1}, dns.RcodeRefused, false)
+	assert.Error(t, err)
+}
+
+func TestParseRejectedRequest(t *testing.T) {
+	dnsQuery := newDNSQuery(t)
+	testCases := []struct {
+		name     string
+		frame    []byte
+		expected *rejectedRequest
+	}{
+		{
+			name:  "TCP over IPv4",
+			frame: buildRequestFrame(t, false, false, 0, httpRequest),
+			expected: &rejectedRequest{
+				srcMAC:  clientMAC,
+				dstMAC:  serverMAC,
+				srcIP:   net.ParseIP("10.10.0.1").To4(),
+				dstIP:   net.ParseIP("10.10.0.2").To4(),
+				srcPort: 40000,
+				dstPort: 80,
+				seqNum:  1000,
+				ackNum:  2000,
+				winSize: 512,
+				payload: httpRequest,
+			},
+		},
+		{
+			name:  "TCP over IPv6 with VLAN",
+			frame: buildRequestFrame(t, true, false, 1, httpRequest),
+			expected: &rejectedRequest{
+				srcMAC:  clientMAC,
+				dstMAC:  serverMAC,
+				srcIP:   net.ParseIP("fd00::1"),
+				dstIP:   net.ParseIP("fd00::2"),
+				isIPv6:  true,
+				srcPort: 40000,
+				dstPort: 80,
+				seqNum:  1000,
+				ackNum:  2000,
+				winSize: 512,
+				payload: httpRequest,
+			},
+		},
+		{
+			name:  "UDP over IPv4 with VLAN",
+			frame: buildRequestFrame(t, false, true, 1, dnsQuery),
+			expected: &rejectedRequest{
+				srcMAC:  clientMAC,
+				dstMAC:  serverMAC,
+				srcIP:   net.ParseIP("10.10.0.1").To4(),
+				dstIP:   net.ParseIP("10.10.0.2").To4(),
+				isUDP:   true,
+				srcPort: 40000,
+				dstPort: 53,
+				payload: dnsQuery,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request, err := parseRejectedRequest(tc.frame)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected.srcMAC, request.srcMAC)
+			assert.Equal(t, tc.expected.dstMAC, request.dstMAC)
+			assert.True(t, tc.expected.srcIP.Equal(request.srcIP))
+			assert.True(t, tc.expected.dstIP.Equal(request.dstIP))
+			assert.Equal(t, tc.expected.isIPv6, request.isIPv6)
+			assert.Equal(t, tc.expected.isUDP, request.isUDP)
+			assert.Equal(t, tc.expected.srcPort, request.srcPort)
+			assert.Equal(t, tc.expected.dstPort, request.dstPort)
+			assert.Equal(t, tc.expected.seqNum, request.seqNum)
+			assert.Equal(t, tc.expected.ackNum, request.ackNum)
+			assert.Equal(t, tc.expected.winSize, request.winSize)
+			assert.Equal(t, tc.expected.payload, request.payload)
+		})
+	}
+
+	_, err := parseRejectedRequest([]byte{0x1, 0x2})
+	assert.Error(t, err)
+}
+
+func TestProcessAlert(t *testing.T) {
+	returnPortOFPort := int32(10)
+	dnsQuery := newDNSQuery(t)
+	newEvent := func(metadata map[string][]string, isUDP bool) []byte {
+		payload := httpRequest
+		if isUDP {
+			payload = dnsQuery
+		}
+		event := map[string]interface{}{
+			"event_type": "alert",
+			"alert": map[string]interface{}{
+				"signature_id": 2,
+				"metadata":     metadata,
+			},
+			"packet": buildRequestFrame(t, false, isUDP, 1, payload),
+		}
+		data, err := json.Marshal(event)
+		require.NoError(t, err)
+		return data
+	}
+	testCases := []struct {
+		name           string
+		data           []byte
+		expectedCalls  func(mockOfClient *oftesting.MockClient)
+		expectedErr    string
+		withoutRetPort bool
+	}{
+		{
+			name: "respond with HTTP status code",
+			data: newEvent(map[string][]string{rejectStatusCodeMetadataKey: {"403"}}, false),
+			expectedCalls: func(mockOfClient *oftesting.MockClient) {
+				response := buildHTTPResponse(403)
+				mockOfClient.EXPECT().SendTCPPacketOut(serverMAC.String(), clientMAC.String(), "10.10.0.2", "10.10.0.1", uint32(returnPortOFPort), uint32(0), false,
+					uint16(80), uint16(40000), uint32(2000), uint32(1000+len(httpRequest)), uint8(0), tcpPsh|tcpAck|tcpFin, uint16(512), response, gomock.Any()).Times(1)
+				mockOfClient.EXPECT().SendTCPPacketOut(clientMAC.String(), serverMAC.String(), "10.10.0.1", "10.10.0.2", uint32(returnPortOFPort), uint32(0), false,
+					uint16(40000), uint16(80), uint32(1000), uint32(2000), uint8(0), tcpRst|tcpAck, uint16(0), []byte(nil), gomock.Any()).Times(1)
+			},
+		},
+		{
+			name: "respond with DNS response code",
+			data: newEvent(map[string][]string{rejectDNSResponseCodeMetadataKey: {"NXDOMAIN"}}, true),
+			expectedCalls: func(mockOfClient *oftesting.MockClient) {
+				response, err := buildDNSResponse(dnsQuery, dns.RcodeNameError, false)
+				require.NoError(t, err)
+				mockOfClient.EXPECT().SendUDPPacketOut(serverMAC.String(), clientMAC.String(), "10.10.0.2", "10.10.0.1", uint32(returnPortOFPort), uint32(0), false,
+					uint16(53), uint16(40000), response, gomock.Any()).Times(1)
+			},
+		},
+		{
+			name: "alert without response",
+			data: newEvent(map[string][]string{"other": {"value"}}, false),
+		},
+		{
+			name:        "invalid status code",
+			data:        newEvent(map[string][]string{rejectStatusCodeMetadataKey: {"forbidden"}}, false),
+			expectedErr: "invalid reject status code",
+		},
+		{
+			name:        "invalid DNS response code",
+			data:        newEvent(map[string][]string{rejectDNSResponseCodeMetadataKey: {"FOO"}}, true),
+			expectedErr: "invalid reject DNS response code",
+		},
+		{
+			name:           "return port not found",
+			data:           newEvent(map[string][]string{rejectStatusCodeMetadataKey: {"403"}}, false),
+			withoutRetPort: true,
+			expectedErr:    "interface antrea-l7-tap1 not found",
+		},
+		{
+			name: "not an alert",
+			data: []byte(`{"event_type":"http"}`),
+		},
+		{
+			name:        "invalid JSON",
+			data:        []byte(`{"event_type":`),
+			expectedErr: "error parsing JSON data",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockOfClient := oftesting.NewMockClient(ctrl)
+			ifaceStore := interfacestore.NewInterfaceStore()
+			if !tc.withoutRetPort {
+				ifaceStore.AddInterface(&interfacestore.InterfaceConfig{
+					InterfaceName: config.L7RedirectReturnPortName,
+					Type:          interfacestore.TrafficControlInterface,
+					OVSPortConfig: &interfacestore.OVSPortConfig{OFPort: returnPortOFPort},
+				})
+			}
+			if tc.expectedCalls != nil {
+				tc.expectedCalls(mockOfClient)
+			}
+			responder := newResponder(mockOfClient, ifaceStore)
+			err := responder.processAlert(tc.data)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -55,13 +55,15 @@ import (
 const testNamespace = "ns1"
 
 var mockOFTables = map[*openflow.Table]uint8{
-	openflow.AntreaPolicyEgressRuleTable:  uint8(5),
-	openflow.EgressRuleTable:              uint8(6),
-	openflow.EgressDefaultTable:           uint8(7),
-	openflow.AntreaPolicyIngressRuleTable: uint8(12),
-	openflow.IngressRuleTable:             uint8(13),
-	openflow.IngressDefaultTable:          uint8(14),
-	openflow.OutputTable:                  uint8(28),
+	openflow.AntreaPolicyEgressAuditTable:  uint8(4),
+	openflow.AntreaPolicyEgressRuleTable:   uint8(5),
+	openflow.EgressRuleTable:               uint8(6),
+	openflow.EgressDefaultTable:            uint8(7),
+	openflow.AntreaPolicyIngressAuditTable: uint8(11),
+	openflow.AntreaPolicyIngressRuleTable:  uint8(12),
+	openflow.IngressRuleTable:              uint8(13),
+	openflow.IngressDefaultTable:           uint8(14),
+	openflow.OutputTable:                   uint8(28),
 }
 
 type antreaClientGetter struct {
@@ -79,7 +81,7 @@ func newTestController() (*Controller, *fake.Clientset, *mockReconciler) {
 	groupIDAllocator := openflow.NewGroupAllocator()
 	groupCounters := []proxytypes.GroupCounter{proxytypes.NewGroupCounter(groupIDAllocator, ch2)}
 	fs := afero.NewMemMapFs()
-	l7reconciler := l7engine.NewReconciler(nil)
+	l7reconciler := l7engine.NewReconciler(nil, nil)
 	controller, _ := NewNetworkPolicyController(&antreaClientGetter{clientset},
 		nil,
 		nil,
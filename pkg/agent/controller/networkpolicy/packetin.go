@@ -33,6 +33,10 @@ import (
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 )
 
+// auditDisposition is used to report traffic which matches a rule of an Antrea-native policy in
+// Audit mode, and which would have been denied by the rule if the policy was enforced.
+const auditDisposition = "Audit"
+
 // HandlePacketIn is the packetIn handler registered to openflow by Antrea network
 // policy agent controller. It performs the appropriate operations based on which
 // bits are set in the "custom reasons" field of the packet received from OVS.
@@ -67,6 +71,17 @@ func (c *Controller) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 	return nil
 }
 
+// isAuditPacket returns whether the packet was sent to antrea-agent by a rule of an Antrea-native
+// policy in Audit mode.
+func isAuditPacket(matchers *ofctrl.Matchers) bool {
+	match := getMatchRegField(matchers, openflow.APAuditRegMark.GetField())
+	if match == nil {
+		return false
+	}
+	auditRegVal, err := getInfoInReg(match, openflow.APAuditRegMark.GetField().GetRange().ToNXRange())
+	return err == nil && auditRegVal == openflow.APAuditRegMark.GetValue()
+}
+
 // getMatchRegField returns match to the regNum register.
 func getMatchRegField(matchers *ofctrl.Matchers, field *binding.RegField) *ofctrl.MatchField {
 	return openflow.GetMatchFieldByRegID(matchers, field.GetRegID())
@@ -75,11 +90,11 @@ func getMatchRegField(matchers *ofctrl.Matchers, field *binding.RegField) *ofctr
 // getMatch receives ofctrl matchers and table id, match field.
 // Modifies match field to Ingress/Egress register based on tableID.
 func getMatch(matchers *ofctrl.Matchers, tableID uint8, disposition uint32) *ofctrl.MatchField {
-	// Get match from CNPDenyConjIDReg if disposition is Drop or Reject.
-	if disposition == openflow.DispositionDrop || disposition == openflow.DispositionRej {
+	// Get match from CNPDenyConjIDReg if disposition is Drop or Reject, unless the rule is in Audit mode.
+	if (disposition == openflow.DispositionDrop || disposition == openflow.DispositionRej) && !isAuditPacket(matchers) {
 		return getMatchRegField(matchers, openflow.APConjIDField)
 	}
-	// Get match from ingress/egress reg if disposition is Allow or Pass.
+	// Get match from ingress/egress reg if disposition is Allow or Pass, or if the rule is in Audit mode.
 	for _, table := range append(openflow.GetAntreaPolicyEgressTables(), openflow.EgressRuleTable) {
 		if table.IsInitialized() && tableID == table.GetID() {
 			return getMatchRegField(matchers, openflow.TFEgressConjIDField)
@@ -159,6 +174,10 @@ func (c *Controller) storeDenyConnectionParsed(pktIn *ofctrl.PacketIn, packet *b
 		return fmt.Errorf("error when getting disposition from reg: %v", err)
 	}
 	disposition := openflow.DispositionToString[id]
+	// The connection is not actually denied if the rule is in Audit mode.
+	if isAuditPacket(matchers) {
+		disposition = auditDisposition
+	}
 
 	// Set match to corresponding ingress/egress reg according to disposition
 	match = getMatch(matchers, tableID, id)
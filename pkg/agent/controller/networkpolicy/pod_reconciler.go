@@ -255,6 +255,13 @@ func newPodReconciler(ofClient openflow.Client,
 				assigner: newPriorityAssigner(false),
 			}
 		}
+		// The rules of the policies in Audit mode are installed in dedicated tables, in which
+		// the rules of all Tiers, including the baseline Tier, are ordered by Tier priority.
+		for _, table := range openflow.GetAntreaPolicyAuditTables() {
+			priorityAssigners[table.GetID()] = &tablePriorityAssigner{
+				assigner: newPriorityAssigner(false),
+			}
+		}
 		if multicastEnabled {
 			for _, table := range openflow.GetAntreaMulticastEgressTables() {
 				priorityAssigners[table.GetID()] = &tablePriorityAssigner{
@@ -299,6 +306,10 @@ func (r *podReconciler) Reconcile(rule *CompletedRule) error {
 	var err error
 	var ofPriority *uint16
 
+	if r.isUnsupportedAuditRule(rule) {
+		klog.InfoS("Ignoring multicast or IGMP rule of NetworkPolicy in Audit mode", "rule", rule.ID, "policy", rule.SourceRef.ToString())
+		return nil
+	}
 	value, exists := r.lastRealizeds.Load(rule.ID)
 	ruleTable := r.getOFRuleTable(rule)
 	priorityAssigner := r.priorityAssigners[ruleTable]
@@ -347,9 +358,16 @@ func (r *podReconciler) getRuleType(rule *CompletedRule) ruleType {
 	return unicast
 }
 
+// isUnsupportedAuditRule returns whether the CompletedRule belongs to a NetworkPolicy in Audit
+// mode but cannot be audited. Audit mode is only supported for unicast traffic, hence the
+// multicast and IGMP rules of such policies are neither enforced nor audited.
+func (r *podReconciler) isUnsupportedAuditRule(rule *CompletedRule) bool {
+	return rule.isAudit() && r.getRuleType(rule) != unicast
+}
+
 // getOFRuleTable retrieves the OpenFlow table to install the CompletedRule.
-// The decision is made based on whether the rule is created for an ACNP/ANNP, and
-// the Tier of that NetworkPolicy.
+// The decision is made based on whether the rule is created for an ACNP/ANNP, the
+// enforcement mode and the Tier of that NetworkPolicy.
 func (r *podReconciler) getOFRuleTable(rule *CompletedRule) uint8 {
 	rType := r.getRuleType(rule)
 	var ruleTables []*openflow.Table
@@ -367,6 +385,9 @@ func (r *podReconciler) getOFRuleTable(rule *CompletedRule) uint8 {
 		} else {
 			ruleTables = openflow.GetAntreaPolicyEgressTables()
 		}
+		if rule.isAudit() {
+			return ruleTables[2].GetID()
+		}
 		if *rule.TierPriority != baselineTierPriority && *rule.TierPriority != banpTierPriority {
 			return ruleTables[0].GetID()
 		}
@@ -439,6 +460,8 @@ func (r *podReconciler) BatchReconcile(rules []*CompletedRule) error {
 	for _, rule := range rules {
 		if _, exists := r.lastRealizeds.Load(rule.ID); exists {
 			klog.ErrorS(nil, "Rule should not have been realized yet: initialization phase", "rule", rule.ID)
+		} else if r.isUnsupportedAuditRule(rule) {
+			klog.InfoS("Ignoring multicast or IGMP rule of NetworkPolicy in Audit mode", "rule", rule.ID, "policy", rule.SourceRef.ToString())
 		} else {
 			rulesToInstall = append(rulesToInstall, rule)
 		}
@@ -531,16 +554,19 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 		ofPorts := r.getOFPorts(rule.TargetMembers)
 		lastRealized.podOFPorts[igmpServicesKey] = ofPorts
 		ofRuleByServicesMap[igmpServicesKey] = &types.PolicyRule{
-			Direction:     v1beta2.DirectionIn,
-			To:            ofPortsToOFAddresses(ofPorts),
-			Service:       rule.Services,
-			Action:        rule.Action,
-			Name:          rule.Name,
-			Priority:      ofPriority,
-			TableID:       table,
-			PolicyRef:     rule.SourceRef,
-			EnableLogging: rule.EnableLogging,
-			LogLabel:      rule.LogLabel,
+			Direction:      v1beta2.DirectionIn,
+			To:             ofPortsToOFAddresses(ofPorts),
+			Service:        rule.Services,
+			Action:         rule.Action,
+			Name:           rule.Name,
+			Priority:       ofPriority,
+			TableID:        table,
+			PolicyRef:      rule.SourceRef,
+			EnableLogging:  rule.EnableLogging,
+			LogLabel:       rule.LogLabel,
+			Audit:          rule.isAudit(),
+			RateLimit:      rule.RateLimit,
+			RejectResponse: rule.RejectResponse,
 		}
 		return ofRuleByServicesMap, lastRealized
 	} else if isIGMP {
@@ -571,19 +597,22 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 				lastRealized.podOFPorts[svcKey] = ofPorts
 			}
 			ofRuleByServicesMap[svcKey] = &types.PolicyRule{
-				Direction:     v1beta2.DirectionIn,
-				From:          from,
-				To:            toAddresses,
-				Service:       filterUnresolvablePort(servicesMap[svcKey]),
-				L7Protocols:   rule.L7Protocols,
-				L7RuleVlanID:  rule.L7RuleVlanID,
-				Action:        rule.Action,
-				Name:          rule.Name,
-				Priority:      ofPriority,
-				TableID:       table,
-				PolicyRef:     rule.SourceRef,
-				EnableLogging: rule.EnableLogging,
-				LogLabel:      rule.LogLabel,
+				Direction:      v1beta2.DirectionIn,
+				From:           from,
+				To:             toAddresses,
+				Service:        filterUnresolvablePort(servicesMap[svcKey]),
+				L7Protocols:    rule.L7Protocols,
+				L7RuleVlanID:   rule.L7RuleVlanID,
+				Action:         rule.Action,
+				Name:           rule.Name,
+				Priority:       ofPriority,
+				TableID:        table,
+				PolicyRef:      rule.SourceRef,
+				EnableLogging:  rule.EnableLogging,
+				LogLabel:       rule.LogLabel,
+				Audit:          rule.isAudit(),
+				RateLimit:      rule.RateLimit,
+				RejectResponse: rule.RejectResponse,
 			}
 		}
 	} else {
@@ -602,19 +631,22 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 		memberByServicesMap, servicesMap := groupMembersByServices(rule.Services, rule.ToAddresses)
 		for svcKey, members := range memberByServicesMap {
 			ofRuleByServicesMap[svcKey] = &types.PolicyRule{
-				Direction:     v1beta2.DirectionOut,
-				From:          from,
-				To:            groupMembersToOFAddresses(members),
-				Service:       filterUnresolvablePort(servicesMap[svcKey]),
-				L7Protocols:   rule.L7Protocols,
-				L7RuleVlanID:  rule.L7RuleVlanID,
-				Action:        rule.Action,
-				Priority:      ofPriority,
-				Name:          rule.Name,
-				TableID:       table,
-				PolicyRef:     rule.SourceRef,
-				EnableLogging: rule.EnableLogging,
-				LogLabel:      rule.LogLabel,
+				Direction:      v1beta2.DirectionOut,
+				From:           from,
+				To:             groupMembersToOFAddresses(members),
+				Service:        filterUnresolvablePort(servicesMap[svcKey]),
+				L7Protocols:    rule.L7Protocols,
+				L7RuleVlanID:   rule.L7RuleVlanID,
+				Action:         rule.Action,
+				Priority:       ofPriority,
+				Name:           rule.Name,
+				TableID:        table,
+				PolicyRef:      rule.SourceRef,
+				EnableLogging:  rule.EnableLogging,
+				LogLabel:       rule.LogLabel,
+				Audit:          rule.isAudit(),
+				RateLimit:      rule.RateLimit,
+				RejectResponse: rule.RejectResponse,
 			}
 		}
 
@@ -629,17 +661,20 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 			// Create a new Openflow rule if the group doesn't exist.
 			if !exists {
 				ofRule = &types.PolicyRule{
-					Direction:     v1beta2.DirectionOut,
-					From:          from,
-					To:            []types.Address{},
-					Service:       filterUnresolvablePort(rule.Services),
-					Action:        rule.Action,
-					Name:          rule.Name,
-					Priority:      nil,
-					TableID:       table,
-					PolicyRef:     rule.SourceRef,
-					EnableLogging: rule.EnableLogging,
-					LogLabel:      rule.LogLabel,
+					Direction:      v1beta2.DirectionOut,
+					From:           from,
+					To:             []types.Address{},
+					Service:        filterUnresolvablePort(rule.Services),
+					Action:         rule.Action,
+					Name:           rule.Name,
+					Priority:       nil,
+					TableID:        table,
+					PolicyRef:      rule.SourceRef,
+					EnableLogging:  rule.EnableLogging,
+					LogLabel:       rule.LogLabel,
+					Audit:          rule.isAudit(),
+					RateLimit:      rule.RateLimit,
+					RejectResponse: rule.RejectResponse,
 				}
 				ofRuleByServicesMap[svcKey] = ofRule
 			}
@@ -728,18 +763,21 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 		// Install a new Openflow rule if this group doesn't exist, otherwise do incremental update.
 		if !exists {
 			ofRule := &types.PolicyRule{
-				Direction:     v1beta2.DirectionIn,
-				To:            ofPortsToOFAddresses(newOFPorts),
-				Service:       newRule.Services,
-				L7Protocols:   newRule.L7Protocols,
-				L7RuleVlanID:  newRule.L7RuleVlanID,
-				Action:        newRule.Action,
-				Priority:      ofPriority,
-				FlowID:        ofID,
-				TableID:       table,
-				PolicyRef:     newRule.SourceRef,
-				EnableLogging: newRule.EnableLogging,
-				LogLabel:      newRule.LogLabel,
+				Direction:      v1beta2.DirectionIn,
+				To:             ofPortsToOFAddresses(newOFPorts),
+				Service:        newRule.Services,
+				L7Protocols:    newRule.L7Protocols,
+				L7RuleVlanID:   newRule.L7RuleVlanID,
+				Action:         newRule.Action,
+				Priority:       ofPriority,
+				FlowID:         ofID,
+				TableID:        table,
+				PolicyRef:      newRule.SourceRef,
+				EnableLogging:  newRule.EnableLogging,
+				LogLabel:       newRule.LogLabel,
+				Audit:          newRule.isAudit(),
+				RateLimit:      newRule.RateLimit,
+				RejectResponse: newRule.RejectResponse,
 			}
 			err := r.idAllocator.allocateForRule(ofRule)
 			if err != nil {
@@ -785,19 +823,22 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 			// Install a new Openflow rule if this group doesn't exist, otherwise do incremental update.
 			if !exists {
 				ofRule := &types.PolicyRule{
-					Direction:     v1beta2.DirectionIn,
-					From:          append(from1, from2...),
-					To:            toAddresses,
-					Service:       filterUnresolvablePort(servicesMap[svcKey]),
-					L7Protocols:   newRule.L7Protocols,
-					L7RuleVlanID:  newRule.L7RuleVlanID,
-					Action:        newRule.Action,
-					Priority:      ofPriority,
-					FlowID:        ofID,
-					TableID:       table,
-					PolicyRef:     newRule.SourceRef,
-					EnableLogging: newRule.EnableLogging,
-					LogLabel:      newRule.LogLabel,
+					Direction:      v1beta2.DirectionIn,
+					From:           append(from1, from2...),
+					To:             toAddresses,
+					Service:        filterUnresolvablePort(servicesMap[svcKey]),
+					L7Protocols:    newRule.L7Protocols,
+					L7RuleVlanID:   newRule.L7RuleVlanID,
+					Action:         newRule.Action,
+					Priority:       ofPriority,
+					FlowID:         ofID,
+					TableID:        table,
+					PolicyRef:      newRule.SourceRef,
+					EnableLogging:  newRule.EnableLogging,
+					LogLabel:       newRule.LogLabel,
+					Audit:          newRule.isAudit(),
+					RateLimit:      newRule.RateLimit,
+					RejectResponse: newRule.RejectResponse,
 				}
 				err := r.idAllocator.allocateForRule(ofRule)
 				if err != nil {
@@ -824,7 +865,7 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 					addedTo = ofPortsToOFAddresses(newOFPorts.Difference(originalOfPortsSet))
 					deletedTo = ofPortsToOFAddresses(originalOfPortsSet.Difference(newOFPorts))
 				}
-				if err := r.updateOFRule(ofID, addedFrom, addedTo, deletedFrom, deletedTo, ofPriority, newRule.EnableLogging, len(newRule.From.LabelIdentities) > 0); err != nil {
+				if err := r.updateOFRule(ofID, addedFrom, addedTo, deletedFrom, deletedTo, ofPriority, newRule.EnableLogging, len(newRule.From.LabelIdentities) > 0 && !newRule.isAudit()); err != nil {
 					return err
 				}
 				// Delete valid servicesKey from staleOFIDs.
@@ -857,19 +898,22 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 			ofID, exists := lastRealized.ofIDs[svcKey]
 			if !exists {
 				ofRule := &types.PolicyRule{
-					Direction:     v1beta2.DirectionOut,
-					From:          from,
-					To:            groupMembersToOFAddresses(members),
-					Service:       filterUnresolvablePort(servicesMap[svcKey]),
-					L7Protocols:   newRule.L7Protocols,
-					L7RuleVlanID:  newRule.L7RuleVlanID,
-					Action:        newRule.Action,
-					Priority:      ofPriority,
-					FlowID:        ofID,
-					TableID:       table,
-					PolicyRef:     newRule.SourceRef,
-					EnableLogging: newRule.EnableLogging,
-					LogLabel:      newRule.LogLabel,
+					Direction:      v1beta2.DirectionOut,
+					From:           from,
+					To:             groupMembersToOFAddresses(members),
+					Service:        filterUnresolvablePort(servicesMap[svcKey]),
+					L7Protocols:    newRule.L7Protocols,
+					L7RuleVlanID:   newRule.L7RuleVlanID,
+					Action:         newRule.Action,
+					Priority:       ofPriority,
+					FlowID:         ofID,
+					TableID:        table,
+					PolicyRef:      newRule.SourceRef,
+					EnableLogging:  newRule.EnableLogging,
+					LogLabel:       newRule.LogLabel,
+					Audit:          newRule.isAudit(),
+					RateLimit:      newRule.RateLimit,
+					RejectResponse: newRule.RejectResponse,
 				}
 				// If the PolicyRule for the original services doesn't exist and IPBlocks is present, it means the
 				// podReconciler hasn't installed flows for IPBlocks, then it must be added to the new PolicyRule.
@@ -20,10 +20,12 @@ import (
 
 	"antrea.io/libOpenflow/protocol"
 	"antrea.io/ofnet/ofctrl"
+	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/openflow"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 )
 
@@ -200,6 +202,7 @@ func (c *Controller) rejectRequest(pktIn *ofctrl.PacketIn) error {
 
 	inPort, outPort := getRejectOFPorts(packetOutType, sIface, dIface, c.gwPort, c.tunPort)
 	mutateFunc := getRejectPacketOutMutateFunc(packetOutType, c.nodeType, isFlexibleIPAMSrc, isFlexibleIPAMDst, ctZone)
+	rejectResponse := c.getRejectResponse(matches)
 
 	return openflow.SendRejectPacketOut(c.ofClient,
 		srcMAC,
@@ -211,9 +214,30 @@ func (c *Controller) rejectRequest(pktIn *ofctrl.PacketIn) error {
 		isIPv6,
 		ethernetPkt,
 		proto,
+		rejectResponse,
 		mutateFunc)
 }
 
+// getRejectResponse returns the reject response configured in the rule which rejected the packet.
+// An empty response, which means the default response, is returned if the rule cannot be found.
+func (c *Controller) getRejectResponse(matches *ofctrl.Matchers) crdv1beta1.RejectResponseType {
+	match := getMatchRegField(matches, openflow.APConjIDField)
+	if match == nil {
+		return ""
+	}
+	ruleID, err := getInfoInReg(match, nil)
+	if err != nil {
+		klog.ErrorS(err, "Error when obtaining rule ID from reg")
+		return ""
+	}
+	rule := c.GetRuleByFlowID(ruleID)
+	if rule == nil {
+		klog.V(4).InfoS("Cannot find rule for the rejected packet, using the default reject response", "ruleID", ruleID)
+		return ""
+	}
+	return rule.RejectResponse
+}
+
 // getRejectType returns rejectType of a rejection.
 func getRejectType(isServiceTraffic, antreaProxyEnabled, srcIsLocal, dstIsLocal, srcFromTun bool) rejectType {
 	if !isServiceTraffic {
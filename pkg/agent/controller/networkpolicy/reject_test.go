@@ -27,6 +27,8 @@ import (
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/agent/types"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 	mocks "antrea.io/antrea/pkg/ovs/openflow/testing"
 )
@@ -512,3 +514,48 @@ func Test_getRejectPacketOutMutateFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRejectResponse(t *testing.T) {
+	ruleCache := &fakeRuleCache{
+		map[uint32]*types.PolicyRule{
+			0x11: {Name: "default-response"},
+			0x12: {Name: "port-unreachable", RejectResponse: crdv1beta1.RejectResponseICMPPortUnreachable},
+		},
+	}
+	tests := []struct {
+		name             string
+		matchers         []openflow15.MatchField
+		expectedResponse crdv1beta1.RejectResponseType
+	}{
+		{
+			name:     "no conjunction ID",
+			matchers: []openflow15.MatchField{},
+		},
+		{
+			name:     "unknown rule",
+			matchers: []openflow15.MatchField{generateRegMatch(openflow.APConjIDField.GetRegID(), []byte{0x00, 0x00, 0x00, 0x13})},
+		},
+		{
+			name:     "default response",
+			matchers: []openflow15.MatchField{generateRegMatch(openflow.APConjIDField.GetRegID(), []byte{0x00, 0x00, 0x00, 0x11})},
+		},
+		{
+			name:             "configured response",
+			matchers:         []openflow15.MatchField{generateRegMatch(openflow.APConjIDField.GetRegID(), []byte{0x00, 0x00, 0x00, 0x12})},
+			expectedResponse: crdv1beta1.RejectResponseICMPPortUnreachable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller, _, _ := newTestController()
+			controller.podReconciler = ruleCache
+			pktIn := &ofctrl.PacketIn{
+				PacketIn: &openflow15.PacketIn{
+					TableId: openflow.AntreaPolicyIngressRuleTable.GetID(),
+					Match:   openflow15.Match{Fields: tt.matchers},
+				},
+			}
+			assert.Equal(t, tt.expectedResponse, controller.getRejectResponse(pktIn.GetMatches()))
+		})
+	}
+}
@@ -47,6 +47,7 @@ import (
 	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/querier"
+	utilip "antrea.io/antrea/pkg/util/ip"
 )
 
 const (
@@ -500,6 +501,8 @@ func (c *Controller) preparePacket(tf *crdv1beta1.Traceflow, intf *interfacestor
 				packet.TCPFlags = uint8(2)
 			case corev1.ProtocolUDP:
 				packet.IPProto = protocol.Type_UDP
+			case corev1.ProtocolSCTP:
+				packet.IPProto = utilip.SCTPProtocol
 			}
 			packet.DestinationPort = uint16(dstSvc.Spec.Ports[0].Port)
 		}
@@ -531,7 +534,7 @@ func (c *Controller) preparePacket(tf *crdv1beta1.Traceflow, intf *interfacestor
 		packet.TTL = defaultTTL
 	}
 
-	// TCP > UDP > ICMP > other IP protocol.
+	// TCP > UDP > SCTP > ICMP > other IP protocol.
 	if tf.Spec.Packet.TransportHeader.TCP != nil {
 		packet.IPProto = protocol.Type_TCP
 		packet.SourcePort = uint16(tf.Spec.Packet.TransportHeader.TCP.SrcPort)
@@ -551,6 +554,11 @@ func (c *Controller) preparePacket(tf *crdv1beta1.Traceflow, intf *interfacestor
 		packet.TCPFlags = uint8(0)
 		packet.SourcePort = uint16(tf.Spec.Packet.TransportHeader.UDP.SrcPort)
 		packet.DestinationPort = uint16(tf.Spec.Packet.TransportHeader.UDP.DstPort)
+	} else if tf.Spec.Packet.TransportHeader.SCTP != nil {
+		packet.IPProto = utilip.SCTPProtocol
+		packet.TCPFlags = uint8(0)
+		packet.SourcePort = uint16(tf.Spec.Packet.TransportHeader.SCTP.SrcPort)
+		packet.DestinationPort = uint16(tf.Spec.Packet.TransportHeader.SCTP.DstPort)
 	} else if tf.Spec.Packet.TransportHeader.ICMP != nil {
 		isICMP = true
 		packet.TCPFlags = uint8(0)
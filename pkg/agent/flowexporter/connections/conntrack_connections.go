@@ -361,6 +361,16 @@ func (cs *ConntrackConnectionStore) fillL7EventInfo(l7EventMap map[connection.Tu
 				conn.HttpVals += string(jsonBytes)
 				conn.AppProtocolName = "http"
 			}
+			// Kafka requests are exported in the same field as HTTP transactions, keyed by their
+			// correlation IDs.
+			if len(l7event.kafka) > 0 {
+				jsonBytes, err := json.Marshal(l7event.kafka)
+				if err != nil {
+					klog.ErrorS(err, "Converting l7Event kafka failed")
+				}
+				conn.HttpVals += string(jsonBytes)
+				conn.AppProtocolName = "kafka"
+			}
 			// In case L7 event is received after the last planned export of the TCP connection, add
 			// the event back to the queue to be exported in next export cycle
 			_, exists := cs.expirePriorityQueue.KeyToItem[connKey]
@@ -238,6 +238,24 @@ func TestConnTrackOvsAppCtl_DumpFlows(t *testing.T) {
 	assert.Equal(t, len(outputFlow), totalConns, "Number of connections in conntrack table should be equal to outputFlow")
 }
 
+func TestFlowStringToAntreaConnectionSCTP(t *testing.T) {
+	flow := "sctp,orig=(src=10.10.0.5,dst=10.10.1.7,sport=36412,dport=38412,packets=12,bytes=1320),reply=(src=10.10.1.7,dst=10.10.0.5,sport=38412,dport=36412,packets=10,bytes=1100),start=2025-03-01T10:00:00.000,id=1234567,zone=65520,status=SEEN_REPLY|ASSURED|CONFIRMED,timeout=431999,protoinfo=(state=ESTABLISHED)"
+	conn, err := flowStringToAntreaConnection(flow, uint16(openflow.CtZone))
+	require.NoError(t, err)
+	require.NotNil(t, conn)
+	assert.Equal(t, connection.Tuple{
+		SourceAddress:      netip.MustParseAddr("10.10.0.5"),
+		DestinationAddress: netip.MustParseAddr("10.10.1.7"),
+		Protocol:           132,
+		SourcePort:         uint16(36412),
+		DestinationPort:    uint16(38412),
+	}, conn.FlowKey)
+	assert.Equal(t, uint64(12), conn.OriginalPackets)
+	assert.Equal(t, uint64(10), conn.ReversePackets)
+	// The SCTP state must not be reported as a TCP state.
+	assert.Empty(t, conn.TCPState)
+}
+
 func TestConnTrackSystem_GetMaxConnections(t *testing.T) {
 	connDumperDPSystem := NewConnTrackSystem(&config.NodeConfig{}, netip.Prefix{}, netip.Prefix{}, false, filter.NewProtocolFilter(nil))
 	maxConns, err := connDumperDPSystem.GetMaxConnections()
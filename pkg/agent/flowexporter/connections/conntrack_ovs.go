@@ -275,8 +275,9 @@ func flowStringToAntreaConnection(flow string, zoneFilter uint16) (*connection.C
 			conn.ID = uint32(val)
 		case strings.Contains(fs, "protoinfo"):
 			fields := strings.Split(fs, "(")
-			// retrieve tcpState from state or state_orig
-			if strings.Contains(fields[1], "state") {
+			// retrieve tcpState from state or state_orig. protoinfo also includes the state of
+			// SCTP connections, which must not be reported as a TCP state.
+			if conn.FlowKey.Protocol == utils.Protocols["tcp"] && strings.Contains(fields[1], "state") {
 				items := strings.Split(fields[1], "=")
 				conn.TCPState = items[1]
 			}
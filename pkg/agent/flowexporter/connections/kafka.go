@@ -0,0 +1,344 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	kafkaAPIKeyProduce  = 0
+	kafkaAPIKeyFetch    = 1
+	kafkaAPIKeyMetadata = 3
+)
+
+var (
+	// kafkaAPIKeyNames maps the API keys of the Kafka requests which are exported as L7 flows to
+	// their names.
+	kafkaAPIKeyNames = map[int16]string{
+		kafkaAPIKeyProduce:  "produce",
+		kafkaAPIKeyFetch:    "fetch",
+		kafkaAPIKeyMetadata: "metadata",
+	}
+	// kafkaFlexibleVersions maps the API keys to the first versions of the requests which use
+	// compact strings and arrays, and have tagged fields.
+	kafkaFlexibleVersions = map[int16]int16{
+		kafkaAPIKeyProduce:  9,
+		kafkaAPIKeyFetch:    12,
+		kafkaAPIKeyMetadata: 9,
+	}
+
+	errKafkaRequestTruncated = errors.New("truncated Kafka request")
+)
+
+// kafkaReader reads the fields of a Kafka request. See https://kafka.apache.org/protocol for the
+// encoding of the fields.
+type kafkaReader struct {
+	data     []byte
+	flexible bool
+}
+
+func (r *kafkaReader) skip(n int) error {
+	if n < 0 || len(r.data) < n {
+		return errKafkaRequestTruncated
+	}
+	r.data = r.data[n:]
+	return nil
+}
+
+func (r *kafkaReader) int16() (int16, error) {
+	if len(r.data) < 2 {
+		return 0, errKafkaRequestTruncated
+	}
+	v := int16(binary.BigEndian.Uint16(r.data))
+	r.data = r.data[2:]
+	return v, nil
+}
+
+func (r *kafkaReader) int32() (int32, error) {
+	if len(r.data) < 4 {
+		return 0, errKafkaRequestTruncated
+	}
+	v := int32(binary.BigEndian.Uint32(r.data))
+	r.data = r.data[4:]
+	return v, nil
+}
+
+func (r *kafkaReader) uvarint() (uint64, error) {
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		return 0, errKafkaRequestTruncated
+	}
+	r.data = r.data[n:]
+	return v, nil
+}
+
+// compactLength reads the length of a compact string, bytes or array, which is encoded as an
+// unsigned varint of the length plus 1. It returns -1 if the field is null.
+func (r *kafkaReader) compactLength() (int, error) {
+	v, err := r.uvarint()
+	if err != nil {
+		return 0, err
+	}
+	return int(v) - 1, nil
+}
+
+// arrayLength reads the length of bytes or an array. It returns -1 if the field is null.
+func (r *kafkaReader) arrayLength() (int, error) {
+	if r.flexible {
+		return r.compactLength()
+	}
+	n, err := r.int32()
+	return int(n), err
+}
+
+// string reads a string. It returns an empty string if the field is null.
+func (r *kafkaReader) string() (string, error) {
+	var n int
+	var err error
+	if r.flexible {
+		n, err = r.compactLength()
+	} else {
+		var n16 int16
+		n16, err = r.int16()
+		n = int(n16)
+	}
+	if err != nil || n < 0 {
+		return "", err
+	}
+	if len(r.data) < n {
+		return "", errKafkaRequestTruncated
+	}
+	s := string(r.data[:n])
+	r.data = r.data[n:]
+	return s, nil
+}
+
+func (r *kafkaReader) skipBytes() error {
+	n, err := r.arrayLength()
+	if err != nil || n < 0 {
+		return err
+	}
+	return r.skip(n)
+}
+
+func (r *kafkaReader) skipTaggedFields() error {
+	if !r.flexible {
+		return nil
+	}
+	n, err := r.uvarint()
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < n; i++ {
+		if _, err := r.uvarint(); err != nil {
+			return err
+		}
+		size, err := r.uvarint()
+		if err != nil {
+			return err
+		}
+		if err := r.skip(int(size)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseKafkaRequest parses the header and the topics of a Kafka produce, fetch or metadata request,
+// and returns the request with its correlation ID. As the payload may not contain the whole
+// request, only the topics which can be read are returned.
+func parseKafkaRequest(payload []byte) (*Kafka, int32, error) {
+	r := &kafkaReader{data: payload}
+	size, err := r.int32()
+	if err != nil {
+		return nil, 0, err
+	}
+	apiKey, err := r.int16()
+	if err != nil {
+		return nil, 0, err
+	}
+	apiVersion, err := r.int16()
+	if err != nil {
+		return nil, 0, err
+	}
+	correlationID, err := r.int32()
+	if err != nil {
+		return nil, 0, err
+	}
+	name, ok := kafkaAPIKeyNames[apiKey]
+	if !ok || apiVersion < 0 || size < 10 {
+		return nil, 0, fmt.Errorf("invalid Kafka request with API key %d, API version %d and size %d", apiKey, apiVersion, size)
+	}
+	// The client ID is always a nullable string with a 2-byte length.
+	clientID, err := r.string()
+	if err != nil {
+		return nil, 0, err
+	}
+	kafka := &Kafka{
+		APIKey:     name,
+		APIVersion: apiVersion,
+		ClientID:   clientID,
+	}
+	r.flexible = apiVersion >= kafkaFlexibleVersions[apiKey]
+	if err := r.skipTaggedFields(); err != nil {
+		return kafka, correlationID, nil
+	}
+	switch apiKey {
+	case kafkaAPIKeyProduce:
+		kafka.Topics = r.produceTopics(apiVersion)
+	case kafkaAPIKeyFetch:
+		kafka.Topics = r.fetchTopics(apiVersion)
+	case kafkaAPIKeyMetadata:
+		kafka.Topics = r.metadataTopics(apiVersion)
+	}
+	return kafka, correlationID, nil
+}
+
+func (r *kafkaReader) produceTopics(version int16) []string {
+	// Topics are identified by IDs instead of names since version 13.
+	if version >= 13 {
+		return nil
+	}
+	if version >= 3 {
+		// transactional_id
+		if _, err := r.string(); err != nil {
+			return nil
+		}
+	}
+	// acks and timeout_ms
+	if err := r.skip(6); err != nil {
+		return nil
+	}
+	n, err := r.arrayLength()
+	if err != nil {
+		return nil
+	}
+	var topics []string
+	for i := 0; i < n; i++ {
+		topic, err := r.string()
+		if err != nil {
+			return topics
+		}
+		topics = append(topics, topic)
+		partitions, err := r.arrayLength()
+		if err != nil {
+			return topics
+		}
+		for j := 0; j < partitions; j++ {
+			// index and records
+			if r.skip(4) != nil || r.skipBytes() != nil || r.skipTaggedFields() != nil {
+				return topics
+			}
+		}
+		if r.skipTaggedFields() != nil {
+			return topics
+		}
+	}
+	return topics
+}
+
+func (r *kafkaReader) fetchTopics(version int16) []string {
+	// Topics are identified by IDs instead of names since version 13.
+	if version >= 13 {
+		return nil
+	}
+	// replica_id, max_wait_ms and min_bytes
+	fieldsLen := 12
+	if version >= 3 {
+		// max_bytes
+		fieldsLen += 4
+	}
+	if version >= 4 {
+		// isolation_level
+		fieldsLen += 1
+	}
+	if version >= 7 {
+		// session_id and session_epoch
+		fieldsLen += 8
+	}
+	if err := r.skip(fieldsLen); err != nil {
+		return nil
+	}
+	// partition, fetch_offset and partition_max_bytes
+	partitionLen := 16
+	if version >= 9 {
+		// current_leader_epoch
+		partitionLen += 4
+	}
+	if version >= 12 {
+		// last_fetched_epoch
+		partitionLen += 4
+	}
+	if version >= 5 {
+		// log_start_offset
+		partitionLen += 8
+	}
+	n, err := r.arrayLength()
+	if err != nil {
+		return nil
+	}
+	var topics []string
+	for i := 0; i < n; i++ {
+		topic, err := r.string()
+		if err != nil {
+			return topics
+		}
+		topics = append(topics, topic)
+		partitions, err := r.arrayLength()
+		if err != nil {
+			return topics
+		}
+		for j := 0; j < partitions; j++ {
+			if r.skip(partitionLen) != nil || r.skipTaggedFields() != nil {
+				return topics
+			}
+		}
+		if r.skipTaggedFields() != nil {
+			return topics
+		}
+	}
+	return topics
+}
+
+func (r *kafkaReader) metadataTopics(version int16) []string {
+	// A null array requests the metadata of all topics.
+	n, err := r.arrayLength()
+	if err != nil {
+		return nil
+	}
+	var topics []string
+	for i := 0; i < n; i++ {
+		if version >= 10 {
+			// topic_id
+			if r.skip(16) != nil {
+				return topics
+			}
+		}
+		topic, err := r.string()
+		if err != nil {
+			return topics
+		}
+		if topic != "" {
+			topics = append(topics, topic)
+		}
+		if r.skipTaggedFields() != nil {
+			return topics
+		}
+	}
+	return topics
+}
@@ -0,0 +1,212 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// kafkaRequestBuilder builds the payload of a Kafka request for testing.
+type kafkaRequestBuilder struct {
+	data []byte
+}
+
+func newKafkaRequestBuilder(apiKey, apiVersion int16, correlationID int32, clientID string) *kafkaRequestBuilder {
+	b := &kafkaRequestBuilder{}
+	// The size of the request is set by build().
+	b.int32(0)
+	b.int16(apiKey)
+	b.int16(apiVersion)
+	b.int32(correlationID)
+	b.string(clientID)
+	return b
+}
+
+func (b *kafkaRequestBuilder) int8(v int8) *kafkaRequestBuilder {
+	b.data = append(b.data, byte(v))
+	return b
+}
+
+func (b *kafkaRequestBuilder) int16(v int16) *kafkaRequestBuilder {
+	b.data = binary.BigEndian.AppendUint16(b.data, uint16(v))
+	return b
+}
+
+func (b *kafkaRequestBuilder) int32(v int32) *kafkaRequestBuilder {
+	b.data = binary.BigEndian.AppendUint32(b.data, uint32(v))
+	return b
+}
+
+func (b *kafkaRequestBuilder) int64(v int64) *kafkaRequestBuilder {
+	b.data = binary.BigEndian.AppendUint64(b.data, uint64(v))
+	return b
+}
+
+func (b *kafkaRequestBuilder) uvarint(v uint64) *kafkaRequestBuilder {
+	b.data = binary.AppendUvarint(b.data, v)
+	return b
+}
+
+func (b *kafkaRequestBuilder) string(s string) *kafkaRequestBuilder {
+	b.int16(int16(len(s)))
+	b.data = append(b.data, s...)
+	return b
+}
+
+func (b *kafkaRequestBuilder) compactString(s string) *kafkaRequestBuilder {
+	b.uvarint(uint64(len(s) + 1))
+	b.data = append(b.data, s...)
+	return b
+}
+
+func (b *kafkaRequestBuilder) build() []byte {
+	binary.BigEndian.PutUint32(b.data, uint32(len(b.data)-4))
+	return b.data
+}
+
+func TestParseKafkaRequest(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		payload               []byte
+		expectedKafka         *Kafka
+		expectedCorrelationID int32
+		expectedErr           string
+	}{
+		{
+			name: "produce v7",
+			payload: newKafkaRequestBuilder(kafkaAPIKeyProduce, 7, 5, "producer-1").
+				// transactional_id, acks and timeout_ms
+				int16(-1).int16(1).int32(1000).
+				// topics
+				int32(1).string("orders").
+				// partitions
+				int32(1).int32(0).int32(3).string("a").
+				build(),
+			expectedKafka: &Kafka{
+				APIKey:     "produce",
+				APIVersion: 7,
+				ClientID:   "producer-1",
+				Topics:     []string{"orders"},
+			},
+			expectedCorrelationID: 5,
+		},
+		{
+			name: "produce v9 truncated",
+			payload: newKafkaRequestBuilder(kafkaAPIKeyProduce, 9, 6, "producer-1").
+				// tagged fields, transactional_id, acks and timeout_ms
+				uvarint(0).uvarint(0).int16(-1).int32(1000).
+				// topics
+				uvarint(3).compactString("orders").
+				// partitions
+				uvarint(2).int32(0).uvarint(4).string("a").uvarint(0).uvarint(0).
+				compactString("events").uvarint(2).int32(0).uvarint(100).
+				build(),
+			expectedKafka: &Kafka{
+				APIKey:     "produce",
+				APIVersion: 9,
+				ClientID:   "producer-1",
+				Topics:     []string{"orders", "events"},
+			},
+			expectedCorrelationID: 6,
+		},
+		{
+			name: "fetch v11",
+			payload: newKafkaRequestBuilder(kafkaAPIKeyFetch, 11, 7, "consumer-1").
+				// replica_id, max_wait_ms, min_bytes, max_bytes, isolation_level, session_id and session_epoch
+				int32(-1).int32(500).int32(1).int32(1024).int8(0).int32(0).int32(-1).
+				// topics
+				int32(2).string("orders").
+				// partitions
+				int32(1).int32(0).int32(-1).int64(100).int64(0).int32(1024).
+				string("events").int32(0).
+				// forgotten_topics_data and rack_id
+				int32(0).string("").
+				build(),
+			expectedKafka: &Kafka{
+				APIKey:     "fetch",
+				APIVersion: 11,
+				ClientID:   "consumer-1",
+				Topics:     []string{"orders", "events"},
+			},
+			expectedCorrelationID: 7,
+		},
+		{
+			name: "fetch v13",
+			payload: newKafkaRequestBuilder(kafkaAPIKeyFetch, 13, 8, "consumer-1").
+				uvarint(0).int32(-1).int32(500).int32(1).int32(1024).int8(0).int32(0).int32(-1).
+				build(),
+			expectedKafka: &Kafka{
+				APIKey:     "fetch",
+				APIVersion: 13,
+				ClientID:   "consumer-1",
+			},
+			expectedCorrelationID: 8,
+		},
+		{
+			name: "metadata v1 all topics",
+			payload: newKafkaRequestBuilder(kafkaAPIKeyMetadata, 1, 9, "").
+				int32(-1).
+				build(),
+			expectedKafka: &Kafka{
+				APIKey:     "metadata",
+				APIVersion: 1,
+			},
+			expectedCorrelationID: 9,
+		},
+		{
+			name: "metadata v12",
+			payload: newKafkaRequestBuilder(kafkaAPIKeyMetadata, 12, 10, "client-1").
+				uvarint(0).
+				// topics
+				uvarint(2).int64(0).int64(0).compactString("orders").uvarint(0).
+				// allow_auto_topic_creation and include_topic_authorized_operations
+				int8(1).int8(0).uvarint(0).
+				build(),
+			expectedKafka: &Kafka{
+				APIKey:     "metadata",
+				APIVersion: 12,
+				ClientID:   "client-1",
+				Topics:     []string{"orders"},
+			},
+			expectedCorrelationID: 10,
+		},
+		{
+			name:        "unsupported API key",
+			payload:     newKafkaRequestBuilder(18, 3, 11, "client-1").build(),
+			expectedErr: "invalid Kafka request with API key 18, API version 3 and size 18",
+		},
+		{
+			name:        "truncated header",
+			payload:     []byte{0, 0, 0, 10, 0, 0},
+			expectedErr: "truncated Kafka request",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kafka, correlationID, err := parseKafkaRequest(tc.payload)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedKafka, kafka)
+			assert.Equal(t, tc.expectedCorrelationID, correlationID)
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"net/netip"
 	"os"
 	"path/filepath"
+	"slices"
 	"sync"
 	"time"
 
@@ -40,9 +41,17 @@ type PodL7FlowExporterAttrGetter interface {
 	IsL7FlowExporterRequested(podNN string, ingress bool) bool
 }
 
+const (
+	// l7ProtocolMetadataKey is the key of the alert metadata which indicates the layer 7 protocol of
+	// a request which cannot be logged by Suricata.
+	l7ProtocolMetadataKey = "antrea_l7_protocol"
+	l7ProtocolKafka       = "kafka"
+)
+
 // L7ProtocolFields holds layer 7 protocols supported
 type L7ProtocolFields struct {
-	http map[int32]*Http
+	http  map[int32]*Http
+	kafka map[int32]*Kafka
 }
 
 // Http holds the L7 HTTP flow JSON values.
@@ -57,6 +66,19 @@ type Http struct {
 	ContentLength int32  `json:"length"`
 }
 
+// Kafka holds the L7 Kafka flow JSON values.
+type Kafka struct {
+	APIKey     string   `json:"api_key"`
+	APIVersion int16    `json:"api_version"`
+	ClientID   string   `json:"client_id"`
+	Topics     []string `json:"topics,omitempty"`
+}
+
+// Alert holds the Suricata alert JSON values.
+type Alert struct {
+	Metadata map[string][]string `json:"metadata,omitempty"`
+}
+
 // JsonToEvent holds Suricata event JSON values.
 // See https://docs.suricata.io/en/latest/output/eve/eve-json-format.html?highlight=HTTP%20event#event-types
 type JsonToEvent struct {
@@ -72,6 +94,8 @@ type JsonToEvent struct {
 	Proto       string     `json:"proto"`
 	TxID        int32      `json:"tx_id"`
 	HTTP        *Http      `json:"http"`
+	Alert       *Alert     `json:"alert,omitempty"`
+	Payload     []byte     `json:"payload,omitempty"`
 }
 
 type L7Listener struct {
@@ -170,7 +194,15 @@ func (l *L7Listener) processLog(data []byte) error {
 	if err != nil {
 		return fmt.Errorf("error parsing JSON data %v", data)
 	}
-	if event.EventType != "http" {
+	switch event.EventType {
+	case "http":
+	case "alert":
+		// Alerts are generated for the requests of the layer 7 protocols which cannot be logged by
+		// Suricata, other alerts are ignored.
+		if event.Alert == nil || !slices.Contains(event.Alert.Metadata[l7ProtocolMetadataKey], l7ProtocolKafka) {
+			return nil
+		}
+	default:
 		return nil
 	}
 	if err = l.addOrUpdateL7EventMap(&event); err != nil {
@@ -210,19 +242,33 @@ func (l *L7Listener) addOrUpdateL7EventMap(event *JsonToEvent) error {
 	if dstFound {
 		destinationPodNN = k8sutil.NamespacedName(dstPod.Namespace, dstPod.Name)
 	}
+	if !l.podL7FlowExporterAttrGetter.IsL7FlowExporterRequested(sourcePodNN, false) && !l.podL7FlowExporterAttrGetter.IsL7FlowExporterRequested(destinationPodNN, true) {
+		return nil
+	}
+	var kafka *Kafka
+	var correlationID int32
+	if event.EventType == "alert" {
+		kafka, correlationID, err = parseKafkaRequest(event.Payload)
+		if err != nil {
+			klog.V(4).InfoS("Failed to parse Kafka request", "srcIP", srcIP, "dstIP", dstIP, "err", err)
+			return nil
+		}
+	}
 	l.l7mut.Lock()
 	defer l.l7mut.Unlock()
+	_, ok := l.l7Events[connKey]
+	if !ok {
+		l.l7Events[connKey] = L7ProtocolFields{
+			http:  make(map[int32]*Http),
+			kafka: make(map[int32]*Kafka),
+		}
+	}
 	switch event.EventType {
 	case "http":
-		if l.podL7FlowExporterAttrGetter.IsL7FlowExporterRequested(sourcePodNN, false) || l.podL7FlowExporterAttrGetter.IsL7FlowExporterRequested(destinationPodNN, true) {
-			_, ok := l.l7Events[connKey]
-			if !ok {
-				l.l7Events[connKey] = L7ProtocolFields{
-					http: make(map[int32]*Http),
-				}
-			}
-			l.l7Events[connKey].http[event.TxID] = event.HTTP
-		}
+		l.l7Events[connKey].http[event.TxID] = event.HTTP
+	case "alert":
+		// Kafka requests are identified by their correlation IDs in a connection.
+		l.l7Events[connKey].kafka[correlationID] = kafka
 	}
 	return nil
 }
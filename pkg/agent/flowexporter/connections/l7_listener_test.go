@@ -163,6 +163,7 @@ func TestFlowExporterL7ListenerHttp(t *testing.T) {
 						ContentLength: 153,
 					},
 				},
+				kafka: map[int32]*Kafka{},
 			},
 		}, {
 			name: "Valid case for persistent http",
@@ -237,6 +238,57 @@ func TestFlowExporterL7ListenerHttp(t *testing.T) {
 						ContentLength: 154,
 					},
 				},
+				kafka: map[int32]*Kafka{},
+			},
+		}, {
+			name: "Alert without Kafka request",
+			input: []JsonToEvent{
+				{
+					Timestamp:   time.Now().String(),
+					FlowID:      1,
+					InInterface: "mock_interface",
+					EventType:   "alert",
+					VLAN:        []int32{1},
+					SrcIP:       netip.MustParseAddr("10.10.0.1"),
+					SrcPort:     59922,
+					DestIP:      netip.MustParseAddr("10.10.0.2"),
+					DestPort:    80,
+					Proto:       "TCP",
+					Alert:       &Alert{},
+				},
+			},
+			eventPresent:   false,
+			expectedEvents: L7ProtocolFields{},
+		}, {
+			name: "Valid case for Kafka",
+			input: []JsonToEvent{
+				{
+					Timestamp:   time.Now().String(),
+					FlowID:      1,
+					InInterface: "mock_interface",
+					EventType:   "alert",
+					SrcIP:       netip.MustParseAddr("10.10.0.1"),
+					SrcPort:     59923,
+					DestIP:      netip.MustParseAddr("10.10.0.2"),
+					DestPort:    9092,
+					Proto:       "TCP",
+					Alert: &Alert{
+						Metadata: map[string][]string{"antrea_l7_protocol": {"kafka"}},
+					},
+					Payload: newKafkaRequestBuilder(kafkaAPIKeyMetadata, 1, 3, "client-1").int32(1).string("orders").build(),
+				},
+			},
+			eventPresent: true,
+			expectedEvents: L7ProtocolFields{
+				http: map[int32]*Http{},
+				kafka: map[int32]*Kafka{
+					3: {
+						APIKey:     "metadata",
+						APIVersion: 1,
+						ClientID:   "client-1",
+						Topics:     []string{"orders"},
+					},
+				},
 			},
 		},
 	}
@@ -302,6 +354,7 @@ func TestFlowExporterL7ListenerHttp(t *testing.T) {
 				assert.Equal(t, tc.eventPresent, exists)
 				if exists {
 					assert.Equal(t, tc.expectedEvents.http, existingEvent.http)
+					assert.Equal(t, tc.expectedEvents.kafka, existingEvent.kafka)
 				}
 			}, 1*time.Second, 100*time.Millisecond, "L7 event map does not match")
 		})
@@ -62,6 +62,7 @@ type FlowExporter struct {
 	collectorAddr          string
 	exporter               exporter.Interface
 	exporterConnected      bool
+	sharder                *collectorSharder // only set when sharding flow records across collector replicas.
 	conntrackConnStore     *connections.ConntrackConnectionStore
 	denyConnStore          *connections.DenyConnectionStore
 	numConnsExported       uint64 // used for unit tests.
@@ -113,23 +114,34 @@ func NewFlowExporter(podStore objectstore.PodStore, proxier proxy.Proxier, k8sCl
 	nodeUID := string(node.UID)
 	klog.InfoS("Retrieved this Node's UID from K8s", "nodeName", nodeName, "nodeUID", nodeUID)
 
-	var exp exporter.Interface
-	if o.FlowCollectorProto == "grpc" {
-		exp = exporter.NewGRPCExporter(nodeName, nodeUID, obsDomainID)
-	} else {
+	newExporter := func() exporter.Interface {
+		if o.FlowCollectorProto == "grpc" {
+			return exporter.NewGRPCExporter(nodeName, nodeUID, obsDomainID)
+		}
 		var collectorProto string
 		if o.FlowCollectorProto == "tls" {
 			collectorProto = "tcp"
 		} else {
 			collectorProto = o.FlowCollectorProto
 		}
-		exp = exporter.NewIPFIXExporter(collectorProto, nodeName, obsDomainID, v4Enabled, v6Enabled)
+		return exporter.NewIPFIXExporter(collectorProto, nodeName, obsDomainID, v4Enabled, v6Enabled)
+	}
+	var exp exporter.Interface
+	var sharder *collectorSharder
+	if o.FlowCollectorSharding {
+		sharder, err = newCollectorSharder(k8sClient, o.FlowCollectorAddr, o.FlowCollectorProto, newExporter)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		exp = newExporter()
 	}
 
 	return &FlowExporter{
 		collectorProto:         o.FlowCollectorProto,
 		collectorAddr:          o.FlowCollectorAddr,
 		exporter:               exp,
+		sharder:                sharder,
 		conntrackConnStore:     conntrackConnStore,
 		denyConnStore:          denyConnStore,
 		v4Enabled:              v4Enabled,
@@ -176,21 +188,35 @@ func (exp *FlowExporter) Run(stopCh <-chan struct{}) {
 			return
 		}
 	}
+	if exp.sharder != nil {
+		// Wait for the initial list of collector replicas.
+		if !exp.sharder.run(stopCh) {
+			return
+		}
+	}
 
 	defaultTimeout := exp.conntrackPriorityQueue.ActiveFlowTimeout
 	expireTimer := time.NewTimer(defaultTimeout)
 	for {
 		select {
 		case <-stopCh:
-			if exp.exporterConnected {
+			if exp.exporterConnected || exp.sharder != nil {
 				exp.resetFlowExporter()
 			}
 			expireTimer.Stop()
 			return
 		case <-expireTimer.C:
-			if !exp.exporterConnected {
+			if exp.sharder != nil || !exp.exporterConnected {
 				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-				err := exp.initFlowExporter(ctx)
+				var err error
+				if exp.sharder != nil {
+					// Pick up membership changes before every export cycle.
+					err = exp.sharder.sync(ctx, func(ctx context.Context) (*exporter.TLSConfig, error) {
+						return exp.getTLSConfig(ctx, exp.sharder.serverName())
+					})
+				} else {
+					err = exp.initFlowExporter(ctx)
+				}
 				cancel()
 				if err != nil {
 					klog.ErrorS(err, "Error when initializing flow exporter")
@@ -219,6 +245,10 @@ func (exp *FlowExporter) Run(stopCh <-chan struct{}) {
 }
 
 func (exp *FlowExporter) resetFlowExporter() {
+	if exp.sharder != nil {
+		exp.sharder.closeAll()
+		return
+	}
 	exp.exporter.CloseConnToCollector()
 	exp.exporterConnected = false
 }
@@ -273,29 +303,38 @@ func (exp *FlowExporter) resolveCollectorAddress(ctx context.Context) (string, s
 	return addr, dns, nil
 }
 
+// getTLSConfig returns the TLS configuration used to connect to the collector, or nil if the
+// connection does not use TLS.
+func (exp *FlowExporter) getTLSConfig(ctx context.Context, serverName string) (*exporter.TLSConfig, error) {
+	if exp.collectorProto != "tls" && exp.collectorProto != "grpc" {
+		return nil, nil
+	}
+	// if CA certificate, client certificate and key do not exist during initialization,
+	// it will retry to obtain the credentials in next export cycle
+	ca, err := getCACert(ctx, exp.k8sClient)
+	if err != nil {
+		return nil, fmt.Errorf("cannot retrieve CA cert: %w", err)
+	}
+	cert, key, err := getClientCertKey(ctx, exp.k8sClient)
+	if err != nil {
+		return nil, fmt.Errorf("cannot retrieve client cert and key: %v", err)
+	}
+	return &exporter.TLSConfig{
+		ServerName: serverName,
+		CAData:     ca,
+		CertData:   cert,
+		KeyData:    key,
+	}, nil
+}
+
 func (exp *FlowExporter) initFlowExporter(ctx context.Context) error {
 	addr, name, err := exp.resolveCollectorAddress(ctx)
 	if err != nil {
 		return err
 	}
-	var tlsConfig *exporter.TLSConfig
-	if exp.collectorProto == "tls" || exp.collectorProto == "grpc" {
-		// if CA certificate, client certificate and key do not exist during initialization,
-		// it will retry to obtain the credentials in next export cycle
-		ca, err := getCACert(ctx, exp.k8sClient)
-		if err != nil {
-			return fmt.Errorf("cannot retrieve CA cert: %w", err)
-		}
-		cert, key, err := getClientCertKey(ctx, exp.k8sClient)
-		if err != nil {
-			return fmt.Errorf("cannot retrieve client cert and key: %v", err)
-		}
-		tlsConfig = &exporter.TLSConfig{
-			ServerName: name,
-			CAData:     ca,
-			CertData:   cert,
-			KeyData:    key,
-		}
+	tlsConfig, err := exp.getTLSConfig(ctx, name)
+	if err != nil {
+		return err
 	}
 
 	if err := exp.exporter.ConnectToCollector(addr, tlsConfig); err != nil {
@@ -370,7 +409,15 @@ func (exp *FlowExporter) exportConn(conn *connection.Connection) error {
 			return nil
 		}
 	}
-	if err := exp.exporter.Export(conn); err != nil {
+	if exp.sharder != nil {
+		shard := exp.sharder.getShard(conn)
+		if shard == nil {
+			return fmt.Errorf("no connected flow collector replica")
+		}
+		if err := shard.exporter.Export(conn); err != nil {
+			return err
+		}
+	} else if err := exp.exporter.Export(conn); err != nil {
 		return err
 	}
 	exp.numConnsExported += 1
@@ -19,6 +19,7 @@ import "time"
 type FlowExporterOptions struct {
 	FlowCollectorAddr      string
 	FlowCollectorProto     string
+	FlowCollectorSharding  bool
 	ActiveFlowTimeout      time.Duration
 	IdleFlowTimeout        time.Duration
 	StaleConnectionTimeout time.Duration
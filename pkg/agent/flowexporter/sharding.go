@@ -0,0 +1,276 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowexporter
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/consistenthash"
+	"antrea.io/antrea/pkg/agent/flowexporter/connection"
+	"antrea.io/antrea/pkg/agent/flowexporter/exporter"
+	"antrea.io/antrea/pkg/agent/metrics"
+	k8sutil "antrea.io/antrea/pkg/util/k8s"
+)
+
+// virtualNodeReplicas is the number of virtual nodes of each collector replica in the
+// consistent hash ring.
+const virtualNodeReplicas = 50
+
+// collectorShard is the connection to one replica of the flow collector.
+type collectorShard struct {
+	exporter  exporter.Interface
+	connected bool
+}
+
+// collectorSharder shards flow records across the replicas of the flow collector, which is
+// running in-cluster as a K8s Service. The replicas are the ready endpoints of the Service,
+// and each connection is assigned to a replica by consistent hashing of its 5-tuple. The
+// endpoints of a connection are sorted before hashing, so that both directions of a
+// connection, which may be exported by different Nodes, are sent to the same replica. This
+// is required for the Flow Aggregator to correlate them.
+// A collectorSharder is only used from the FlowExporter's main loop and is not thread-safe.
+type collectorSharder struct {
+	k8sClient   kubernetes.Interface
+	namespace   string
+	serviceName string
+	servicePort int32
+	protocol    corev1.Protocol
+	// serviceResolved indicates whether portName and addressType have been resolved from the
+	// Service. portName is the name of the Service port which matches servicePort, and is used
+	// to look up the target port in the EndpointSlices. Only the EndpointSlices for the primary
+	// IP family of the Service are used, so that each replica is added to the ring only once.
+	serviceResolved bool
+	portName        string
+	addressType     discoveryv1.AddressType
+
+	informerFactory     informers.SharedInformerFactory
+	endpointSliceLister discoverylisters.EndpointSliceLister
+	endpointSliceSynced cache.InformerSynced
+
+	newExporter func() exporter.Interface
+	// shards maps the address of each replica to the connection to that replica.
+	shards  map[string]*collectorShard
+	hashMap *consistenthash.Map
+}
+
+func newCollectorSharder(k8sClient kubernetes.Interface, collectorAddr, collectorProto string, newExporter func() exporter.Interface) (*collectorSharder, error) {
+	host, portStr, err := net.SplitHostPort(collectorAddr)
+	if err != nil {
+		return nil, err
+	}
+	ns, name := k8sutil.SplitNamespacedName(host)
+	if ns == "" {
+		return nil, fmt.Errorf("sharding requires the flow collector address to be a Service reference (<Namespace>/<Name>), got %q", host)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid flow collector port %q: %w", portStr, err)
+	}
+	protocol := corev1.ProtocolTCP
+	if collectorProto == "udp" {
+		protocol = corev1.ProtocolUDP
+	}
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(k8sClient, 0, informers.WithNamespace(ns), informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+		options.LabelSelector = discoveryv1.LabelServiceName + "=" + name
+	}))
+	endpointSliceInformer := informerFactory.Discovery().V1().EndpointSlices()
+	return &collectorSharder{
+		k8sClient:           k8sClient,
+		namespace:           ns,
+		serviceName:         name,
+		servicePort:         int32(port),
+		protocol:            protocol,
+		informerFactory:     informerFactory,
+		endpointSliceLister: endpointSliceInformer.Lister(),
+		endpointSliceSynced: endpointSliceInformer.Informer().HasSynced,
+		newExporter:         newExporter,
+		shards:              make(map[string]*collectorShard),
+		hashMap:             consistenthash.New(virtualNodeReplicas, nil),
+	}, nil
+}
+
+func (s *collectorSharder) run(stopCh <-chan struct{}) bool {
+	s.informerFactory.Start(stopCh)
+	return cache.WaitForCacheSync(stopCh, s.endpointSliceSynced)
+}
+
+func (s *collectorSharder) serverName() string {
+	return fmt.Sprintf("%s.%s.svc", s.serviceName, s.namespace)
+}
+
+func (s *collectorSharder) resolveService(ctx context.Context) error {
+	if s.serviceResolved {
+		return nil
+	}
+	svc, err := s.k8sClient.CoreV1().Services(s.namespace).Get(ctx, s.serviceName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get Service %s/%s: %w", s.namespace, s.serviceName, err)
+	}
+	addressType := discoveryv1.AddressTypeIPv4
+	if len(svc.Spec.IPFamilies) > 0 && svc.Spec.IPFamilies[0] == corev1.IPv6Protocol {
+		addressType = discoveryv1.AddressTypeIPv6
+	}
+	for _, port := range svc.Spec.Ports {
+		if port.Port == s.servicePort && port.Protocol == s.protocol {
+			s.portName = port.Name
+			s.addressType = addressType
+			s.serviceResolved = true
+			return nil
+		}
+	}
+	return fmt.Errorf("no %s port %d in Service %s/%s", s.protocol, s.servicePort, s.namespace, s.serviceName)
+}
+
+// getMembers returns the addresses of the ready replicas of the flow collector.
+func (s *collectorSharder) getMembers(ctx context.Context) (sets.Set[string], error) {
+	if err := s.resolveService(ctx); err != nil {
+		return nil, err
+	}
+	endpointSlices, err := s.endpointSliceLister.EndpointSlices(s.namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	members := sets.New[string]()
+	for _, endpointSlice := range endpointSlices {
+		if endpointSlice.AddressType != s.addressType {
+			continue
+		}
+		var targetPort *int32
+		for _, port := range endpointSlice.Ports {
+			if port.Name != nil && *port.Name == s.portName && port.Protocol != nil && *port.Protocol == s.protocol {
+				targetPort = port.Port
+				break
+			}
+		}
+		if targetPort == nil {
+			continue
+		}
+		for _, endpoint := range endpointSlice.Endpoints {
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			for _, address := range endpoint.Addresses {
+				members.Insert(net.JoinHostPort(address, strconv.Itoa(int(*targetPort))))
+			}
+		}
+	}
+	return members, nil
+}
+
+// sync updates the shards and the consistent hash ring to match the ready replicas of the
+// flow collector, and connects to the replicas which are not connected yet. When the
+// membership changes, connections are rebalanced across the new set of replicas. It only
+// returns an error if no replica is connected.
+func (s *collectorSharder) sync(ctx context.Context, getTLSConfig func(ctx context.Context) (*exporter.TLSConfig, error)) error {
+	members, err := s.getMembers(ctx)
+	if err != nil {
+		return err
+	}
+	for addr, shard := range s.shards {
+		if members.Has(addr) {
+			continue
+		}
+		klog.InfoS("Flow collector replica removed", "address", addr)
+		if shard.connected {
+			shard.exporter.CloseConnToCollector()
+		}
+		delete(s.shards, addr)
+		s.hashMap.Remove(addr)
+	}
+	for addr := range members {
+		if _, ok := s.shards[addr]; ok {
+			continue
+		}
+		klog.InfoS("Flow collector replica added", "address", addr)
+		s.shards[addr] = &collectorShard{exporter: s.newExporter()}
+		s.hashMap.Add(addr)
+	}
+	if len(s.shards) == 0 {
+		return fmt.Errorf("no ready endpoint for Service %s/%s", s.namespace, s.serviceName)
+	}
+
+	var tlsConfig *exporter.TLSConfig
+	tlsConfigLoaded := false
+	numConnected := 0
+	for addr, shard := range s.shards {
+		if shard.connected {
+			numConnected++
+			continue
+		}
+		if !tlsConfigLoaded {
+			if tlsConfig, err = getTLSConfig(ctx); err != nil {
+				return err
+			}
+			tlsConfigLoaded = true
+		}
+		if err := shard.exporter.ConnectToCollector(addr, tlsConfig); err != nil {
+			klog.ErrorS(err, "Error when connecting to flow collector replica", "address", addr)
+			continue
+		}
+		shard.connected = true
+		numConnected++
+		metrics.ReconnectionsToFlowCollector.Inc()
+	}
+	if numConnected == 0 {
+		return fmt.Errorf("failed to connect to any replica of Service %s/%s", s.namespace, s.serviceName)
+	}
+	return nil
+}
+
+// getShard returns the shard which the connection is assigned to. Connections assigned to a
+// replica which is not connected are sent to the next replica in the ring.
+func (s *collectorSharder) getShard(conn *connection.Connection) *collectorShard {
+	addr := s.hashMap.GetWithFilters(getShardingKey(conn.FlowKey), func(addr string) bool {
+		return s.shards[addr].connected
+	})
+	if addr == "" {
+		return nil
+	}
+	return s.shards[addr]
+}
+
+func (s *collectorSharder) closeAll() {
+	for _, shard := range s.shards {
+		if shard.connected {
+			shard.exporter.CloseConnToCollector()
+			shard.connected = false
+		}
+	}
+}
+
+// getShardingKey returns the same key for both directions of a connection.
+func getShardingKey(tuple connection.Tuple) string {
+	src := netip.AddrPortFrom(tuple.SourceAddress, tuple.SourcePort)
+	dst := netip.AddrPortFrom(tuple.DestinationAddress, tuple.DestinationPort)
+	if src.Compare(dst) > 0 {
+		src, dst = dst, src
+	}
+	return fmt.Sprintf("%d/%s/%s", tuple.Protocol, src, dst)
+}
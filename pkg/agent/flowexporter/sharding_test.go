@@ -0,0 +1,227 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowexporter
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/agent/flowexporter/connection"
+	"antrea.io/antrea/pkg/agent/flowexporter/exporter"
+	exportertesting "antrea.io/antrea/pkg/agent/flowexporter/exporter/testing"
+	"antrea.io/antrea/pkg/agent/metrics"
+)
+
+func TestGetShardingKey(t *testing.T) {
+	tuple := connection.Tuple{
+		SourceAddress:      netip.MustParseAddr("10.10.0.1"),
+		DestinationAddress: netip.MustParseAddr("10.10.1.1"),
+		Protocol:           6,
+		SourcePort:         52000,
+		DestinationPort:    80,
+	}
+	reverseTuple := connection.Tuple{
+		SourceAddress:      tuple.DestinationAddress,
+		DestinationAddress: tuple.SourceAddress,
+		Protocol:           tuple.Protocol,
+		SourcePort:         tuple.DestinationPort,
+		DestinationPort:    tuple.SourcePort,
+	}
+	otherTuple := tuple
+	otherTuple.SourcePort = 52001
+
+	key := getShardingKey(tuple)
+	assert.Equal(t, "6/10.10.0.1:52000/10.10.1.1:80", key)
+	assert.Equal(t, key, getShardingKey(reverseTuple))
+	assert.NotEqual(t, key, getShardingKey(otherTuple))
+}
+
+func newTestCollectorEndpointSlice(name string, addressType discoveryv1.AddressType, ready map[string]bool) *discoveryv1.EndpointSlice {
+	endpointSlice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "flow-aggregator",
+			Labels: map[string]string{
+				discoveryv1.LabelServiceName: "flow-aggregator",
+			},
+		},
+		AddressType: addressType,
+		Ports: []discoveryv1.EndpointPort{
+			{Name: ptr.To("ipfix-udp"), Protocol: ptr.To(corev1.ProtocolUDP), Port: ptr.To[int32](4739)},
+			{Name: ptr.To("ipfix-tcp"), Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To[int32](4739)},
+			{Name: ptr.To("grpc"), Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To[int32](14739)},
+		},
+	}
+	for address, isReady := range ready {
+		endpointSlice.Endpoints = append(endpointSlice.Endpoints, discoveryv1.Endpoint{
+			Addresses:  []string{address},
+			Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(isReady)},
+		})
+	}
+	return endpointSlice
+}
+
+func TestCollectorSharder(t *testing.T) {
+	metrics.InitializeConnectionMetrics()
+	defer metrics.ReconnectionsToFlowCollector.Set(0)
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "flow-aggregator",
+			Namespace: "flow-aggregator",
+		},
+		Spec: corev1.ServiceSpec{
+			IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+			Ports: []corev1.ServicePort{
+				{Name: "ipfix-udp", Protocol: corev1.ProtocolUDP, Port: 4739, TargetPort: intstr.FromInt32(4739)},
+				{Name: "ipfix-tcp", Protocol: corev1.ProtocolTCP, Port: 4739, TargetPort: intstr.FromInt32(4739)},
+				{Name: "grpc", Protocol: corev1.ProtocolTCP, Port: 4740, TargetPort: intstr.FromInt32(14739)},
+			},
+		},
+	}
+	k8sClient := fake.NewSimpleClientset(
+		svc,
+		newTestCollectorEndpointSlice("flow-aggregator-v4", discoveryv1.AddressTypeIPv4, map[string]bool{
+			"10.10.0.10": true,
+			"10.10.1.10": true,
+			"10.10.2.10": false,
+		}),
+		newTestCollectorEndpointSlice("flow-aggregator-v6", discoveryv1.AddressTypeIPv6, map[string]bool{
+			"fd00:10:10::10": true,
+		}),
+	)
+	updateEndpointSlice := func(ready map[string]bool) {
+		endpointSlice := newTestCollectorEndpointSlice("flow-aggregator-v4", discoveryv1.AddressTypeIPv4, ready)
+		_, err := k8sClient.DiscoveryV1().EndpointSlices("flow-aggregator").Update(ctx, endpointSlice, metav1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+
+	tlsConfig := &exporter.TLSConfig{ServerName: "flow-aggregator.flow-aggregator.svc"}
+	getTLSConfig := func(ctx context.Context) (*exporter.TLSConfig, error) {
+		return tlsConfig, nil
+	}
+	var connectedAddrs []string
+	newExporter := func() exporter.Interface {
+		exp := exportertesting.NewMockInterface(ctrl)
+		exp.EXPECT().ConnectToCollector(gomock.Any(), tlsConfig).Do(func(addr string, _ *exporter.TLSConfig) {
+			connectedAddrs = append(connectedAddrs, addr)
+		})
+		return exp
+	}
+
+	sharder, err := newCollectorSharder(k8sClient, "flow-aggregator/flow-aggregator:4740", "grpc", newExporter)
+	require.NoError(t, err)
+	assert.Equal(t, "flow-aggregator.flow-aggregator.svc", sharder.serverName())
+	require.True(t, sharder.run(stopCh))
+
+	require.NoError(t, sharder.sync(ctx, getTLSConfig))
+	assert.ElementsMatch(t, []string{"10.10.0.10:14739", "10.10.1.10:14739"}, connectedAddrs)
+	require.Len(t, sharder.shards, 2)
+	shard1, shard2 := sharder.shards["10.10.0.10:14739"], sharder.shards["10.10.1.10:14739"]
+	assert.True(t, shard1.connected)
+	assert.True(t, shard2.connected)
+
+	conns := make([]*connection.Connection, 0, 100)
+	assignedShards := make(map[*collectorShard]int)
+	for i := range 100 {
+		conn := &connection.Connection{
+			FlowKey: connection.Tuple{
+				SourceAddress:      netip.MustParseAddr(fmt.Sprintf("10.10.0.%d", i)),
+				DestinationAddress: netip.MustParseAddr("10.10.1.1"),
+				Protocol:           6,
+				SourcePort:         uint16(50000 + i),
+				DestinationPort:    80,
+			},
+		}
+		reverseConn := &connection.Connection{
+			FlowKey: connection.Tuple{
+				SourceAddress:      conn.FlowKey.DestinationAddress,
+				DestinationAddress: conn.FlowKey.SourceAddress,
+				Protocol:           conn.FlowKey.Protocol,
+				SourcePort:         conn.FlowKey.DestinationPort,
+				DestinationPort:    conn.FlowKey.SourcePort,
+			},
+		}
+		shard := sharder.getShard(conn)
+		require.NotNil(t, shard)
+		assert.Same(t, shard, sharder.getShard(reverseConn), "Both directions of a connection should be assigned to the same replica")
+		assignedShards[shard]++
+		conns = append(conns, conn)
+	}
+	assert.Len(t, assignedShards, 2, "Connections should be sharded across all replicas")
+
+	// When a replica is not connected, its connections are assigned to another replica.
+	shard1.connected = false
+	for _, conn := range conns {
+		assert.Same(t, shard2, sharder.getShard(conn))
+	}
+	shard1.connected = true
+
+	// When a replica is removed, the connection to it is closed and its connections are
+	// rebalanced.
+	shard1.exporter.(*exportertesting.MockInterface).EXPECT().CloseConnToCollector()
+	updateEndpointSlice(map[string]bool{"10.10.0.10": false, "10.10.1.10": true})
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		members, err := sharder.getMembers(ctx)
+		require.NoError(c, err)
+		assert.Equal(c, 1, members.Len())
+	}, 2*time.Second, 10*time.Millisecond)
+	require.NoError(t, sharder.sync(ctx, getTLSConfig))
+	assert.Len(t, sharder.shards, 1)
+	for _, conn := range conns {
+		assert.Same(t, shard2, sharder.getShard(conn))
+	}
+
+	// When no replica is ready, sync fails.
+	shard2.exporter.(*exportertesting.MockInterface).EXPECT().CloseConnToCollector()
+	updateEndpointSlice(nil)
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		members, err := sharder.getMembers(ctx)
+		require.NoError(c, err)
+		assert.Equal(c, 0, members.Len())
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.ErrorContains(t, sharder.sync(ctx, getTLSConfig), "no ready endpoint for Service flow-aggregator/flow-aggregator")
+	assert.Nil(t, sharder.getShard(conns[0]))
+}
+
+func TestNewCollectorSharder(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset()
+	newExporter := func() exporter.Interface { return nil }
+	_, err := newCollectorSharder(k8sClient, "10.96.1.100:4739", "tls", newExporter)
+	assert.ErrorContains(t, err, "sharding requires the flow collector address to be a Service reference")
+	sharder, err := newCollectorSharder(k8sClient, "ns/svc:4739", "udp", newExporter)
+	require.NoError(t, err)
+	assert.Equal(t, corev1.ProtocolUDP, sharder.protocol)
+	assert.Equal(t, int32(4739), sharder.servicePort)
+	_, err = sharder.getMembers(context.Background())
+	assert.ErrorContains(t, err, "failed to get Service ns/svc")
+}
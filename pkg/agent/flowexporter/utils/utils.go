@@ -34,6 +34,7 @@ var (
 		"tcp":       6,
 		"udp":       17,
 		"ipv6-icmp": 58,
+		"sctp":      132,
 	}
 )
 
@@ -63,6 +64,7 @@ const (
 	NetworkPolicyRuleActionAllow    = uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_ALLOW)
 	NetworkPolicyRuleActionDrop     = uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_DROP)
 	NetworkPolicyRuleActionReject   = uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_REJECT)
+	NetworkPolicyRuleActionAudit    = uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_AUDIT)
 )
 
 func IsConnectionDying(conn *connection.Connection) bool {
@@ -102,6 +104,8 @@ func RuleActionToUint8(action string) uint8 {
 		return NetworkPolicyRuleActionDrop
 	case "Reject":
 		return NetworkPolicyRuleActionReject
+	case "Audit":
+		return NetworkPolicyRuleActionAudit
 	default:
 		return NetworkPolicyRuleActionNoAction
 	}
@@ -213,7 +213,7 @@ func (s *StretchedNetworkPolicyController) syncPodClassifierFlow(podRef types.Na
 	if err != nil {
 		return fmt.Errorf("can't get Namespace %s: %v", podRef.Namespace, err)
 	}
-	normalizedLabel := member.GetNormalizedLabel(podNS.Labels, pod.Labels, podNS.Name)
+	normalizedLabel := member.GetNormalizedLabel(podNS.Labels, member.GetPodIdentityLabels(pod), podNS.Name)
 	labelID := s.getLabelIdentity(podRef, normalizedLabel)
 	return s.ofClient.InstallPodFlows(
 		containerConfigs[0].InterfaceName,
@@ -1227,6 +1227,19 @@ func (c *client) SendTraceflowPacket(dataplaneTag uint8, packet *binding.Packet,
 		}
 		packetOutBuilder = packetOutBuilder.SetUDPDstPort(packet.DestinationPort).
 			SetUDPSrcPort(udpSrcPort)
+	case utilip.SCTPProtocol:
+		if packet.IsIPv6 {
+			packetOutBuilder = packetOutBuilder.SetIPProtocol(binding.ProtocolSCTPv6)
+		} else {
+			packetOutBuilder = packetOutBuilder.SetIPProtocol(binding.ProtocolSCTP)
+		}
+		sctpSrcPort := packet.SourcePort
+		if sctpSrcPort == 0 {
+			// #nosec G404: random number generator not used for security purposes.
+			sctpSrcPort = uint16(rand.Uint32())
+		}
+		packetOutBuilder = packetOutBuilder.SetSCTPDstPort(packet.DestinationPort).
+			SetSCTPSrcPort(sctpSrcPort)
 	default:
 		packetOutBuilder = packetOutBuilder.SetIPProtocolValue(packet.IsIPv6, packet.IPProto)
 	}
@@ -77,6 +77,10 @@ var (
 	GeneratedRejectPacketOutRegMark = binding.NewOneBitRegMark(0, 13)
 	// reg0[14]: Mark to indicate a Service without any Endpoints (used by Proxy)
 	SvcNoEpRegMark = binding.NewOneBitRegMark(0, 14)
+	// reg0[15]: Mark to indicate the packet matches a Drop/Reject rule of an Antrea Policy in Audit mode, i.e. the
+	// packet would have been denied if the rule was enforced.
+	APAuditRegMark    = binding.NewOneBitRegMark(0, 15)
+	NotAPAuditRegMark = binding.NewOneBitZeroRegMark(0, 15)
 	// reg0[19]: Mark to indicate remote SNAT for Egress.
 	RemoteSNATRegMark = binding.NewOneBitRegMark(0, 19)
 	// reg0[20]: Field to indicate redirect action of layer 7 NetworkPolicy.
@@ -222,7 +222,9 @@ func (f *featureNetworkPolicy) getRequiredTables() []*Table {
 	}
 	if f.enableAntreaPolicy {
 		tables = append(tables,
+			AntreaPolicyEgressAuditTable,
 			AntreaPolicyEgressRuleTable,
+			AntreaPolicyIngressAuditTable,
 			AntreaPolicyIngressRuleTable,
 		)
 		if f.enableL7NetworkPolicy {
@@ -132,6 +132,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -142,6 +143,7 @@ func TestBuildPipeline(t *testing.T) {
 					SNATMarkTable,
 					SNATTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -179,6 +181,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -189,6 +192,7 @@ func TestBuildPipeline(t *testing.T) {
 					SNATMarkTable,
 					SNATTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -221,6 +225,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -231,6 +236,7 @@ func TestBuildPipeline(t *testing.T) {
 					SNATMarkTable,
 					SNATTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -263,6 +269,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -274,6 +281,7 @@ func TestBuildPipeline(t *testing.T) {
 					SNATTable,
 					L2ForwardingCalcTable,
 					TrafficControlTable,
+					AntreaPolicyIngressAuditTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -343,6 +351,7 @@ func TestBuildPipeline(t *testing.T) {
 					ConntrackTable,
 					ConntrackStateTable,
 					DNATTable,
+					AntreaPolicyEgressAuditTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -350,6 +359,7 @@ func TestBuildPipeline(t *testing.T) {
 					L3ForwardingTable,
 					L3DecTTLTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -387,6 +397,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -397,6 +408,7 @@ func TestBuildPipeline(t *testing.T) {
 					SNATMarkTable,
 					SNATTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -434,6 +446,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -443,6 +456,7 @@ func TestBuildPipeline(t *testing.T) {
 					SNATMarkTable,
 					SNATTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -483,12 +497,14 @@ func TestBuildPipeline(t *testing.T) {
 					ConntrackTable,
 					ConntrackStateTable,
 					EgressSecurityClassifierTable,
+					AntreaPolicyEgressAuditTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
 					EgressMetricTable,
 					L2ForwardingCalcTable,
 					IngressSecurityClassifierTable,
+					AntreaPolicyIngressAuditTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -0,0 +1,69 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openflow
+
+import (
+	"fmt"
+	"sync"
+
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+)
+
+const (
+	// The meter IDs used to rate limit the traffic matched by NetworkPolicy rules. They don't
+	// overlap with the meter IDs reserved for Egress QoS and packetIn rate limiting.
+	minRuleMeterID binding.MeterIDType = 1024
+	maxRuleMeterID binding.MeterIDType = 65535
+)
+
+// meterIDAllocator allocates meter IDs in a fixed range. Released IDs are reused first.
+type meterIDAllocator struct {
+	mu sync.Mutex
+
+	nextID   binding.MeterIDType
+	maxID    binding.MeterIDType
+	recycled []binding.MeterIDType
+}
+
+func newMeterIDAllocator(minID, maxID binding.MeterIDType) *meterIDAllocator {
+	return &meterIDAllocator{
+		nextID: minID,
+		maxID:  maxID,
+	}
+}
+
+func (a *meterIDAllocator) allocate() (binding.MeterIDType, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(a.recycled) != 0 {
+		id := a.recycled[len(a.recycled)-1]
+		a.recycled = a.recycled[:len(a.recycled)-1]
+		return id, nil
+	}
+	if a.nextID > a.maxID {
+		return 0, fmt.Errorf("no meter ID available")
+	}
+	id := a.nextID
+	a.nextID += 1
+	return id, nil
+}
+
+func (a *meterIDAllocator) release(id binding.MeterIDType) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.recycled = append(a.recycled, id)
+}
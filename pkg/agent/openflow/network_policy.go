@@ -15,6 +15,7 @@
 package openflow
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"strconv"
@@ -22,6 +23,7 @@ import (
 	"sync"
 
 	"antrea.io/libOpenflow/openflow15"
+	"antrea.io/ofnet/ofctrl"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
@@ -668,6 +670,8 @@ type policyRuleConjunction struct {
 	serviceClause *clause
 	actionFlows   []*openflow15.FlowMod
 	metricFlows   []*openflow15.FlowMod
+	// meters are the OpenFlow meters enforcing the rate limit of the rule, keyed by meter ID.
+	meters map[binding.MeterIDType]binding.Meter
 	// NetworkPolicy reference information for debugging usage, its value can be nil
 	// for conjunctions that are not built for a specific NetworkPolicy, e.g. DNS packetin Conjunction.
 	npRef        *v1beta2.NetworkPolicyReference
@@ -1163,6 +1167,10 @@ func (c *client) InstallPolicyRuleFlows(rule *types.PolicyRule) error {
 	defer c.replayMutex.RUnlock()
 
 	conj := c.featureNetworkPolicy.calculateActionFlowChangesForRule(rule)
+	if err := c.featureNetworkPolicy.addRuleMeters(conj); err != nil {
+		c.featureNetworkPolicy.rollbackRuleMeters(conj)
+		return err
+	}
 
 	c.featureNetworkPolicy.conjMatchFlowLock.Lock()
 	defer c.featureNetworkPolicy.conjMatchFlowLock.Unlock()
@@ -1171,9 +1179,17 @@ func (c *client) InstallPolicyRuleFlows(rule *types.PolicyRule) error {
 	var flowMessages []*openflow15.FlowMod
 	flowMessages = append(flowMessages, append(conj.metricFlows, conj.actionFlows...)...)
 	if err := c.ofEntryOperations.AddAll(flowMessages); err != nil {
+		c.featureNetworkPolicy.rollbackRuleMeters(conj)
 		return err
 	}
 	if err := c.featureNetworkPolicy.applyConjunctiveMatchFlows(ctxChanges); err != nil {
+		// The flows referring to the meters are deleted first, OVS also deletes them along with the meters anyway.
+		if len(conj.meters) > 0 {
+			if deleteErr := c.ofEntryOperations.DeleteAll(flowMessages); deleteErr != nil {
+				klog.ErrorS(deleteErr, "Failed to delete OpenFlow flows of rule", "ruleID", conj.id)
+			}
+			c.featureNetworkPolicy.rollbackRuleMeters(conj)
+		}
 		return err
 	}
 	// Add the policyRuleConjunction into policyCache
@@ -1208,7 +1224,11 @@ func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.Pol
 		// Install action flows.
 		var actionFlows []binding.Flow
 		var metricFlows []binding.Flow
-		if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionDrop {
+		if rule.IsAntreaNetworkPolicyRule() && rule.Audit {
+			// The rules of policies in Audit mode are not enforced, hence there are no metric flows for them. The
+			// metrics are collected from their action flows.
+			actionFlows = append(actionFlows, f.conjunctionActionAuditFlow(ruleOfID, ruleTable, rule.Priority, getRuleDisposition(*rule.Action), rule.EnableLogging))
+		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionDrop {
 			metricFlows = append(metricFlows, f.denyRuleMetricFlow(ruleOfID, isIngress, rule.TableID))
 			actionFlows = append(actionFlows, f.conjunctionActionDenyFlow(ruleOfID, ruleTable, rule.Priority, DispositionDrop, rule.EnableLogging))
 		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionReject {
@@ -1217,8 +1237,9 @@ func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.Pol
 		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionPass {
 			actionFlows = append(actionFlows, f.conjunctionActionPassFlow(ruleOfID, ruleTable, rule.Priority, rule.EnableLogging))
 		} else {
-			metricFlows = append(metricFlows, f.allowRulesMetricFlows(ruleOfID, isIngress, rule.TableID)...)
-			actionFlows = append(actionFlows, f.conjunctionActionFlow(ruleOfID, ruleTable, dropTable.GetNext(), rule.Priority, rule.EnableLogging, rule.L7RuleVlanID)...)
+			connectionMeterID, bandwidthMeterID := f.newRuleMeters(conj, rule)
+			metricFlows = append(metricFlows, f.allowRulesMetricFlows(ruleOfID, isIngress, rule.TableID, bandwidthMeterID)...)
+			actionFlows = append(actionFlows, f.conjunctionActionFlow(ruleOfID, ruleTable, dropTable.GetNext(), rule.Priority, rule.EnableLogging, rule.L7RuleVlanID, connectionMeterID)...)
 		}
 		conj.actionFlows = GetFlowModMessages(actionFlows, binding.AddMessage)
 		conj.metricFlows = GetFlowModMessages(metricFlows, binding.AddMessage)
@@ -1226,6 +1247,97 @@ func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.Pol
 	return conj
 }
 
+// getRuleDisposition returns the disposition value corresponding to the action of an Antrea-native policy rule.
+func getRuleDisposition(action crdv1beta1.RuleAction) uint32 {
+	switch action {
+	case crdv1beta1.RuleActionDrop:
+		return DispositionDrop
+	case crdv1beta1.RuleActionReject:
+		return DispositionRej
+	case crdv1beta1.RuleActionPass:
+		return DispositionPass
+	default:
+		return DispositionAllow
+	}
+}
+
+// newRuleMeters generates the OpenFlow meters enforcing the rate limit of an Allow rule and stores them in the
+// policyRuleConjunction. It returns the IDs of the meters limiting the rate of new connections and the bandwidth, 0 if
+// there is no such limit.
+func (f *featureNetworkPolicy) newRuleMeters(conj *policyRuleConjunction, rule *types.PolicyRule) (connectionMeterID, bandwidthMeterID uint32) {
+	rateLimit := rule.RateLimit
+	if rateLimit == nil {
+		return 0, 0
+	}
+	if !f.ovsMetersAreSupported {
+		klog.InfoS("OVS meters are not supported, the traffic of the rule will not be rate limited", "rule", rule.Name, "policy", rule.PolicyRef)
+		return 0, 0
+	}
+	newMeter := func(flags ofctrl.MeterFlag, rate, burst uint32) uint32 {
+		meterID, err := f.ruleMeterIDAllocator.allocate()
+		if err != nil {
+			klog.ErrorS(err, "Failed to allocate meter ID, the traffic of the rule will not be rate limited", "rule", rule.Name, "policy", rule.PolicyRef)
+			return 0
+		}
+		if conj.meters == nil {
+			conj.meters = make(map[binding.MeterIDType]binding.Meter)
+		}
+		conj.meters[meterID] = f.bridge.NewMeter(meterID, ofctrl.MeterBurst|flags).
+			MeterBand().
+			MeterType(ofctrl.MeterDrop).
+			Rate(rate).
+			Burst(burst).
+			Done()
+		return uint32(meterID)
+	}
+	if rateLimit.ConnectionsPerSecond > 0 {
+		connectionMeterID = newMeter(ofctrl.MeterPktps, uint32(rateLimit.ConnectionsPerSecond), uint32(rateLimit.ConnectionsPerSecond))
+	}
+	if rateLimit.BandwidthRate > 0 {
+		burst := rateLimit.BandwidthBurst
+		if burst == 0 {
+			burst = rateLimit.BandwidthRate
+		}
+		bandwidthMeterID = newMeter(ofctrl.MeterKbps, uint32(rateLimit.BandwidthRate), uint32(burst))
+	}
+	return connectionMeterID, bandwidthMeterID
+}
+
+// addRuleMeters installs the OpenFlow meters of the policyRuleConjunction. They must be installed before the flows
+// referring to them.
+func (f *featureNetworkPolicy) addRuleMeters(conj *policyRuleConjunction) error {
+	for meterID, meter := range conj.meters {
+		// Openflow bundle message doesn't support meter, meters are added individually.
+		if err := meter.Add(); err != nil {
+			return fmt.Errorf("error when installing OpenFlow meter %d for rule %d: %w", meterID, conj.id, err)
+		}
+	}
+	return nil
+}
+
+// deleteRuleMeters uninstalls the OpenFlow meters of the policyRuleConjunction and releases their IDs. A meter which
+// fails to be uninstalled is kept in the policyRuleConjunction, and doesn't prevent the other meters from being
+// uninstalled.
+func (f *featureNetworkPolicy) deleteRuleMeters(conj *policyRuleConjunction) error {
+	var errs []error
+	for meterID, meter := range conj.meters {
+		if err := meter.Delete(); err != nil {
+			errs = append(errs, fmt.Errorf("error when deleting OpenFlow meter %d for rule %d: %w", meterID, conj.id, err))
+			continue
+		}
+		f.ruleMeterIDAllocator.release(meterID)
+		delete(conj.meters, meterID)
+	}
+	return errors.Join(errs...)
+}
+
+// rollbackRuleMeters uninstalls the OpenFlow meters of a policyRuleConjunction whose flows failed to be installed.
+func (f *featureNetworkPolicy) rollbackRuleMeters(conj *policyRuleConjunction) {
+	if err := f.deleteRuleMeters(conj); err != nil {
+		klog.ErrorS(err, "Failed to delete OpenFlow meters of rule", "ruleID", conj.id)
+	}
+}
+
 // calculateMatchFlowChangesForRule calculates the contextChanges for the policyRule, and updates the context status in case of batch install.
 func (f *featureNetworkPolicy) calculateMatchFlowChangesForRule(conj *policyRuleConjunction, rule *types.PolicyRule) []*conjMatchFlowContextChange {
 	// Calculate the conjMatchFlowContext changes. The changed Openflow entries are included in the conjMatchFlowContext change.
@@ -1237,7 +1349,7 @@ func (f *featureNetworkPolicy) calculateMatchFlowChangesForRule(conj *policyRule
 // Unlike calculateMatchFlowChangesForRule, it updates the context status directly and doesn't calculate flow changes.
 // It's used in initial batch install where we first add all rules then calculates flows change based on final state.
 func (f *featureNetworkPolicy) addRuleToConjunctiveMatch(conj *policyRuleConjunction, rule *types.PolicyRule) {
-	isMCNPRule := containsLabelIdentityAddress(rule.From)
+	isMCNPRule := containsLabelIdentityAddress(rule.From) && !rule.Audit
 	if conj.fromClause != nil {
 		for _, addr := range rule.From {
 			match := generateAddressConjMatch(conj.fromClause.ruleTable.GetID(), addr, types.SrcAddress, rule.Priority)
@@ -1315,6 +1427,15 @@ func (c *client) BatchInstallPolicyRuleFlows(ofPolicyRules []*types.PolicyRule)
 
 	for _, rule := range ofPolicyRules {
 		conj := c.featureNetworkPolicy.calculateActionFlowChangesForRule(rule)
+		if err := c.featureNetworkPolicy.addRuleMeters(conj); err != nil {
+			// Delete the meters already installed for this rule and the previous ones, and reset the global
+			// conjunctive match flow cache since none of the rules will be installed.
+			for _, installedConj := range append(conjunctions, conj) {
+				c.featureNetworkPolicy.rollbackRuleMeters(installedConj)
+			}
+			c.featureNetworkPolicy.globalConjMatchFlowCache = map[string]*conjMatchFlowContext{}
+			return err
+		}
 		c.featureNetworkPolicy.addRuleToConjunctiveMatch(conj, rule)
 		allFlowMessages = append(allFlowMessages, append(conj.actionFlows, conj.metricFlows...)...)
 		conjunctions = append(conjunctions, conj)
@@ -1339,9 +1460,12 @@ func (c *client) BatchInstallPolicyRuleFlows(ofPolicyRules []*types.PolicyRule)
 
 	// Send the changed Openflow entries to the OVS bridge.
 	if err := c.ofEntryOperations.AddAll(allFlowMessages); err != nil {
-		// Reset the global conjunctive match flow cache since the OpenFlow bundle, which contains
-		// all the match flows to be installed, was not applied successfully.
+		// Reset the global conjunctive match flow cache and delete the installed meters since the OpenFlow
+		// bundle, which contains all the match flows to be installed, was not applied successfully.
 		c.featureNetworkPolicy.globalConjMatchFlowCache = map[string]*conjMatchFlowContext{}
+		for _, conj := range conjunctions {
+			c.featureNetworkPolicy.rollbackRuleMeters(conj)
+		}
 		return err
 	}
 	// Update conjMatchFlowContexts as the expected status.
@@ -1455,7 +1579,7 @@ func (c *policyRuleConjunction) calculateClauses(rule *types.PolicyRule) (uint8,
 		c.fromClause = c.newClause(fromID, nClause, ruleTable, defaultTable)
 	}
 	if rule.To != nil {
-		if isEgressRule || (rule.IsAntreaNetworkPolicyRule() && !containsLabelIdentityAddress(rule.From)) {
+		if isEgressRule || (rule.IsAntreaNetworkPolicyRule() && (rule.Audit || !containsLabelIdentityAddress(rule.From))) {
 			defaultTable = nil
 		} else {
 			defaultTable = dropTable
@@ -1471,7 +1595,7 @@ func (c *policyRuleConjunction) calculateClauses(rule *types.PolicyRule) (uint8,
 // calculateChangesForRuleCreation returns the conjMatchFlowContextChanges of the new policyRuleConjunction. It
 // will calculate the expected conjMatchFlowContext status, and the changed Openflow entries.
 func (c *policyRuleConjunction) calculateChangesForRuleCreation(featureNetworkPolicy *featureNetworkPolicy, rule *types.PolicyRule) []*conjMatchFlowContextChange {
-	isMCNPRule := containsLabelIdentityAddress(rule.From)
+	isMCNPRule := containsLabelIdentityAddress(rule.From) && !rule.Audit
 	var ctxChanges []*conjMatchFlowContextChange
 	if c.fromClause != nil {
 		ctxChanges = append(ctxChanges, c.fromClause.addAddrFlows(featureNetworkPolicy, types.SrcAddress, rule.From, rule.Priority, rule.EnableLogging, isMCNPRule)...)
@@ -1578,6 +1702,10 @@ func (c *client) UninstallPolicyRuleFlows(ruleID uint32) ([]string, error) {
 	if err := c.ofEntryOperations.DeleteAll(append(conj.actionFlows, conj.metricFlows...)); err != nil {
 		return nil, err
 	}
+	// The meters can only be deleted after the flows referring to them.
+	if err := c.featureNetworkPolicy.deleteRuleMeters(conj); err != nil {
+		return nil, err
+	}
 	c.featureNetworkPolicy.conjMatchFlowLock.Lock()
 	defer c.featureNetworkPolicy.conjMatchFlowLock.Unlock()
 	// Get the conjMatchFlowContext changes.
@@ -1927,6 +2055,13 @@ func parseDropFlow(flowMap map[string]string) (uint32, types.RuleMetric) {
 	return uint32(id), m
 }
 
+func parseAuditFlow(flowMap map[string]string) (uint32, types.RuleMetric) {
+	m := parseFlowMetric(flowMap)
+	m.Sessions = m.Packets
+	id, _ := strconv.ParseUint(flowMap["conj_id"], 0, 32)
+	return uint32(id), m
+}
+
 func parseAllowFlow(flowMap map[string]string) (uint32, types.RuleMetric) {
 	m := parseFlowMetric(flowMap)
 	if strings.Contains(flowMap["ct_state"], "+") { // ct_state=+new
@@ -2057,6 +2192,21 @@ func (c *client) NetworkPolicyMetrics() map[uint32]*types.RuleMetric {
 	// flows to get the correct number of total packets.
 	collectMetricsFromFlows(EgressMetricTable, parseMetricFlow)
 	collectMetricsFromFlows(IngressMetricTable, parseMetricFlow)
+	if c.enableAntreaPolicy {
+		// The rules of Antrea-native policies in Audit mode are not enforced, so their metrics are collected from
+		// their action flows in the audit tables, which are only hit by the first packet of each connection.
+		for _, table := range GetAntreaPolicyAuditTables() {
+			dumpedFlows, _ := c.ovsctlClient.DumpTableFlows(table.ofTable.GetID())
+			for _, flow := range dumpedFlows {
+				flowMap := parseFlowToMap(flow)
+				if _, ok := flowMap["conj_id"]; !ok {
+					continue
+				}
+				ruleID, metric := parseAuditFlow(flowMap)
+				result[ruleID] = &metric
+			}
+		}
+	}
 	return result
 }
 
@@ -2084,6 +2234,8 @@ type featureNetworkPolicy struct {
 	// OVS pipeline. The key is the next table used in the second bucket, and the value is the Openflow group.
 	loggingGroupCache sync.Map
 	groupAllocator    GroupAllocator
+	// ruleMeterIDAllocator allocates the IDs of the meters enforcing the rate limits of the rules.
+	ruleMeterIDAllocator *meterIDAllocator
 
 	ovsMetersAreSupported bool
 	enableDenyTracking    bool
@@ -2137,12 +2289,14 @@ func newFeatureNetworkPolicy(
 		loggingGroupCache:        sync.Map{},
 		cachedFlows:              newFlowCategoryCache(),
 		groupAllocator:           grpAllocator,
+		ruleMeterIDAllocator:     newMeterIDAllocator(minRuleMeterID, maxRuleMeterID),
 	}
 }
 
 func (f *featureNetworkPolicy) initFlows() []*openflow15.FlowMod {
 	f.egressTables = map[uint8]struct{}{EgressRuleTable.GetID(): {}, EgressDefaultTable.GetID(): {}}
 	if f.enableAntreaPolicy {
+		f.egressTables[AntreaPolicyEgressAuditTable.GetID()] = struct{}{}
 		f.egressTables[AntreaPolicyEgressRuleTable.GetID()] = struct{}{}
 		if f.enableMulticast {
 			f.egressTables[MulticastEgressRuleTable.GetID()] = struct{}{}
@@ -2153,6 +2307,9 @@ func (f *featureNetworkPolicy) initFlows() []*openflow15.FlowMod {
 		flows = append(flows, f.ingressClassifierFlows()...)
 	}
 	flows = append(flows, f.skipPolicyRuleCheckFlows()...)
+	if f.enableAntreaPolicy {
+		flows = append(flows, f.auditTableFlows()...)
+	}
 	flows = append(flows, f.initLoggingFlows()...)
 	return GetFlowModMessages(flows, binding.AddMessage)
 }
@@ -2350,6 +2507,11 @@ func (f *featureNetworkPolicy) initLoggingFlows() []binding.Flow {
 func (f *featureNetworkPolicy) initGroups() []binding.OFEntry {
 	var groups []binding.OFEntry
 	candidateTables := []*Table{EgressRuleTable, EgressMetricTable, IngressRuleTable, IngressMetricTable}
+	if f.enableAntreaPolicy {
+		// The packets matching the rules of Antrea-native policies in Audit mode are resubmitted to the audit tables
+		// after being logged.
+		candidateTables = append(candidateTables, GetAntreaPolicyAuditTables()...)
+	}
 	if f.enableMulticast {
 		candidateTables = append(candidateTables, MulticastEgressMetricTable, MulticastIngressMetricTable)
 	}
@@ -2380,7 +2542,14 @@ func (f *featureNetworkPolicy) initGroups() []binding.OFEntry {
 }
 
 func (f *featureNetworkPolicy) replayMeters() []binding.OFEntry {
-	return nil
+	var meters []binding.OFEntry
+	for _, obj := range f.policyCache.List() {
+		for _, meter := range obj.(*policyRuleConjunction).meters {
+			meter.Reset()
+			meters = append(meters, meter)
+		}
+	}
+	return meters
 }
 
 func (f *featureNetworkPolicy) getLoggingAndResubmitGroupID(nextTable uint8) binding.GroupIDType {
@@ -520,6 +520,274 @@ func TestBatchInstallPolicyRuleFlows(t *testing.T) {
 	}
 }
 
+func TestInstallPolicyRuleFlowsWithRateLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := opstest.NewMockOFEntryOperations(ctrl)
+	bridge := mocks.NewMockBridge(ctrl)
+	fc := newFakeClient(m, true, false, config.K8sNode, config.TrafficEncapModeEncap, setEnableOVSMeters(true))
+	defer resetPipelines()
+	fc.featureNetworkPolicy.bridge = bridge
+
+	rule := &types.PolicyRule{
+		Direction: v1beta2.DirectionIn,
+		From:      parseAddresses([]string{"192.168.1.40"}),
+		To:        []types.Address{NewOFPortAddress(1)},
+		Action:    &actionAllow,
+		Priority:  &priority100,
+		FlowID:    uint32(10),
+		TableID:   AntreaPolicyIngressRuleTable.GetID(),
+		PolicyRef: &v1beta2.NetworkPolicyReference{
+			Type:      v1beta2.AntreaNetworkPolicy,
+			Namespace: "ns1",
+			Name:      "np1",
+			UID:       "id1",
+		},
+		RateLimit: &v1beta2.RuleRateLimit{ConnectionsPerSecond: 100, BandwidthRate: 10000, BandwidthBurst: 20000},
+	}
+	expectedFlows := []string{
+		"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=100,conj_id=10,ip actions=meter:1024,set_field:0xa->reg6,ct(commit,table=IngressMetric,zone=65520,exec(set_field:0xa/0xffffffff->ct_label))",
+		"cookie=0x1020000000000, table=IngressMetric, priority=200,ct_state=+new,ct_label=0xa/0xffffffff,ip actions=meter:1025,goto_table:ConntrackCommit",
+		"cookie=0x1020000000000, table=IngressMetric, priority=200,ct_state=-new,ct_label=0xa/0xffffffff,ip actions=meter:1025,goto_table:ConntrackCommit",
+	}
+
+	var meters []*mocks.MockMeter
+	expectNewMeter := func(id, rate, burst uint32, unit ofctrl.MeterFlag) {
+		meter := mocks.NewMockMeter(ctrl)
+		meterBuilder := mocks.NewMockMeterBandBuilder(ctrl)
+		bridge.EXPECT().NewMeter(binding.MeterIDType(id), ofctrl.MeterBurst|unit).Return(meter).Times(1)
+		meter.EXPECT().MeterBand().Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().MeterType(ofctrl.MeterDrop).Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().Rate(rate).Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().Burst(burst).Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().Done().Return(meter).Times(1)
+		meter.EXPECT().Add().Return(nil).Times(1)
+		meters = append(meters, meter)
+	}
+	expectNewMeter(1024, 100, 100, ofctrl.MeterPktps)
+	expectNewMeter(1025, 10000, 20000, ofctrl.MeterKbps)
+
+	var actualFlows []string
+	m.EXPECT().AddAll(gomock.Any()).Do(func(flowMessages []*openflow15.FlowMod) {
+		actualFlows = append(actualFlows, getFlowStrings(flowMessages)...)
+	}).Return(nil).Times(1)
+	bridge.EXPECT().AddFlowsInBundle(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	require.NoError(t, fc.InstallPolicyRuleFlows(rule))
+	assert.ElementsMatch(t, expectedFlows, actualFlows)
+
+	// The meters are replayed with the flows.
+	for _, meter := range meters {
+		meter.EXPECT().Reset().Times(1)
+	}
+	assert.Len(t, fc.featureNetworkPolicy.replayMeters(), 2)
+
+	// The meters are deleted with the rule, and their IDs are reused.
+	m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
+	bridge.EXPECT().AddFlowsInBundle(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	for _, meter := range meters {
+		meter.EXPECT().Delete().Return(nil).Times(1)
+	}
+	_, err := fc.UninstallPolicyRuleFlows(rule.FlowID)
+	require.NoError(t, err)
+	id, err := fc.featureNetworkPolicy.ruleMeterIDAllocator.allocate()
+	require.NoError(t, err)
+	assert.Contains(t, []binding.MeterIDType{1024, 1025}, id)
+}
+
+func TestBatchInstallPolicyRuleFlowsWithRateLimitFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := opstest.NewMockOFEntryOperations(ctrl)
+	bridge := mocks.NewMockBridge(ctrl)
+	fc := newFakeClient(m, true, false, config.K8sNode, config.TrafficEncapModeEncap, setEnableOVSMeters(true))
+	defer resetPipelines()
+	fc.featureNetworkPolicy.bridge = bridge
+
+	policyRef := &v1beta2.NetworkPolicyReference{
+		Type:      v1beta2.AntreaNetworkPolicy,
+		Namespace: "ns1",
+		Name:      "np1",
+		UID:       "id1",
+	}
+	rules := []*types.PolicyRule{
+		{
+			Direction: v1beta2.DirectionIn,
+			From:      parseAddresses([]string{"192.168.1.40"}),
+			To:        []types.Address{NewOFPortAddress(1)},
+			Action:    &actionAllow,
+			Priority:  &priority100,
+			FlowID:    uint32(10),
+			TableID:   AntreaPolicyIngressRuleTable.GetID(),
+			PolicyRef: policyRef,
+			RateLimit: &v1beta2.RuleRateLimit{ConnectionsPerSecond: 100, BandwidthRate: 10000},
+		},
+		{
+			Direction: v1beta2.DirectionIn,
+			From:      parseAddresses([]string{"192.168.1.41"}),
+			To:        []types.Address{NewOFPortAddress(1)},
+			Action:    &actionAllow,
+			Priority:  &priority200,
+			FlowID:    uint32(11),
+			TableID:   AntreaPolicyIngressRuleTable.GetID(),
+			PolicyRef: policyRef,
+			RateLimit: &v1beta2.RuleRateLimit{ConnectionsPerSecond: 200},
+		},
+	}
+
+	expectNewMeter := func(id uint32, unit ofctrl.MeterFlag, addErr error) {
+		meter := mocks.NewMockMeter(ctrl)
+		meterBuilder := mocks.NewMockMeterBandBuilder(ctrl)
+		bridge.EXPECT().NewMeter(binding.MeterIDType(id), ofctrl.MeterBurst|unit).Return(meter).Times(1)
+		meter.EXPECT().MeterBand().Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().MeterType(ofctrl.MeterDrop).Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().Rate(gomock.Any()).Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().Burst(gomock.Any()).Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().Done().Return(meter).Times(1)
+		meter.EXPECT().Add().Return(addErr).Times(1)
+		// The meters of all the rules are deleted when any of them cannot be installed.
+		meter.EXPECT().Delete().Return(nil).Times(1)
+	}
+	expectNewMeter(1024, ofctrl.MeterPktps, nil)
+	expectNewMeter(1025, ofctrl.MeterKbps, nil)
+	expectNewMeter(1026, ofctrl.MeterPktps, fmt.Errorf("meter error"))
+
+	err := fc.BatchInstallPolicyRuleFlows(rules)
+	assert.ErrorContains(t, err, "meter error")
+	assert.Empty(t, fc.featureNetworkPolicy.globalConjMatchFlowCache)
+	assert.Empty(t, fc.featureNetworkPolicy.policyCache.List())
+	// The IDs of the deleted meters are released.
+	var ids []binding.MeterIDType
+	for range 3 {
+		id, err := fc.featureNetworkPolicy.ruleMeterIDAllocator.allocate()
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	assert.ElementsMatch(t, []binding.MeterIDType{1024, 1025, 1026}, ids)
+}
+
+func TestInstallPolicyRuleFlowsWithRateLimitAddFlowsFailure(t *testing.T) {
+	policyRef := &v1beta2.NetworkPolicyReference{
+		Type:      v1beta2.AntreaNetworkPolicy,
+		Namespace: "ns1",
+		Name:      "np1",
+		UID:       "id1",
+	}
+	rules := []*types.PolicyRule{
+		{
+			Direction: v1beta2.DirectionIn,
+			From:      parseAddresses([]string{"192.168.1.40"}),
+			To:        []types.Address{NewOFPortAddress(1)},
+			Action:    &actionAllow,
+			Priority:  &priority100,
+			FlowID:    uint32(10),
+			TableID:   AntreaPolicyIngressRuleTable.GetID(),
+			PolicyRef: policyRef,
+			RateLimit: &v1beta2.RuleRateLimit{ConnectionsPerSecond: 100, BandwidthRate: 10000},
+		},
+		{
+			Direction: v1beta2.DirectionIn,
+			From:      parseAddresses([]string{"192.168.1.41"}),
+			To:        []types.Address{NewOFPortAddress(1)},
+			Action:    &actionAllow,
+			Priority:  &priority200,
+			FlowID:    uint32(11),
+			TableID:   AntreaPolicyIngressRuleTable.GetID(),
+			PolicyRef: policyRef,
+			RateLimit: &v1beta2.RuleRateLimit{ConnectionsPerSecond: 200},
+		},
+	}
+
+	// The meter IDs are reused in the order they are released, so each case uses a new client.
+	newClient := func(t *testing.T) (*client, *opstest.MockOFEntryOperations, *mocks.MockBridge, *gomock.Controller) {
+		ctrl := gomock.NewController(t)
+		m := opstest.NewMockOFEntryOperations(ctrl)
+		bridge := mocks.NewMockBridge(ctrl)
+		fc := newFakeClient(m, true, false, config.K8sNode, config.TrafficEncapModeEncap, setEnableOVSMeters(true))
+		t.Cleanup(resetPipelines)
+		fc.featureNetworkPolicy.bridge = bridge
+		return fc, m, bridge, ctrl
+	}
+	expectNewMeter := func(ctrl *gomock.Controller, bridge *mocks.MockBridge, id uint32, unit ofctrl.MeterFlag) {
+		meter := mocks.NewMockMeter(ctrl)
+		meterBuilder := mocks.NewMockMeterBandBuilder(ctrl)
+		bridge.EXPECT().NewMeter(binding.MeterIDType(id), ofctrl.MeterBurst|unit).Return(meter).Times(1)
+		meter.EXPECT().MeterBand().Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().MeterType(ofctrl.MeterDrop).Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().Rate(gomock.Any()).Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().Burst(gomock.Any()).Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().Done().Return(meter).Times(1)
+		meter.EXPECT().Add().Return(nil).Times(1)
+		// The meters are deleted when the flows referring to them cannot be installed.
+		meter.EXPECT().Delete().Return(nil).Times(1)
+	}
+	assertMeterIDsReleased := func(t *testing.T, fc *client, expectedIDs ...binding.MeterIDType) {
+		var ids []binding.MeterIDType
+		for range expectedIDs {
+			id, err := fc.featureNetworkPolicy.ruleMeterIDAllocator.allocate()
+			require.NoError(t, err)
+			ids = append(ids, id)
+		}
+		assert.ElementsMatch(t, expectedIDs, ids)
+	}
+
+	t.Run("InstallPolicyRuleFlows", func(t *testing.T) {
+		fc, m, bridge, ctrl := newClient(t)
+		expectNewMeter(ctrl, bridge, 1024, ofctrl.MeterPktps)
+		expectNewMeter(ctrl, bridge, 1025, ofctrl.MeterKbps)
+		m.EXPECT().AddAll(gomock.Any()).Return(fmt.Errorf("bundle error")).Times(1)
+
+		err := fc.InstallPolicyRuleFlows(rules[0])
+		assert.ErrorContains(t, err, "bundle error")
+		assert.Empty(t, fc.featureNetworkPolicy.policyCache.List())
+		assertMeterIDsReleased(t, fc, 1024, 1025)
+	})
+
+	t.Run("BatchInstallPolicyRuleFlows", func(t *testing.T) {
+		fc, m, bridge, ctrl := newClient(t)
+		expectNewMeter(ctrl, bridge, 1024, ofctrl.MeterPktps)
+		expectNewMeter(ctrl, bridge, 1025, ofctrl.MeterKbps)
+		expectNewMeter(ctrl, bridge, 1026, ofctrl.MeterPktps)
+		m.EXPECT().AddAll(gomock.Any()).Return(fmt.Errorf("bundle error")).Times(1)
+
+		err := fc.BatchInstallPolicyRuleFlows(rules)
+		assert.ErrorContains(t, err, "bundle error")
+		assert.Empty(t, fc.featureNetworkPolicy.globalConjMatchFlowCache)
+		assert.Empty(t, fc.featureNetworkPolicy.policyCache.List())
+		assertMeterIDsReleased(t, fc, 1024, 1025, 1026)
+	})
+}
+
+func TestDeleteRuleMetersWithFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := opstest.NewMockOFEntryOperations(ctrl)
+	fc := newFakeClient(m, true, false, config.K8sNode, config.TrafficEncapModeEncap, setEnableOVSMeters(true))
+	defer resetPipelines()
+
+	conj := &policyRuleConjunction{id: 10, meters: map[binding.MeterIDType]binding.Meter{}}
+	for _, deleteErr := range []error{fmt.Errorf("meter error"), nil, nil} {
+		id, err := fc.featureNetworkPolicy.ruleMeterIDAllocator.allocate()
+		require.NoError(t, err)
+		meter := mocks.NewMockMeter(ctrl)
+		meter.EXPECT().Delete().Return(deleteErr).Times(1)
+		conj.meters[id] = meter
+	}
+
+	// The meter which cannot be deleted doesn't prevent the other meters from being deleted.
+	err := fc.featureNetworkPolicy.deleteRuleMeters(conj)
+	assert.ErrorContains(t, err, "meter error")
+	require.Len(t, conj.meters, 1)
+	var remainingID binding.MeterIDType
+	for id := range conj.meters {
+		remainingID = id
+	}
+	var ids []binding.MeterIDType
+	for range 2 {
+		id, err := fc.featureNetworkPolicy.ruleMeterIDAllocator.allocate()
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	assert.NotContains(t, ids, remainingID)
+	assert.Subset(t, []binding.MeterIDType{1024, 1025, 1026}, ids)
+}
+
 type flowModIgnoreTxIDMatcher struct {
 	flowMods []string
 }
@@ -1102,12 +1370,23 @@ func TestParseMetricFlow(t *testing.T) {
 	}
 }
 
+func TestParseAuditFlow(t *testing.T) {
+	flow := "table=AntreaPolicyIngressAudit, n_packets=3, n_bytes=222, priority=14900,conj_id=7 actions=set_field:0x7->reg6,set_field:0x8000/0x8000->reg0,group:4"
+	rule, metric := parseAuditFlow(parseFlowToMap(flow))
+	require.Equal(t, uint32(7), rule)
+	require.Equal(t, uint64(222), metric.Bytes)
+	require.Equal(t, uint64(3), metric.Sessions)
+	require.Equal(t, uint64(3), metric.Packets)
+}
+
 func TestNetworkPolicyMetrics(t *testing.T) {
 	tests := []struct {
-		name         string
-		egressFlows  []string
-		ingressFlows []string
-		want         map[uint32]*types.RuleMetric
+		name              string
+		egressFlows       []string
+		ingressFlows      []string
+		egressAuditFlows  []string
+		ingressAuditFlows []string
+		want              map[uint32]*types.RuleMetric
 	}{
 		{
 			name: "Normal flows",
@@ -1175,6 +1454,35 @@ func TestNetworkPolicyMetrics(t *testing.T) {
 				11: {Bytes: 338, Sessions: 4, Packets: 4},
 			},
 		},
+		{
+			name: "Flows with audit flows",
+			egressFlows: []string{
+				"table=61, n_packets=1, n_bytes=74, priority=200,ct_state=+new,ct_label=0x200000000/0xffffffff00000000,ip actions=goto_table:70",
+				"table=61, n_packets=11, n_bytes=1661, priority=200,ct_state=-new,ct_label=0x200000000/0xffffffff00000000,ip actions=goto_table:70",
+				"table=61, n_packets=1502362, n_bytes=601635949, priority=0 actions=goto_table:70",
+			},
+			ingressFlows: []string{
+				"table=101, n_packets=1, n_bytes=74, priority=200,ct_state=+new,ct_label=0x1/0xffffffff,ip actions=resubmit(,105)",
+				"table=101, n_packets=11, n_bytes=1661, priority=200,ct_state=-new,ct_label=0x1/0xffffffff,ip actions=resubmit(,105)",
+				"table=101, n_packets=1407190, n_bytes=509746586, priority=0 actions=resubmit(,105)",
+			},
+			egressAuditFlows: []string{
+				"table=AntreaPolicyEgressAudit, n_packets=5, n_bytes=370, priority=64990,reg0=0x8000/0x8000 actions=set_field:0x0/0x8000->reg0,set_field:0x0/0x1800->reg0,set_field:0x0->reg5,set_field:0x0/0xfe000000->reg0,set_field:0x0/0xff->reg2,goto_table:AntreaPolicyEgressRule",
+				"table=AntreaPolicyEgressAudit, n_packets=2, n_bytes=148, priority=14900,ip,nw_dst=10.10.0.1 actions=conjunction(4,2/2)",
+				"table=AntreaPolicyEgressAudit, n_packets=2, n_bytes=148, priority=14900,conj_id=4 actions=set_field:0x4->reg5,set_field:0x8000/0x8000->reg0,group:3",
+				"table=AntreaPolicyEgressAudit, n_packets=1502362, n_bytes=601635949, priority=0 actions=goto_table:AntreaPolicyEgressRule",
+			},
+			ingressAuditFlows: []string{
+				"table=AntreaPolicyIngressAudit, n_packets=3, n_bytes=222, priority=14900,conj_id=3 actions=goto_table:AntreaPolicyIngressRule",
+				"table=AntreaPolicyIngressAudit, n_packets=1407190, n_bytes=509746586, priority=0 actions=goto_table:AntreaPolicyIngressRule",
+			},
+			want: map[uint32]*types.RuleMetric{
+				2: {Bytes: 1735, Sessions: 1, Packets: 12},
+				4: {Bytes: 148, Sessions: 2, Packets: 2},
+				1: {Bytes: 1735, Sessions: 1, Packets: 12},
+				3: {Bytes: 222, Sessions: 3, Packets: 3},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1182,11 +1490,14 @@ func TestNetworkPolicyMetrics(t *testing.T) {
 			preparePipelines()
 			defer resetPipelines()
 			c = prepareClient(ctrl, false)
+			c.enableAntreaPolicy = true
 			mockOVSClient := ovsctltest.NewMockOVSCtlClient(ctrl)
 			c.ovsctlClient = mockOVSClient
 			gomock.InOrder(
 				mockOVSClient.EXPECT().DumpTableFlows(EgressMetricTable.ofTable.GetID()).Return(tt.egressFlows, nil),
 				mockOVSClient.EXPECT().DumpTableFlows(IngressMetricTable.ofTable.GetID()).Return(tt.ingressFlows, nil),
+				mockOVSClient.EXPECT().DumpTableFlows(AntreaPolicyEgressAuditTable.ofTable.GetID()).Return(tt.egressAuditFlows, nil),
+				mockOVSClient.EXPECT().DumpTableFlows(AntreaPolicyIngressAuditTable.ofTable.GetID()).Return(tt.ingressAuditFlows, nil),
 			)
 			got := c.NetworkPolicyMetrics()
 			assert.Equal(t, tt.want, got)
@@ -1285,6 +1596,50 @@ func setMockOFTables(ctrl *gomock.Controller, tableMap map[*Table]**mocks.MockTa
 	}
 }
 
+func TestGetServiceMatchPairs(t *testing.T) {
+	protocolSCTP := v1beta2.ProtocolSCTP
+	port36412 := intstr.FromInt(36412)
+	endPort36415 := int32(36415)
+	endPort32801 := int32(32801)
+	portMask := uint16(0xfffc)
+	srcPortMask := uint16(0xfffe)
+	tests := []struct {
+		name        string
+		service     v1beta2.Service
+		ipProtocols []binding.Protocol
+		expected    [][]matchPair
+	}{
+		{
+			name:        "SCTP port range",
+			service:     v1beta2.Service{Protocol: &protocolSCTP, Port: &port36412, EndPort: &endPort36415},
+			ipProtocols: []binding.Protocol{binding.ProtocolIP},
+			expected: [][]matchPair{
+				{{matchKey: MatchSCTPDstPort, matchValue: types.BitRange{Value: 36412, Mask: &portMask}}},
+			},
+		},
+		{
+			name:        "SCTP source port range in dual-stack cluster",
+			service:     v1beta2.Service{Protocol: &protocolSCTP, SrcPort: &port32800, SrcEndPort: &endPort32801},
+			ipProtocols: []binding.Protocol{binding.ProtocolIP, binding.ProtocolIPv6},
+			expected: [][]matchPair{
+				{
+					{matchKey: MatchSCTPDstPort, matchValue: types.BitRange{Value: 0}},
+					{matchKey: MatchSCTPSrcPort, matchValue: types.BitRange{Value: 32800, Mask: &srcPortMask}},
+				},
+				{
+					{matchKey: MatchSCTPv6DstPort, matchValue: types.BitRange{Value: 0}},
+					{matchKey: MatchSCTPv6SrcPort, matchValue: types.BitRange{Value: 32800, Mask: &srcPortMask}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getServiceMatchPairs(tt.service, tt.ipProtocols))
+		})
+	}
+}
+
 func TestClient_GetPolicyInfoFromConjunction(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	preparePipelines()
@@ -1387,6 +1742,12 @@ func networkPolicyInitFlows(ovsMeterSupported, externalNodeEnabled bool) []strin
 			"cookie=0x1020000000000, table=AntreaPolicyEgressRule, priority=64990,ct_state=-new+rel,ip actions=goto_table:EgressMetric",
 			"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=64990,ct_state=-new+est,ip actions=goto_table:IngressMetric",
 			"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=64990,ct_state=-new+rel,ip actions=goto_table:IngressMetric",
+			"cookie=0x1020000000000, table=AntreaPolicyEgressAudit, priority=64990,reg0=0x8000/0x8000 actions=set_field:0x0/0x8000->reg0,set_field:0x0/0x1800->reg0,set_field:0x0->reg5,set_field:0x0/0xfe000000->reg0,set_field:0x0/0xff->reg2,goto_table:AntreaPolicyEgressRule",
+			"cookie=0x1020000000000, table=AntreaPolicyEgressAudit, priority=64990,ct_state=-new+est,ip actions=goto_table:AntreaPolicyEgressRule",
+			"cookie=0x1020000000000, table=AntreaPolicyEgressAudit, priority=64990,ct_state=-new+rel,ip actions=goto_table:AntreaPolicyEgressRule",
+			"cookie=0x1020000000000, table=AntreaPolicyIngressAudit, priority=64990,reg0=0x8000/0x8000 actions=set_field:0x0/0x8000->reg0,set_field:0x0/0x1800->reg0,set_field:0x0->reg6,set_field:0x0/0xfe000000->reg0,set_field:0x0/0xff->reg2,goto_table:AntreaPolicyIngressRule",
+			"cookie=0x1020000000000, table=AntreaPolicyIngressAudit, priority=64990,ct_state=-new+est,ip actions=goto_table:AntreaPolicyIngressRule",
+			"cookie=0x1020000000000, table=AntreaPolicyIngressAudit, priority=64990,ct_state=-new+rel,ip actions=goto_table:AntreaPolicyIngressRule",
 		)
 	}
 	initFlows := append(loggingFlows,
@@ -1398,6 +1759,12 @@ func networkPolicyInitFlows(ovsMeterSupported, externalNodeEnabled bool) []strin
 		"cookie=0x1020000000000, table=AntreaPolicyEgressRule, priority=64990,ct_state=-new+rel,ip actions=goto_table:EgressMetric",
 		"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=64990,ct_state=-new+est,ip actions=goto_table:IngressMetric",
 		"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=64990,ct_state=-new+rel,ip actions=goto_table:IngressMetric",
+		"cookie=0x1020000000000, table=AntreaPolicyEgressAudit, priority=64990,reg0=0x8000/0x8000 actions=set_field:0x0/0x8000->reg0,set_field:0x0/0x1800->reg0,set_field:0x0->reg5,set_field:0x0/0xfe000000->reg0,set_field:0x0/0xff->reg2,goto_table:AntreaPolicyEgressRule",
+		"cookie=0x1020000000000, table=AntreaPolicyEgressAudit, priority=64990,ct_state=-new+est,ip actions=goto_table:AntreaPolicyEgressRule",
+		"cookie=0x1020000000000, table=AntreaPolicyEgressAudit, priority=64990,ct_state=-new+rel,ip actions=goto_table:AntreaPolicyEgressRule",
+		"cookie=0x1020000000000, table=AntreaPolicyIngressAudit, priority=64990,reg0=0x8000/0x8000 actions=set_field:0x0/0x8000->reg0,set_field:0x0/0x1800->reg0,set_field:0x0->reg6,set_field:0x0/0xfe000000->reg0,set_field:0x0/0xff->reg2,goto_table:AntreaPolicyIngressRule",
+		"cookie=0x1020000000000, table=AntreaPolicyIngressAudit, priority=64990,ct_state=-new+est,ip actions=goto_table:AntreaPolicyIngressRule",
+		"cookie=0x1020000000000, table=AntreaPolicyIngressAudit, priority=64990,ct_state=-new+rel,ip actions=goto_table:AntreaPolicyIngressRule",
 	)
 	return initFlows
 }
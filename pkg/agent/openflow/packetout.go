@@ -19,6 +19,7 @@ import (
 
 	"antrea.io/libOpenflow/protocol"
 
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 )
 
@@ -32,12 +33,37 @@ const (
 	tcpRst uint8 = 0b000100
 
 	icmpDstUnreachableType         uint8 = 3
+	icmpDstHostUnreachableCode     uint8 = 1
+	icmpDstPortUnreachableCode     uint8 = 3
 	icmpDstHostAdminProhibitedCode uint8 = 10
 
-	icmpv6DstUnreachableType     uint8 = 1
-	icmpv6DstAdminProhibitedCode uint8 = 1
+	icmpv6DstUnreachableType        uint8 = 1
+	icmpv6DstAdminProhibitedCode    uint8 = 1
+	icmpv6DstAddressUnreachableCode uint8 = 3
+	icmpv6DstPortUnreachableCode    uint8 = 4
 )
 
+// getRejectICMPCode returns the code of the ICMP or ICMPv6 destination unreachable message sent
+// for the provided reject response. ICMP administratively prohibited is used by default.
+func getRejectICMPCode(rejectResponse crdv1beta1.RejectResponseType, isIPv6 bool) uint8 {
+	switch rejectResponse {
+	case crdv1beta1.RejectResponseICMPPortUnreachable:
+		if isIPv6 {
+			return icmpv6DstPortUnreachableCode
+		}
+		return icmpDstPortUnreachableCode
+	case crdv1beta1.RejectResponseICMPHostUnreachable:
+		if isIPv6 {
+			return icmpv6DstAddressUnreachableCode
+		}
+		return icmpDstHostUnreachableCode
+	}
+	if isIPv6 {
+		return icmpv6DstAdminProhibitedCode
+	}
+	return icmpDstHostAdminProhibitedCode
+}
+
 func SendRejectPacketOut(ofClient Client,
 	srcMAC string,
 	dstMAC string,
@@ -48,8 +74,10 @@ func SendRejectPacketOut(ofClient Client,
 	isIPv6 bool,
 	ethernetPkt *protocol.Ethernet,
 	proto uint8,
+	rejectResponse crdv1beta1.RejectResponseType,
 	mutateFunc func(binding.PacketOutBuilder) binding.PacketOutBuilder) error {
-	if proto == protocol.Type_TCP {
+	// TCP connections are reset unless an ICMP response is requested.
+	if proto == protocol.Type_TCP && (rejectResponse == "" || rejectResponse == crdv1beta1.RejectResponseTCPReset) {
 		// Get TCP data.
 		oriTCPSrcPort, oriTCPDstPort, oriTCPSeqNum, _, _, _, _, err := binding.GetTCPHeaderData(ethernetPkt.Data)
 		if err != nil {
@@ -75,13 +103,12 @@ func SendRejectPacketOut(ofClient Client,
 			nil,
 			mutateFunc)
 	}
-	// Use ICMP host administratively prohibited for ICMP, UDP, SCTP reject.
+	// Use ICMP destination unreachable for ICMP, UDP, SCTP reject, and for TCP reject if requested.
 	icmpType := icmpDstUnreachableType
-	icmpCode := icmpDstHostAdminProhibitedCode
+	icmpCode := getRejectICMPCode(rejectResponse, isIPv6)
 	ipHdrLen := ipv4HdrLen
 	if isIPv6 {
 		icmpType = icmpv6DstUnreachableType
-		icmpCode = icmpv6DstAdminProhibitedCode
 		ipHdrLen = ipv6HdrLen
 	}
 	ipHdr, _ := ethernetPkt.Data.MarshalBinary()
@@ -0,0 +1,43 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+func TestGetRejectICMPCode(t *testing.T) {
+	tests := []struct {
+		rejectResponse crdv1beta1.RejectResponseType
+		expectedCode   uint8
+		expectedCodeV6 uint8
+	}{
+		{rejectResponse: "", expectedCode: 10, expectedCodeV6: 1},
+		{rejectResponse: crdv1beta1.RejectResponseTCPReset, expectedCode: 10, expectedCodeV6: 1},
+		{rejectResponse: crdv1beta1.RejectResponseICMPAdminProhibited, expectedCode: 10, expectedCodeV6: 1},
+		{rejectResponse: crdv1beta1.RejectResponseICMPPortUnreachable, expectedCode: 3, expectedCodeV6: 4},
+		{rejectResponse: crdv1beta1.RejectResponseICMPHostUnreachable, expectedCode: 1, expectedCodeV6: 3},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.rejectResponse), func(t *testing.T) {
+			assert.Equal(t, tt.expectedCode, getRejectICMPCode(tt.rejectResponse, false))
+			assert.Equal(t, tt.expectedCodeV6, getRejectICMPCode(tt.rejectResponse, true))
+		})
+	}
+}
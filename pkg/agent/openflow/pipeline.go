@@ -39,6 +39,7 @@ import (
 	"antrea.io/antrea/pkg/agent/util"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/ovs/ovsctl"
+	utilip "antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/runtime"
 	"antrea.io/antrea/third_party/proxy"
 )
@@ -150,6 +151,7 @@ var (
 
 	// Tables in stageEgressSecurity:
 	EgressSecurityClassifierTable = newTable("EgressSecurityClassifier", stageEgressSecurity, pipelineIP)
+	AntreaPolicyEgressAuditTable  = newTable("AntreaPolicyEgressAudit", stageEgressSecurity, pipelineIP)
 	AntreaPolicyEgressRuleTable   = newTable("AntreaPolicyEgressRule", stageEgressSecurity, pipelineIP)
 	EgressRuleTable               = newTable("EgressRule", stageEgressSecurity, pipelineIP)
 	EgressDefaultTable            = newTable("EgressDefaultRule", stageEgressSecurity, pipelineIP)
@@ -171,6 +173,7 @@ var (
 
 	// Tables in stageIngressSecurity:
 	IngressSecurityClassifierTable = newTable("IngressSecurityClassifier", stageIngressSecurity, pipelineIP)
+	AntreaPolicyIngressAuditTable  = newTable("AntreaPolicyIngressAudit", stageIngressSecurity, pipelineIP)
 	AntreaPolicyIngressRuleTable   = newTable("AntreaPolicyIngressRule", stageIngressSecurity, pipelineIP)
 	IngressRuleTable               = newTable("IngressRule", stageIngressSecurity, pipelineIP)
 	IngressDefaultTable            = newTable("IngressDefaultRule", stageIngressSecurity, pipelineIP)
@@ -279,10 +282,14 @@ func GetTableList() []binding.Table {
 	return tables
 }
 
+// GetAntreaPolicyEgressTables returns the tables in which the egress rules of Antrea-native policies
+// are installed: the table for the rules of non-baseline Tiers, the table for the rules of the
+// baseline Tier, and the table for the rules of policies in Audit mode.
 func GetAntreaPolicyEgressTables() []*Table {
 	return []*Table{
 		AntreaPolicyEgressRuleTable,
 		EgressDefaultTable,
+		AntreaPolicyEgressAuditTable,
 	}
 }
 
@@ -298,10 +305,13 @@ func GetAntreaMulticastEgressTables() []*Table {
 	}
 }
 
+// GetAntreaPolicyIngressTables returns the tables in which the ingress rules of Antrea-native
+// policies are installed, in the same order as GetAntreaPolicyEgressTables.
 func GetAntreaPolicyIngressTables() []*Table {
 	return []*Table{
 		AntreaPolicyIngressRuleTable,
 		IngressDefaultTable,
+		AntreaPolicyIngressAuditTable,
 	}
 }
 
@@ -319,6 +329,13 @@ func GetAntreaPolicyMultiTierTables() []*Table {
 	}
 }
 
+func GetAntreaPolicyAuditTables() []*Table {
+	return []*Table{
+		AntreaPolicyEgressAuditTable,
+		AntreaPolicyIngressAuditTable,
+	}
+}
+
 const (
 	CtZone       = 0xfff0
 	CtZoneV6     = 0xffe6
@@ -938,10 +955,16 @@ func (f *featurePodConnectivity) flowsToTrace(dataplaneTag uint8,
 			} else {
 				flowBuilder = flowBuilder.MatchProtocol(binding.ProtocolUDP)
 			}
+		case utilip.SCTPProtocol:
+			if packet.IsIPv6 {
+				flowBuilder = flowBuilder.MatchProtocol(binding.ProtocolSCTPv6)
+			} else {
+				flowBuilder = flowBuilder.MatchProtocol(binding.ProtocolSCTP)
+			}
 		default:
 			flowBuilder = flowBuilder.MatchIPProtocolValue(packet.IsIPv6, packet.IPProto)
 		}
-		if packet.IPProto == protocol.Type_TCP || packet.IPProto == protocol.Type_UDP {
+		if packet.IPProto == protocol.Type_TCP || packet.IPProto == protocol.Type_UDP || packet.IPProto == utilip.SCTPProtocol {
 			if packet.DestinationPort != 0 {
 				flowBuilder = flowBuilder.MatchDstPort(packet.DestinationPort, nil)
 			}
@@ -1604,7 +1627,10 @@ func (f *featurePodConnectivity) arpNormalFlow() binding.Flow {
 		Done()
 }
 
-func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingress bool, tableID uint8) []binding.Flow {
+// allowRulesMetricFlows generates the flows to count the packets and sessions matching an Allow rule. If
+// bandwidthMeterID is not 0, the packets are sent to the meter first, to limit the bandwidth of the traffic matching
+// the rule.
+func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingress bool, tableID uint8, bandwidthMeterID uint32) []binding.Flow {
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
 	metricTable := IngressMetricTable
 	offset := 0
@@ -1623,12 +1649,15 @@ func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingre
 		metricTable = MulticastIngressMetricTable
 	}
 	metricFlow := func(isCTNew bool, protocol binding.Protocol) binding.Flow {
-		return metricTable.ofTable.BuildFlow(priorityNormal).
+		fb := metricTable.ofTable.BuildFlow(priorityNormal).
 			Cookie(cookieID).
 			MatchProtocol(protocol).
 			MatchCTStateNew(isCTNew).
-			MatchCTLabelField(0, uint64(conjunctionID)<<offset, field).
-			Action().NextTable().
+			MatchCTLabelField(0, uint64(conjunctionID)<<offset, field)
+		if bandwidthMeterID != 0 {
+			fb = fb.Action().Meter(bandwidthMeterID)
+		}
+		return fb.Action().NextTable().
 			Done()
 	}
 	var flows []binding.Flow
@@ -1718,7 +1747,9 @@ func (f *featurePodConnectivity) ipv6Flows() []binding.Flow {
 
 // For normal traffic, conjunctionActionFlow generates the flow to jump to a specific table if policyRuleConjunction ID is matched. Priority of
 // conjunctionActionFlow is created at priorityLow for k8s network policies, and *priority assigned by PriorityAssigner for AntreaPolicy.
-func (f *featureNetworkPolicy) conjunctionActionFlow(conjunctionID uint32, table binding.Table, nextTable uint8, priority *uint16, enableLogging bool, l7RuleVlanID *uint32) []binding.Flow {
+// If connectionMeterID is not 0, the packets are sent to the meter first. As only the first packet of each connection
+// is processed by the rule tables, the meter limits the rate of new connections.
+func (f *featureNetworkPolicy) conjunctionActionFlow(conjunctionID uint32, table binding.Table, nextTable uint8, priority *uint16, enableLogging bool, l7RuleVlanID *uint32, connectionMeterID uint32) []binding.Flow {
 	tableID := table.GetID()
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
 	var ofPriority uint16
@@ -1739,9 +1770,12 @@ func (f *featureNetworkPolicy) conjunctionActionFlow(conjunctionID uint32, table
 		if proto == binding.ProtocolIPv6 {
 			ctZone = CtZoneV6
 		}
+		fb := table.BuildFlow(ofPriority).MatchProtocol(proto).
+			MatchConjID(conjunctionID)
+		if connectionMeterID != 0 {
+			fb = fb.Action().Meter(connectionMeterID)
+		}
 		if enableLogging {
-			fb := table.BuildFlow(ofPriority).MatchProtocol(proto).
-				MatchConjID(conjunctionID)
 			if l7RuleVlanID != nil {
 				return fb.
 					Action().LoadToRegField(conjReg, conjunctionID).        // Traceflow.
@@ -1770,8 +1804,7 @@ func (f *featureNetworkPolicy) conjunctionActionFlow(conjunctionID uint32, table
 				Done()
 		}
 		if l7RuleVlanID != nil {
-			return table.BuildFlow(ofPriority).MatchProtocol(proto).
-				MatchConjID(conjunctionID).
+			return fb.
 				Action().LoadToRegField(conjReg, conjunctionID).        // Traceflow.
 				Action().CT(true, nextTable, ctZone, f.ctZoneSrcField). // CT action requires commit flag if actions other than NAT without arguments are specified.
 				LoadToLabelField(uint64(conjunctionID), labelField).
@@ -1781,8 +1814,7 @@ func (f *featureNetworkPolicy) conjunctionActionFlow(conjunctionID uint32, table
 				Cookie(cookieID).
 				Done()
 		}
-		return table.BuildFlow(ofPriority).MatchProtocol(proto).
-			MatchConjID(conjunctionID).
+		return fb.
 			Action().LoadToRegField(conjReg, conjunctionID).        // Traceflow.
 			Action().CT(true, nextTable, ctZone, f.ctZoneSrcField). // CT action requires commit flag if actions other than NAT without arguments are specified.
 			LoadToLabelField(uint64(conjunctionID), labelField).
@@ -1888,6 +1920,91 @@ func (f *featureNetworkPolicy) conjunctionActionPassFlow(conjunctionID uint32, t
 		Done()
 }
 
+// conjunctionActionAuditFlow generates the flow for a rule of an Antrea-native policy in Audit mode, which is installed
+// in AntreaPolicyEgressAuditTable or AntreaPolicyIngressAuditTable. Whatever the action of the rule, the matched packets
+// are not denied nor allowed, but forwarded to the next table, in which the rules of the enforced Antrea-native policies
+// are evaluated. For a Drop or Reject rule, or if logging is enabled for the rule, the packet is also sent to
+// antrea-agent with APAuditRegMark, so that the connection can be logged, and then resubmitted to the audit table, in
+// which the marks loaded for the packetIn are cleared by the flow generated by auditTableFlows.
+func (f *featureNetworkPolicy) conjunctionActionAuditFlow(conjunctionID uint32, table binding.Table, priority *uint16, disposition uint32, enableLogging bool) binding.Flow {
+	ofPriority := *priority
+	tableID := table.GetID()
+	conjReg := TFIngressConjIDField
+	nextTable := AntreaPolicyIngressRuleTable
+	if _, ok := f.egressTables[tableID]; ok {
+		conjReg = TFEgressConjIDField
+		nextTable = AntreaPolicyEgressRuleTable
+	}
+	flowBuilder := table.BuildFlow(ofPriority).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchConjID(conjunctionID)
+	isDeny := disposition == DispositionDrop || disposition == DispositionRej
+	if !isDeny && !enableLogging {
+		return flowBuilder.Action().GotoTable(nextTable.GetID()).
+			Done()
+	}
+	packetInOperations := uint8(PacketInNPLoggingOperation)
+	if isDeny && f.enableDenyTracking {
+		packetInOperations += PacketInNPStoreDenyOperation
+	}
+	return flowBuilder.
+		Action().LoadToRegField(conjReg, conjunctionID).
+		Action().LoadRegMark(APAuditRegMark).
+		Action().LoadToRegField(APDispositionField, disposition).
+		Action().LoadToRegField(PacketInOperationField, uint32(packetInOperations)).
+		Action().LoadToRegField(PacketInTableField, uint32(tableID)).
+		Action().Group(f.getLoggingAndResubmitGroupID(tableID)).
+		Done()
+}
+
+// auditTableFlows generates the default flows of AntreaPolicyEgressAuditTable and AntreaPolicyIngressAuditTable:
+//  1. The flows to forward the packets resubmitted by the logging group after matching a rule of an Antrea-native policy
+//     in Audit mode to the next table, after clearing the marks loaded for the packetIn, so that the packets are
+//     processed by the rules of the enforced policies as if they had not matched any audited rule.
+//  2. The flows to forward the packets in an established or related connection to the next table directly, so that only
+//     the first packet of each connection is audited.
+func (f *featureNetworkPolicy) auditTableFlows() []binding.Flow {
+	var flows []binding.Flow
+	cookieID := f.cookieAllocator.Request(f.category).Raw()
+	for _, tables := range []struct {
+		auditTable *Table
+		nextTable  *Table
+		conjReg    *binding.RegField
+	}{
+		{AntreaPolicyEgressAuditTable, AntreaPolicyEgressRuleTable, TFEgressConjIDField},
+		{AntreaPolicyIngressAuditTable, AntreaPolicyIngressRuleTable, TFIngressConjIDField},
+	} {
+		flows = append(flows, tables.auditTable.ofTable.BuildFlow(priorityTopAntreaPolicy).
+			Cookie(cookieID).
+			MatchRegMark(APAuditRegMark).
+			Action().LoadRegMark(NotAPAuditRegMark, DispositionAllowRegMark).
+			Action().LoadToRegField(tables.conjReg, 0).
+			Action().LoadToRegField(PacketInOperationField, 0).
+			Action().LoadToRegField(PacketInTableField, 0).
+			Action().GotoTable(tables.nextTable.GetID()).
+			Done())
+		for _, ipProtocol := range f.ipProtocols {
+			flows = append(flows,
+				tables.auditTable.ofTable.BuildFlow(priorityTopAntreaPolicy).
+					Cookie(cookieID).
+					MatchProtocol(ipProtocol).
+					MatchCTStateNew(false).
+					MatchCTStateEst(true).
+					Action().GotoTable(tables.nextTable.GetID()).
+					Done(),
+				tables.auditTable.ofTable.BuildFlow(priorityTopAntreaPolicy).
+					Cookie(cookieID).
+					MatchProtocol(ipProtocol).
+					MatchCTStateNew(false).
+					MatchCTStateRel(true).
+					Action().GotoTable(tables.nextTable.GetID()).
+					Done(),
+			)
+		}
+	}
+	return flows
+}
+
 func (c *client) Disconnect() error {
 	return c.bridge.Disconnect()
 }
@@ -1958,6 +2075,10 @@ func (f *featureNetworkPolicy) addFlowMatch(fb binding.FlowBuilder, matchKey *ty
 	case MatchUDPSrcPort:
 		fallthrough
 	case MatchUDPv6SrcPort:
+		fallthrough
+	case MatchSCTPSrcPort:
+		fallthrough
+	case MatchSCTPv6SrcPort:
 		fb = fb.MatchProtocol(matchKey.GetOFProtocol())
 		portValue := matchValue.(types.BitRange)
 		if portValue.Value > 0 {
@@ -2173,12 +2294,13 @@ func (f *featureNetworkPolicy) ingressClassifierFlows() []binding.Flow {
 			Done(),
 	}
 	if f.enableAntreaPolicy && f.proxyAll {
-		// This generates the flow to match the NodePort Service packets and forward them to AntreaPolicyIngressRuleTable.
-		// Policies applied on NodePort Service will be enforced in AntreaPolicyIngressRuleTable.
+		// This generates the flow to match the NodePort Service packets and forward them to AntreaPolicyIngressAuditTable.
+		// Policies applied on NodePort Service will be audited in AntreaPolicyIngressAuditTable and enforced in
+		// AntreaPolicyIngressRuleTable.
 		flows = append(flows, IngressSecurityClassifierTable.ofTable.BuildFlow(priorityNormal+1).
 			Cookie(cookieID).
 			MatchRegMark(ToNodePortAddressRegMark).
-			Action().GotoTable(AntreaPolicyIngressRuleTable.GetID()).
+			Action().GotoTable(AntreaPolicyIngressAuditTable.GetID()).
 			Done())
 	}
 	return flows
@@ -39,7 +39,8 @@ func pipelineDefaultFlows(egressTrafficShapingEnabled, externalNodeEnabled, isEn
 			"cookie=0x1000000000000, table=PipelineRootClassifier, priority=0 actions=drop",
 			"cookie=0x1000000000000, table=ConntrackZone, priority=0 actions=goto_table:ConntrackState",
 			"cookie=0x1000000000000, table=ConntrackState, priority=0 actions=goto_table:EgressSecurityClassifier",
-			"cookie=0x1000000000000, table=EgressSecurityClassifier, priority=0 actions=goto_table:AntreaPolicyEgressRule",
+			"cookie=0x1000000000000, table=EgressSecurityClassifier, priority=0 actions=goto_table:AntreaPolicyEgressAudit",
+			"cookie=0x1000000000000, table=AntreaPolicyEgressAudit, priority=0 actions=goto_table:AntreaPolicyEgressRule",
 			"cookie=0x1000000000000, table=AntreaPolicyEgressRule, priority=0 actions=goto_table:EgressRule",
 			"cookie=0x1000000000000, table=EgressRule, priority=0 actions=goto_table:EgressDefaultRule",
 			"cookie=0x1000000000000, table=EgressDefaultRule, priority=0 actions=goto_table:EgressMetric",
@@ -47,7 +48,8 @@ func pipelineDefaultFlows(egressTrafficShapingEnabled, externalNodeEnabled, isEn
 			"cookie=0x1000000000000, table=L3Forwarding, priority=0 actions=goto_table:EgressMark",
 			"cookie=0x1000000000000, table=EgressMark, priority=0 actions=goto_table:L2ForwardingCalc",
 			"cookie=0x1000000000000, table=L2ForwardingCalc, priority=0 actions=goto_table:IngressSecurityClassifier",
-			"cookie=0x1000000000000, table=IngressSecurityClassifier, priority=0 actions=goto_table:AntreaPolicyIngressRule",
+			"cookie=0x1000000000000, table=IngressSecurityClassifier, priority=0 actions=goto_table:AntreaPolicyIngressAudit",
+			"cookie=0x1000000000000, table=AntreaPolicyIngressAudit, priority=0 actions=goto_table:AntreaPolicyIngressRule",
 			"cookie=0x1000000000000, table=AntreaPolicyIngressRule, priority=0 actions=goto_table:IngressRule",
 			"cookie=0x1000000000000, table=IngressRule, priority=0 actions=goto_table:IngressDefaultRule",
 			"cookie=0x1000000000000, table=IngressDefaultRule, priority=0 actions=goto_table:IngressMetric",
@@ -70,7 +72,8 @@ func pipelineDefaultFlows(egressTrafficShapingEnabled, externalNodeEnabled, isEn
 			"cookie=0x1000000000000, table=PreRoutingClassifier, priority=0 actions=goto_table:SessionAffinity",
 			"cookie=0x1000000000000, table=SessionAffinity, priority=0 actions=goto_table:ServiceLB",
 			"cookie=0x1000000000000, table=ServiceLB, priority=0 actions=goto_table:EndpointDNAT",
-			"cookie=0x1000000000000, table=EndpointDNAT, priority=0 actions=goto_table:AntreaPolicyEgressRule",
+			"cookie=0x1000000000000, table=EndpointDNAT, priority=0 actions=goto_table:AntreaPolicyEgressAudit",
+			"cookie=0x1000000000000, table=AntreaPolicyEgressAudit, priority=0 actions=goto_table:AntreaPolicyEgressRule",
 			"cookie=0x1000000000000, table=AntreaPolicyEgressRule, priority=0 actions=goto_table:EgressRule",
 			"cookie=0x1000000000000, table=EgressRule, priority=0 actions=goto_table:EgressDefaultRule",
 			"cookie=0x1000000000000, table=EgressDefaultRule, priority=0 actions=goto_table:EgressMetric",
@@ -81,7 +84,8 @@ func pipelineDefaultFlows(egressTrafficShapingEnabled, externalNodeEnabled, isEn
 			"cookie=0x1000000000000, table=SNAT, priority=0 actions=goto_table:L2ForwardingCalc",
 			"cookie=0x1000000000000, table=L2ForwardingCalc, priority=0 actions=goto_table:TrafficControl",
 			"cookie=0x1000000000000, table=TrafficControl, priority=0 actions=goto_table:IngressSecurityClassifier",
-			"cookie=0x1000000000000, table=IngressSecurityClassifier, priority=0 actions=goto_table:AntreaPolicyIngressRule",
+			"cookie=0x1000000000000, table=IngressSecurityClassifier, priority=0 actions=goto_table:AntreaPolicyIngressAudit",
+			"cookie=0x1000000000000, table=AntreaPolicyIngressAudit, priority=0 actions=goto_table:AntreaPolicyIngressRule",
 			"cookie=0x1000000000000, table=AntreaPolicyIngressRule, priority=0 actions=goto_table:IngressRule",
 			"cookie=0x1000000000000, table=IngressRule, priority=0 actions=goto_table:IngressDefaultRule",
 			"cookie=0x1000000000000, table=IngressDefaultRule, priority=0 actions=goto_table:IngressMetric",
@@ -139,7 +143,8 @@ func pipelineDefaultFlows(egressTrafficShapingEnabled, externalNodeEnabled, isEn
 			"cookie=0x1000000000000, table=PreRoutingClassifier, priority=0 actions=goto_table:SessionAffinity",
 			"cookie=0x1000000000000, table=SessionAffinity, priority=0 actions=goto_table:ServiceLB",
 			"cookie=0x1000000000000, table=ServiceLB, priority=0 actions=goto_table:EndpointDNAT",
-			"cookie=0x1000000000000, table=EndpointDNAT, priority=0 actions=goto_table:AntreaPolicyEgressRule",
+			"cookie=0x1000000000000, table=EndpointDNAT, priority=0 actions=goto_table:AntreaPolicyEgressAudit",
+			"cookie=0x1000000000000, table=AntreaPolicyEgressAudit, priority=0 actions=goto_table:AntreaPolicyEgressRule",
 			"cookie=0x1000000000000, table=AntreaPolicyEgressRule, priority=0 actions=goto_table:EgressRule",
 			"cookie=0x1000000000000, table=EgressRule, priority=0 actions=goto_table:EgressDefaultRule",
 			"cookie=0x1000000000000, table=EgressDefaultRule, priority=0 actions=goto_table:EgressMetric",
@@ -151,7 +156,8 @@ func pipelineDefaultFlows(egressTrafficShapingEnabled, externalNodeEnabled, isEn
 			"cookie=0x1000000000000, table=SNAT, priority=0 actions=goto_table:L2ForwardingCalc",
 			"cookie=0x1000000000000, table=L2ForwardingCalc, priority=0 actions=goto_table:TrafficControl",
 			"cookie=0x1000000000000, table=TrafficControl, priority=0 actions=goto_table:IngressSecurityClassifier",
-			"cookie=0x1000000000000, table=IngressSecurityClassifier, priority=0 actions=goto_table:AntreaPolicyIngressRule",
+			"cookie=0x1000000000000, table=IngressSecurityClassifier, priority=0 actions=goto_table:AntreaPolicyIngressAudit",
+			"cookie=0x1000000000000, table=AntreaPolicyIngressAudit, priority=0 actions=goto_table:AntreaPolicyIngressRule",
 			"cookie=0x1000000000000, table=AntreaPolicyIngressRule, priority=0 actions=goto_table:IngressRule",
 			"cookie=0x1000000000000, table=IngressRule, priority=0 actions=goto_table:IngressDefaultRule",
 			"cookie=0x1000000000000, table=IngressDefaultRule, priority=0 actions=goto_table:IngressMetric",
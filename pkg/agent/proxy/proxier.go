@@ -1332,6 +1332,7 @@ func (p *proxier) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 		isIPv6,
 		ethernetPkt,
 		proto,
+		"",
 		nil)
 }
 
@@ -99,20 +99,23 @@ type NodePolicyRule struct {
 
 // PolicyRule groups configurations to set up conjunctive match for egress/ingress policy rules.
 type PolicyRule struct {
-	Direction     v1beta2.Direction
-	From          []Address
-	To            []Address
-	Service       []v1beta2.Service
-	L7Protocols   []v1beta2.L7Protocol
-	L7RuleVlanID  *uint32
-	Action        *secv1beta1.RuleAction
-	Priority      *uint16
-	Name          string
-	FlowID        uint32
-	TableID       uint8
-	PolicyRef     *v1beta2.NetworkPolicyReference
-	EnableLogging bool
-	LogLabel      string
+	Direction      v1beta2.Direction
+	From           []Address
+	To             []Address
+	Service        []v1beta2.Service
+	L7Protocols    []v1beta2.L7Protocol
+	L7RuleVlanID   *uint32
+	Action         *secv1beta1.RuleAction
+	Priority       *uint16
+	Name           string
+	FlowID         uint32
+	TableID        uint8
+	PolicyRef      *v1beta2.NetworkPolicyReference
+	EnableLogging  bool
+	LogLabel       string
+	Audit          bool
+	RateLimit      *v1beta2.RuleRateLimit
+	RejectResponse secv1beta1.RejectResponseType
 }
 
 // IsAntreaNetworkPolicyRule returns if a PolicyRule is created for Antrea NetworkPolicy types.
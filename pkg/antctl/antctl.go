@@ -25,6 +25,7 @@ import (
 	"antrea.io/antrea/pkg/antctl/raw/multicluster"
 	"antrea.io/antrea/pkg/antctl/raw/packetcapture"
 	"antrea.io/antrea/pkg/antctl/raw/proxy"
+	"antrea.io/antrea/pkg/antctl/raw/quarantine"
 	"antrea.io/antrea/pkg/antctl/raw/set"
 	"antrea.io/antrea/pkg/antctl/raw/supportbundle"
 	"antrea.io/antrea/pkg/antctl/raw/traceflow"
@@ -217,9 +218,9 @@ $ antctl get podmulticaststats pod -n namespace`,
 						},
 						{
 							name:            "type",
-							usage:           "Get NetworkPolicies with specific type. Type refers to the type of its source NetworkPolicy: K8sNP, ACNP, ANNP, BANP or ANP",
+							usage:           "Get NetworkPolicies with specific type. Type refers to the type of its source NetworkPolicy: K8sNP, ACNP, ANNP, BANP, ANP or NSProfile",
 							shorthand:       "T",
-							supportedValues: []string{"K8sNP", "ACNP", "ANNP", "BANP", "ANP"},
+							supportedValues: []string{"K8sNP", "ACNP", "ANNP", "BANP", "ANP", "NSProfile"},
 						},
 					}, getSortByFlag()),
 					outputType: multiple,
@@ -406,8 +407,8 @@ $ antctl get podmulticaststats pod -n namespace`,
 						},
 						{
 							name:            "type",
-							usage:           "NetworkPolicy type. Valid types are K8sNP, ACNP, ANNP, BANP or ANP.",
-							supportedValues: []string{"K8sNP", "ACNP", "ANNP", "BANP", "ANP"},
+							usage:           "NetworkPolicy type. Valid types are K8sNP, ACNP, ANNP, BANP, ANP or NSProfile.",
+							supportedValues: []string{"K8sNP", "ACNP", "ANNP", "BANP", "ANP", "NSProfile"},
 						},
 						{
 							name:      "table",
@@ -519,9 +520,13 @@ $ antctl get podmulticaststats pod -n namespace`,
 			use:     "networkpolicyevaluation",
 			aliases: []string{"networkpoliciesevaluation", "networkpolicyeval", "networkpolicieseval", "netpoleval"},
 			short:   "Analyze effective NetworkPolicy rules.",
-			long:    "Analyze network policies in the cluster and return the rule expected to be effective on the source and destination endpoints provided.",
+			long:    "Analyze network policies in the cluster and return the rule expected to be effective on the source and destination endpoints provided, or the connections which would be denied by a candidate policy.",
 			example: `  Query effective NetworkPolicy rule between two Pods
   $ antctl query networkpolicyevaluation -S ns1/pod1 -D ns2/pod2
+  Query connections between Pods which would be denied by a candidate NetworkPolicy
+  $ antctl query networkpolicyevaluation -c policy.yaml
+  Query connections to Pods in Namespace ns2 which would be denied by a candidate NetworkPolicy
+  $ antctl query networkpolicyevaluation -c policy.yaml -D ns2/
 `,
 			commandGroup: query,
 			controllerEndpoint: &endpoint{
@@ -538,6 +543,11 @@ $ antctl get podmulticaststats pod -n namespace`,
 							usage:     "Destination endpoint, specified by <Namespace>/<name>.",
 							shorthand: "D",
 						},
+						{
+							name:      "candidate",
+							usage:     "Path to the manifest of a candidate Antrea ClusterNetworkPolicy, Antrea NetworkPolicy or K8s NetworkPolicy. When set, the connections between Pods which would be denied by the candidate policy are returned, and source and destination are optional filters which can be specified by <Namespace>/ to match all Pods in a Namespace.",
+							shorthand: "c",
+						},
 					},
 					parameterTransform: networkpolicy.NewNetworkPolicyEvaluation,
 					restMethod:         restPost,
@@ -546,6 +556,90 @@ $ antctl get podmulticaststats pod -n namespace`,
 			},
 			transformedResponse: reflect.TypeOf(networkpolicy.EvaluationResponse{}),
 		},
+		{
+			use:     "connectivity-matrix",
+			aliases: []string{"connectivitymatrix", "connmatrix"},
+			short:   "Analyze effective NetworkPolicy rules between multiple Pods.",
+			long:    "Analyze network policies in the cluster and return the effective action, along with the effective rule, for the connections from each selected source Pod to each selected destination Pod on the provided protocol and port. Pods are selected by Namespace, Namespace label selector and Pod label selector, and all Pods are selected if no selector is provided.",
+			example: `  Query the effective actions between all Pods in Namespace ns1 for TCP port 80
+  $ antctl query connectivity-matrix --source-namespace ns1 --destination-namespace ns1 --port 80
+  Query the effective actions from Pods with label app=client in all Namespaces to Pods with label app=db in Namespace ns2 for TCP port 5432
+  $ antctl query connectivity-matrix --source-selector app=client --destination-namespace ns2 --destination-selector app=db --port 5432
+  Query the effective actions from Pods in Namespaces with label env=prod to all Pods for UDP port 53
+  $ antctl query connectivity-matrix --source-namespace-selector env=prod --protocol UDP --port 53
+`,
+			commandGroup: query,
+			controllerEndpoint: &endpoint{
+				resourceEndpoint: &resourceEndpoint{
+					groupVersionResource: &cpv1beta.ConnectivityMatrixVersionResource,
+					params: []flagInfo{
+						{
+							name:  "source-namespace",
+							usage: "Namespace of the source Pods.",
+						},
+						{
+							name:  "source-namespace-selector",
+							usage: "Label selector of the Namespaces of the source Pods, cannot be used with source-namespace.",
+						},
+						{
+							name:  "source-selector",
+							usage: "Label selector of the source Pods.",
+						},
+						{
+							name:  "destination-namespace",
+							usage: "Namespace of the destination Pods.",
+						},
+						{
+							name:  "destination-namespace-selector",
+							usage: "Label selector of the Namespaces of the destination Pods, cannot be used with destination-namespace.",
+						},
+						{
+							name:  "destination-selector",
+							usage: "Label selector of the destination Pods.",
+						},
+						{
+							name:  "protocol",
+							usage: "Protocol of the connections, one of TCP, UDP and SCTP. Defaults to TCP.",
+						},
+						{
+							name:  "port",
+							usage: "Destination port of the connections.",
+						},
+					},
+					parameterTransform: networkpolicy.NewConnectivityMatrix,
+					restMethod:         restPost,
+				},
+				addonTransform: networkpolicy.ConnectivityMatrixTransform,
+			},
+			transformedResponse: reflect.TypeOf(networkpolicy.ConnectivityMatrixEntryResponse{}),
+		},
+		{
+			use:     "policies",
+			aliases: []string{"policy", "networkpolicies", "netpol"},
+			short:   "Analyze NetworkPolicy rules to find the ones that never take effect.",
+			long:    "Analyze the NetworkPolicy rules in the cluster, and report the rules which are fully shadowed by a higher precedence rule with a different action, the redundant rules which are fully matched by a higher precedence rule with the same action, and the Pass rules which don't fall through to any K8s NetworkPolicy or Baseline Tier rule. When a zero-hit window is provided, the rules which haven't matched any traffic during the window, according to the NetworkPolicyStats, are reported as well.",
+			example: `  Analyze all NetworkPolicy rules
+  $ antctl analyze policies
+  Analyze all NetworkPolicy rules, and report the rules which haven't matched any traffic in the last 7 days
+  $ antctl analyze policies --zero-hit-window 168h
+`,
+			commandGroup: analyze,
+			controllerEndpoint: &endpoint{
+				resourceEndpoint: &resourceEndpoint{
+					groupVersionResource: &cpv1beta.PolicyAnalysisVersionResource,
+					params: []flagInfo{
+						{
+							name:  "zero-hit-window",
+							usage: "Report the rules which haven't matched any traffic during this duration (e.g. 24h). It requires the NetworkPolicyStats feature.",
+						},
+					},
+					parameterTransform: networkpolicy.NewPolicyAnalysis,
+					restMethod:         restPost,
+				},
+				addonTransform: networkpolicy.PolicyAnalysisTransform,
+			},
+			transformedResponse: reflect.TypeOf(networkpolicy.PolicyAnalysisFindingResponse{}),
+		},
 		{
 			use:   "flowrecords",
 			short: "Print the matching flow records in the flow aggregator",
@@ -600,6 +694,37 @@ $ antctl get podmulticaststats pod -n namespace`,
 			},
 			transformedResponse: reflect.TypeOf(aggregatorapis.RecordMetricsResponse{}),
 		},
+		{
+			use:     "policyrecommendations",
+			aliases: []string{"policyrecommendation", "prec"},
+			short:   "Print the policies recommended by the flow aggregator",
+			long:    "Print the Antrea NetworkPolicies which are recommended by the flow aggregator to allow the connections observed in the flow records. Optionally, a ClusterNetworkPolicy denying all other connections can be recommended for each Namespace. The policies can be applied with kubectl when they are output in yaml or json format.",
+			example: `  Get the policies recommended for all Namespaces in yaml format
+  $ antctl get policyrecommendations -o yaml
+  Get the policies recommended for Namespace ns1, including a default-deny policy, and apply them
+  $ antctl get policyrecommendations -n ns1 --default-deny -o yaml > ns1-policies.yaml
+  $ kubectl apply -f ns1-policies.yaml`,
+			commandGroup: get,
+			flowAggregatorEndpoint: &endpoint{
+				nonResourceEndpoint: &nonResourceEndpoint{
+					path: "/policyrecommendations",
+					params: []flagInfo{
+						{
+							name:      "namespace",
+							usage:     "Only get the policies recommended for Pods in the provided Namespace.",
+							shorthand: "n",
+						},
+						{
+							name:   "default-deny",
+							usage:  "Also get a ClusterNetworkPolicy for each Namespace, which denies all connections not allowed by the recommended NetworkPolicies except DNS queries.",
+							isBool: true,
+						},
+					},
+					outputType: single,
+				},
+			},
+			transformedResponse: reflect.TypeOf(aggregatorapis.PolicyRecommendationsResponse{}),
+		},
 		{
 			use:          "serviceexternalip",
 			short:        "Print Service external IP status",
@@ -782,6 +907,16 @@ $ antctl get podmulticaststats pod -n namespace`,
 			supportAgent:      true,
 			supportController: true,
 		},
+		{
+			cobraCommand:      quarantine.QuarantineCmd,
+			supportAgent:      false,
+			supportController: false,
+		},
+		{
+			cobraCommand:      quarantine.ReleaseCmd,
+			supportAgent:      false,
+			supportController: false,
+		},
 		{
 			cobraCommand:      proxy.Command,
 			supportAgent:      false,
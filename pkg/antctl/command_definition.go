@@ -71,6 +71,7 @@ const (
 	mc
 	upgrade
 	check
+	analyze
 )
 
 var groupCommands = map[commandGroup]*cobra.Command{
@@ -98,6 +99,11 @@ var groupCommands = map[commandGroup]*cobra.Command{
 		Use:   "check",
 		Short: "Performs pre and post installation checks",
 	},
+	analyze: {
+		Use:   "analyze",
+		Short: "Analyze the configuration of a topic",
+		Long:  "Analyze the configuration of a topic",
+	},
 }
 
 type endpointResponder interface {
@@ -456,6 +462,8 @@ func (cd *commandDefinition) output(resp io.Reader, writer io.Writer, ft formatt
 				return output.TableOutputForQueryEndpoint(obj, writer)
 			}
 			return output.TableOutputForGetCommands(obj, writer)
+		case analyze:
+			return output.TableOutputForGetCommands(obj, writer)
 		default:
 			return output.TableOutput(obj, writer)
 		}
@@ -586,7 +594,7 @@ func (cd *commandDefinition) applyFlagsToCommand(cmd *cobra.Command) {
 	switch cd.commandGroup {
 	case get:
 		cmd.Flags().StringP("output", "o", "table", "output format: json|table|yaml|raw")
-	case query:
+	case query, analyze:
 		cmd.Flags().StringP("output", "o", "table", "output format: json|table|yaml|raw")
 	default:
 		cmd.Flags().StringP("output", "o", "yaml", "output format: json|table|yaml|raw")
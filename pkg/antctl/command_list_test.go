@@ -74,7 +74,7 @@ func TestGetDebugCommands(t *testing.T) {
 		{
 			name:     "Antctl running against flow-aggregator mode",
 			mode:     "flowaggregator",
-			expected: [][]string{{"version"}, {"log-level"}, {"get", "flowrecords"}, {"get", "recordmetrics"}},
+			expected: [][]string{{"version"}, {"log-level"}, {"get", "flowrecords"}, {"get", "recordmetrics"}, {"get", "policyrecommendations"}},
 		},
 	}
 	for _, tt := range tc {
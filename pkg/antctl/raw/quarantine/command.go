@@ -0,0 +1,173 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quarantine
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"antrea.io/antrea/pkg/antctl/raw"
+	cpv1beta2 "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
+)
+
+const requestTimeout = 10 * time.Second
+
+var (
+	QuarantineCmd = &cobra.Command{
+		Use:   "quarantine",
+		Short: "Isolate a workload from the network",
+	}
+	ReleaseCmd = &cobra.Command{
+		Use:   "release",
+		Short: "Release a quarantined workload",
+	}
+
+	options = &struct {
+		allowedNamespaces []string
+		allowedCIDRs      []string
+		capturePackets    int32
+		captureTimeout    time.Duration
+	}{}
+	getClient = getAntreaClient
+)
+
+func init() {
+	quarantinePodCmd := &cobra.Command{
+		Use:   "pod NAMESPACE/NAME",
+		Short: "Quarantine a Pod",
+		Long: "Quarantine a Pod by enforcing an Antrea ClusterNetworkPolicy in the Emergency Tier, which drops all traffic of the Pod " +
+			"except the traffic with the allowed Namespaces and CIDRs. The Pod is labelled with antrea.io/quarantine, and a PacketCapture " +
+			"of the traffic of the Pod can be started at the same time.",
+		Example: `  Quarantine Pod pod1 in Namespace ns1
+  $ antctl quarantine pod ns1/pod1
+  Quarantine Pod pod1 in Namespace ns1, while allowing traffic with Pods in Namespace forensics and with 10.10.0.5
+  $ antctl quarantine pod ns1/pod1 --allow-namespace forensics --allow-cidr 10.10.0.5/32
+  Quarantine Pod pod1 in Namespace ns1 and capture its first 1000 packets
+  $ antctl quarantine pod ns1/pod1 --capture-packets 1000 --capture-timeout 5m
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: quarantinePodRunE,
+	}
+	quarantinePodCmd.Flags().StringSliceVar(&options.allowedNamespaces, "allow-namespace", nil, "Namespaces of the Pods which can still connect to and be reached from the quarantined Pod")
+	quarantinePodCmd.Flags().StringSliceVar(&options.allowedCIDRs, "allow-cidr", nil, "CIDRs which can still connect to and be reached from the quarantined Pod")
+	quarantinePodCmd.Flags().Int32Var(&options.capturePackets, "capture-packets", 0, "if positive, start a PacketCapture of this number of packets sent or received by the quarantined Pod")
+	quarantinePodCmd.Flags().DurationVar(&options.captureTimeout, "capture-timeout", 0, "timeout of the PacketCapture, cannot be longer than 5m, defaults to the PacketCapture default timeout")
+	QuarantineCmd.AddCommand(quarantinePodCmd)
+
+	releasePodCmd := &cobra.Command{
+		Use:   "pod NAMESPACE/NAME",
+		Short: "Release a quarantined Pod",
+		Long: "Release a Pod quarantined by \"antctl quarantine pod\", by deleting the Antrea ClusterNetworkPolicy isolating the Pod and removing " +
+			"the antrea.io/quarantine label from the Pod. The PacketCapture started for the Pod, if any, is not deleted so that its results remain available.",
+		Example: `  Release Pod pod1 in Namespace ns1
+  $ antctl release pod ns1/pod1
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: releasePodRunE,
+	}
+	ReleaseCmd.AddCommand(releasePodCmd)
+}
+
+func getAntreaClient(cmd *cobra.Command) (antrea.Interface, error) {
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return nil, err
+	}
+	_, client, err := raw.SetupClients(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+	return client, nil
+}
+
+// parsePod parses a Pod specified by <Namespace>/<name>, or by <name> for a Pod in the default Namespace.
+func parsePod(pod string) (string, string, error) {
+	split := strings.Split(pod, "/")
+	switch {
+	case len(split) == 1 && split[0] != "":
+		return "default", split[0], nil
+	case len(split) == 2 && split[0] != "" && split[1] != "":
+		return split[0], split[1], nil
+	}
+	return "", "", fmt.Errorf("invalid Pod %q, must be specified by <Namespace>/<name>", pod)
+}
+
+func quarantinePodRunE(cmd *cobra.Command, args []string) error {
+	client, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	return quarantinePod(cmd.Context(), cmd.OutOrStdout(), client, args[0])
+}
+
+func quarantinePod(ctx context.Context, out io.Writer, client antrea.Interface, pod string) error {
+	namespace, name, err := parsePod(pod)
+	if err != nil {
+		return err
+	}
+	quarantine := &cpv1beta2.PodQuarantine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: cpv1beta2.PodQuarantineSpec{
+			AllowedNamespaces: options.allowedNamespaces,
+			AllowedCIDRs:      options.allowedCIDRs,
+		},
+	}
+	if options.capturePackets > 0 {
+		quarantine.Spec.PacketCapture = &cpv1beta2.PodQuarantinePacketCapture{
+			Number:  options.capturePackets,
+			Timeout: int32(options.captureTimeout.Seconds()),
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	result, err := client.ControlplaneV1beta2().PodQuarantines(namespace).Create(ctx, quarantine, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error when quarantining Pod %s/%s: %w", namespace, name, err)
+	}
+	fmt.Fprintf(out, "Pod %s/%s quarantined by ClusterNetworkPolicy %s\n", namespace, name, result.Status.PolicyName)
+	if result.Status.PacketCaptureName != "" {
+		fmt.Fprintf(out, "PacketCapture Name: %s\n", result.Status.PacketCaptureName)
+	}
+	return nil
+}
+
+func releasePodRunE(cmd *cobra.Command, args []string) error {
+	client, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	return releasePod(cmd.Context(), cmd.OutOrStdout(), client, args[0])
+}
+
+func releasePod(ctx context.Context, out io.Writer, client antrea.Interface, pod string) error {
+	namespace, name, err := parsePod(pod)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	if err := client.ControlplaneV1beta2().PodQuarantines(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+		return fmt.Errorf("error when releasing Pod %s/%s: %w", namespace, name, err)
+	}
+	fmt.Fprintf(out, "Pod %s/%s released\n", namespace, name)
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quarantine
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	cpv1beta2 "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	antreafakeclient "antrea.io/antrea/pkg/client/clientset/versioned/fake"
+)
+
+func TestParsePod(t *testing.T) {
+	tests := []struct {
+		pod               string
+		expectedNamespace string
+		expectedName      string
+		expectedErr       string
+	}{
+		{pod: "ns1/pod1", expectedNamespace: "ns1", expectedName: "pod1"},
+		{pod: "pod1", expectedNamespace: "default", expectedName: "pod1"},
+		{pod: "ns1/", expectedErr: `invalid Pod "ns1/", must be specified by <Namespace>/<name>`},
+		{pod: "ns1/pod1/foo", expectedErr: `invalid Pod "ns1/pod1/foo", must be specified by <Namespace>/<name>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pod, func(t *testing.T) {
+			namespace, name, err := parsePod(tt.pod)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedNamespace, namespace)
+			assert.Equal(t, tt.expectedName, name)
+		})
+	}
+}
+
+func TestQuarantinePod(t *testing.T) {
+	tests := []struct {
+		name              string
+		allowedNamespaces []string
+		capturePackets    int32
+		captureTimeout    time.Duration
+		createErr         error
+		expectedSpec      cpv1beta2.PodQuarantineSpec
+		expectedOutput    string
+		expectedErr       string
+	}{
+		{
+			name:              "without PacketCapture",
+			allowedNamespaces: []string{"forensics"},
+			expectedSpec:      cpv1beta2.PodQuarantineSpec{AllowedNamespaces: []string{"forensics"}},
+			expectedOutput:    "Pod ns1/pod1 quarantined by ClusterNetworkPolicy quarantine-uid1\n",
+		},
+		{
+			name:           "with PacketCapture",
+			capturePackets: 100,
+			captureTimeout: time.Minute,
+			expectedSpec: cpv1beta2.PodQuarantineSpec{
+				PacketCapture: &cpv1beta2.PodQuarantinePacketCapture{Number: 100, Timeout: 60},
+			},
+			expectedOutput: "Pod ns1/pod1 quarantined by ClusterNetworkPolicy quarantine-uid1\nPacketCapture Name: quarantine-uid1\n",
+		},
+		{
+			name:        "create error",
+			createErr:   fmt.Errorf("the Pod is already quarantined"),
+			expectedErr: "error when quarantining Pod ns1/pod1: the Pod is already quarantined",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options.allowedNamespaces = tt.allowedNamespaces
+			options.capturePackets = tt.capturePackets
+			options.captureTimeout = tt.captureTimeout
+			defer func() {
+				options.allowedNamespaces = nil
+				options.capturePackets = 0
+				options.captureTimeout = 0
+			}()
+			client := antreafakeclient.NewSimpleClientset()
+			var actualSpec cpv1beta2.PodQuarantineSpec
+			client.PrependReactor("create", "podquarantines", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if tt.createErr != nil {
+					return true, nil, tt.createErr
+				}
+				quarantine := action.(k8stesting.CreateAction).GetObject().(*cpv1beta2.PodQuarantine).DeepCopy()
+				actualSpec = quarantine.Spec
+				quarantine.Status.PolicyName = "quarantine-uid1"
+				if quarantine.Spec.PacketCapture != nil {
+					quarantine.Status.PacketCaptureName = "quarantine-uid1"
+				}
+				return true, quarantine, nil
+			})
+			var out bytes.Buffer
+			err := quarantinePod(context.Background(), &out, client, "ns1/pod1")
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSpec, actualSpec)
+			assert.Equal(t, tt.expectedOutput, out.String())
+		})
+	}
+}
+
+func TestReleasePod(t *testing.T) {
+	client := antreafakeclient.NewSimpleClientset()
+	var deleted string
+	client.PrependReactor("delete", "podquarantines", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		deleteAction := action.(k8stesting.DeleteAction)
+		deleted = deleteAction.GetNamespace() + "/" + deleteAction.GetName()
+		return true, nil, nil
+	})
+	var out bytes.Buffer
+	require.NoError(t, releasePod(context.Background(), &out, client, "ns1/pod1"))
+	assert.Equal(t, "ns1/pod1", deleted)
+	assert.Equal(t, "Pod ns1/pod1 released\n", out.String())
+}
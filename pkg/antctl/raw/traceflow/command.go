@@ -61,6 +61,7 @@ var protocols = map[string]int32{
 	"icmp": 1,
 	"tcp":  6,
 	"udp":  17,
+	"sctp": 132,
 }
 
 type CapturedPacket struct {
@@ -97,6 +98,8 @@ func init() {
   $antctl traceflow -S pod1 -D ns1/svc1 -f tcp,tcp_dst=80
   Start a Traceflow from pod1 to pod2, with a UDP packet to destination port 1234
   $antctl traceflow -S pod1 -D pod2 -f udp,udp_dst=1234
+  Start a Traceflow from pod1 to pod2, with an SCTP packet to destination port 36412
+  $antctl traceflow -S pod1 -D pod2 -f sctp,sctp_dst=36412
   Start a Traceflow for live TCP traffic from pod1 to svc1, with 1 minute timeout
   $antctl traceflow -S pod1 -D svc1 -f tcp --live-traffic -t 1m
   Start a Traceflow to capture the first dropped TCP packet to pod1 on port 80, within 10 minutes
@@ -109,7 +112,7 @@ func init() {
 	Command.Flags().StringVarP(&option.source, "source", "S", "", "source of the Traceflow: Namespace/Pod, Pod, or IP")
 	Command.Flags().StringVarP(&option.destination, "destination", "D", "", "destination of the Traceflow: Namespace/Pod, Pod, Namespace/Service, Service or IP")
 	Command.Flags().StringVarP(&option.outputType, "output", "o", "yaml", "output type: yaml (default), json")
-	Command.Flags().StringVarP(&option.flow, "flow", "f", "", "specify the flow (packet headers) of the Traceflow packet, including tcp_src, tcp_dst, tcp_flags, udp_src, udp_dst, ipv6")
+	Command.Flags().StringVarP(&option.flow, "flow", "f", "", "specify the flow (packet headers) of the Traceflow packet, including tcp_src, tcp_dst, tcp_flags, udp_src, udp_dst, sctp_src, sctp_dst, ipv6")
 	Command.Flags().BoolVarP(&option.liveTraffic, "live-traffic", "L", false, "if set, the Traceflow will trace the first packet of the matched live traffic flow")
 	Command.Flags().BoolVarP(&option.droppedOnly, "dropped-only", "", false, "if set, capture only the dropped packet in a live-traffic Traceflow")
 	Command.Flags().BoolVarP(&option.nowait, "nowait", "", false, "if set, command returns without retrieving results")
@@ -368,6 +371,16 @@ func parseFlow() (*v1beta1.Packet, error) {
 		}
 		pkt.TransportHeader.UDP.DstPort = int32(r)
 	}
+	if r, ok := fields["sctp_src"]; ok {
+		pkt.TransportHeader.SCTP = new(v1beta1.SCTPHeader)
+		pkt.TransportHeader.SCTP.SrcPort = int32(r)
+	}
+	if r, ok := fields["sctp_dst"]; ok {
+		if pkt.TransportHeader.SCTP == nil {
+			pkt.TransportHeader.SCTP = new(v1beta1.SCTPHeader)
+		}
+		pkt.TransportHeader.SCTP.DstPort = int32(r)
+	}
 
 	return &pkt, nil
 }
@@ -415,7 +428,7 @@ func output(tf *v1beta1.Traceflow, writer io.Writer) error {
 		if pkt.IPv6Header == nil {
 			r.CapturedPacket.IPHeader = pkt.IPHeader
 		}
-		if pkt.TransportHeader.TCP != nil || pkt.TransportHeader.UDP != nil || pkt.TransportHeader.ICMP != nil {
+		if pkt.TransportHeader.TCP != nil || pkt.TransportHeader.UDP != nil || pkt.TransportHeader.SCTP != nil || pkt.TransportHeader.ICMP != nil {
 			r.CapturedPacket.TransportHeader = &pkt.TransportHeader
 		}
 	}
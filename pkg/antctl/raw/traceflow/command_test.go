@@ -165,6 +165,25 @@ func TestParseFlow(t *testing.T) {
 				},
 			},
 		},
+		{
+			flow:    "sctp,sctp_src=1234,sctp_dst=36412",
+			success: true,
+			expected: &v1beta1.Traceflow{
+				Spec: v1beta1.TraceflowSpec{
+					Packet: v1beta1.Packet{
+						IPHeader: &v1beta1.IPHeader{
+							Protocol: 132,
+						},
+						TransportHeader: v1beta1.TransportHeader{
+							SCTP: &v1beta1.SCTPHeader{
+								SrcPort: 1234,
+								DstPort: 36412,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			flow:    "tcp,tcp_dst=4321,ipv6",
 			success: true,
@@ -16,9 +16,14 @@ package networkpolicy
 
 import (
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
 
 	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 )
@@ -40,6 +45,9 @@ func parsePeer(str string) (string, string) {
 // NewNetworkPolicyEvaluation creates a new NetworkPolicyEvaluation resource
 // request from the command-line arguments provided to antctl.
 func NewNetworkPolicyEvaluation(args map[string]string) (runtime.Object, error) {
+	if path, ok := args["candidate"]; ok {
+		return newCandidateNetworkPolicyEvaluation(path, args)
+	}
 	var ns1, pod1, ns2, pod2 string
 	if val, ok := args["source"]; ok {
 		ns1, pod1 = parsePeer(val)
@@ -57,3 +65,87 @@ func NewNetworkPolicyEvaluation(args map[string]string) (runtime.Object, error)
 		},
 	}, nil
 }
+
+// newCandidateNetworkPolicyEvaluation creates a new NetworkPolicyEvaluation resource
+// request for the candidate NetworkPolicy manifest at the provided path. The source
+// and destination arguments are optional filters, a Namespace without Pod name
+// (<Namespace>/) matches all Pods in the Namespace.
+func newCandidateNetworkPolicyEvaluation(path string, args map[string]string) (runtime.Object, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading candidate NetworkPolicy manifest: %w", err)
+	}
+	candidate, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing candidate NetworkPolicy manifest: %w", err)
+	}
+	request := &cpv1beta.NetworkPolicyEvaluationRequest{
+		CandidatePolicy: &runtime.RawExtension{Raw: candidate},
+	}
+	for _, filter := range []struct {
+		name   string
+		entity *cpv1beta.Entity
+	}{{"source", &request.Source}, {"destination", &request.Destination}} {
+		val, ok := args[filter.name]
+		if !ok {
+			continue
+		}
+		ns, pod := parsePeer(val)
+		if ns == "" && pod == "" {
+			return nil, fmt.Errorf("invalid %s filter for NetworkPolicyEvaluation request: %s", filter.name, val)
+		}
+		filter.entity.Pod = &cpv1beta.PodReference{Namespace: ns, Name: pod}
+	}
+	return &cpv1beta.NetworkPolicyEvaluation{Request: request}, nil
+}
+
+// NewConnectivityMatrix creates a new ConnectivityMatrix resource request from the
+// command-line arguments provided to antctl.
+func NewConnectivityMatrix(args map[string]string) (runtime.Object, error) {
+	val, ok := args["port"]
+	if !ok {
+		return nil, fmt.Errorf("missing port for ConnectivityMatrix request")
+	}
+	port, err := strconv.ParseInt(val, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port for ConnectivityMatrix request: %s", val)
+	}
+	request := &cpv1beta.ConnectivityMatrixRequest{
+		Protocol: cpv1beta.Protocol(strings.ToUpper(args["protocol"])),
+		Port:     int32(port),
+	}
+	for _, peer := range []struct {
+		name     string
+		selector *cpv1beta.EntitySelector
+	}{{"source", &request.Sources}, {"destination", &request.Destinations}} {
+		peer.selector.Namespace = args[peer.name+"-namespace"]
+		if val, ok := args[peer.name+"-namespace-selector"]; ok {
+			if peer.selector.NamespaceSelector, err = metav1.ParseToLabelSelector(val); err != nil {
+				return nil, fmt.Errorf("invalid %s Namespace selector for ConnectivityMatrix request: %w", peer.name, err)
+			}
+		}
+		if val, ok := args[peer.name+"-selector"]; ok {
+			if peer.selector.PodSelector, err = metav1.ParseToLabelSelector(val); err != nil {
+				return nil, fmt.Errorf("invalid %s Pod selector for ConnectivityMatrix request: %w", peer.name, err)
+			}
+		}
+	}
+	return &cpv1beta.ConnectivityMatrix{Request: request}, nil
+}
+
+// NewPolicyAnalysis creates a new PolicyAnalysis resource request from the command-line
+// arguments provided to antctl.
+func NewPolicyAnalysis(args map[string]string) (runtime.Object, error) {
+	request := &cpv1beta.PolicyAnalysisRequest{}
+	if val, ok := args["zero-hit-window"]; ok {
+		window, err := time.ParseDuration(val)
+		if err != nil || window < 0 {
+			return nil, fmt.Errorf("invalid zero-hit window for PolicyAnalysis request: %s", val)
+		}
+		if window > 0 && window < time.Second {
+			return nil, fmt.Errorf("zero-hit window for PolicyAnalysis request must be at least 1s: %s", val)
+		}
+		request.ZeroHitWindowSeconds = int64(window / time.Second)
+	}
+	return &cpv1beta.PolicyAnalysis{Request: request}, nil
+}
@@ -15,10 +15,13 @@
 package networkpolicy
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
@@ -78,3 +81,194 @@ func TestNewNetworkPolicyEvaluation(t *testing.T) {
 		})
 	}
 }
+
+func TestNewCandidateNetworkPolicyEvaluation(t *testing.T) {
+	manifest := `apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: np
+  namespace: ns
+spec:
+  podSelector: {}
+`
+	candidatePath := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(candidatePath, []byte(manifest), 0644))
+	candidatePolicy := &runtime.RawExtension{
+		Raw: []byte(`{"apiVersion":"networking.k8s.io/v1","kind":"NetworkPolicy","metadata":{"name":"np","namespace":"ns"},"spec":{"podSelector":{}}}`),
+	}
+
+	tests := []struct {
+		name           string
+		args           map[string]string
+		expectedObject runtime.Object
+		expectedError  string
+	}{
+		{
+			name: "Candidate without filter",
+			args: map[string]string{"candidate": candidatePath},
+			expectedObject: &cpv1beta.NetworkPolicyEvaluation{
+				Request: &cpv1beta.NetworkPolicyEvaluationRequest{CandidatePolicy: candidatePolicy},
+			},
+		},
+		{
+			name: "Candidate with filters",
+			args: map[string]string{"candidate": candidatePath, "source": "ns/pod1", "destination": "ns2/"},
+			expectedObject: &cpv1beta.NetworkPolicyEvaluation{
+				Request: &cpv1beta.NetworkPolicyEvaluationRequest{
+					Source:          cpv1beta.Entity{Pod: &cpv1beta.PodReference{Namespace: "ns", Name: "pod1"}},
+					Destination:     cpv1beta.Entity{Pod: &cpv1beta.PodReference{Namespace: "ns2"}},
+					CandidatePolicy: candidatePolicy,
+				},
+			},
+		},
+		{
+			name:          "Invalid filter",
+			args:          map[string]string{"candidate": candidatePath, "source": "ns/pod1/foo"},
+			expectedError: "invalid source filter for NetworkPolicyEvaluation request",
+		},
+		{
+			name:          "Missing manifest",
+			args:          map[string]string{"candidate": filepath.Join(t.TempDir(), "missing.yaml")},
+			expectedError: "error reading candidate NetworkPolicy manifest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotObject, err := NewNetworkPolicyEvaluation(tt.args)
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedObject, gotObject)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestNewConnectivityMatrix(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           map[string]string
+		expectedObject runtime.Object
+		expectedError  string
+	}{
+		{
+			name: "Namespaces and selectors",
+			args: map[string]string{
+				"source-namespace-selector": "env=prod",
+				"source-selector":           "app=client",
+				"destination-namespace":     "ns2",
+				"destination-selector":      "app in (db,cache)",
+				"protocol":                  "udp",
+				"port":                      "53",
+			},
+			expectedObject: &cpv1beta.ConnectivityMatrix{
+				Request: &cpv1beta.ConnectivityMatrixRequest{
+					Sources: cpv1beta.EntitySelector{
+						NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}, MatchExpressions: []metav1.LabelSelectorRequirement{}},
+						PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "client"}, MatchExpressions: []metav1.LabelSelectorRequirement{}},
+					},
+					Destinations: cpv1beta.EntitySelector{
+						Namespace: "ns2",
+						PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{}, MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"cache", "db"}},
+						}},
+					},
+					Protocol: cpv1beta.ProtocolUDP,
+					Port:     53,
+				},
+			},
+		},
+		{
+			name: "All Pods",
+			args: map[string]string{
+				"port": "80",
+			},
+			expectedObject: &cpv1beta.ConnectivityMatrix{
+				Request: &cpv1beta.ConnectivityMatrixRequest{Port: 80},
+			},
+		},
+		{
+			name:          "Missing port",
+			args:          map[string]string{"source-namespace": "ns1"},
+			expectedError: "missing port for ConnectivityMatrix request",
+		},
+		{
+			name:          "Invalid port",
+			args:          map[string]string{"port": "http"},
+			expectedError: "invalid port for ConnectivityMatrix request: http",
+		},
+		{
+			name: "Invalid selector",
+			args: map[string]string{
+				"destination-selector": "app in db",
+				"port":                 "80",
+			},
+			expectedError: "invalid destination Pod selector for ConnectivityMatrix request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotObject, err := NewConnectivityMatrix(tt.args)
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedObject, gotObject)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestNewPolicyAnalysis(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           map[string]string
+		expectedObject runtime.Object
+		expectedError  string
+	}{
+		{
+			name: "Zero-hit window",
+			args: map[string]string{"zero-hit-window": "24h"},
+			expectedObject: &cpv1beta.PolicyAnalysis{
+				Request: &cpv1beta.PolicyAnalysisRequest{ZeroHitWindowSeconds: 86400},
+			},
+		},
+		{
+			name: "No zero-hit window",
+			args: map[string]string{},
+			expectedObject: &cpv1beta.PolicyAnalysis{
+				Request: &cpv1beta.PolicyAnalysisRequest{},
+			},
+		},
+		{
+			name:          "Invalid zero-hit window",
+			args:          map[string]string{"zero-hit-window": "1d"},
+			expectedError: "invalid zero-hit window for PolicyAnalysis request: 1d",
+		},
+		{
+			name:          "Negative zero-hit window",
+			args:          map[string]string{"zero-hit-window": "-1h"},
+			expectedError: "invalid zero-hit window for PolicyAnalysis request: -1h",
+		},
+		{
+			name:          "Zero-hit window too short",
+			args:          map[string]string{"zero-hit-window": "100ms"},
+			expectedError: "zero-hit window for PolicyAnalysis request must be at least 1s: 100ms",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotObject, err := NewPolicyAnalysis(tt.args)
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedObject, gotObject)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
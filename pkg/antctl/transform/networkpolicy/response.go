@@ -176,9 +176,34 @@ func EvaluationTransform(reader io.Reader, _ bool, _ map[string]string) (interfa
 	if err := json.NewDecoder(reader).Decode(&eval); err != nil {
 		return nil, err
 	}
+	if eval.Request != nil && eval.Request.CandidatePolicy != nil {
+		// Output one row per connection which would be denied by the candidate NetworkPolicy.
+		connections := make([]DeniedConnectionResponse, 0)
+		if eval.Response != nil {
+			for i := range eval.Response.DeniedConnections {
+				connections = append(connections, DeniedConnectionResponse{&eval.Response.DeniedConnections[i]})
+			}
+		}
+		return connections, nil
+	}
 	return EvaluationResponse{&eval}, nil
 }
 
+// ruleActionToString returns the action of the rule referenced by a NetworkPolicyEvaluation response.
+func ruleActionToString(rule cpv1beta.RuleRef, ruleIndex int32) string {
+	if rule.Action != nil {
+		return string(*rule.Action)
+	} else if ruleIndex == math.MaxInt32 {
+		// Responses from endpoint query with original rules will always have
+		// valid action fields, except for the synthetic isolation rules,
+		// identified by a MaxInt32 rule index. "Isolate" corresponds to
+		// a drop action because of the default isolation model of K8s NPs.
+		return "Isolate"
+	}
+	// Should not be possible.
+	return "Unknown"
+}
+
 var _ common.TableOutput = new(EvaluationResponse)
 
 func (r EvaluationResponse) GetTableHeader() []string {
@@ -187,26 +212,13 @@ func (r EvaluationResponse) GetTableHeader() []string {
 
 func (r EvaluationResponse) GetTableRow(_ int) []string {
 	if r.NetworkPolicyEvaluation != nil && r.Response != nil {
-		action := ""
-		if r.Response.Rule.Action != nil {
-			action = string(*r.Response.Rule.Action)
-		} else if r.Response.RuleIndex == math.MaxInt32 {
-			// Responses from endpoint query with original rules will always have
-			// valid action fields, except for the synthetic isolation rules,
-			// identified by a MaxInt32 rule index. "Isolate" corresponds to
-			// a drop action because of the default isolation model of K8s NPs.
-			action = "Isolate"
-		} else {
-			// Should not be possible.
-			action = "Unknown"
-		}
 		return []string{
 			r.Response.NetworkPolicy.Name,
 			r.Response.NetworkPolicy.Namespace,
 			string(r.Response.NetworkPolicy.Type),
 			strconv.Itoa(int(r.Response.RuleIndex)),
 			string(r.Response.Rule.Direction),
-			action,
+			ruleActionToString(r.Response.Rule, r.Response.RuleIndex),
 		}
 	}
 	return make([]string, len(r.GetTableHeader()))
@@ -215,3 +227,138 @@ func (r EvaluationResponse) GetTableRow(_ int) []string {
 func (r EvaluationResponse) SortRows() bool {
 	return false
 }
+
+// DeniedConnectionResponse stores a connection which would be denied by the candidate
+// NetworkPolicy of a NetworkPolicyEvaluation command, and implements TableOutput.
+type DeniedConnectionResponse struct {
+	*cpv1beta.DeniedConnection
+}
+
+var _ common.TableOutput = new(DeniedConnectionResponse)
+
+func (r DeniedConnectionResponse) GetTableHeader() []string {
+	return []string{"SOURCE", "DESTINATION", "NAME", "NAMESPACE", "POLICY-TYPE", "RULE-INDEX", "DIRECTION", "ACTION"}
+}
+
+func (r DeniedConnectionResponse) GetTableRow(_ int) []string {
+	return []string{
+		r.Source.Namespace + "/" + r.Source.Name,
+		r.Destination.Namespace + "/" + r.Destination.Name,
+		r.NetworkPolicy.Name,
+		r.NetworkPolicy.Namespace,
+		string(r.NetworkPolicy.Type),
+		strconv.Itoa(int(r.RuleIndex)),
+		string(r.Rule.Direction),
+		ruleActionToString(r.Rule, r.RuleIndex),
+	}
+}
+
+func (r DeniedConnectionResponse) SortRows() bool {
+	return false
+}
+
+// ConnectivityMatrixTransform returns the entries of the response to a ConnectivityMatrix command.
+func ConnectivityMatrixTransform(reader io.Reader, _ bool, _ map[string]string) (interface{}, error) {
+	var matrix cpv1beta.ConnectivityMatrix
+	if err := json.NewDecoder(reader).Decode(&matrix); err != nil {
+		return nil, err
+	}
+	entries := make([]ConnectivityMatrixEntryResponse, 0)
+	if matrix.Response != nil {
+		for i := range matrix.Response.Entries {
+			entries = append(entries, ConnectivityMatrixEntryResponse{&matrix.Response.Entries[i]})
+		}
+	}
+	return entries, nil
+}
+
+// ConnectivityMatrixEntryResponse stores the effective action and rule of the connections
+// between two Pods returned by a ConnectivityMatrix command, and implements TableOutput.
+type ConnectivityMatrixEntryResponse struct {
+	*cpv1beta.ConnectivityMatrixEntry
+}
+
+var _ common.TableOutput = new(ConnectivityMatrixEntryResponse)
+
+func (r ConnectivityMatrixEntryResponse) GetTableHeader() []string {
+	return []string{"SOURCE", "DESTINATION", "ACTION", "NAME", "NAMESPACE", "POLICY-TYPE", "RULE-INDEX", "DIRECTION", "RULE-ACTION"}
+}
+
+func (r ConnectivityMatrixEntryResponse) GetTableRow(_ int) []string {
+	row := []string{
+		r.Source.Namespace + "/" + r.Source.Name,
+		r.Destination.Namespace + "/" + r.Destination.Name,
+		string(r.Action),
+	}
+	if r.NetworkPolicy == nil || r.Rule == nil {
+		// No NetworkPolicy applies to the connections.
+		return append(row, "", "", "", "", "", "")
+	}
+	return append(row,
+		r.NetworkPolicy.Name,
+		r.NetworkPolicy.Namespace,
+		string(r.NetworkPolicy.Type),
+		strconv.Itoa(int(r.RuleIndex)),
+		string(r.Rule.Direction),
+		ruleActionToString(*r.Rule, r.RuleIndex),
+	)
+}
+
+func (r ConnectivityMatrixEntryResponse) SortRows() bool {
+	return false
+}
+
+// PolicyAnalysisTransform returns the findings of the response to a PolicyAnalysis command.
+func PolicyAnalysisTransform(reader io.Reader, _ bool, _ map[string]string) (interface{}, error) {
+	var analysis cpv1beta.PolicyAnalysis
+	if err := json.NewDecoder(reader).Decode(&analysis); err != nil {
+		return nil, err
+	}
+	findings := make([]PolicyAnalysisFindingResponse, 0)
+	if analysis.Response != nil {
+		for i := range analysis.Response.Findings {
+			findings = append(findings, PolicyAnalysisFindingResponse{&analysis.Response.Findings[i]})
+		}
+	}
+	return findings, nil
+}
+
+// PolicyAnalysisFindingResponse stores a finding returned by a PolicyAnalysis command, and
+// implements TableOutput.
+type PolicyAnalysisFindingResponse struct {
+	*cpv1beta.PolicyAnalysisFinding
+}
+
+var _ common.TableOutput = new(PolicyAnalysisFindingResponse)
+
+func (r PolicyAnalysisFindingResponse) GetTableHeader() []string {
+	return []string{"TYPE", "NAME", "NAMESPACE", "POLICY-TYPE", "DIRECTION", "RULE", "COVERING-POLICY", "COVERING-RULE", "MESSAGE"}
+}
+
+func (r PolicyAnalysisFindingResponse) GetTableRow(_ int) []string {
+	coveringPolicy, coveringRule := "", ""
+	if r.CoveringNetworkPolicy != nil {
+		coveringPolicy = r.CoveringNetworkPolicy.Name
+		if r.CoveringNetworkPolicy.Namespace != "" {
+			coveringPolicy = r.CoveringNetworkPolicy.Namespace + "/" + coveringPolicy
+		}
+	}
+	if r.CoveringRule != nil {
+		coveringRule = r.CoveringRule.Name
+	}
+	return []string{
+		string(r.Type),
+		r.NetworkPolicy.Name,
+		r.NetworkPolicy.Namespace,
+		string(r.NetworkPolicy.Type),
+		string(r.Rule.Direction),
+		r.Rule.Name,
+		coveringPolicy,
+		coveringRule,
+		r.Message,
+	}
+}
+
+func (r PolicyAnalysisFindingResponse) SortRows() bool {
+	return false
+}
@@ -17,6 +17,7 @@ package networkpolicy
 import (
 	"fmt"
 	"math"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -250,3 +251,162 @@ func TestEvaluationResponseTransform(t *testing.T) {
 		})
 	}
 }
+
+func TestEvaluationTransformWithCandidate(t *testing.T) {
+	testDropAction := crdv1beta1.RuleActionDrop
+	tests := []struct {
+		name           string
+		input          string
+		expectedOutput interface{}
+		expectedRows   [][]string
+	}{
+		{
+			name:  "denied connections",
+			input: `{"request":{"candidatePolicy":{"kind":"NetworkPolicy"}},"response":{"deniedConnections":[{"source":{"namespace":"ns1","name":"pod1"},"destination":{"namespace":"ns2","name":"pod2"},"networkPolicy":{"type":"K8sNetworkPolicy","namespace":"ns2","name":"np"},"ruleIndex":2147483647,"rule":{"direction":"In"}},{"source":{"namespace":"ns1","name":"pod1"},"destination":{"namespace":"ns2","name":"pod3"},"networkPolicy":{"type":"AntreaNetworkPolicy","namespace":"ns2","name":"anp"},"rule":{"direction":"In","action":"Drop"}}]}}`,
+			expectedOutput: []DeniedConnectionResponse{
+				{&cpv1beta.DeniedConnection{
+					Source:        cpv1beta.PodReference{Namespace: "ns1", Name: "pod1"},
+					Destination:   cpv1beta.PodReference{Namespace: "ns2", Name: "pod2"},
+					NetworkPolicy: cpv1beta.NetworkPolicyReference{Type: cpv1beta.K8sNetworkPolicy, Namespace: "ns2", Name: "np"},
+					RuleIndex:     math.MaxInt32,
+					Rule:          cpv1beta.RuleRef{Direction: cpv1beta.DirectionIn},
+				}},
+				{&cpv1beta.DeniedConnection{
+					Source:        cpv1beta.PodReference{Namespace: "ns1", Name: "pod1"},
+					Destination:   cpv1beta.PodReference{Namespace: "ns2", Name: "pod3"},
+					NetworkPolicy: cpv1beta.NetworkPolicyReference{Type: cpv1beta.AntreaNetworkPolicy, Namespace: "ns2", Name: "anp"},
+					Rule:          cpv1beta.RuleRef{Direction: cpv1beta.DirectionIn, Action: &testDropAction},
+				}},
+			},
+			expectedRows: [][]string{
+				{"ns1/pod1", "ns2/pod2", "np", "ns2", "K8sNetworkPolicy", fmt.Sprint(math.MaxInt32), "In", "Isolate"},
+				{"ns1/pod1", "ns2/pod3", "anp", "ns2", "AntreaNetworkPolicy", "0", "In", "Drop"},
+			},
+		},
+		{
+			name:           "no denied connection",
+			input:          `{"request":{"candidatePolicy":{"kind":"NetworkPolicy"}},"response":{}}`,
+			expectedOutput: []DeniedConnectionResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := EvaluationTransform(strings.NewReader(tt.input), true, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+			for i, connection := range output.([]DeniedConnectionResponse) {
+				assert.Equal(t, []string{"SOURCE", "DESTINATION", "NAME", "NAMESPACE", "POLICY-TYPE", "RULE-INDEX", "DIRECTION", "ACTION"}, connection.GetTableHeader())
+				assert.Equal(t, tt.expectedRows[i], connection.GetTableRow(32))
+			}
+		})
+	}
+}
+
+func TestConnectivityMatrixTransform(t *testing.T) {
+	testRejectAction := crdv1beta1.RuleActionReject
+	tests := []struct {
+		name           string
+		input          string
+		expectedOutput interface{}
+		expectedRows   [][]string
+	}{
+		{
+			name:  "entries",
+			input: `{"request":{"port":80},"response":{"entries":[{"source":{"namespace":"ns1","name":"pod1"},"destination":{"namespace":"ns2","name":"pod2"},"action":"Allow"},{"source":{"namespace":"ns1","name":"pod1"},"destination":{"namespace":"ns2","name":"pod3"},"action":"Drop","networkPolicy":{"type":"K8sNetworkPolicy","namespace":"ns2","name":"np"},"ruleIndex":2147483647,"rule":{"direction":"In"}},{"source":{"namespace":"ns1","name":"pod1"},"destination":{"namespace":"ns2","name":"pod4"},"action":"Reject","networkPolicy":{"type":"AntreaClusterNetworkPolicy","name":"acnp"},"ruleIndex":1,"rule":{"direction":"Out","action":"Reject"}}]}}`,
+			expectedOutput: []ConnectivityMatrixEntryResponse{
+				{&cpv1beta.ConnectivityMatrixEntry{
+					Source:      cpv1beta.PodReference{Namespace: "ns1", Name: "pod1"},
+					Destination: cpv1beta.PodReference{Namespace: "ns2", Name: "pod2"},
+					Action:      crdv1beta1.RuleActionAllow,
+				}},
+				{&cpv1beta.ConnectivityMatrixEntry{
+					Source:        cpv1beta.PodReference{Namespace: "ns1", Name: "pod1"},
+					Destination:   cpv1beta.PodReference{Namespace: "ns2", Name: "pod3"},
+					Action:        crdv1beta1.RuleActionDrop,
+					NetworkPolicy: &cpv1beta.NetworkPolicyReference{Type: cpv1beta.K8sNetworkPolicy, Namespace: "ns2", Name: "np"},
+					RuleIndex:     math.MaxInt32,
+					Rule:          &cpv1beta.RuleRef{Direction: cpv1beta.DirectionIn},
+				}},
+				{&cpv1beta.ConnectivityMatrixEntry{
+					Source:        cpv1beta.PodReference{Namespace: "ns1", Name: "pod1"},
+					Destination:   cpv1beta.PodReference{Namespace: "ns2", Name: "pod4"},
+					Action:        crdv1beta1.RuleActionReject,
+					NetworkPolicy: &cpv1beta.NetworkPolicyReference{Type: cpv1beta.AntreaClusterNetworkPolicy, Name: "acnp"},
+					RuleIndex:     1,
+					Rule:          &cpv1beta.RuleRef{Direction: cpv1beta.DirectionOut, Action: &testRejectAction},
+				}},
+			},
+			expectedRows: [][]string{
+				{"ns1/pod1", "ns2/pod2", "Allow", "", "", "", "", "", ""},
+				{"ns1/pod1", "ns2/pod3", "Drop", "np", "ns2", "K8sNetworkPolicy", fmt.Sprint(math.MaxInt32), "In", "Isolate"},
+				{"ns1/pod1", "ns2/pod4", "Reject", "acnp", "", "AntreaClusterNetworkPolicy", "1", "Out", "Reject"},
+			},
+		},
+		{
+			name:           "no entry",
+			input:          `{"request":{"port":80},"response":{}}`,
+			expectedOutput: []ConnectivityMatrixEntryResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := ConnectivityMatrixTransform(strings.NewReader(tt.input), true, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+			for i, entry := range output.([]ConnectivityMatrixEntryResponse) {
+				assert.Equal(t, []string{"SOURCE", "DESTINATION", "ACTION", "NAME", "NAMESPACE", "POLICY-TYPE", "RULE-INDEX", "DIRECTION", "RULE-ACTION"}, entry.GetTableHeader())
+				assert.Equal(t, tt.expectedRows[i], entry.GetTableRow(32))
+			}
+		})
+	}
+}
+
+func TestPolicyAnalysisTransform(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedOutput interface{}
+		expectedRows   [][]string
+	}{
+		{
+			name:  "findings",
+			input: `{"request":{},"response":{"findings":[{"type":"ShadowedRule","networkPolicy":{"type":"AntreaNetworkPolicy","namespace":"ns1","name":"annp"},"rule":{"direction":"In","name":"allow-web"},"coveringNetworkPolicy":{"type":"AntreaClusterNetworkPolicy","name":"acnp"},"coveringRule":{"direction":"In","name":"drop-all"},"message":"shadowed"},{"type":"ZeroHitRule","networkPolicy":{"type":"AntreaClusterNetworkPolicy","name":"acnp"},"rule":{"direction":"Out","name":"allow-dns"},"message":"no hit"}]}}`,
+			expectedOutput: []PolicyAnalysisFindingResponse{
+				{&cpv1beta.PolicyAnalysisFinding{
+					Type:                  cpv1beta.ShadowedRule,
+					NetworkPolicy:         cpv1beta.NetworkPolicyReference{Type: cpv1beta.AntreaNetworkPolicy, Namespace: "ns1", Name: "annp"},
+					Rule:                  cpv1beta.RuleRef{Direction: cpv1beta.DirectionIn, Name: "allow-web"},
+					CoveringNetworkPolicy: &cpv1beta.NetworkPolicyReference{Type: cpv1beta.AntreaClusterNetworkPolicy, Name: "acnp"},
+					CoveringRule:          &cpv1beta.RuleRef{Direction: cpv1beta.DirectionIn, Name: "drop-all"},
+					Message:               "shadowed",
+				}},
+				{&cpv1beta.PolicyAnalysisFinding{
+					Type:          cpv1beta.ZeroHitRule,
+					NetworkPolicy: cpv1beta.NetworkPolicyReference{Type: cpv1beta.AntreaClusterNetworkPolicy, Name: "acnp"},
+					Rule:          cpv1beta.RuleRef{Direction: cpv1beta.DirectionOut, Name: "allow-dns"},
+					Message:       "no hit",
+				}},
+			},
+			expectedRows: [][]string{
+				{"ShadowedRule", "annp", "ns1", "AntreaNetworkPolicy", "In", "allow-web", "acnp", "drop-all", "shadowed"},
+				{"ZeroHitRule", "acnp", "", "AntreaClusterNetworkPolicy", "Out", "allow-dns", "", "", "no hit"},
+			},
+		},
+		{
+			name:           "no finding",
+			input:          `{"request":{},"response":{}}`,
+			expectedOutput: []PolicyAnalysisFindingResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := PolicyAnalysisTransform(strings.NewReader(tt.input), true, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+			for i, finding := range output.([]PolicyAnalysisFindingResponse) {
+				assert.Equal(t, []string{"TYPE", "NAME", "NAMESPACE", "POLICY-TYPE", "DIRECTION", "RULE", "COVERING-POLICY", "COVERING-RULE", "MESSAGE"}, finding.GetTableHeader())
+				assert.Equal(t, tt.expectedRows[i], finding.GetTableRow(32))
+			}
+		})
+	}
+}
@@ -55,6 +55,9 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NetworkPolicyList{},
 		&NetworkPolicyStatus{},
 		&NetworkPolicyEvaluation{},
+		&ConnectivityMatrix{},
+		&PolicyAnalysis{},
+		&PodQuarantine{},
 		&NodeStatsSummary{},
 		&ClusterGroupMembers{},
 		&GroupMembers{},
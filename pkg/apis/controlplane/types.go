@@ -19,6 +19,7 @@ import (
 	"net"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -202,6 +203,7 @@ const (
 	AntreaNetworkPolicy        NetworkPolicyType = "AntreaNetworkPolicy"
 	AdminNetworkPolicy         NetworkPolicyType = "AdminNetworkPolicy"
 	BaselineAdminNetworkPolicy NetworkPolicyType = "BaselineAdminNetworkPolicy"
+	NamespaceProfile           NetworkPolicyType = "NamespaceProfile"
 )
 
 type NetworkPolicyReference struct {
@@ -234,6 +236,9 @@ type NetworkPolicy struct {
 	TierPriority *int32
 	// Reference to the original NetworkPolicy that the internal NetworkPolicy is created for.
 	SourceRef *NetworkPolicyReference
+	// EnforcementMode specifies how the rules of this NetworkPolicy are enforced. It will
+	// remain empty for K8s NetworkPolicy, whose rules are always enforced.
+	EnforcementMode crdv1beta1.PolicyEnforcementMode
 }
 
 // Direction defines traffic direction of NetworkPolicyRule.
@@ -277,6 +282,21 @@ type NetworkPolicyRule struct {
 	L7Protocols []L7Protocol
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	LogLabel string
+	// RateLimit specifies the limits applied to the traffic matched by the rule.
+	RateLimit *RuleRateLimit
+	// RejectResponse is the response sent to the client when traffic is rejected by the rule.
+	// An empty value means the default response.
+	RejectResponse crdv1beta1.RejectResponseType
+}
+
+// RuleRateLimit describes the limits applied to the traffic matched by a rule on each Node.
+type RuleRateLimit struct {
+	// ConnectionsPerSecond is the maximum number of new connections per second. 0 means no limit.
+	ConnectionsPerSecond int32
+	// BandwidthRate is the maximum bandwidth in kilobits per second. 0 means no limit.
+	BandwidthRate int32
+	// BandwidthBurst is the maximum burst size in kilobits when the traffic exceeds BandwidthRate.
+	BandwidthBurst int32
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -328,8 +348,11 @@ type Service struct {
 
 // L7Protocol defines application layer protocol to match.
 type L7Protocol struct {
-	HTTP *HTTPProtocol
-	TLS  *TLSProtocol
+	HTTP  *HTTPProtocol
+	TLS   *TLSProtocol
+	GRPC  *GRPCProtocol
+	DNS   *DNSProtocol
+	Kafka *KafkaProtocol
 }
 
 // HTTPProtocol matches HTTP requests with specific host, method, and path. All
@@ -344,6 +367,36 @@ type HTTPProtocol struct {
 	Method string
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string
+	// Headers is a list of HTTP request headers which must all be present in the request.
+	Headers []HTTPHeaderMatch
+	// QueryParams is a list of query parameters which must all be present in the request URI.
+	QueryParams []HTTPQueryParamMatch
+	// UserAgent represents the User-Agent header of the request to match.
+	UserAgent string
+	// RejectStatusCode is the status code of the HTTP response sent to the client when an HTTP
+	// request does not match the rule. If it is not provided, the TCP connection is reset.
+	// It applies to the whole rule, hence must be the same in all HTTP protocols of the rule.
+	RejectStatusCode int32
+}
+
+// HTTPHeaderMatch matches an HTTP request header with a specific name and value. Value and Regex
+// cannot be set at the same time. If neither is provided, any value is matched.
+type HTTPHeaderMatch struct {
+	// Name represents the name of the header to match. It is case-insensitive.
+	Name string
+	// Value represents the exact value of the header to match.
+	Value string
+	// Regex represents a regular expression which must match the whole value of the header.
+	Regex string
+}
+
+// HTTPQueryParamMatch matches a query parameter of an HTTP request URI with a specific name and
+// value. If Value is not provided, any value is matched.
+type HTTPQueryParamMatch struct {
+	// Name represents the name of the query parameter to match.
+	Name string
+	// Value represents the exact value of the query parameter to match.
+	Value string
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -353,6 +406,50 @@ type TLSProtocol struct {
 	SNI string
 }
 
+// GRPCProtocol matches gRPC requests with specific service, method, and metadata. All fields could
+// be used alone or together. If all fields are not provided, this matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully-qualified name of the gRPC service to match (Ex. "payments.Ledger").
+	Service string
+	// Method represents the name of the gRPC method to match (Ex. "Read").
+	Method string
+	// Metadata is a list of metadata which must all be present in the gRPC request.
+	Metadata []GRPCMetadataMatch
+}
+
+// GRPCMetadataMatch matches a gRPC metadata entry with a specific name and value.
+type GRPCMetadataMatch struct {
+	// Name represents the name of the metadata entry to match. It is case-insensitive.
+	Name string
+	// Value represents the exact value of the metadata entry to match. If it is not provided,
+	// any value is matched.
+	Value string
+}
+
+// DNSProtocol matches DNS queries with specific query name and record types. All fields could be
+// used alone or together. If all fields are not provided, this matches all DNS queries.
+type DNSProtocol struct {
+	// QueryName represents the name being resolved to match. Both exact matches and wildcards are
+	// supported (Ex. "*.foo.com", "foo.bar.com").
+	QueryName string
+	// RecordTypes is a list of record types of the query to match (Ex. "A", "AAAA", "TXT").
+	RecordTypes []string
+	// RejectResponseCode is the response code of the DNS response sent to the client when a DNS
+	// query does not match the rule. It could be REFUSED or NXDOMAIN.
+	// It applies to the whole rule, hence must be the same in all DNS protocols of the rule.
+	RejectResponseCode string
+}
+
+// KafkaProtocol matches Kafka requests with specific API key and topic. All fields could be used
+// alone or together. If all fields are not provided, this matches all produce, fetch and metadata
+// requests.
+type KafkaProtocol struct {
+	// APIKey represents the type of the Kafka request to match. It could be produce, fetch and metadata.
+	APIKey string
+	// Topic represents the name of a topic in the Kafka request to match.
+	Topic string
+}
+
 // NetworkPolicyPeer describes a peer of NetworkPolicyRules.
 // It could contain one of the subfields or a combination of them.
 type NetworkPolicyPeer struct {
@@ -467,6 +564,11 @@ type Entity struct {
 type NetworkPolicyEvaluationRequest struct {
 	Source      Entity
 	Destination Entity
+	// CandidatePolicy is an Antrea ClusterNetworkPolicy, Antrea NetworkPolicy or K8s NetworkPolicy
+	// which has not been created yet. When it is set, the evaluation returns the connections between
+	// Pods which are currently allowed and would be denied once the policy is created. Source and
+	// Destination are optional in that case and only filter the returned connections.
+	CandidatePolicy *runtime.RawExtension
 }
 
 // RuleRef contains basic information for the rule.
@@ -483,6 +585,171 @@ type NetworkPolicyEvaluationResponse struct {
 	RuleIndex     int32
 	// The content of the effective rule.
 	Rule RuleRef
+	// The connections which would be denied by the candidate NetworkPolicy.
+	DeniedConnections []DeniedConnection
+}
+
+// DeniedConnection describes a connection between two Pods which is currently allowed and would be
+// denied by a candidate NetworkPolicy.
+type DeniedConnection struct {
+	Source      PodReference
+	Destination PodReference
+	// The reference of the NetworkPolicy which would deny the connection.
+	NetworkPolicy NetworkPolicyReference
+	RuleIndex     int32
+	// The content of the rule which would deny the connection.
+	Rule RuleRef
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ConnectivityMatrix contains the request and response for a bulk NetworkPolicy evaluation, which
+// evaluates the connections from multiple source Pods to multiple destination Pods at once.
+type ConnectivityMatrix struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Request  *ConnectivityMatrixRequest
+	Response *ConnectivityMatrixResponse
+}
+
+// EntitySelector selects Pods by Namespace and labels as a request parameter.
+type EntitySelector struct {
+	// Namespace of the selected Pods. Namespace and NamespaceSelector cannot be set at the same time.
+	// If both are empty, Pods are selected from all Namespaces.
+	Namespace string
+	// NamespaceSelector selects the Namespaces of the selected Pods.
+	NamespaceSelector *metav1.LabelSelector
+	// PodSelector selects Pods by labels. If it is nil, all Pods in the selected Namespaces are selected.
+	PodSelector *metav1.LabelSelector
+}
+
+// ConnectivityMatrixRequest is the request body of a bulk NetworkPolicy evaluation.
+type ConnectivityMatrixRequest struct {
+	Sources      EntitySelector
+	Destinations EntitySelector
+	// Protocol of the evaluated connections. It must be TCP, UDP or SCTP, and defaults to TCP.
+	Protocol Protocol
+	// Destination port of the evaluated connections.
+	Port int32
+}
+
+// ConnectivityMatrixResponse is the response of a bulk NetworkPolicy evaluation.
+type ConnectivityMatrixResponse struct {
+	// Entries contains one entry for each pair of different source and destination Pods.
+	Entries []ConnectivityMatrixEntry
+}
+
+// ConnectivityMatrixEntry describes the effective action of the connections from a source Pod to a
+// destination Pod, along with the NetworkPolicy rule deciding it.
+type ConnectivityMatrixEntry struct {
+	Source      PodReference
+	Destination PodReference
+	// The effective action of the connections, which can be Allow, Drop or Reject.
+	Action crdv1beta1.RuleAction
+	// The reference of the effective NetworkPolicy. It is nil if no NetworkPolicy applies to the
+	// connections, in which case they are allowed.
+	NetworkPolicy *NetworkPolicyReference
+	RuleIndex     int32
+	// The content of the effective rule.
+	Rule *RuleRef
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PolicyAnalysis contains the request and response for an analysis of the Antrea-native policy
+// rules, which reports the rules that can never match any traffic, or haven't matched any traffic
+// recently.
+type PolicyAnalysis struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Request  *PolicyAnalysisRequest
+	Response *PolicyAnalysisResponse
+}
+
+// PolicyAnalysisRequest is the request body of a policy analysis.
+type PolicyAnalysisRequest struct {
+	// ZeroHitWindowSeconds is the length of the window, in seconds, used to report the rules which
+	// haven't matched any traffic according to the NetworkPolicyStats. The report of such rules is
+	// disabled if it is 0.
+	ZeroHitWindowSeconds int64
+}
+
+// PolicyAnalysisFindingType is the type of a policy analysis finding.
+type PolicyAnalysisFindingType string
+
+const (
+	// ShadowedRule is a rule whose traffic is all matched by a higher precedence rule with a
+	// different action, hence it never takes effect.
+	ShadowedRule PolicyAnalysisFindingType = "ShadowedRule"
+	// RedundantRule is a rule whose traffic is all matched by a higher precedence rule with the
+	// same action, hence it can be removed without any change.
+	RedundantRule PolicyAnalysisFindingType = "RedundantRule"
+	// PassFallThroughRule is a Pass rule whose traffic isn't evaluated by any K8s NetworkPolicy or
+	// Baseline Tier rule, hence it's equivalent to an Allow rule.
+	PassFallThroughRule PolicyAnalysisFindingType = "PassFallThroughRule"
+	// ZeroHitRule is a rule which hasn't matched any traffic during the requested window.
+	ZeroHitRule PolicyAnalysisFindingType = "ZeroHitRule"
+)
+
+// PolicyAnalysisResponse is the response of a policy analysis.
+type PolicyAnalysisResponse struct {
+	Findings []PolicyAnalysisFinding
+}
+
+// PolicyAnalysisFinding describes an issue found with a policy rule.
+type PolicyAnalysisFinding struct {
+	Type PolicyAnalysisFindingType
+	// The reference of the NetworkPolicy the rule belongs to.
+	NetworkPolicy NetworkPolicyReference
+	Rule          RuleRef
+	// The reference of the NetworkPolicy the higher precedence rule belongs to. It's only set for
+	// ShadowedRule and RedundantRule findings.
+	CoveringNetworkPolicy *NetworkPolicyReference
+	// The higher precedence rule which matches all the traffic of the rule. It's only set for
+	// ShadowedRule and RedundantRule findings.
+	CoveringRule *RuleRef
+	// A human-readable description of the finding.
+	Message string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PodQuarantine isolates a Pod by enforcing an Antrea ClusterNetworkPolicy in the Emergency Tier,
+// which drops all traffic of the Pod except the traffic with the allowed peers. The Namespace and
+// name of a PodQuarantine are the ones of the quarantined Pod. Deleting it releases the Pod.
+type PodQuarantine struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec   PodQuarantineSpec
+	Status PodQuarantineStatus
+}
+
+// PodQuarantineSpec describes the traffic still allowed for a quarantined Pod.
+type PodQuarantineSpec struct {
+	// AllowedNamespaces are the Namespaces of the Pods which can still connect to and be
+	// reached from the quarantined Pod, e.g. the Namespace of forensics tooling.
+	AllowedNamespaces []string
+	// AllowedCIDRs are the IP blocks which can still connect to and be reached from the
+	// quarantined Pod.
+	AllowedCIDRs []string
+	// PacketCapture starts capturing the packets of the quarantined Pod when set.
+	PacketCapture *PodQuarantinePacketCapture
+}
+
+// PodQuarantinePacketCapture describes the PacketCapture started for a quarantined Pod.
+type PodQuarantinePacketCapture struct {
+	// Number of packets to capture.
+	Number int32
+	// Timeout of the capture in seconds. Defaults to the PacketCapture default timeout.
+	Timeout int32
+}
+
+// PodQuarantineStatus references the resources created to quarantine a Pod.
+type PodQuarantineStatus struct {
+	// Name of the Antrea ClusterNetworkPolicy isolating the Pod.
+	PolicyName string
+	// Name of the PacketCapture capturing the packets of the Pod, if any.
+	PacketCaptureName string
 }
 
 type GroupReference struct {
@@ -49,6 +49,16 @@ var (
 		Version:  SchemeGroupVersion.Version,
 		Resource: "networkpolicyevaluation",
 	}
+	ConnectivityMatrixVersionResource = schema.GroupVersionResource{
+		Group:    SchemeGroupVersion.Group,
+		Version:  SchemeGroupVersion.Version,
+		Resource: "connectivitymatrices",
+	}
+	PolicyAnalysisVersionResource = schema.GroupVersionResource{
+		Group:    SchemeGroupVersion.Group,
+		Version:  SchemeGroupVersion.Version,
+		Resource: "policyanalyses",
+	}
 )
 
 // Resource takes an unqualified resource and returns a Group qualified GroupResource.
@@ -75,6 +85,9 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NetworkPolicyList{},
 		&NetworkPolicyStatus{},
 		&NetworkPolicyEvaluation{},
+		&ConnectivityMatrix{},
+		&PolicyAnalysis{},
+		&PodQuarantine{},
 		&NodeStatsSummary{},
 		&ClusterGroupMembers{},
 		&GroupMembers{},
@@ -16,6 +16,7 @@ package v1beta2
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -200,6 +201,7 @@ const (
 	AntreaNetworkPolicy        NetworkPolicyType = "AntreaNetworkPolicy"
 	AdminNetworkPolicy         NetworkPolicyType = "AdminNetworkPolicy"
 	BaselineAdminNetworkPolicy NetworkPolicyType = "BaselineAdminNetworkPolicy"
+	NamespaceProfile           NetworkPolicyType = "NamespaceProfile"
 )
 
 type NetworkPolicyReference struct {
@@ -235,6 +237,9 @@ type NetworkPolicy struct {
 	TierPriority *int32 `json:"tierPriority,omitempty" protobuf:"varint,5,opt,name=tierPriority"`
 	// Reference to the original NetworkPolicy that the internal NetworkPolicy is created for.
 	SourceRef *NetworkPolicyReference `json:"sourceRef,omitempty" protobuf:"bytes,6,opt,name=sourceRef"`
+	// EnforcementMode specifies how the rules of this policy are enforced. It will
+	// remain empty for K8s NetworkPolicy, whose rules are always enforced.
+	EnforcementMode crdv1beta1.PolicyEnforcementMode `json:"enforcementMode,omitempty" protobuf:"bytes,7,opt,name=enforcementMode,casttype=antrea.io/antrea/pkg/apis/crd/v1beta1.PolicyEnforcementMode"`
 }
 
 // Direction defines traffic direction of NetworkPolicyRule.
@@ -277,6 +282,21 @@ type NetworkPolicyRule struct {
 	L7Protocols []L7Protocol `json:"l7Protocols,omitempty" protobuf:"bytes,10,rep,name=l7Protocols"`
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	LogLabel string `json:"logLabel,omitempty" protobuf:"bytes,11,opt,name=logLabel"`
+	// RateLimit specifies the limits applied to the traffic matched by the rule.
+	RateLimit *RuleRateLimit `json:"rateLimit,omitempty" protobuf:"bytes,12,opt,name=rateLimit"`
+	// RejectResponse is the response sent to the client when traffic is rejected by the rule.
+	// An empty value means the default response.
+	RejectResponse crdv1beta1.RejectResponseType `json:"rejectResponse,omitempty" protobuf:"bytes,13,opt,name=rejectResponse,casttype=antrea.io/antrea/pkg/apis/crd/v1beta1.RejectResponseType"`
+}
+
+// RuleRateLimit describes the limits applied to the traffic matched by a rule on each Node.
+type RuleRateLimit struct {
+	// ConnectionsPerSecond is the maximum number of new connections per second. 0 means no limit.
+	ConnectionsPerSecond int32 `json:"connectionsPerSecond,omitempty" protobuf:"varint,1,opt,name=connectionsPerSecond"`
+	// BandwidthRate is the maximum bandwidth in kilobits per second. 0 means no limit.
+	BandwidthRate int32 `json:"bandwidthRate,omitempty" protobuf:"varint,2,opt,name=bandwidthRate"`
+	// BandwidthBurst is the maximum burst size in kilobits when the traffic exceeds BandwidthRate.
+	BandwidthBurst int32 `json:"bandwidthBurst,omitempty" protobuf:"varint,3,opt,name=bandwidthBurst"`
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -328,8 +348,11 @@ type Service struct {
 
 // L7Protocol defines application layer protocol to match.
 type L7Protocol struct {
-	HTTP *HTTPProtocol `json:"http,omitempty" protobuf:"bytes,1,opt,name=http"`
-	TLS  *TLSProtocol  `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+	HTTP  *HTTPProtocol  `json:"http,omitempty" protobuf:"bytes,1,opt,name=http"`
+	TLS   *TLSProtocol   `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+	GRPC  *GRPCProtocol  `json:"grpc,omitempty" protobuf:"bytes,3,opt,name=grpc"`
+	DNS   *DNSProtocol   `json:"dns,omitempty" protobuf:"bytes,4,opt,name=dns"`
+	Kafka *KafkaProtocol `json:"kafka,omitempty" protobuf:"bytes,5,opt,name=kafka"`
 }
 
 // HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
@@ -343,6 +366,36 @@ type HTTPProtocol struct {
 	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string `json:"path,omitempty" protobuf:"bytes,3,opt,name=path"`
+	// Headers is a list of HTTP request headers which must all be present in the request.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty" protobuf:"bytes,4,rep,name=headers"`
+	// QueryParams is a list of query parameters which must all be present in the request URI.
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty" protobuf:"bytes,5,rep,name=queryParams"`
+	// UserAgent represents the User-Agent header of the request to match.
+	UserAgent string `json:"userAgent,omitempty" protobuf:"bytes,6,opt,name=userAgent"`
+	// RejectStatusCode is the status code of the HTTP response sent to the client when an HTTP
+	// request does not match the rule. If it is not provided, the TCP connection is reset.
+	// It applies to the whole rule, hence must be the same in all HTTP protocols of the rule.
+	RejectStatusCode int32 `json:"rejectStatusCode,omitempty" protobuf:"varint,7,opt,name=rejectStatusCode"`
+}
+
+// HTTPHeaderMatch matches an HTTP request header with a specific name and value. Value and Regex
+// cannot be set at the same time. If neither is provided, any value is matched.
+type HTTPHeaderMatch struct {
+	// Name represents the name of the header to match. It is case-insensitive.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value represents the exact value of the header to match.
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+	// Regex represents a regular expression which must match the whole value of the header.
+	Regex string `json:"regex,omitempty" protobuf:"bytes,3,opt,name=regex"`
+}
+
+// HTTPQueryParamMatch matches a query parameter of an HTTP request URI with a specific name and
+// value. If Value is not provided, any value is matched.
+type HTTPQueryParamMatch struct {
+	// Name represents the name of the query parameter to match.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value represents the exact value of the query parameter to match.
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -352,6 +405,50 @@ type TLSProtocol struct {
 	SNI string `json:"sni,omitempty" protobuf:"bytes,1,opt,name=sni"`
 }
 
+// GRPCProtocol matches gRPC requests with specific service, method, and metadata. All fields could
+// be used alone or together. If all fields are not provided, this matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully-qualified name of the gRPC service to match (Ex. "payments.Ledger").
+	Service string `json:"service,omitempty" protobuf:"bytes,1,opt,name=service"`
+	// Method represents the name of the gRPC method to match (Ex. "Read").
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+	// Metadata is a list of metadata which must all be present in the gRPC request.
+	Metadata []GRPCMetadataMatch `json:"metadata,omitempty" protobuf:"bytes,3,rep,name=metadata"`
+}
+
+// GRPCMetadataMatch matches a gRPC metadata entry with a specific name and value.
+type GRPCMetadataMatch struct {
+	// Name represents the name of the metadata entry to match. It is case-insensitive.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value represents the exact value of the metadata entry to match. If it is not provided,
+	// any value is matched.
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+}
+
+// DNSProtocol matches DNS queries with specific query name and record types. All fields could be
+// used alone or together. If all fields are not provided, this matches all DNS queries.
+type DNSProtocol struct {
+	// QueryName represents the name being resolved to match. Both exact matches and wildcards are
+	// supported (Ex. "*.foo.com", "foo.bar.com").
+	QueryName string `json:"queryName,omitempty" protobuf:"bytes,1,opt,name=queryName"`
+	// RecordTypes is a list of record types of the query to match (Ex. "A", "AAAA", "TXT").
+	RecordTypes []string `json:"recordTypes,omitempty" protobuf:"bytes,2,rep,name=recordTypes"`
+	// RejectResponseCode is the response code of the DNS response sent to the client when a DNS
+	// query does not match the rule. It could be REFUSED or NXDOMAIN.
+	// It applies to the whole rule, hence must be the same in all DNS protocols of the rule.
+	RejectResponseCode string `json:"rejectResponseCode,omitempty" protobuf:"bytes,3,opt,name=rejectResponseCode"`
+}
+
+// KafkaProtocol matches Kafka requests with specific API key and topic. All fields could be used
+// alone or together. If all fields are not provided, this matches all produce, fetch and metadata
+// requests.
+type KafkaProtocol struct {
+	// APIKey represents the type of the Kafka request to match. It could be produce, fetch and metadata.
+	APIKey string `json:"apiKey,omitempty" protobuf:"bytes,1,opt,name=apiKey"`
+	// Topic represents the name of a topic in the Kafka request to match.
+	Topic string `json:"topic,omitempty" protobuf:"bytes,2,opt,name=topic"`
+}
+
 // NetworkPolicyPeer describes a peer of NetworkPolicyRules.
 // It could be a list of names of AddressGroups and/or a list of IPBlock.
 type NetworkPolicyPeer struct {
@@ -479,6 +576,11 @@ type Entity struct {
 type NetworkPolicyEvaluationRequest struct {
 	Source      Entity `json:"source,omitempty" protobuf:"bytes,1,opt,name=source"`
 	Destination Entity `json:"destination,omitempty" protobuf:"bytes,2,opt,name=destination"`
+	// CandidatePolicy is an Antrea ClusterNetworkPolicy, Antrea NetworkPolicy or K8s NetworkPolicy
+	// which has not been created yet. When it is set, the evaluation returns the connections between
+	// Pods which are currently allowed and would be denied once the policy is created. Source and
+	// Destination are optional in that case and only filter the returned connections.
+	CandidatePolicy *runtime.RawExtension `json:"candidatePolicy,omitempty" protobuf:"bytes,3,opt,name=candidatePolicy"`
 }
 
 // RuleRef contains basic information for the rule.
@@ -495,6 +597,179 @@ type NetworkPolicyEvaluationResponse struct {
 	RuleIndex     int32                  `json:"ruleIndex,omitempty" protobuf:"varint,2,opt,name=ruleIndex"`
 	// The content of the effective rule.
 	Rule RuleRef `json:"rule,omitempty" protobuf:"bytes,3,opt,name=rule"`
+	// The connections which would be denied by the candidate NetworkPolicy.
+	DeniedConnections []DeniedConnection `json:"deniedConnections,omitempty" protobuf:"bytes,4,rep,name=deniedConnections"`
+}
+
+// DeniedConnection describes a connection between two Pods which is currently allowed and would be
+// denied by a candidate NetworkPolicy.
+type DeniedConnection struct {
+	Source      PodReference `json:"source" protobuf:"bytes,1,opt,name=source"`
+	Destination PodReference `json:"destination" protobuf:"bytes,2,opt,name=destination"`
+	// The reference of the NetworkPolicy which would deny the connection.
+	NetworkPolicy NetworkPolicyReference `json:"networkPolicy,omitempty" protobuf:"bytes,3,opt,name=networkPolicy"`
+	RuleIndex     int32                  `json:"ruleIndex,omitempty" protobuf:"varint,4,opt,name=ruleIndex"`
+	// The content of the rule which would deny the connection.
+	Rule RuleRef `json:"rule,omitempty" protobuf:"bytes,5,opt,name=rule"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ConnectivityMatrix contains the request and response for a bulk NetworkPolicy evaluation, which
+// evaluates the connections from multiple source Pods to multiple destination Pods at once.
+type ConnectivityMatrix struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Request           *ConnectivityMatrixRequest  `json:"request,omitempty" protobuf:"bytes,2,opt,name=request"`
+	Response          *ConnectivityMatrixResponse `json:"response,omitempty" protobuf:"bytes,3,opt,name=response"`
+}
+
+// EntitySelector selects Pods by Namespace and labels as a request parameter.
+type EntitySelector struct {
+	// Namespace of the selected Pods. Namespace and NamespaceSelector cannot be set at the same time.
+	// If both are empty, Pods are selected from all Namespaces.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+	// NamespaceSelector selects the Namespaces of the selected Pods.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty" protobuf:"bytes,2,opt,name=namespaceSelector"`
+	// PodSelector selects Pods by labels. If it is nil, all Pods in the selected Namespaces are selected.
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty" protobuf:"bytes,3,opt,name=podSelector"`
+}
+
+// ConnectivityMatrixRequest is the request body of a bulk NetworkPolicy evaluation.
+type ConnectivityMatrixRequest struct {
+	Sources      EntitySelector `json:"sources,omitempty" protobuf:"bytes,1,opt,name=sources"`
+	Destinations EntitySelector `json:"destinations,omitempty" protobuf:"bytes,2,opt,name=destinations"`
+	// Protocol of the evaluated connections. It must be TCP, UDP or SCTP, and defaults to TCP.
+	Protocol Protocol `json:"protocol,omitempty" protobuf:"bytes,3,opt,name=protocol"`
+	// Destination port of the evaluated connections.
+	Port int32 `json:"port,omitempty" protobuf:"varint,4,opt,name=port"`
+}
+
+// ConnectivityMatrixResponse is the response of a bulk NetworkPolicy evaluation.
+type ConnectivityMatrixResponse struct {
+	// Entries contains one entry for each pair of different source and destination Pods.
+	Entries []ConnectivityMatrixEntry `json:"entries,omitempty" protobuf:"bytes,1,rep,name=entries"`
+}
+
+// ConnectivityMatrixEntry describes the effective action of the connections from a source Pod to a
+// destination Pod, along with the NetworkPolicy rule deciding it.
+type ConnectivityMatrixEntry struct {
+	Source      PodReference `json:"source" protobuf:"bytes,1,opt,name=source"`
+	Destination PodReference `json:"destination" protobuf:"bytes,2,opt,name=destination"`
+	// The effective action of the connections, which can be Allow, Drop or Reject.
+	Action crdv1beta1.RuleAction `json:"action" protobuf:"bytes,3,opt,name=action,casttype=antrea.io/antrea/pkg/apis/security/v1beta1.RuleAction"`
+	// The reference of the effective NetworkPolicy. It is nil if no NetworkPolicy applies to the
+	// connections, in which case they are allowed.
+	NetworkPolicy *NetworkPolicyReference `json:"networkPolicy,omitempty" protobuf:"bytes,4,opt,name=networkPolicy"`
+	RuleIndex     int32                   `json:"ruleIndex,omitempty" protobuf:"varint,5,opt,name=ruleIndex"`
+	// The content of the effective rule.
+	Rule *RuleRef `json:"rule,omitempty" protobuf:"bytes,6,opt,name=rule"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PolicyAnalysis contains the request and response for an analysis of the Antrea-native policy
+// rules, which reports the rules that can never match any traffic, or haven't matched any traffic
+// recently.
+type PolicyAnalysis struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Request           *PolicyAnalysisRequest  `json:"request,omitempty" protobuf:"bytes,2,opt,name=request"`
+	Response          *PolicyAnalysisResponse `json:"response,omitempty" protobuf:"bytes,3,opt,name=response"`
+}
+
+// PolicyAnalysisRequest is the request body of a policy analysis.
+type PolicyAnalysisRequest struct {
+	// ZeroHitWindowSeconds is the length of the window, in seconds, used to report the rules which
+	// haven't matched any traffic according to the NetworkPolicyStats. The report of such rules is
+	// disabled if it is 0.
+	ZeroHitWindowSeconds int64 `json:"zeroHitWindowSeconds,omitempty" protobuf:"varint,1,opt,name=zeroHitWindowSeconds"`
+}
+
+// PolicyAnalysisFindingType is the type of a policy analysis finding.
+type PolicyAnalysisFindingType string
+
+const (
+	// ShadowedRule is a rule whose traffic is all matched by a higher precedence rule with a
+	// different action, hence it never takes effect.
+	ShadowedRule PolicyAnalysisFindingType = "ShadowedRule"
+	// RedundantRule is a rule whose traffic is all matched by a higher precedence rule with the
+	// same action, hence it can be removed without any change.
+	RedundantRule PolicyAnalysisFindingType = "RedundantRule"
+	// PassFallThroughRule is a Pass rule whose traffic isn't evaluated by any K8s NetworkPolicy or
+	// Baseline Tier rule, hence it's equivalent to an Allow rule.
+	PassFallThroughRule PolicyAnalysisFindingType = "PassFallThroughRule"
+	// ZeroHitRule is a rule which hasn't matched any traffic during the requested window.
+	ZeroHitRule PolicyAnalysisFindingType = "ZeroHitRule"
+)
+
+// PolicyAnalysisResponse is the response of a policy analysis.
+type PolicyAnalysisResponse struct {
+	Findings []PolicyAnalysisFinding `json:"findings,omitempty" protobuf:"bytes,1,rep,name=findings"`
+}
+
+// PolicyAnalysisFinding describes an issue found with a policy rule.
+type PolicyAnalysisFinding struct {
+	Type PolicyAnalysisFindingType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=PolicyAnalysisFindingType"`
+	// The reference of the NetworkPolicy the rule belongs to.
+	NetworkPolicy NetworkPolicyReference `json:"networkPolicy" protobuf:"bytes,2,opt,name=networkPolicy"`
+	Rule          RuleRef                `json:"rule" protobuf:"bytes,3,opt,name=rule"`
+	// The reference of the NetworkPolicy the higher precedence rule belongs to. It's only set for
+	// ShadowedRule and RedundantRule findings.
+	CoveringNetworkPolicy *NetworkPolicyReference `json:"coveringNetworkPolicy,omitempty" protobuf:"bytes,4,opt,name=coveringNetworkPolicy"`
+	// The higher precedence rule which matches all the traffic of the rule. It's only set for
+	// ShadowedRule and RedundantRule findings.
+	CoveringRule *RuleRef `json:"coveringRule,omitempty" protobuf:"bytes,5,opt,name=coveringRule"`
+	// A human-readable description of the finding.
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+}
+
+// +genclient
+// +genclient:onlyVerbs=create,delete
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PodQuarantine isolates a Pod by enforcing an Antrea ClusterNetworkPolicy in the Emergency Tier,
+// which drops all traffic of the Pod except the traffic with the allowed peers. The Namespace and
+// name of a PodQuarantine are the ones of the quarantined Pod. Deleting it releases the Pod.
+type PodQuarantine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Spec              PodQuarantineSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status            PodQuarantineStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// PodQuarantineSpec describes the traffic still allowed for a quarantined Pod.
+type PodQuarantineSpec struct {
+	// AllowedNamespaces are the Namespaces of the Pods which can still connect to and be
+	// reached from the quarantined Pod, e.g. the Namespace of forensics tooling.
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty" protobuf:"bytes,1,rep,name=allowedNamespaces"`
+	// AllowedCIDRs are the IP blocks which can still connect to and be reached from the
+	// quarantined Pod.
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty" protobuf:"bytes,2,rep,name=allowedCIDRs"`
+	// PacketCapture starts capturing the packets of the quarantined Pod when set.
+	PacketCapture *PodQuarantinePacketCapture `json:"packetCapture,omitempty" protobuf:"bytes,3,opt,name=packetCapture"`
+}
+
+// PodQuarantinePacketCapture describes the PacketCapture started for a quarantined Pod.
+type PodQuarantinePacketCapture struct {
+	// Number of packets to capture.
+	Number int32 `json:"number" protobuf:"varint,1,opt,name=number"`
+	// Timeout of the capture in seconds. Defaults to the PacketCapture default timeout.
+	Timeout int32 `json:"timeout,omitempty" protobuf:"varint,2,opt,name=timeout"`
+}
+
+// PodQuarantineStatus references the resources created to quarantine a Pod.
+type PodQuarantineStatus struct {
+	// Name of the Antrea ClusterNetworkPolicy isolating the Pod.
+	PolicyName string `json:"policyName,omitempty" protobuf:"bytes,1,opt,name=policyName"`
+	// Name of the PacketCapture capturing the packets of the Pod, if any.
+	PacketCaptureName string `json:"packetCaptureName,omitempty" protobuf:"bytes,2,opt,name=packetCaptureName"`
 }
 
 type GroupReference struct {
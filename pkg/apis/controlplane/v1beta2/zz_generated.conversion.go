@@ -26,6 +26,7 @@ import (
 	controlplane "antrea.io/antrea/pkg/apis/controlplane"
 	v1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	v1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	types "k8s.io/apimachinery/pkg/types"
@@ -139,6 +140,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ConnectivityMatrix)(nil), (*controlplane.ConnectivityMatrix)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ConnectivityMatrix_To_controlplane_ConnectivityMatrix(a.(*ConnectivityMatrix), b.(*controlplane.ConnectivityMatrix), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.ConnectivityMatrix)(nil), (*ConnectivityMatrix)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_ConnectivityMatrix_To_v1beta2_ConnectivityMatrix(a.(*controlplane.ConnectivityMatrix), b.(*ConnectivityMatrix), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConnectivityMatrixEntry)(nil), (*controlplane.ConnectivityMatrixEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ConnectivityMatrixEntry_To_controlplane_ConnectivityMatrixEntry(a.(*ConnectivityMatrixEntry), b.(*controlplane.ConnectivityMatrixEntry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.ConnectivityMatrixEntry)(nil), (*ConnectivityMatrixEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_ConnectivityMatrixEntry_To_v1beta2_ConnectivityMatrixEntry(a.(*controlplane.ConnectivityMatrixEntry), b.(*ConnectivityMatrixEntry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConnectivityMatrixRequest)(nil), (*controlplane.ConnectivityMatrixRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ConnectivityMatrixRequest_To_controlplane_ConnectivityMatrixRequest(a.(*ConnectivityMatrixRequest), b.(*controlplane.ConnectivityMatrixRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.ConnectivityMatrixRequest)(nil), (*ConnectivityMatrixRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_ConnectivityMatrixRequest_To_v1beta2_ConnectivityMatrixRequest(a.(*controlplane.ConnectivityMatrixRequest), b.(*ConnectivityMatrixRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConnectivityMatrixResponse)(nil), (*controlplane.ConnectivityMatrixResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ConnectivityMatrixResponse_To_controlplane_ConnectivityMatrixResponse(a.(*ConnectivityMatrixResponse), b.(*controlplane.ConnectivityMatrixResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.ConnectivityMatrixResponse)(nil), (*ConnectivityMatrixResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_ConnectivityMatrixResponse_To_v1beta2_ConnectivityMatrixResponse(a.(*controlplane.ConnectivityMatrixResponse), b.(*ConnectivityMatrixResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSProtocol)(nil), (*controlplane.DNSProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(a.(*DNSProtocol), b.(*controlplane.DNSProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.DNSProtocol)(nil), (*DNSProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(a.(*controlplane.DNSProtocol), b.(*DNSProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DeniedConnection)(nil), (*controlplane.DeniedConnection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_DeniedConnection_To_controlplane_DeniedConnection(a.(*DeniedConnection), b.(*controlplane.DeniedConnection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.DeniedConnection)(nil), (*DeniedConnection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_DeniedConnection_To_v1beta2_DeniedConnection(a.(*controlplane.DeniedConnection), b.(*DeniedConnection), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EgressGroup)(nil), (*controlplane.EgressGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_EgressGroup_To_controlplane_EgressGroup(a.(*EgressGroup), b.(*controlplane.EgressGroup), scope)
 	}); err != nil {
@@ -179,6 +240,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EntitySelector)(nil), (*controlplane.EntitySelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_EntitySelector_To_controlplane_EntitySelector(a.(*EntitySelector), b.(*controlplane.EntitySelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.EntitySelector)(nil), (*EntitySelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_EntitySelector_To_v1beta2_EntitySelector(a.(*controlplane.EntitySelector), b.(*EntitySelector), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExternalEntityReference)(nil), (*controlplane.ExternalEntityReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ExternalEntityReference_To_controlplane_ExternalEntityReference(a.(*ExternalEntityReference), b.(*controlplane.ExternalEntityReference), scope)
 	}); err != nil {
@@ -189,6 +260,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GRPCMetadataMatch)(nil), (*controlplane.GRPCMetadataMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_GRPCMetadataMatch_To_controlplane_GRPCMetadataMatch(a.(*GRPCMetadataMatch), b.(*controlplane.GRPCMetadataMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.GRPCMetadataMatch)(nil), (*GRPCMetadataMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_GRPCMetadataMatch_To_v1beta2_GRPCMetadataMatch(a.(*controlplane.GRPCMetadataMatch), b.(*GRPCMetadataMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GRPCProtocol)(nil), (*controlplane.GRPCProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(a.(*GRPCProtocol), b.(*controlplane.GRPCProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.GRPCProtocol)(nil), (*GRPCProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(a.(*controlplane.GRPCProtocol), b.(*GRPCProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GroupAssociation)(nil), (*controlplane.GroupAssociation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_GroupAssociation_To_controlplane_GroupAssociation(a.(*GroupAssociation), b.(*controlplane.GroupAssociation), scope)
 	}); err != nil {
@@ -229,6 +320,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPHeaderMatch)(nil), (*controlplane.HTTPHeaderMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(a.(*HTTPHeaderMatch), b.(*controlplane.HTTPHeaderMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.HTTPHeaderMatch)(nil), (*HTTPHeaderMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(a.(*controlplane.HTTPHeaderMatch), b.(*HTTPHeaderMatch), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HTTPProtocol)(nil), (*controlplane.HTTPProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(a.(*HTTPProtocol), b.(*controlplane.HTTPProtocol), scope)
 	}); err != nil {
@@ -239,6 +340,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPQueryParamMatch)(nil), (*controlplane.HTTPQueryParamMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(a.(*HTTPQueryParamMatch), b.(*controlplane.HTTPQueryParamMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.HTTPQueryParamMatch)(nil), (*HTTPQueryParamMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(a.(*controlplane.HTTPQueryParamMatch), b.(*HTTPQueryParamMatch), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IPBlock)(nil), (*controlplane.IPBlock)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_IPBlock_To_controlplane_IPBlock(a.(*IPBlock), b.(*controlplane.IPBlock), scope)
 	}); err != nil {
@@ -269,6 +380,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KafkaProtocol)(nil), (*controlplane.KafkaProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(a.(*KafkaProtocol), b.(*controlplane.KafkaProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.KafkaProtocol)(nil), (*KafkaProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(a.(*controlplane.KafkaProtocol), b.(*KafkaProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*L7Protocol)(nil), (*controlplane.L7Protocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_L7Protocol_To_controlplane_L7Protocol(a.(*L7Protocol), b.(*controlplane.L7Protocol), scope)
 	}); err != nil {
@@ -439,6 +560,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodQuarantine)(nil), (*controlplane.PodQuarantine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PodQuarantine_To_controlplane_PodQuarantine(a.(*PodQuarantine), b.(*controlplane.PodQuarantine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.PodQuarantine)(nil), (*PodQuarantine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_PodQuarantine_To_v1beta2_PodQuarantine(a.(*controlplane.PodQuarantine), b.(*PodQuarantine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodQuarantinePacketCapture)(nil), (*controlplane.PodQuarantinePacketCapture)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PodQuarantinePacketCapture_To_controlplane_PodQuarantinePacketCapture(a.(*PodQuarantinePacketCapture), b.(*controlplane.PodQuarantinePacketCapture), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.PodQuarantinePacketCapture)(nil), (*PodQuarantinePacketCapture)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_PodQuarantinePacketCapture_To_v1beta2_PodQuarantinePacketCapture(a.(*controlplane.PodQuarantinePacketCapture), b.(*PodQuarantinePacketCapture), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodQuarantineSpec)(nil), (*controlplane.PodQuarantineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PodQuarantineSpec_To_controlplane_PodQuarantineSpec(a.(*PodQuarantineSpec), b.(*controlplane.PodQuarantineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.PodQuarantineSpec)(nil), (*PodQuarantineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_PodQuarantineSpec_To_v1beta2_PodQuarantineSpec(a.(*controlplane.PodQuarantineSpec), b.(*PodQuarantineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodQuarantineStatus)(nil), (*controlplane.PodQuarantineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PodQuarantineStatus_To_controlplane_PodQuarantineStatus(a.(*PodQuarantineStatus), b.(*controlplane.PodQuarantineStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.PodQuarantineStatus)(nil), (*PodQuarantineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_PodQuarantineStatus_To_v1beta2_PodQuarantineStatus(a.(*controlplane.PodQuarantineStatus), b.(*PodQuarantineStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodReference)(nil), (*controlplane.PodReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_PodReference_To_controlplane_PodReference(a.(*PodReference), b.(*controlplane.PodReference), scope)
 	}); err != nil {
@@ -449,6 +610,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PolicyAnalysis)(nil), (*controlplane.PolicyAnalysis)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PolicyAnalysis_To_controlplane_PolicyAnalysis(a.(*PolicyAnalysis), b.(*controlplane.PolicyAnalysis), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.PolicyAnalysis)(nil), (*PolicyAnalysis)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_PolicyAnalysis_To_v1beta2_PolicyAnalysis(a.(*controlplane.PolicyAnalysis), b.(*PolicyAnalysis), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PolicyAnalysisFinding)(nil), (*controlplane.PolicyAnalysisFinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PolicyAnalysisFinding_To_controlplane_PolicyAnalysisFinding(a.(*PolicyAnalysisFinding), b.(*controlplane.PolicyAnalysisFinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.PolicyAnalysisFinding)(nil), (*PolicyAnalysisFinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_PolicyAnalysisFinding_To_v1beta2_PolicyAnalysisFinding(a.(*controlplane.PolicyAnalysisFinding), b.(*PolicyAnalysisFinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PolicyAnalysisRequest)(nil), (*controlplane.PolicyAnalysisRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PolicyAnalysisRequest_To_controlplane_PolicyAnalysisRequest(a.(*PolicyAnalysisRequest), b.(*controlplane.PolicyAnalysisRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.PolicyAnalysisRequest)(nil), (*PolicyAnalysisRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_PolicyAnalysisRequest_To_v1beta2_PolicyAnalysisRequest(a.(*controlplane.PolicyAnalysisRequest), b.(*PolicyAnalysisRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PolicyAnalysisResponse)(nil), (*controlplane.PolicyAnalysisResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PolicyAnalysisResponse_To_controlplane_PolicyAnalysisResponse(a.(*PolicyAnalysisResponse), b.(*controlplane.PolicyAnalysisResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.PolicyAnalysisResponse)(nil), (*PolicyAnalysisResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_PolicyAnalysisResponse_To_v1beta2_PolicyAnalysisResponse(a.(*controlplane.PolicyAnalysisResponse), b.(*PolicyAnalysisResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RuleRateLimit)(nil), (*controlplane.RuleRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_RuleRateLimit_To_controlplane_RuleRateLimit(a.(*RuleRateLimit), b.(*controlplane.RuleRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.RuleRateLimit)(nil), (*RuleRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_RuleRateLimit_To_v1beta2_RuleRateLimit(a.(*controlplane.RuleRateLimit), b.(*RuleRateLimit), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RuleRef)(nil), (*controlplane.RuleRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_RuleRef_To_controlplane_RuleRef(a.(*RuleRef), b.(*controlplane.RuleRef), scope)
 	}); err != nil {
@@ -771,6 +982,190 @@ func Convert_controlplane_ClusterGroupMembers_To_v1beta2_ClusterGroupMembers(in
 	return autoConvert_controlplane_ClusterGroupMembers_To_v1beta2_ClusterGroupMembers(in, out, s)
 }
 
+func autoConvert_v1beta2_ConnectivityMatrix_To_controlplane_ConnectivityMatrix(in *ConnectivityMatrix, out *controlplane.ConnectivityMatrix, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Request = (*controlplane.ConnectivityMatrixRequest)(unsafe.Pointer(in.Request))
+	out.Response = (*controlplane.ConnectivityMatrixResponse)(unsafe.Pointer(in.Response))
+	return nil
+}
+
+// Convert_v1beta2_ConnectivityMatrix_To_controlplane_ConnectivityMatrix is an autogenerated conversion function.
+func Convert_v1beta2_ConnectivityMatrix_To_controlplane_ConnectivityMatrix(in *ConnectivityMatrix, out *controlplane.ConnectivityMatrix, s conversion.Scope) error {
+	return autoConvert_v1beta2_ConnectivityMatrix_To_controlplane_ConnectivityMatrix(in, out, s)
+}
+
+func autoConvert_controlplane_ConnectivityMatrix_To_v1beta2_ConnectivityMatrix(in *controlplane.ConnectivityMatrix, out *ConnectivityMatrix, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Request = (*ConnectivityMatrixRequest)(unsafe.Pointer(in.Request))
+	out.Response = (*ConnectivityMatrixResponse)(unsafe.Pointer(in.Response))
+	return nil
+}
+
+// Convert_controlplane_ConnectivityMatrix_To_v1beta2_ConnectivityMatrix is an autogenerated conversion function.
+func Convert_controlplane_ConnectivityMatrix_To_v1beta2_ConnectivityMatrix(in *controlplane.ConnectivityMatrix, out *ConnectivityMatrix, s conversion.Scope) error {
+	return autoConvert_controlplane_ConnectivityMatrix_To_v1beta2_ConnectivityMatrix(in, out, s)
+}
+
+func autoConvert_v1beta2_ConnectivityMatrixEntry_To_controlplane_ConnectivityMatrixEntry(in *ConnectivityMatrixEntry, out *controlplane.ConnectivityMatrixEntry, s conversion.Scope) error {
+	if err := Convert_v1beta2_PodReference_To_controlplane_PodReference(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_PodReference_To_controlplane_PodReference(&in.Destination, &out.Destination, s); err != nil {
+		return err
+	}
+	out.Action = v1beta1.RuleAction(in.Action)
+	out.NetworkPolicy = (*controlplane.NetworkPolicyReference)(unsafe.Pointer(in.NetworkPolicy))
+	out.RuleIndex = in.RuleIndex
+	out.Rule = (*controlplane.RuleRef)(unsafe.Pointer(in.Rule))
+	return nil
+}
+
+// Convert_v1beta2_ConnectivityMatrixEntry_To_controlplane_ConnectivityMatrixEntry is an autogenerated conversion function.
+func Convert_v1beta2_ConnectivityMatrixEntry_To_controlplane_ConnectivityMatrixEntry(in *ConnectivityMatrixEntry, out *controlplane.ConnectivityMatrixEntry, s conversion.Scope) error {
+	return autoConvert_v1beta2_ConnectivityMatrixEntry_To_controlplane_ConnectivityMatrixEntry(in, out, s)
+}
+
+func autoConvert_controlplane_ConnectivityMatrixEntry_To_v1beta2_ConnectivityMatrixEntry(in *controlplane.ConnectivityMatrixEntry, out *ConnectivityMatrixEntry, s conversion.Scope) error {
+	if err := Convert_controlplane_PodReference_To_v1beta2_PodReference(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	if err := Convert_controlplane_PodReference_To_v1beta2_PodReference(&in.Destination, &out.Destination, s); err != nil {
+		return err
+	}
+	out.Action = v1beta1.RuleAction(in.Action)
+	out.NetworkPolicy = (*NetworkPolicyReference)(unsafe.Pointer(in.NetworkPolicy))
+	out.RuleIndex = in.RuleIndex
+	out.Rule = (*RuleRef)(unsafe.Pointer(in.Rule))
+	return nil
+}
+
+// Convert_controlplane_ConnectivityMatrixEntry_To_v1beta2_ConnectivityMatrixEntry is an autogenerated conversion function.
+func Convert_controlplane_ConnectivityMatrixEntry_To_v1beta2_ConnectivityMatrixEntry(in *controlplane.ConnectivityMatrixEntry, out *ConnectivityMatrixEntry, s conversion.Scope) error {
+	return autoConvert_controlplane_ConnectivityMatrixEntry_To_v1beta2_ConnectivityMatrixEntry(in, out, s)
+}
+
+func autoConvert_v1beta2_ConnectivityMatrixRequest_To_controlplane_ConnectivityMatrixRequest(in *ConnectivityMatrixRequest, out *controlplane.ConnectivityMatrixRequest, s conversion.Scope) error {
+	if err := Convert_v1beta2_EntitySelector_To_controlplane_EntitySelector(&in.Sources, &out.Sources, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_EntitySelector_To_controlplane_EntitySelector(&in.Destinations, &out.Destinations, s); err != nil {
+		return err
+	}
+	out.Protocol = controlplane.Protocol(in.Protocol)
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_v1beta2_ConnectivityMatrixRequest_To_controlplane_ConnectivityMatrixRequest is an autogenerated conversion function.
+func Convert_v1beta2_ConnectivityMatrixRequest_To_controlplane_ConnectivityMatrixRequest(in *ConnectivityMatrixRequest, out *controlplane.ConnectivityMatrixRequest, s conversion.Scope) error {
+	return autoConvert_v1beta2_ConnectivityMatrixRequest_To_controlplane_ConnectivityMatrixRequest(in, out, s)
+}
+
+func autoConvert_controlplane_ConnectivityMatrixRequest_To_v1beta2_ConnectivityMatrixRequest(in *controlplane.ConnectivityMatrixRequest, out *ConnectivityMatrixRequest, s conversion.Scope) error {
+	if err := Convert_controlplane_EntitySelector_To_v1beta2_EntitySelector(&in.Sources, &out.Sources, s); err != nil {
+		return err
+	}
+	if err := Convert_controlplane_EntitySelector_To_v1beta2_EntitySelector(&in.Destinations, &out.Destinations, s); err != nil {
+		return err
+	}
+	out.Protocol = Protocol(in.Protocol)
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_controlplane_ConnectivityMatrixRequest_To_v1beta2_ConnectivityMatrixRequest is an autogenerated conversion function.
+func Convert_controlplane_ConnectivityMatrixRequest_To_v1beta2_ConnectivityMatrixRequest(in *controlplane.ConnectivityMatrixRequest, out *ConnectivityMatrixRequest, s conversion.Scope) error {
+	return autoConvert_controlplane_ConnectivityMatrixRequest_To_v1beta2_ConnectivityMatrixRequest(in, out, s)
+}
+
+func autoConvert_v1beta2_ConnectivityMatrixResponse_To_controlplane_ConnectivityMatrixResponse(in *ConnectivityMatrixResponse, out *controlplane.ConnectivityMatrixResponse, s conversion.Scope) error {
+	out.Entries = *(*[]controlplane.ConnectivityMatrixEntry)(unsafe.Pointer(&in.Entries))
+	return nil
+}
+
+// Convert_v1beta2_ConnectivityMatrixResponse_To_controlplane_ConnectivityMatrixResponse is an autogenerated conversion function.
+func Convert_v1beta2_ConnectivityMatrixResponse_To_controlplane_ConnectivityMatrixResponse(in *ConnectivityMatrixResponse, out *controlplane.ConnectivityMatrixResponse, s conversion.Scope) error {
+	return autoConvert_v1beta2_ConnectivityMatrixResponse_To_controlplane_ConnectivityMatrixResponse(in, out, s)
+}
+
+func autoConvert_controlplane_ConnectivityMatrixResponse_To_v1beta2_ConnectivityMatrixResponse(in *controlplane.ConnectivityMatrixResponse, out *ConnectivityMatrixResponse, s conversion.Scope) error {
+	out.Entries = *(*[]ConnectivityMatrixEntry)(unsafe.Pointer(&in.Entries))
+	return nil
+}
+
+// Convert_controlplane_ConnectivityMatrixResponse_To_v1beta2_ConnectivityMatrixResponse is an autogenerated conversion function.
+func Convert_controlplane_ConnectivityMatrixResponse_To_v1beta2_ConnectivityMatrixResponse(in *controlplane.ConnectivityMatrixResponse, out *ConnectivityMatrixResponse, s conversion.Scope) error {
+	return autoConvert_controlplane_ConnectivityMatrixResponse_To_v1beta2_ConnectivityMatrixResponse(in, out, s)
+}
+
+func autoConvert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in *DNSProtocol, out *controlplane.DNSProtocol, s conversion.Scope) error {
+	out.QueryName = in.QueryName
+	out.RecordTypes = *(*[]string)(unsafe.Pointer(&in.RecordTypes))
+	out.RejectResponseCode = in.RejectResponseCode
+	return nil
+}
+
+// Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol is an autogenerated conversion function.
+func Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in *DNSProtocol, out *controlplane.DNSProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in *controlplane.DNSProtocol, out *DNSProtocol, s conversion.Scope) error {
+	out.QueryName = in.QueryName
+	out.RecordTypes = *(*[]string)(unsafe.Pointer(&in.RecordTypes))
+	out.RejectResponseCode = in.RejectResponseCode
+	return nil
+}
+
+// Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol is an autogenerated conversion function.
+func Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in *controlplane.DNSProtocol, out *DNSProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in, out, s)
+}
+
+func autoConvert_v1beta2_DeniedConnection_To_controlplane_DeniedConnection(in *DeniedConnection, out *controlplane.DeniedConnection, s conversion.Scope) error {
+	if err := Convert_v1beta2_PodReference_To_controlplane_PodReference(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_PodReference_To_controlplane_PodReference(&in.Destination, &out.Destination, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_NetworkPolicyReference_To_controlplane_NetworkPolicyReference(&in.NetworkPolicy, &out.NetworkPolicy, s); err != nil {
+		return err
+	}
+	out.RuleIndex = in.RuleIndex
+	if err := Convert_v1beta2_RuleRef_To_controlplane_RuleRef(&in.Rule, &out.Rule, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_DeniedConnection_To_controlplane_DeniedConnection is an autogenerated conversion function.
+func Convert_v1beta2_DeniedConnection_To_controlplane_DeniedConnection(in *DeniedConnection, out *controlplane.DeniedConnection, s conversion.Scope) error {
+	return autoConvert_v1beta2_DeniedConnection_To_controlplane_DeniedConnection(in, out, s)
+}
+
+func autoConvert_controlplane_DeniedConnection_To_v1beta2_DeniedConnection(in *controlplane.DeniedConnection, out *DeniedConnection, s conversion.Scope) error {
+	if err := Convert_controlplane_PodReference_To_v1beta2_PodReference(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	if err := Convert_controlplane_PodReference_To_v1beta2_PodReference(&in.Destination, &out.Destination, s); err != nil {
+		return err
+	}
+	if err := Convert_controlplane_NetworkPolicyReference_To_v1beta2_NetworkPolicyReference(&in.NetworkPolicy, &out.NetworkPolicy, s); err != nil {
+		return err
+	}
+	out.RuleIndex = in.RuleIndex
+	if err := Convert_controlplane_RuleRef_To_v1beta2_RuleRef(&in.Rule, &out.Rule, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_controlplane_DeniedConnection_To_v1beta2_DeniedConnection is an autogenerated conversion function.
+func Convert_controlplane_DeniedConnection_To_v1beta2_DeniedConnection(in *controlplane.DeniedConnection, out *DeniedConnection, s conversion.Scope) error {
+	return autoConvert_controlplane_DeniedConnection_To_v1beta2_DeniedConnection(in, out, s)
+}
+
 func autoConvert_v1beta2_EgressGroup_To_controlplane_EgressGroup(in *EgressGroup, out *controlplane.EgressGroup, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.GroupMembers = *(*[]controlplane.GroupMember)(unsafe.Pointer(&in.GroupMembers))
@@ -859,6 +1254,30 @@ func Convert_controlplane_Entity_To_v1beta2_Entity(in *controlplane.Entity, out
 	return autoConvert_controlplane_Entity_To_v1beta2_Entity(in, out, s)
 }
 
+func autoConvert_v1beta2_EntitySelector_To_controlplane_EntitySelector(in *EntitySelector, out *controlplane.EntitySelector, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.PodSelector = (*v1.LabelSelector)(unsafe.Pointer(in.PodSelector))
+	return nil
+}
+
+// Convert_v1beta2_EntitySelector_To_controlplane_EntitySelector is an autogenerated conversion function.
+func Convert_v1beta2_EntitySelector_To_controlplane_EntitySelector(in *EntitySelector, out *controlplane.EntitySelector, s conversion.Scope) error {
+	return autoConvert_v1beta2_EntitySelector_To_controlplane_EntitySelector(in, out, s)
+}
+
+func autoConvert_controlplane_EntitySelector_To_v1beta2_EntitySelector(in *controlplane.EntitySelector, out *EntitySelector, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.PodSelector = (*v1.LabelSelector)(unsafe.Pointer(in.PodSelector))
+	return nil
+}
+
+// Convert_controlplane_EntitySelector_To_v1beta2_EntitySelector is an autogenerated conversion function.
+func Convert_controlplane_EntitySelector_To_v1beta2_EntitySelector(in *controlplane.EntitySelector, out *EntitySelector, s conversion.Scope) error {
+	return autoConvert_controlplane_EntitySelector_To_v1beta2_EntitySelector(in, out, s)
+}
+
 func autoConvert_v1beta2_ExternalEntityReference_To_controlplane_ExternalEntityReference(in *ExternalEntityReference, out *controlplane.ExternalEntityReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Namespace = in.Namespace
@@ -881,6 +1300,52 @@ func Convert_controlplane_ExternalEntityReference_To_v1beta2_ExternalEntityRefer
 	return autoConvert_controlplane_ExternalEntityReference_To_v1beta2_ExternalEntityReference(in, out, s)
 }
 
+func autoConvert_v1beta2_GRPCMetadataMatch_To_controlplane_GRPCMetadataMatch(in *GRPCMetadataMatch, out *controlplane.GRPCMetadataMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1beta2_GRPCMetadataMatch_To_controlplane_GRPCMetadataMatch is an autogenerated conversion function.
+func Convert_v1beta2_GRPCMetadataMatch_To_controlplane_GRPCMetadataMatch(in *GRPCMetadataMatch, out *controlplane.GRPCMetadataMatch, s conversion.Scope) error {
+	return autoConvert_v1beta2_GRPCMetadataMatch_To_controlplane_GRPCMetadataMatch(in, out, s)
+}
+
+func autoConvert_controlplane_GRPCMetadataMatch_To_v1beta2_GRPCMetadataMatch(in *controlplane.GRPCMetadataMatch, out *GRPCMetadataMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_controlplane_GRPCMetadataMatch_To_v1beta2_GRPCMetadataMatch is an autogenerated conversion function.
+func Convert_controlplane_GRPCMetadataMatch_To_v1beta2_GRPCMetadataMatch(in *controlplane.GRPCMetadataMatch, out *GRPCMetadataMatch, s conversion.Scope) error {
+	return autoConvert_controlplane_GRPCMetadataMatch_To_v1beta2_GRPCMetadataMatch(in, out, s)
+}
+
+func autoConvert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in *GRPCProtocol, out *controlplane.GRPCProtocol, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Method = in.Method
+	out.Metadata = *(*[]controlplane.GRPCMetadataMatch)(unsafe.Pointer(&in.Metadata))
+	return nil
+}
+
+// Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol is an autogenerated conversion function.
+func Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in *GRPCProtocol, out *controlplane.GRPCProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in *controlplane.GRPCProtocol, out *GRPCProtocol, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Method = in.Method
+	out.Metadata = *(*[]GRPCMetadataMatch)(unsafe.Pointer(&in.Metadata))
+	return nil
+}
+
+// Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol is an autogenerated conversion function.
+func Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in *controlplane.GRPCProtocol, out *GRPCProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in, out, s)
+}
+
 func autoConvert_v1beta2_GroupAssociation_To_controlplane_GroupAssociation(in *GroupAssociation, out *controlplane.GroupAssociation, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.AssociatedGroups = *(*[]controlplane.GroupReference)(unsafe.Pointer(&in.AssociatedGroups))
@@ -987,10 +1452,38 @@ func Convert_controlplane_GroupReference_To_v1beta2_GroupReference(in *controlpl
 	return autoConvert_controlplane_GroupReference_To_v1beta2_GroupReference(in, out, s)
 }
 
+func autoConvert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(in *HTTPHeaderMatch, out *controlplane.HTTPHeaderMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Regex = in.Regex
+	return nil
+}
+
+// Convert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch is an autogenerated conversion function.
+func Convert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(in *HTTPHeaderMatch, out *controlplane.HTTPHeaderMatch, s conversion.Scope) error {
+	return autoConvert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(in, out, s)
+}
+
+func autoConvert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(in *controlplane.HTTPHeaderMatch, out *HTTPHeaderMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Regex = in.Regex
+	return nil
+}
+
+// Convert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch is an autogenerated conversion function.
+func Convert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(in *controlplane.HTTPHeaderMatch, out *HTTPHeaderMatch, s conversion.Scope) error {
+	return autoConvert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(in, out, s)
+}
+
 func autoConvert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(in *HTTPProtocol, out *controlplane.HTTPProtocol, s conversion.Scope) error {
 	out.Host = in.Host
 	out.Method = in.Method
 	out.Path = in.Path
+	out.Headers = *(*[]controlplane.HTTPHeaderMatch)(unsafe.Pointer(&in.Headers))
+	out.QueryParams = *(*[]controlplane.HTTPQueryParamMatch)(unsafe.Pointer(&in.QueryParams))
+	out.UserAgent = in.UserAgent
+	out.RejectStatusCode = in.RejectStatusCode
 	return nil
 }
 
@@ -1003,6 +1496,10 @@ func autoConvert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in *controlpl
 	out.Host = in.Host
 	out.Method = in.Method
 	out.Path = in.Path
+	out.Headers = *(*[]HTTPHeaderMatch)(unsafe.Pointer(&in.Headers))
+	out.QueryParams = *(*[]HTTPQueryParamMatch)(unsafe.Pointer(&in.QueryParams))
+	out.UserAgent = in.UserAgent
+	out.RejectStatusCode = in.RejectStatusCode
 	return nil
 }
 
@@ -1011,6 +1508,28 @@ func Convert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in *controlplane.
 	return autoConvert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in, out, s)
 }
 
+func autoConvert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(in *HTTPQueryParamMatch, out *controlplane.HTTPQueryParamMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch is an autogenerated conversion function.
+func Convert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(in *HTTPQueryParamMatch, out *controlplane.HTTPQueryParamMatch, s conversion.Scope) error {
+	return autoConvert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(in, out, s)
+}
+
+func autoConvert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(in *controlplane.HTTPQueryParamMatch, out *HTTPQueryParamMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch is an autogenerated conversion function.
+func Convert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(in *controlplane.HTTPQueryParamMatch, out *HTTPQueryParamMatch, s conversion.Scope) error {
+	return autoConvert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(in, out, s)
+}
+
 func autoConvert_v1beta2_IPBlock_To_controlplane_IPBlock(in *IPBlock, out *controlplane.IPBlock, s conversion.Scope) error {
 	if err := Convert_v1beta2_IPNet_To_controlplane_IPNet(&in.CIDR, &out.CIDR, s); err != nil {
 		return err
@@ -1081,9 +1600,34 @@ func Convert_controlplane_IPNet_To_v1beta2_IPNet(in *controlplane.IPNet, out *IP
 	return autoConvert_controlplane_IPNet_To_v1beta2_IPNet(in, out, s)
 }
 
+func autoConvert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(in *KafkaProtocol, out *controlplane.KafkaProtocol, s conversion.Scope) error {
+	out.APIKey = in.APIKey
+	out.Topic = in.Topic
+	return nil
+}
+
+// Convert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol is an autogenerated conversion function.
+func Convert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(in *KafkaProtocol, out *controlplane.KafkaProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(in *controlplane.KafkaProtocol, out *KafkaProtocol, s conversion.Scope) error {
+	out.APIKey = in.APIKey
+	out.Topic = in.Topic
+	return nil
+}
+
+// Convert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol is an autogenerated conversion function.
+func Convert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(in *controlplane.KafkaProtocol, out *KafkaProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(in, out, s)
+}
+
 func autoConvert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *controlplane.L7Protocol, s conversion.Scope) error {
 	out.HTTP = (*controlplane.HTTPProtocol)(unsafe.Pointer(in.HTTP))
 	out.TLS = (*controlplane.TLSProtocol)(unsafe.Pointer(in.TLS))
+	out.GRPC = (*controlplane.GRPCProtocol)(unsafe.Pointer(in.GRPC))
+	out.DNS = (*controlplane.DNSProtocol)(unsafe.Pointer(in.DNS))
+	out.Kafka = (*controlplane.KafkaProtocol)(unsafe.Pointer(in.Kafka))
 	return nil
 }
 
@@ -1095,6 +1639,9 @@ func Convert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *
 func autoConvert_controlplane_L7Protocol_To_v1beta2_L7Protocol(in *controlplane.L7Protocol, out *L7Protocol, s conversion.Scope) error {
 	out.HTTP = (*HTTPProtocol)(unsafe.Pointer(in.HTTP))
 	out.TLS = (*TLSProtocol)(unsafe.Pointer(in.TLS))
+	out.GRPC = (*GRPCProtocol)(unsafe.Pointer(in.GRPC))
+	out.DNS = (*DNSProtocol)(unsafe.Pointer(in.DNS))
+	out.Kafka = (*KafkaProtocol)(unsafe.Pointer(in.Kafka))
 	return nil
 }
 
@@ -1166,6 +1713,7 @@ func autoConvert_v1beta2_NetworkPolicy_To_controlplane_NetworkPolicy(in *Network
 	out.Priority = (*float64)(unsafe.Pointer(in.Priority))
 	out.TierPriority = (*int32)(unsafe.Pointer(in.TierPriority))
 	out.SourceRef = (*controlplane.NetworkPolicyReference)(unsafe.Pointer(in.SourceRef))
+	out.EnforcementMode = v1beta1.PolicyEnforcementMode(in.EnforcementMode)
 	return nil
 }
 
@@ -1191,6 +1739,7 @@ func autoConvert_controlplane_NetworkPolicy_To_v1beta2_NetworkPolicy(in *control
 	out.Priority = (*float64)(unsafe.Pointer(in.Priority))
 	out.TierPriority = (*int32)(unsafe.Pointer(in.TierPriority))
 	out.SourceRef = (*NetworkPolicyReference)(unsafe.Pointer(in.SourceRef))
+	out.EnforcementMode = v1beta1.PolicyEnforcementMode(in.EnforcementMode)
 	return nil
 }
 
@@ -1230,6 +1779,7 @@ func autoConvert_v1beta2_NetworkPolicyEvaluationRequest_To_controlplane_NetworkP
 	if err := Convert_v1beta2_Entity_To_controlplane_Entity(&in.Destination, &out.Destination, s); err != nil {
 		return err
 	}
+	out.CandidatePolicy = (*runtime.RawExtension)(unsafe.Pointer(in.CandidatePolicy))
 	return nil
 }
 
@@ -1245,6 +1795,7 @@ func autoConvert_controlplane_NetworkPolicyEvaluationRequest_To_v1beta2_NetworkP
 	if err := Convert_controlplane_Entity_To_v1beta2_Entity(&in.Destination, &out.Destination, s); err != nil {
 		return err
 	}
+	out.CandidatePolicy = (*runtime.RawExtension)(unsafe.Pointer(in.CandidatePolicy))
 	return nil
 }
 
@@ -1261,6 +1812,7 @@ func autoConvert_v1beta2_NetworkPolicyEvaluationResponse_To_controlplane_Network
 	if err := Convert_v1beta2_RuleRef_To_controlplane_RuleRef(&in.Rule, &out.Rule, s); err != nil {
 		return err
 	}
+	out.DeniedConnections = *(*[]controlplane.DeniedConnection)(unsafe.Pointer(&in.DeniedConnections))
 	return nil
 }
 
@@ -1277,6 +1829,7 @@ func autoConvert_controlplane_NetworkPolicyEvaluationResponse_To_v1beta2_Network
 	if err := Convert_controlplane_RuleRef_To_v1beta2_RuleRef(&in.Rule, &out.Rule, s); err != nil {
 		return err
 	}
+	out.DeniedConnections = *(*[]DeniedConnection)(unsafe.Pointer(&in.DeniedConnections))
 	return nil
 }
 
@@ -1433,6 +1986,8 @@ func autoConvert_v1beta2_NetworkPolicyRule_To_controlplane_NetworkPolicyRule(in
 	out.Name = in.Name
 	out.L7Protocols = *(*[]controlplane.L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	out.LogLabel = in.LogLabel
+	out.RateLimit = (*controlplane.RuleRateLimit)(unsafe.Pointer(in.RateLimit))
+	out.RejectResponse = v1beta1.RejectResponseType(in.RejectResponse)
 	return nil
 }
 
@@ -1467,6 +2022,8 @@ func autoConvert_controlplane_NetworkPolicyRule_To_v1beta2_NetworkPolicyRule(in
 	out.AppliedToGroups = *(*[]string)(unsafe.Pointer(&in.AppliedToGroups))
 	out.L7Protocols = *(*[]L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	out.LogLabel = in.LogLabel
+	out.RateLimit = (*RuleRateLimit)(unsafe.Pointer(in.RateLimit))
+	out.RejectResponse = v1beta1.RejectResponseType(in.RejectResponse)
 	return nil
 }
 
@@ -1620,6 +2177,106 @@ func Convert_url_Values_To_v1beta2_PaginationGetOptions(in *url.Values, out *Pag
 	return autoConvert_url_Values_To_v1beta2_PaginationGetOptions(in, out, s)
 }
 
+func autoConvert_v1beta2_PodQuarantine_To_controlplane_PodQuarantine(in *PodQuarantine, out *controlplane.PodQuarantine, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta2_PodQuarantineSpec_To_controlplane_PodQuarantineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_PodQuarantineStatus_To_controlplane_PodQuarantineStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_PodQuarantine_To_controlplane_PodQuarantine is an autogenerated conversion function.
+func Convert_v1beta2_PodQuarantine_To_controlplane_PodQuarantine(in *PodQuarantine, out *controlplane.PodQuarantine, s conversion.Scope) error {
+	return autoConvert_v1beta2_PodQuarantine_To_controlplane_PodQuarantine(in, out, s)
+}
+
+func autoConvert_controlplane_PodQuarantine_To_v1beta2_PodQuarantine(in *controlplane.PodQuarantine, out *PodQuarantine, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_controlplane_PodQuarantineSpec_To_v1beta2_PodQuarantineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_controlplane_PodQuarantineStatus_To_v1beta2_PodQuarantineStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_controlplane_PodQuarantine_To_v1beta2_PodQuarantine is an autogenerated conversion function.
+func Convert_controlplane_PodQuarantine_To_v1beta2_PodQuarantine(in *controlplane.PodQuarantine, out *PodQuarantine, s conversion.Scope) error {
+	return autoConvert_controlplane_PodQuarantine_To_v1beta2_PodQuarantine(in, out, s)
+}
+
+func autoConvert_v1beta2_PodQuarantinePacketCapture_To_controlplane_PodQuarantinePacketCapture(in *PodQuarantinePacketCapture, out *controlplane.PodQuarantinePacketCapture, s conversion.Scope) error {
+	out.Number = in.Number
+	out.Timeout = in.Timeout
+	return nil
+}
+
+// Convert_v1beta2_PodQuarantinePacketCapture_To_controlplane_PodQuarantinePacketCapture is an autogenerated conversion function.
+func Convert_v1beta2_PodQuarantinePacketCapture_To_controlplane_PodQuarantinePacketCapture(in *PodQuarantinePacketCapture, out *controlplane.PodQuarantinePacketCapture, s conversion.Scope) error {
+	return autoConvert_v1beta2_PodQuarantinePacketCapture_To_controlplane_PodQuarantinePacketCapture(in, out, s)
+}
+
+func autoConvert_controlplane_PodQuarantinePacketCapture_To_v1beta2_PodQuarantinePacketCapture(in *controlplane.PodQuarantinePacketCapture, out *PodQuarantinePacketCapture, s conversion.Scope) error {
+	out.Number = in.Number
+	out.Timeout = in.Timeout
+	return nil
+}
+
+// Convert_controlplane_PodQuarantinePacketCapture_To_v1beta2_PodQuarantinePacketCapture is an autogenerated conversion function.
+func Convert_controlplane_PodQuarantinePacketCapture_To_v1beta2_PodQuarantinePacketCapture(in *controlplane.PodQuarantinePacketCapture, out *PodQuarantinePacketCapture, s conversion.Scope) error {
+	return autoConvert_controlplane_PodQuarantinePacketCapture_To_v1beta2_PodQuarantinePacketCapture(in, out, s)
+}
+
+func autoConvert_v1beta2_PodQuarantineSpec_To_controlplane_PodQuarantineSpec(in *PodQuarantineSpec, out *controlplane.PodQuarantineSpec, s conversion.Scope) error {
+	out.AllowedNamespaces = *(*[]string)(unsafe.Pointer(&in.AllowedNamespaces))
+	out.AllowedCIDRs = *(*[]string)(unsafe.Pointer(&in.AllowedCIDRs))
+	out.PacketCapture = (*controlplane.PodQuarantinePacketCapture)(unsafe.Pointer(in.PacketCapture))
+	return nil
+}
+
+// Convert_v1beta2_PodQuarantineSpec_To_controlplane_PodQuarantineSpec is an autogenerated conversion function.
+func Convert_v1beta2_PodQuarantineSpec_To_controlplane_PodQuarantineSpec(in *PodQuarantineSpec, out *controlplane.PodQuarantineSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_PodQuarantineSpec_To_controlplane_PodQuarantineSpec(in, out, s)
+}
+
+func autoConvert_controlplane_PodQuarantineSpec_To_v1beta2_PodQuarantineSpec(in *controlplane.PodQuarantineSpec, out *PodQuarantineSpec, s conversion.Scope) error {
+	out.AllowedNamespaces = *(*[]string)(unsafe.Pointer(&in.AllowedNamespaces))
+	out.AllowedCIDRs = *(*[]string)(unsafe.Pointer(&in.AllowedCIDRs))
+	out.PacketCapture = (*PodQuarantinePacketCapture)(unsafe.Pointer(in.PacketCapture))
+	return nil
+}
+
+// Convert_controlplane_PodQuarantineSpec_To_v1beta2_PodQuarantineSpec is an autogenerated conversion function.
+func Convert_controlplane_PodQuarantineSpec_To_v1beta2_PodQuarantineSpec(in *controlplane.PodQuarantineSpec, out *PodQuarantineSpec, s conversion.Scope) error {
+	return autoConvert_controlplane_PodQuarantineSpec_To_v1beta2_PodQuarantineSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_PodQuarantineStatus_To_controlplane_PodQuarantineStatus(in *PodQuarantineStatus, out *controlplane.PodQuarantineStatus, s conversion.Scope) error {
+	out.PolicyName = in.PolicyName
+	out.PacketCaptureName = in.PacketCaptureName
+	return nil
+}
+
+// Convert_v1beta2_PodQuarantineStatus_To_controlplane_PodQuarantineStatus is an autogenerated conversion function.
+func Convert_v1beta2_PodQuarantineStatus_To_controlplane_PodQuarantineStatus(in *PodQuarantineStatus, out *controlplane.PodQuarantineStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_PodQuarantineStatus_To_controlplane_PodQuarantineStatus(in, out, s)
+}
+
+func autoConvert_controlplane_PodQuarantineStatus_To_v1beta2_PodQuarantineStatus(in *controlplane.PodQuarantineStatus, out *PodQuarantineStatus, s conversion.Scope) error {
+	out.PolicyName = in.PolicyName
+	out.PacketCaptureName = in.PacketCaptureName
+	return nil
+}
+
+// Convert_controlplane_PodQuarantineStatus_To_v1beta2_PodQuarantineStatus is an autogenerated conversion function.
+func Convert_controlplane_PodQuarantineStatus_To_v1beta2_PodQuarantineStatus(in *controlplane.PodQuarantineStatus, out *PodQuarantineStatus, s conversion.Scope) error {
+	return autoConvert_controlplane_PodQuarantineStatus_To_v1beta2_PodQuarantineStatus(in, out, s)
+}
+
 func autoConvert_v1beta2_PodReference_To_controlplane_PodReference(in *PodReference, out *controlplane.PodReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Namespace = in.Namespace
@@ -1642,6 +2299,132 @@ func Convert_controlplane_PodReference_To_v1beta2_PodReference(in *controlplane.
 	return autoConvert_controlplane_PodReference_To_v1beta2_PodReference(in, out, s)
 }
 
+func autoConvert_v1beta2_PolicyAnalysis_To_controlplane_PolicyAnalysis(in *PolicyAnalysis, out *controlplane.PolicyAnalysis, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Request = (*controlplane.PolicyAnalysisRequest)(unsafe.Pointer(in.Request))
+	out.Response = (*controlplane.PolicyAnalysisResponse)(unsafe.Pointer(in.Response))
+	return nil
+}
+
+// Convert_v1beta2_PolicyAnalysis_To_controlplane_PolicyAnalysis is an autogenerated conversion function.
+func Convert_v1beta2_PolicyAnalysis_To_controlplane_PolicyAnalysis(in *PolicyAnalysis, out *controlplane.PolicyAnalysis, s conversion.Scope) error {
+	return autoConvert_v1beta2_PolicyAnalysis_To_controlplane_PolicyAnalysis(in, out, s)
+}
+
+func autoConvert_controlplane_PolicyAnalysis_To_v1beta2_PolicyAnalysis(in *controlplane.PolicyAnalysis, out *PolicyAnalysis, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Request = (*PolicyAnalysisRequest)(unsafe.Pointer(in.Request))
+	out.Response = (*PolicyAnalysisResponse)(unsafe.Pointer(in.Response))
+	return nil
+}
+
+// Convert_controlplane_PolicyAnalysis_To_v1beta2_PolicyAnalysis is an autogenerated conversion function.
+func Convert_controlplane_PolicyAnalysis_To_v1beta2_PolicyAnalysis(in *controlplane.PolicyAnalysis, out *PolicyAnalysis, s conversion.Scope) error {
+	return autoConvert_controlplane_PolicyAnalysis_To_v1beta2_PolicyAnalysis(in, out, s)
+}
+
+func autoConvert_v1beta2_PolicyAnalysisFinding_To_controlplane_PolicyAnalysisFinding(in *PolicyAnalysisFinding, out *controlplane.PolicyAnalysisFinding, s conversion.Scope) error {
+	out.Type = controlplane.PolicyAnalysisFindingType(in.Type)
+	if err := Convert_v1beta2_NetworkPolicyReference_To_controlplane_NetworkPolicyReference(&in.NetworkPolicy, &out.NetworkPolicy, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_RuleRef_To_controlplane_RuleRef(&in.Rule, &out.Rule, s); err != nil {
+		return err
+	}
+	out.CoveringNetworkPolicy = (*controlplane.NetworkPolicyReference)(unsafe.Pointer(in.CoveringNetworkPolicy))
+	out.CoveringRule = (*controlplane.RuleRef)(unsafe.Pointer(in.CoveringRule))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta2_PolicyAnalysisFinding_To_controlplane_PolicyAnalysisFinding is an autogenerated conversion function.
+func Convert_v1beta2_PolicyAnalysisFinding_To_controlplane_PolicyAnalysisFinding(in *PolicyAnalysisFinding, out *controlplane.PolicyAnalysisFinding, s conversion.Scope) error {
+	return autoConvert_v1beta2_PolicyAnalysisFinding_To_controlplane_PolicyAnalysisFinding(in, out, s)
+}
+
+func autoConvert_controlplane_PolicyAnalysisFinding_To_v1beta2_PolicyAnalysisFinding(in *controlplane.PolicyAnalysisFinding, out *PolicyAnalysisFinding, s conversion.Scope) error {
+	out.Type = PolicyAnalysisFindingType(in.Type)
+	if err := Convert_controlplane_NetworkPolicyReference_To_v1beta2_NetworkPolicyReference(&in.NetworkPolicy, &out.NetworkPolicy, s); err != nil {
+		return err
+	}
+	if err := Convert_controlplane_RuleRef_To_v1beta2_RuleRef(&in.Rule, &out.Rule, s); err != nil {
+		return err
+	}
+	out.CoveringNetworkPolicy = (*NetworkPolicyReference)(unsafe.Pointer(in.CoveringNetworkPolicy))
+	out.CoveringRule = (*RuleRef)(unsafe.Pointer(in.CoveringRule))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_controlplane_PolicyAnalysisFinding_To_v1beta2_PolicyAnalysisFinding is an autogenerated conversion function.
+func Convert_controlplane_PolicyAnalysisFinding_To_v1beta2_PolicyAnalysisFinding(in *controlplane.PolicyAnalysisFinding, out *PolicyAnalysisFinding, s conversion.Scope) error {
+	return autoConvert_controlplane_PolicyAnalysisFinding_To_v1beta2_PolicyAnalysisFinding(in, out, s)
+}
+
+func autoConvert_v1beta2_PolicyAnalysisRequest_To_controlplane_PolicyAnalysisRequest(in *PolicyAnalysisRequest, out *controlplane.PolicyAnalysisRequest, s conversion.Scope) error {
+	out.ZeroHitWindowSeconds = in.ZeroHitWindowSeconds
+	return nil
+}
+
+// Convert_v1beta2_PolicyAnalysisRequest_To_controlplane_PolicyAnalysisRequest is an autogenerated conversion function.
+func Convert_v1beta2_PolicyAnalysisRequest_To_controlplane_PolicyAnalysisRequest(in *PolicyAnalysisRequest, out *controlplane.PolicyAnalysisRequest, s conversion.Scope) error {
+	return autoConvert_v1beta2_PolicyAnalysisRequest_To_controlplane_PolicyAnalysisRequest(in, out, s)
+}
+
+func autoConvert_controlplane_PolicyAnalysisRequest_To_v1beta2_PolicyAnalysisRequest(in *controlplane.PolicyAnalysisRequest, out *PolicyAnalysisRequest, s conversion.Scope) error {
+	out.ZeroHitWindowSeconds = in.ZeroHitWindowSeconds
+	return nil
+}
+
+// Convert_controlplane_PolicyAnalysisRequest_To_v1beta2_PolicyAnalysisRequest is an autogenerated conversion function.
+func Convert_controlplane_PolicyAnalysisRequest_To_v1beta2_PolicyAnalysisRequest(in *controlplane.PolicyAnalysisRequest, out *PolicyAnalysisRequest, s conversion.Scope) error {
+	return autoConvert_controlplane_PolicyAnalysisRequest_To_v1beta2_PolicyAnalysisRequest(in, out, s)
+}
+
+func autoConvert_v1beta2_PolicyAnalysisResponse_To_controlplane_PolicyAnalysisResponse(in *PolicyAnalysisResponse, out *controlplane.PolicyAnalysisResponse, s conversion.Scope) error {
+	out.Findings = *(*[]controlplane.PolicyAnalysisFinding)(unsafe.Pointer(&in.Findings))
+	return nil
+}
+
+// Convert_v1beta2_PolicyAnalysisResponse_To_controlplane_PolicyAnalysisResponse is an autogenerated conversion function.
+func Convert_v1beta2_PolicyAnalysisResponse_To_controlplane_PolicyAnalysisResponse(in *PolicyAnalysisResponse, out *controlplane.PolicyAnalysisResponse, s conversion.Scope) error {
+	return autoConvert_v1beta2_PolicyAnalysisResponse_To_controlplane_PolicyAnalysisResponse(in, out, s)
+}
+
+func autoConvert_controlplane_PolicyAnalysisResponse_To_v1beta2_PolicyAnalysisResponse(in *controlplane.PolicyAnalysisResponse, out *PolicyAnalysisResponse, s conversion.Scope) error {
+	out.Findings = *(*[]PolicyAnalysisFinding)(unsafe.Pointer(&in.Findings))
+	return nil
+}
+
+// Convert_controlplane_PolicyAnalysisResponse_To_v1beta2_PolicyAnalysisResponse is an autogenerated conversion function.
+func Convert_controlplane_PolicyAnalysisResponse_To_v1beta2_PolicyAnalysisResponse(in *controlplane.PolicyAnalysisResponse, out *PolicyAnalysisResponse, s conversion.Scope) error {
+	return autoConvert_controlplane_PolicyAnalysisResponse_To_v1beta2_PolicyAnalysisResponse(in, out, s)
+}
+
+func autoConvert_v1beta2_RuleRateLimit_To_controlplane_RuleRateLimit(in *RuleRateLimit, out *controlplane.RuleRateLimit, s conversion.Scope) error {
+	out.ConnectionsPerSecond = in.ConnectionsPerSecond
+	out.BandwidthRate = in.BandwidthRate
+	out.BandwidthBurst = in.BandwidthBurst
+	return nil
+}
+
+// Convert_v1beta2_RuleRateLimit_To_controlplane_RuleRateLimit is an autogenerated conversion function.
+func Convert_v1beta2_RuleRateLimit_To_controlplane_RuleRateLimit(in *RuleRateLimit, out *controlplane.RuleRateLimit, s conversion.Scope) error {
+	return autoConvert_v1beta2_RuleRateLimit_To_controlplane_RuleRateLimit(in, out, s)
+}
+
+func autoConvert_controlplane_RuleRateLimit_To_v1beta2_RuleRateLimit(in *controlplane.RuleRateLimit, out *RuleRateLimit, s conversion.Scope) error {
+	out.ConnectionsPerSecond = in.ConnectionsPerSecond
+	out.BandwidthRate = in.BandwidthRate
+	out.BandwidthBurst = in.BandwidthBurst
+	return nil
+}
+
+// Convert_controlplane_RuleRateLimit_To_v1beta2_RuleRateLimit is an autogenerated conversion function.
+func Convert_controlplane_RuleRateLimit_To_v1beta2_RuleRateLimit(in *controlplane.RuleRateLimit, out *RuleRateLimit, s conversion.Scope) error {
+	return autoConvert_controlplane_RuleRateLimit_To_v1beta2_RuleRateLimit(in, out, s)
+}
+
 func autoConvert_v1beta2_RuleRef_To_controlplane_RuleRef(in *RuleRef, out *controlplane.RuleRef, s conversion.Scope) error {
 	out.Direction = controlplane.Direction(in.Direction)
 	out.Name = in.Name
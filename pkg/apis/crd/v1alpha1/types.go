@@ -52,6 +52,11 @@ type ExternalNodeSpec struct {
 	// Only one network interface is supported now.
 	// Other interfaces except interfaces[0] will be ignored if there are more than one interfaces.
 	Interfaces []NetworkInterface `json:"interfaces,omitempty"`
+	// ServiceAccountName is the name of the ServiceAccount the ExternalNode
+	// runs as, in the Namespace of the ExternalNode. It is set in the spec of
+	// the ExternalEntity of the ExternalNode to derive its workload identity.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
 }
 
 type NetworkInterface struct {
@@ -40,6 +40,11 @@ type ExternalEntitySpec struct {
 	// ExternalNode is the opaque identifier of the agent/controller responsible
 	// for additional processing or handling of this external entity.
 	ExternalNode string `json:"externalNode,omitempty"`
+	// ServiceAccountName is the name of the ServiceAccount the entity runs as,
+	// in the Namespace of the entity. It is used to derive the workload identity
+	// of the entity, which can be selected by Antrea-native policies.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
 }
 
 // Endpoint refers to an endpoint associated with the ExternalEntity.
@@ -402,6 +402,44 @@ type GroupSpec struct {
 	// Cannot be set with any selector/IPBlock/ServiceReference.
 	// +optional
 	ChildGroups []ClusterGroupReference `json:"childGroups,omitempty"`
+	// IPList describes a list of IP addresses and CIDRs that is read from
+	// an external source by the controller, and refreshed periodically.
+	// It is intended for large lists, e.g. threat-intelligence blocklists.
+	// IPList cannot be set as part of the AppliedTo field.
+	// Cannot be set with any other selector, IPBlocks or ServiceReference.
+	// +optional
+	IPList *IPListSource `json:"ipList,omitempty"`
+}
+
+// IPListSource describes where the IP list of a Group is read from. Exactly
+// one of ConfigMap and URL must be set. The list must contain one IP address
+// or CIDR per line. Empty lines and comments starting with '#' or ';' are
+// ignored.
+type IPListSource struct {
+	// ConfigMap refers to a key of a ConfigMap holding the list.
+	// +optional
+	ConfigMap *IPListConfigMapSource `json:"configMap,omitempty"`
+	// URL is the HTTP or HTTPS URL the list is fetched from. It can only be
+	// set for a ClusterGroup.
+	// +optional
+	URL string `json:"url,omitempty"`
+	// RefreshInterval is the interval at which the list is read again from
+	// its source. It must be at least 10s. Defaults to 5m.
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// IPListConfigMapSource refers to a key of a ConfigMap.
+type IPListConfigMapSource struct {
+	// Namespace of the ConfigMap. It is required for a ClusterGroup. For a
+	// Group, it defaults to the Namespace of the Group, and cannot be set to
+	// any other Namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+	// Key of the list in the data of the ConfigMap.
+	Key string `json:"key"`
 }
 
 type GroupConditionType string
@@ -417,6 +455,22 @@ type GroupCondition struct {
 // GroupStatus represents information about the status of a Group.
 type GroupStatus struct {
 	Conditions []GroupCondition `json:"conditions,omitempty"`
+	// IPList reports the state of the IP list of the Group, if it has one.
+	IPList *IPListStatus `json:"ipList,omitempty"`
+}
+
+// IPListStatus represents information about the IP list of a Group.
+type IPListStatus struct {
+	// Size is the number of IP addresses and CIDRs in the list.
+	Size int32 `json:"size"`
+	// AggregatedSize is the number of CIDRs the list is aggregated into,
+	// after merging overlapping and adjacent CIDRs.
+	AggregatedSize int32 `json:"aggregatedSize"`
+	// LastRefreshTime is the last time the list was read successfully.
+	LastRefreshTime metav1.Time `json:"lastRefreshTime,omitempty"`
+	// LastRefreshError is the error of the last attempt to read the list, if
+	// it failed. The last list read successfully is still used in this case.
+	LastRefreshError string `json:"lastRefreshError,omitempty"`
 }
 
 // ClusterGroupReference represent reference to a ClusterGroup.
@@ -498,6 +552,46 @@ type NetworkPolicySpec struct {
 	// field within a Rule.
 	// +optional
 	Egress []Rule `json:"egress,omitempty"`
+	// EnforcementMode specifies how the rules of this policy are enforced.
+	// Defaults to Enforce. In Audit mode, the rules are not enforced: traffic
+	// matching a rule is logged, but neither denied nor allowed.
+	// +optional
+	EnforcementMode PolicyEnforcementMode `json:"enforcementMode,omitempty"`
+	// Expiry specifies when this policy expires. An expired policy is no
+	// longer enforced, as if it had no rule, and an Expired condition is
+	// added to its status. The policy never expires if not set.
+	// +optional
+	Expiry *PolicyExpiry `json:"expiry,omitempty"`
+}
+
+// PolicyEnforcementMode describes how the rules of an Antrea-native policy are enforced.
+type PolicyEnforcementMode string
+
+const (
+	// PolicyEnforcementModeEnforce means that all rules of the policy are enforced.
+	PolicyEnforcementModeEnforce PolicyEnforcementMode = "Enforce"
+	// PolicyEnforcementModeAudit means that the rules of the policy, whatever their
+	// action, only match and log traffic. They are evaluated before the rules of the
+	// enforced policies, and matching traffic is then evaluated against these rules
+	// as if it had not matched the policy. Multicast and IGMP rules are ignored.
+	PolicyEnforcementModeAudit PolicyEnforcementMode = "Audit"
+)
+
+// PolicyExpiry describes when an Antrea-native policy expires. Exactly one of
+// ExpirationTime and TTL must be set.
+type PolicyExpiry struct {
+	// ExpirationTime is the time at which the policy expires.
+	// +optional
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+	// TTL is the duration after the creation of the policy at which the
+	// policy expires, e.g. "2h30m".
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+	// DeleteOnExpiry indicates whether the policy is deleted once it expires.
+	// Defaults to false, in which case the expired policy is kept but not
+	// enforced.
+	// +optional
+	DeleteOnExpiry bool `json:"deleteOnExpiry,omitempty"`
 }
 
 // NetworkPolicyPhase defines the phase in which a NetworkPolicy is.
@@ -524,6 +618,8 @@ const (
 	NetworkPolicyConditionRealizable NetworkPolicyConditionType = "Realizable"
 	// NetworkPolicyConditionRealizationFailure reports information about a failure when realizing the NetworkPolicy on a Node.
 	NetworkPolicyConditionRealizationFailure NetworkPolicyConditionType = "RealizationFailure"
+	// NetworkPolicyConditionExpired reports whether the NetworkPolicy has expired and is no longer enforced.
+	NetworkPolicyConditionExpired NetworkPolicyConditionType = "Expired"
 )
 
 // NetworkPolicyCondition describes the state of a NetworkPolicy at a certain point.
@@ -555,6 +651,30 @@ type NetworkPolicyStatus struct {
 	DesiredNodesRealized int32 `json:"desiredNodesRealized"`
 	// Represents the latest available observations of a NetworkPolicy current state.
 	Conditions []NetworkPolicyCondition `json:"conditions"`
+	// The number of hits of each Drop or Reject rule when the NetworkPolicy is in
+	// Audit mode, i.e. the number of connections that would have been denied.
+	// It is only populated when the NetworkPolicyStats feature is enabled.
+	// +optional
+	AuditedRules []RuleAuditStatus `json:"auditedRules,omitempty"`
+	// Whether each rule with a schedule is currently enforced.
+	// +optional
+	ScheduledRules []RuleScheduleStatus `json:"scheduledRules,omitempty"`
+}
+
+// RuleScheduleStatus reports whether a rule with a schedule is currently enforced.
+type RuleScheduleStatus struct {
+	// Name of the rule.
+	Name string `json:"name"`
+	// Active is true if the current time falls in any window of the rule's schedule.
+	Active bool `json:"active"`
+}
+
+// RuleAuditStatus reports the traffic matched by a rule of a NetworkPolicy in Audit mode.
+type RuleAuditStatus struct {
+	// Name of the rule.
+	Name string `json:"name"`
+	// Hits is the number of connections that would have been denied by the rule.
+	Hits int64 `json:"hits"`
 }
 
 // Rule describes the traffic allowed to/from the workloads selected by
@@ -611,8 +731,74 @@ type Rule struct {
 	// conjunction with NetworkPolicySpec/ClusterNetworkPolicySpec.AppliedTo.
 	// +optional
 	AppliedTo []AppliedTo `json:"appliedTo,omitempty"`
+	// Schedule restricts the rule to the given time windows. Outside of these
+	// windows, the rule is not enforced, as if it was not part of the policy.
+	// If not set, the rule is always enforced.
+	// +optional
+	Schedule *RuleSchedule `json:"schedule,omitempty"`
+	// RateLimit throttles the traffic matched by the rule. It can only be set
+	// for rules with the Allow action. Traffic exceeding the limits is dropped.
+	// +optional
+	RateLimit *RuleRateLimit `json:"rateLimit,omitempty"`
+	// RejectResponse is the response sent to the client when traffic is
+	// rejected by the rule. It can only be set for rules with the Reject
+	// action. If not set, TCP connections are reset and other traffic is
+	// responded with ICMP (or ICMPv6) administratively prohibited.
+	// +optional
+	RejectResponse RejectResponseType `json:"rejectResponse,omitempty"`
+}
+
+// RuleRateLimit describes the limits applied to the traffic matched by a rule.
+// The limits are enforced on each Node separately, and are shared by all the
+// workloads on the Node to which the rule applies.
+type RuleRateLimit struct {
+	// ConnectionsPerSecond is the maximum number of new connections per second
+	// matched by the rule.
+	// +optional
+	ConnectionsPerSecond *int32 `json:"connectionsPerSecond,omitempty"`
+	// Bandwidth is the maximum bandwidth of the traffic matched by the rule.
+	// +optional
+	Bandwidth *Bandwidth `json:"bandwidth,omitempty"`
 }
 
+// RuleSchedule describes the recurring time windows during which a rule is
+// enforced.
+type RuleSchedule struct {
+	// TimeZone is the IANA Time Zone name in which the windows are
+	// interpreted, e.g. "America/Los_Angeles". Defaults to "UTC".
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// Windows is the list of time windows during which the rule is enforced.
+	// The rule is enforced if the current time falls in any of the windows.
+	Windows []ScheduleWindow `json:"windows"`
+}
+
+// ScheduleWindow describes a recurring daily time window.
+type ScheduleWindow struct {
+	// Start is the start time of the window in "HH:MM" 24-hour format.
+	Start string `json:"start"`
+	// End is the end time of the window in "HH:MM" 24-hour format. If End is
+	// not later than Start, the window ends on the next day.
+	End string `json:"end"`
+	// DaysOfWeek restricts the window to the given days of the week, on which
+	// the window starts. The window recurs every day if not set.
+	// +optional
+	DaysOfWeek []DayOfWeek `json:"daysOfWeek,omitempty"`
+}
+
+// DayOfWeek is a day of the week, e.g. "Mon".
+type DayOfWeek string
+
+const (
+	DayOfWeekMonday    DayOfWeek = "Mon"
+	DayOfWeekTuesday   DayOfWeek = "Tue"
+	DayOfWeekWednesday DayOfWeek = "Wed"
+	DayOfWeekThursday  DayOfWeek = "Thu"
+	DayOfWeekFriday    DayOfWeek = "Fri"
+	DayOfWeekSaturday  DayOfWeek = "Sat"
+	DayOfWeekSunday    DayOfWeek = "Sun"
+)
+
 // NetworkPolicyPeer describes the grouping selector of workloads.
 type NetworkPolicyPeer struct {
 	// IPBlock describes the IPAddresses/IPBlocks that is matched in to/from.
@@ -665,11 +851,16 @@ type NetworkPolicyPeer struct {
 	// Cannot be set with any other selector.
 	// +optional
 	ServiceAccount *NamespacedName `json:"serviceAccount,omitempty"`
+	// Select all Pods and ExternalEntities with the workload identity
+	// matched by this field, as workloads in To/From fields.
+	// Cannot be set with any other selector except Scope.
+	// +optional
+	Identity *WorkloadIdentity `json:"identity,omitempty"`
 	// Select certain Nodes which match the label selector.
 	// A NodeSelector cannot be set with any other selector.
 	// +optional
 	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
-	// Define scope of the Pod/NamespaceSelector(s) of this peer.
+	// Define scope of the Pod/NamespaceSelector(s) or Identity of this peer.
 	// Can only be used in ingress NetworkPolicyPeers.
 	// Defaults to "Cluster".
 	// +optional
@@ -708,6 +899,11 @@ type AppliedTo struct {
 	// Cannot be set with any other selector.
 	// +optional
 	ServiceAccount *NamespacedName `json:"serviceAccount,omitempty"`
+	// Select all Pods and ExternalEntities with the workload identity
+	// matched by this field, as workloads in AppliedTo fields.
+	// Cannot be set with any other selector.
+	// +optional
+	Identity *WorkloadIdentity `json:"identity,omitempty"`
 	// Select a certain Service which matches the NamespacedName.
 	// A Service can only be set in either policy level AppliedTo field in a policy
 	// that only has ingress rules or rule level AppliedTo field in an ingress rule.
@@ -805,6 +1001,26 @@ const (
 	IGMPReportV3 int32 = 0x22
 )
 
+// RejectResponseType describes the response sent to reject traffic.
+type RejectResponseType string
+
+const (
+	// RejectResponseTCPReset resets TCP connections and responds to other traffic with ICMP
+	// administratively prohibited. This is the default response.
+	RejectResponseTCPReset RejectResponseType = "TCPReset"
+	// RejectResponseICMPAdminProhibited responds to all traffic, including TCP, with ICMP
+	// destination unreachable "host administratively prohibited", or with ICMPv6 destination
+	// unreachable "communication with destination administratively prohibited" for IPv6.
+	RejectResponseICMPAdminProhibited RejectResponseType = "ICMPAdminProhibited"
+	// RejectResponseICMPPortUnreachable responds to all traffic, including TCP, with ICMP
+	// destination unreachable "port unreachable", or with its ICMPv6 equivalent for IPv6.
+	RejectResponseICMPPortUnreachable RejectResponseType = "ICMPPortUnreachable"
+	// RejectResponseICMPHostUnreachable responds to all traffic, including TCP, with ICMP
+	// destination unreachable "host unreachable", or with ICMPv6 destination unreachable
+	// "address unreachable" for IPv6.
+	RejectResponseICMPHostUnreachable RejectResponseType = "ICMPHostUnreachable"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type NetworkPolicyList struct {
@@ -855,6 +1071,16 @@ type ClusterNetworkPolicySpec struct {
 	// field within a Rule.
 	// +optional
 	Egress []Rule `json:"egress,omitempty"`
+	// EnforcementMode specifies how the rules of this policy are enforced.
+	// Defaults to Enforce. In Audit mode, the rules are not enforced: traffic
+	// matching a rule is logged, but neither denied nor allowed.
+	// +optional
+	EnforcementMode PolicyEnforcementMode `json:"enforcementMode,omitempty"`
+	// Expiry specifies when this policy expires. An expired policy is no
+	// longer enforced, as if it had no rule, and an Expired condition is
+	// added to its status. The policy never expires if not set.
+	// +optional
+	Expiry *PolicyExpiry `json:"expiry,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -867,6 +1093,24 @@ type ClusterNetworkPolicyList struct {
 	Items []ClusterNetworkPolicy `json:"items"`
 }
 
+// WorkloadIdentity describes the identity of workloads, which is derived from
+// their ServiceAccount. The identity of Pods is the ServiceAccount they run as,
+// and the identity of ExternalEntities is the ServiceAccount set in their spec.
+// Exactly one of the fields must be set.
+type WorkloadIdentity struct {
+	// SPIFFEID is the SPIFFE ID of the workloads, as carried by their X.509
+	// SVIDs. It must follow the format derived from the ServiceAccount of the
+	// workloads: spiffe://<trust-domain>/ns/<namespace>/sa/<service-account>.
+	// The trust domain is not interpreted, so the same SPIFFE ID selects the
+	// workloads with the same ServiceAccount in every member cluster of a
+	// ClusterSet.
+	// +optional
+	SPIFFEID string `json:"spiffeID,omitempty"`
+	// ServiceAccount is the ServiceAccount the identity is derived from.
+	// +optional
+	ServiceAccount *NamespacedName `json:"serviceAccount,omitempty"`
+}
+
 // NamespacedName refers to a Namespace scoped resource.
 // All fields must be used together.
 type NamespacedName struct {
@@ -875,11 +1119,26 @@ type NamespacedName struct {
 }
 
 // PeerService refers to a Service, which can be a in-cluster Service or
-// imported multi-cluster service.
+// imported multi-cluster service. Services can be referenced either by Name,
+// or by ServiceSelector to select all Services with matching labels.
 type PeerService struct {
-	Name      string    `json:"name,omitempty"`
-	Namespace string    `json:"namespace,omitempty"`
-	Scope     PeerScope `json:"scope,omitempty"`
+	Name string `json:"name,omitempty"`
+	// Namespace of the referenced Service(s). For Antrea NetworkPolicies, it
+	// defaults to the Namespace of the policy. It cannot be set together with
+	// NamespaceSelector.
+	Namespace string `json:"namespace,omitempty"`
+	// Select Services with matching labels. It cannot be set together with
+	// Name. The membership is updated as Services are created, deleted or
+	// relabelled.
+	// +optional
+	ServiceSelector *metav1.LabelSelector `json:"serviceSelector,omitempty"`
+	// Select the Namespaces in which Services are selected by ServiceSelector.
+	// It can only be set together with ServiceSelector. If neither Namespace
+	// nor NamespaceSelector is set, a ClusterNetworkPolicy selects Services in
+	// all Namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	Scope             PeerScope             `json:"scope,omitempty"`
 }
 
 // NetworkPolicyProtocol defines additional protocols that are not supported by
@@ -910,8 +1169,11 @@ type IGMPProtocol struct {
 }
 
 type L7Protocol struct {
-	HTTP *HTTPProtocol `json:"http,omitempty"`
-	TLS  *TLSProtocol  `json:"tls,omitempty"`
+	HTTP  *HTTPProtocol  `json:"http,omitempty"`
+	TLS   *TLSProtocol   `json:"tls,omitempty"`
+	GRPC  *GRPCProtocol  `json:"grpc,omitempty"`
+	DNS   *DNSProtocol   `json:"dns,omitempty"`
+	Kafka *KafkaProtocol `json:"kafka,omitempty"`
 }
 
 // HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
@@ -925,6 +1187,37 @@ type HTTPProtocol struct {
 	Method string `json:"method,omitempty"`
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string `json:"path,omitempty"`
+	// Headers is a list of HTTP request headers which must all be present in the request.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty"`
+	// QueryParams is a list of query parameters which must all be present in the request URI.
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty"`
+	// UserAgent represents the User-Agent header of the request to match.
+	UserAgent string `json:"userAgent,omitempty"`
+	// RejectStatusCode is the status code of the HTTP response sent to the client when an HTTP
+	// request does not match the rule. If it is not provided, the TCP connection is reset.
+	// It applies to the whole rule, hence must be the same in all HTTP protocols of the rule.
+	RejectStatusCode int32 `json:"rejectStatusCode,omitempty"`
+}
+
+// HTTPHeaderMatch matches an HTTP request header with a specific name and value. Value and Regex
+// cannot be set at the same time. If neither is provided, any value is matched.
+type HTTPHeaderMatch struct {
+	// Name represents the name of the header to match. It is case-insensitive.
+	Name string `json:"name"`
+	// Value represents the exact value of the header to match.
+	Value string `json:"value,omitempty"`
+	// Regex represents a regular expression which must match the whole value of the header. It uses
+	// the RE2 syntax and can only contain printable ASCII characters other than '/', '"' and ';'.
+	Regex string `json:"regex,omitempty"`
+}
+
+// HTTPQueryParamMatch matches a query parameter of an HTTP request URI with a specific name and
+// value. If Value is not provided, any value is matched.
+type HTTPQueryParamMatch struct {
+	// Name represents the name of the query parameter to match.
+	Name string `json:"name"`
+	// Value represents the exact value of the query parameter to match.
+	Value string `json:"value,omitempty"`
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -934,6 +1227,67 @@ type TLSProtocol struct {
 	SNI string `json:"sni,omitempty"`
 }
 
+// GRPCProtocol matches gRPC requests with specific service, method, and metadata. All fields could
+// be used alone or together. If all fields are not provided, this matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully-qualified name of the gRPC service to match (Ex. "payments.Ledger").
+	Service string `json:"service,omitempty"`
+	// Method represents the name of the gRPC method to match (Ex. "Read").
+	Method string `json:"method,omitempty"`
+	// Metadata is a list of metadata which must all be present in the gRPC request.
+	Metadata []GRPCMetadataMatch `json:"metadata,omitempty"`
+}
+
+// GRPCMetadataMatch matches a gRPC metadata entry with a specific name and value.
+type GRPCMetadataMatch struct {
+	// Name represents the name of the metadata entry to match. It is case-insensitive.
+	Name string `json:"name"`
+	// Value represents the exact value of the metadata entry to match. If it is not provided,
+	// any value is matched.
+	Value string `json:"value,omitempty"`
+}
+
+// DNSProtocol matches DNS queries with specific query name and record types. All fields could be
+// used alone or together. If all fields are not provided, this matches all DNS queries.
+type DNSProtocol struct {
+	// QueryName represents the name being resolved to match. Both exact matches and wildcards are
+	// supported (Ex. "*.foo.com", "foo.bar.com").
+	QueryName string `json:"queryName,omitempty"`
+	// RecordTypes is a list of record types of the query to match (Ex. "A", "AAAA", "TXT").
+	RecordTypes []string `json:"recordTypes,omitempty"`
+	// RejectResponseCode is the response code of the DNS response sent to the client when a DNS
+	// query does not match the rule. It could be REFUSED or NXDOMAIN, and defaults to REFUSED.
+	// It applies to the whole rule, hence must be the same in all DNS protocols of the rule.
+	RejectResponseCode DNSResponseCode `json:"rejectResponseCode,omitempty"`
+}
+
+// DNSResponseCode is the response code of a DNS response sent to reject a DNS query.
+type DNSResponseCode string
+
+const (
+	DNSResponseCodeRefused  DNSResponseCode = "REFUSED"
+	DNSResponseCodeNXDomain DNSResponseCode = "NXDOMAIN"
+)
+
+// KafkaProtocol matches Kafka requests with specific API key and topic. All fields could be used
+// alone or together. If all fields are not provided, this matches all produce, fetch and metadata
+// requests.
+type KafkaProtocol struct {
+	// APIKey represents the type of the Kafka request to match. It could be produce, fetch and metadata.
+	APIKey KafkaAPIKey `json:"apiKey,omitempty"`
+	// Topic represents the name of a topic in the Kafka request to match. Only exact match is supported.
+	Topic string `json:"topic,omitempty"`
+}
+
+// KafkaAPIKey is the type of a Kafka request.
+type KafkaAPIKey string
+
+const (
+	KafkaAPIKeyProduce  KafkaAPIKey = "produce"
+	KafkaAPIKeyFetch    KafkaAPIKey = "fetch"
+	KafkaAPIKeyMetadata KafkaAPIKey = "metadata"
+)
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -1076,6 +1430,7 @@ var SupportedProtocols = map[string]int32{
 	"TCP":  TCPProtocolNumber,
 	"UDP":  UDPProtocolNumber,
 	"ICMP": ICMPProtocolNumber,
+	"SCTP": SCTPProtocolNumber,
 }
 
 var ProtocolsToString = map[int32]string{
@@ -1177,6 +1532,7 @@ type TransportHeader struct {
 	ICMP *ICMPEchoRequestHeader `json:"icmp,omitempty" yaml:"icmp,omitempty"`
 	UDP  *UDPHeader             `json:"udp,omitempty" yaml:"udp,omitempty"`
 	TCP  *TCPHeader             `json:"tcp,omitempty" yaml:"tcp,omitempty"`
+	SCTP *SCTPHeader            `json:"sctp,omitempty" yaml:"sctp,omitempty"`
 }
 
 // ICMPEchoRequestHeader describes spec of an ICMP echo request header.
@@ -1205,6 +1561,14 @@ type TCPHeader struct {
 	Flags *int32 `json:"flags,omitempty"`
 }
 
+// SCTPHeader describes spec of an SCTP header.
+type SCTPHeader struct {
+	// SrcPort is the source port.
+	SrcPort int32 `json:"srcPort,omitempty"`
+	// DstPort is the destination port.
+	DstPort int32 `json:"dstPort,omitempty"`
+}
+
 // Packet includes header info.
 type Packet struct {
 	SrcIP string `json:"srcIP,omitempty"`
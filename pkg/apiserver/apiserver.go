@@ -49,11 +49,14 @@ import (
 	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/addressgroup"
 	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/appliedtogroup"
 	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/clustergroupmember"
+	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/connectivitymatrix"
 	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/groupassociation"
 	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/groupmember"
 	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/ipgroupassociation"
 	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/networkpolicy"
 	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/networkpolicyevaluation"
+	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/podquarantine"
+	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/policyanalysis"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/antreaclusternetworkpolicystats"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/antreanetworkpolicystats"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/multicastgroup"
@@ -204,7 +207,16 @@ func installAPIGroup(s *APIServer, c completedConfig) error {
 	appliedToGroupStorage := appliedtogroup.NewREST(c.extraConfig.appliedToGroupStore)
 	networkPolicyStorage := networkpolicy.NewREST(c.extraConfig.networkPolicyStore)
 	networkPolicyStatusStorage := networkpolicy.NewStatusREST(c.extraConfig.networkPolicyStatusController)
-	networkPolicyEvaluationStorage := networkpolicyevaluation.NewREST(controllernetworkpolicy.NewPolicyRuleQuerier(c.extraConfig.endpointQuerier))
+	policyRuleQuerier := controllernetworkpolicy.NewPolicyRuleQuerier(c.extraConfig.endpointQuerier)
+	networkPolicyEvaluationStorage := networkpolicyevaluation.NewREST(policyRuleQuerier)
+	connectivityMatrixStorage := connectivitymatrix.NewREST(policyRuleQuerier)
+	podQuarantineStorage := podquarantine.NewREST(c.extraConfig.networkPolicyController)
+	// The rule hits are only available when the NetworkPolicyStats feature is enabled.
+	var ruleHitProvider controllernetworkpolicy.RuleHitProvider
+	if c.extraConfig.statsAggregator != nil {
+		ruleHitProvider = c.extraConfig.statsAggregator
+	}
+	policyAnalysisStorage := policyanalysis.NewREST(controllernetworkpolicy.NewPolicyAnalyzer(c.extraConfig.networkPolicyController, ruleHitProvider))
 	clusterGroupMembershipStorage := clustergroupmember.NewREST(c.extraConfig.networkPolicyController)
 	groupMembershipStorage := groupmember.NewREST(c.extraConfig.networkPolicyController)
 	groupAssociationStorage := groupassociation.NewREST(c.extraConfig.networkPolicyController)
@@ -220,6 +232,9 @@ func installAPIGroup(s *APIServer, c completedConfig) error {
 	cpv1beta2Storage["networkpolicies"] = networkPolicyStorage
 	cpv1beta2Storage["networkpolicies/status"] = networkPolicyStatusStorage
 	cpv1beta2Storage["networkpolicyevaluation"] = networkPolicyEvaluationStorage
+	cpv1beta2Storage["connectivitymatrices"] = connectivityMatrixStorage
+	cpv1beta2Storage["podquarantines"] = podQuarantineStorage
+	cpv1beta2Storage["policyanalyses"] = policyAnalysisStorage
 	cpv1beta2Storage["nodestatssummaries"] = nodeStatsSummaryStorage
 	cpv1beta2Storage["groupassociations"] = groupAssociationStorage
 	cpv1beta2Storage["ipgroupassociations"] = ipGroupAssociationStorage
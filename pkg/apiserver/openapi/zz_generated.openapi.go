@@ -39,19 +39,31 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.BundleFileServer":                  schema_pkg_apis_controlplane_v1beta2_BundleFileServer(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.BundleServerAuthConfiguration":     schema_pkg_apis_controlplane_v1beta2_BundleServerAuthConfiguration(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ClusterGroupMembers":               schema_pkg_apis_controlplane_v1beta2_ClusterGroupMembers(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrix":                schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrix(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixEntry":           schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrixEntry(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixRequest":         schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrixRequest(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixResponse":        schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrixResponse(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol":                       schema_pkg_apis_controlplane_v1beta2_DNSProtocol(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.DeniedConnection":                  schema_pkg_apis_controlplane_v1beta2_DeniedConnection(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroup":                       schema_pkg_apis_controlplane_v1beta2_EgressGroup(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroupList":                   schema_pkg_apis_controlplane_v1beta2_EgressGroupList(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroupPatch":                  schema_pkg_apis_controlplane_v1beta2_EgressGroupPatch(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.Entity":                            schema_pkg_apis_controlplane_v1beta2_Entity(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EntitySelector":                    schema_pkg_apis_controlplane_v1beta2_EntitySelector(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ExternalEntityReference":           schema_pkg_apis_controlplane_v1beta2_ExternalEntityReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCMetadataMatch":                 schema_pkg_apis_controlplane_v1beta2_GRPCMetadataMatch(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol":                      schema_pkg_apis_controlplane_v1beta2_GRPCProtocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupAssociation":                  schema_pkg_apis_controlplane_v1beta2_GroupAssociation(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember":                       schema_pkg_apis_controlplane_v1beta2_GroupMember(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMembers":                      schema_pkg_apis_controlplane_v1beta2_GroupMembers(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupReference":                    schema_pkg_apis_controlplane_v1beta2_GroupReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch":                   schema_pkg_apis_controlplane_v1beta2_HTTPHeaderMatch(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol":                      schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPQueryParamMatch":               schema_pkg_apis_controlplane_v1beta2_HTTPQueryParamMatch(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPBlock":                           schema_pkg_apis_controlplane_v1beta2_IPBlock(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPGroupAssociation":                schema_pkg_apis_controlplane_v1beta2_IPGroupAssociation(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet":                             schema_pkg_apis_controlplane_v1beta2_IPNet(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.KafkaProtocol":                     schema_pkg_apis_controlplane_v1beta2_KafkaProtocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol":                        schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.MulticastGroupInfo":                schema_pkg_apis_controlplane_v1beta2_MulticastGroupInfo(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NamedPort":                         schema_pkg_apis_controlplane_v1beta2_NamedPort(ref),
@@ -69,7 +81,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NodeReference":                     schema_pkg_apis_controlplane_v1beta2_NodeReference(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NodeStatsSummary":                  schema_pkg_apis_controlplane_v1beta2_NodeStatsSummary(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PaginationGetOptions":              schema_pkg_apis_controlplane_v1beta2_PaginationGetOptions(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodQuarantine":                     schema_pkg_apis_controlplane_v1beta2_PodQuarantine(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodQuarantinePacketCapture":        schema_pkg_apis_controlplane_v1beta2_PodQuarantinePacketCapture(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodQuarantineSpec":                 schema_pkg_apis_controlplane_v1beta2_PodQuarantineSpec(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodQuarantineStatus":               schema_pkg_apis_controlplane_v1beta2_PodQuarantineStatus(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference":                      schema_pkg_apis_controlplane_v1beta2_PodReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PolicyAnalysis":                    schema_pkg_apis_controlplane_v1beta2_PolicyAnalysis(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PolicyAnalysisFinding":             schema_pkg_apis_controlplane_v1beta2_PolicyAnalysisFinding(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PolicyAnalysisRequest":             schema_pkg_apis_controlplane_v1beta2_PolicyAnalysisRequest(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PolicyAnalysisResponse":            schema_pkg_apis_controlplane_v1beta2_PolicyAnalysisResponse(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRateLimit":                     schema_pkg_apis_controlplane_v1beta2_RuleRateLimit(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef":                           schema_pkg_apis_controlplane_v1beta2_RuleRef(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service":                           schema_pkg_apis_controlplane_v1beta2_Service(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ServiceReference":                  schema_pkg_apis_controlplane_v1beta2_ServiceReference(ref),
@@ -91,6 +112,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ClusterNetworkPolicyList":                   schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicyList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ClusterNetworkPolicySpec":                   schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicySpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ControllerCondition":                        schema_pkg_apis_crd_v1beta1_ControllerCondition(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol":                                schema_pkg_apis_crd_v1beta1_DNSProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Destination":                                schema_pkg_apis_crd_v1beta1_Destination(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Egress":                                     schema_pkg_apis_crd_v1beta1_Egress(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressCondition":                            schema_pkg_apis_crd_v1beta1_EgressCondition(ref),
@@ -101,12 +123,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolList":                         schema_pkg_apis_crd_v1beta1_ExternalIPPoolList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolSpec":                         schema_pkg_apis_crd_v1beta1_ExternalIPPoolSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolStatus":                       schema_pkg_apis_crd_v1beta1_ExternalIPPoolStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCMetadataMatch":                          schema_pkg_apis_crd_v1beta1_GRPCMetadataMatch(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol":                               schema_pkg_apis_crd_v1beta1_GRPCProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Group":                                      schema_pkg_apis_crd_v1beta1_Group(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition":                             schema_pkg_apis_crd_v1beta1_GroupCondition(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupList":                                  schema_pkg_apis_crd_v1beta1_GroupList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupSpec":                                  schema_pkg_apis_crd_v1beta1_GroupSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupStatus":                                schema_pkg_apis_crd_v1beta1_GroupStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch":                            schema_pkg_apis_crd_v1beta1_HTTPHeaderMatch(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol":                               schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPQueryParamMatch":                        schema_pkg_apis_crd_v1beta1_HTTPQueryParamMatch(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPEchoRequestHeader":                      schema_pkg_apis_crd_v1beta1_ICMPEchoRequestHeader(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPProtocol":                               schema_pkg_apis_crd_v1beta1_ICMPProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IGMPProtocol":                               schema_pkg_apis_crd_v1beta1_IGMPProtocol(ref),
@@ -114,6 +140,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPAddressState":                             schema_pkg_apis_crd_v1beta1_IPAddressState(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPBlock":                                    schema_pkg_apis_crd_v1beta1_IPBlock(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPHeader":                                   schema_pkg_apis_crd_v1beta1_IPHeader(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPListConfigMapSource":                      schema_pkg_apis_crd_v1beta1_IPListConfigMapSource(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPListSource":                               schema_pkg_apis_crd_v1beta1_IPListSource(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPListStatus":                               schema_pkg_apis_crd_v1beta1_IPListStatus(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPool":                                     schema_pkg_apis_crd_v1beta1_IPPool(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolList":                                 schema_pkg_apis_crd_v1beta1_IPPoolList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolSpec":                                 schema_pkg_apis_crd_v1beta1_IPPoolSpec(ref),
@@ -121,6 +150,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolUsage":                                schema_pkg_apis_crd_v1beta1_IPPoolUsage(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPRange":                                    schema_pkg_apis_crd_v1beta1_IPRange(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6Header":                                 schema_pkg_apis_crd_v1beta1_IPv6Header(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.KafkaProtocol":                              schema_pkg_apis_crd_v1beta1_KafkaProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol":                                 schema_pkg_apis_crd_v1beta1_L7Protocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName":                             schema_pkg_apis_crd_v1beta1_NamespacedName(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicy":                              schema_pkg_apis_crd_v1beta1_NetworkPolicy(ref),
@@ -139,7 +169,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.PeerNamespaces":                             schema_pkg_apis_crd_v1beta1_PeerNamespaces(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService":                                schema_pkg_apis_crd_v1beta1_PeerService(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.PodOwner":                                   schema_pkg_apis_crd_v1beta1_PodOwner(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.PolicyExpiry":                               schema_pkg_apis_crd_v1beta1_PolicyExpiry(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Rule":                                       schema_pkg_apis_crd_v1beta1_Rule(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.RuleAuditStatus":                            schema_pkg_apis_crd_v1beta1_RuleAuditStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.RuleRateLimit":                              schema_pkg_apis_crd_v1beta1_RuleRateLimit(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.RuleSchedule":                               schema_pkg_apis_crd_v1beta1_RuleSchedule(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.RuleScheduleStatus":                         schema_pkg_apis_crd_v1beta1_RuleScheduleStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.SCTPHeader":                                 schema_pkg_apis_crd_v1beta1_SCTPHeader(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.ScheduleWindow":                             schema_pkg_apis_crd_v1beta1_ScheduleWindow(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Source":                                     schema_pkg_apis_crd_v1beta1_Source(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.StatefulSetOwner":                           schema_pkg_apis_crd_v1beta1_StatefulSetOwner(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.SubnetInfo":                                 schema_pkg_apis_crd_v1beta1_SubnetInfo(ref),
@@ -154,6 +191,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowStatus":                            schema_pkg_apis_crd_v1beta1_TraceflowStatus(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TransportHeader":                            schema_pkg_apis_crd_v1beta1_TransportHeader(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.UDPHeader":                                  schema_pkg_apis_crd_v1beta1_UDPHeader(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.WorkloadIdentity":                           schema_pkg_apis_crd_v1beta1_WorkloadIdentity(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.AntreaClusterNetworkPolicyStats":         schema_pkg_apis_stats_v1alpha1_AntreaClusterNetworkPolicyStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.AntreaClusterNetworkPolicyStatsList":     schema_pkg_apis_stats_v1alpha1_AntreaClusterNetworkPolicyStatsList(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.AntreaNetworkPolicyStats":                schema_pkg_apis_stats_v1alpha1_AntreaNetworkPolicyStats(ref),
@@ -941,11 +979,12 @@ func schema_pkg_apis_controlplane_v1beta2_ClusterGroupMembers(ref common.Referen
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_EgressGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrix(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ConnectivityMatrix contains the request and response for a bulk NetworkPolicy evaluation, which evaluates the connections from multiple source Pods to multiple destination Pods at once.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -967,184 +1006,243 @@ func schema_pkg_apis_controlplane_v1beta2_EgressGroup(ref common.ReferenceCallba
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"groupMembers": {
+					"request": {
 						SchemaProps: spec.SchemaProps{
-							Description: "GroupMembers is list of resources selected by this group.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember"),
-									},
-								},
-							},
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixRequest"),
+						},
+					},
+					"response": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixResponse"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixRequest", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixResponse", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_EgressGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrixEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EgressGroupList is a list of EgressGroup objects.",
+				Description: "ConnectivityMatrixEntry describes the effective action of the connections from a source Pod to a destination Pod, along with the NetworkPolicy rule deciding it.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"),
 						},
 					},
-					"apiVersion": {
+					"destination": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Default: map[string]interface{}{},
+							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"),
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The effective action of the connections, which can be Allow, Drop or Reject.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"networkPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "The reference of the effective NetworkPolicy. It is nil if no NetworkPolicy applies to the connections, in which case they are allowed.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference"),
 						},
 					},
-					"items": {
+					"ruleIndex": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroup"),
-									},
-								},
-							},
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"rule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The content of the effective rule.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"source", "destination", "action"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroup", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_EgressGroupPatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrixRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EgressGroupPatch describes the incremental update of an EgressGroup.",
+				Description: "ConnectivityMatrixRequest is the request body of a bulk NetworkPolicy evaluation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"TypeMeta": {
+					"sources": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta"),
+							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.EntitySelector"),
 						},
 					},
-					"ObjectMeta": {
+					"destinations": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.EntitySelector"),
 						},
 					},
-					"AddedGroupMembers": {
+					"protocol": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember"),
-									},
-								},
-							},
+							Description: "Protocol of the evaluated connections. It must be TCP, UDP or SCTP, and defaults to TCP.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"RemovedGroupMembers": {
+					"port": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Destination port of the evaluated connections.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EntitySelector"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrixResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConnectivityMatrixResponse is the response of a bulk NetworkPolicy evaluation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"entries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Entries contains one entry for each pair of different source and destination Pods.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember"),
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixEntry"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"TypeMeta", "ObjectMeta", "AddedGroupMembers", "RemovedGroupMembers"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixEntry"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_Entity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_DNSProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Entity contains Namespace and Pod name as a request parameter.",
+				Description: "DNSProtocol matches DNS queries with specific query name and record types. All fields could be used alone or together. If all fields are not provided, this matches all DNS queries.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"pod": {
+					"queryName": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"),
+							Description: "QueryName represents the name being resolved to match. Both exact matches and wildcards are supported (Ex. \"*.foo.com\", \"foo.bar.com\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"recordTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecordTypes is a list of record types of the query to match (Ex. \"A\", \"AAAA\", \"TXT\").",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"rejectResponseCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RejectResponseCode is the response code of the DNS response sent to the client when a DNS query does not match the rule. It could be REFUSED or NXDOMAIN. It applies to the whole rule, hence must be the same in all DNS protocols of the rule.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_ExternalEntityReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_DeniedConnection(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExternalEntityReference represents a ExternalEntity Reference.",
+				Description: "DeniedConnection describes a connection between two Pods which is currently allowed and would be denied by a candidate NetworkPolicy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of this ExternalEntity.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"),
 						},
 					},
-					"namespace": {
+					"destination": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The Namespace of this ExternalEntity.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"),
+						},
+					},
+					"networkPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reference of the NetworkPolicy which would deny the connection.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference"),
+						},
+					},
+					"ruleIndex": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"rule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The content of the rule which would deny the connection.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef"),
 						},
 					},
 				},
+				Required: []string{"source", "destination"},
 			},
 		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_GroupAssociation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_EgressGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GroupAssociation is the message format in an API response for groupassociation queries.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -1166,124 +1264,97 @@ func schema_pkg_apis_controlplane_v1beta2_GroupAssociation(ref common.ReferenceC
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"associatedGroups": {
+					"groupMembers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AssociatedGroups is a list of GroupReferences that is associated with the Pod/ExternalEntity being queried.",
+							Description: "GroupMembers is list of resources selected by this group.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupReference"),
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"associatedGroups"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_GroupMember(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_EgressGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GroupMember represents resource member to be populated in Groups.",
+				Description: "EgressGroupList is a list of EgressGroup objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"pod": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pod maintains the reference to the Pod.",
-							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"externalEntity": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExternalEntity maintains the reference to the ExternalEntity.",
-							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.ExternalEntityReference"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"ips": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IP is the IP address of the Endpoints associated with the GroupMember.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "byte",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"ports": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ports is the list NamedPort of the GroupMember.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NamedPort"),
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroup"),
 									},
 								},
 							},
 						},
 					},
-					"node": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Node maintains the reference to the Node.",
-							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NodeReference"),
-						},
-					},
-					"service": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Service is the reference to the Service. It can only be used in an AppliedTo Group and only a NodePort type Service can be referred by this field.",
-							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.ServiceReference"),
-						},
-					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ExternalEntityReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NamedPort", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NodeReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.ServiceReference"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroup", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_GroupMembers(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_EgressGroupPatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GroupMembers is a list of GroupMember objects or IPBlocks that are currently selected by a Group.",
+				Description: "EgressGroupPatch describes the incremental update of an EgressGroup.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"TypeMeta": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta"),
 						},
 					},
-					"metadata": {
+					"ObjectMeta": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"effectiveMembers": {
+					"AddedGroupMembers": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -1296,158 +1367,187 @@ func schema_pkg_apis_controlplane_v1beta2_GroupMembers(ref common.ReferenceCallb
 							},
 						},
 					},
-					"effectiveIPBlocks": {
+					"RemovedGroupMembers": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet"),
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember"),
 									},
 								},
 							},
 						},
 					},
-					"totalMembers": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
-						},
-					},
-					"totalPages": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
-						},
-					},
-					"currentPage": {
+				},
+				Required: []string{"TypeMeta", "ObjectMeta", "AddedGroupMembers", "RemovedGroupMembers"},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_Entity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Entity contains Namespace and Pod name as a request parameter.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pod": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"),
 						},
 					},
 				},
-				Required: []string{"effectiveMembers", "effectiveIPBlocks", "totalMembers", "totalPages", "currentPage"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_GroupReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_EntitySelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "EntitySelector selects Pods by Namespace and labels as a request parameter.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace of the Group. Empty for ClusterGroup.",
+							Description: "Namespace of the selected Pods. Namespace and NamespaceSelector cannot be set at the same time. If both are empty, Pods are selected from all Namespaces.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"namespaceSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the Group.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "NamespaceSelector selects the Namespaces of the selected Pods.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"uid": {
+					"podSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UID of the Group.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "PodSelector selects Pods by labels. If it is nil, all Pods in the selected Namespaces are selected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_ExternalEntityReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together. If all fields are not provided, it matches all HTTP requests.",
+				Description: "ExternalEntityReference represents a ExternalEntity Reference.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"host": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Host represents the hostname present in the URI or the HTTP Host header to match. It does not contain the port associated with the host.",
+							Description: "The name of this ExternalEntity.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"method": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Method represents the HTTP method to match. It could be GET, POST, PUT, HEAD, DELETE, TRACE, OPTIONS, CONNECT and PATCH.",
+							Description: "The Namespace of this ExternalEntity.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"path": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_GRPCMetadataMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCMetadataMatch matches a gRPC metadata entry with a specific name and value.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path represents the URI path to match (Ex. \"/index.html\", \"/admin\").",
+							Description: "Name represents the name of the metadata entry to match. It is case-insensitive.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value represents the exact value of the metadata entry to match. If it is not provided, any value is matched.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_IPBlock(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_GRPCProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPBlock describes a particular CIDR (Ex. \"192.168.1.1/24\"). The except entry describes CIDRs that should not be included within this rule.",
+				Description: "GRPCProtocol matches gRPC requests with specific service, method, and metadata. All fields could be used alone or together. If all fields are not provided, this matches all gRPC requests.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"cidr": {
+					"service": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CIDR is an IPNet represents the IP Block.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet"),
+							Description: "Service represents the fully-qualified name of the gRPC service to match (Ex. \"payments.Ledger\").",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"except": {
+					"method": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Except is a slice of IPNets that should not be included within an IP Block. Except values will be rejected if they are outside the CIDR range.",
+							Description: "Method represents the name of the gRPC method to match (Ex. \"Read\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata is a list of metadata which must all be present in the gRPC request.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet"),
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCMetadataMatch"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"cidr"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCMetadataMatch"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_IPGroupAssociation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_GroupAssociation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "GroupAssociation is the message format in an API response for groupassociation queries.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -1471,7 +1571,7 @@ func schema_pkg_apis_controlplane_v1beta2_IPGroupAssociation(ref common.Referenc
 					},
 					"associatedGroups": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AssociatedGroups is a list of GroupReferences that is associated with the IP address being queried.",
+							Description: "AssociatedGroups is a list of GroupReferences that is associated with the Pod/ExternalEntity being queried.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -1492,131 +1592,78 @@ func schema_pkg_apis_controlplane_v1beta2_IPGroupAssociation(ref common.Referenc
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_IPNet(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "IPNet describes an IP network.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ip": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
-						},
-					},
-					"prefixLength": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_GroupMember(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "L7Protocol defines application layer protocol to match.",
+				Description: "GroupMember represents resource member to be populated in Groups.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"http": {
+					"pod": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol"),
+							Description: "Pod maintains the reference to the Pod.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"),
 						},
 					},
-					"tls": {
+					"externalEntity": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"),
+							Description: "ExternalEntity maintains the reference to the ExternalEntity.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.ExternalEntityReference"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"},
-	}
-}
-
-func schema_pkg_apis_controlplane_v1beta2_MulticastGroupInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MulticastGroupInfo contains the list of Pods that have joined a multicast group, for a given Node.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"group": {
+					"ips": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Group is the IP of the multicast group.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "IP is the IP address of the Endpoints associated with the GroupMember.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "byte",
+									},
+								},
+							},
 						},
 					},
-					"pods": {
+					"ports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the list of Pods that have joined the multicast group.",
+							Description: "Ports is the list NamedPort of the GroupMember.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"),
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NamedPort"),
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"},
-	}
-}
-
-func schema_pkg_apis_controlplane_v1beta2_NamedPort(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "NamedPort represents a Port with a name on Pod.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"port": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Port represents the Port number.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"name": {
+					"node": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name represents the associated name with this Port number.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Node maintains the reference to the Node.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NodeReference"),
 						},
 					},
-					"protocol": {
+					"service": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Protocol for port. Must be UDP, TCP, or SCTP.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Service is the reference to the Service. It can only be used in an AppliedTo Group and only a NodePort type Service can be referred by this field.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.ServiceReference"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ExternalEntityReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NamedPort", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NodeReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.ServiceReference"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_NetworkPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_GroupMembers(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NetworkPolicy is the message format of antrea/pkg/controller/types.NetworkPolicy in an API response.",
+				Description: "GroupMembers is a list of GroupMember objects or IPBlocks that are currently selected by a Group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -1639,177 +1686,280 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicy(ref common.ReferenceCall
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"rules": {
+					"effectiveMembers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Rules is a list of rules to be applied to the selected GroupMembers.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyRule"),
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember"),
 									},
 								},
 							},
 						},
 					},
-					"appliedToGroups": {
+					"effectiveIPBlocks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AppliedToGroups is a list of names of AppliedToGroups to which this policy applies. Cannot be set in conjunction with any NetworkPolicyRule.AppliedToGroups in Rules.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet"),
 									},
 								},
 							},
 						},
 					},
-					"priority": {
+					"totalMembers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Priority represents the relative priority of this Network Policy as compared to other Network Policies. Priority will be unset (nil) for K8s NetworkPolicy.",
-							Type:        []string{"number"},
-							Format:      "double",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
-					"tierPriority": {
+					"totalPages": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TierPriority represents the priority of the Tier associated with this Network Policy. The TierPriority will remain nil for K8s NetworkPolicy.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
-					"sourceRef": {
+					"currentPage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to the original NetworkPolicy that the internal NetworkPolicy is created for.",
-							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference"),
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
 				},
+				Required: []string{"effectiveMembers", "effectiveIPBlocks", "totalMembers", "totalPages", "currentPage"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyRule", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyEvaluation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_GroupReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NetworkPolicyEvaluation contains the request and response for a NetworkPolicy evaluation.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Namespace of the Group. Empty for ClusterGroup.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Name of the Group.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"uid": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObjectMeta was omitted by mistake when this type was first defined, and was added later on. To ensure backwards-compatibility, we had to use Protobuf field number 3 when adding the field, as 1 was already taken by the request field. This is unusual, as K8s API types always use 1 as the Protobuf field number for the metadata field, and that's also what we do for all other Antrea API types. It should only affect the wire format, and nothing else. When a new version of this API is introduced in the future (e.g., v1), we can correct this and assign 1 as the Protobuf field number for the metadata field.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "UID of the Group.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"request": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_HTTPHeaderMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeaderMatch matches an HTTP request header with a specific name and value. Value and Regex cannot be set at the same time. If neither is provided, any value is matched.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyEvaluationRequest"),
+							Description: "Name represents the name of the header to match. It is case-insensitive.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"response": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyEvaluationResponse"),
+							Description: "Value represents the exact value of the header to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"regex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Regex represents a regular expression which must match the whole value of the header.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyEvaluationRequest", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyEvaluationResponse", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyEvaluationRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NetworkPolicyEvaluationRequest is the request body of NetworkPolicy evaluation.",
+				Description: "HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together. If all fields are not provided, it matches all HTTP requests.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"source": {
+					"host": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.Entity"),
+							Description: "Host represents the hostname present in the URI or the HTTP Host header to match. It does not contain the port associated with the host.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"destination": {
+					"method": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.Entity"),
+							Description: "Method represents the HTTP method to match. It could be GET, POST, PUT, HEAD, DELETE, TRACE, OPTIONS, CONNECT and PATCH.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path represents the URI path to match (Ex. \"/index.html\", \"/admin\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers is a list of HTTP request headers which must all be present in the request.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch"),
+									},
+								},
+							},
+						},
+					},
+					"queryParams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryParams is a list of query parameters which must all be present in the request URI.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPQueryParamMatch"),
+									},
+								},
+							},
+						},
+					},
+					"userAgent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserAgent represents the User-Agent header of the request to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rejectStatusCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RejectStatusCode is the status code of the HTTP response sent to the client when an HTTP request does not match the rule. If it is not provided, the TCP connection is reset. It applies to the whole rule, hence must be the same in all HTTP protocols of the rule.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.Entity"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPQueryParamMatch"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyEvaluationResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_HTTPQueryParamMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NetworkPolicyEvaluationResponse is the response of NetworkPolicy evaluation.",
+				Description: "HTTPQueryParamMatch matches a query parameter of an HTTP request URI with a specific name and value. If Value is not provided, any value is matched.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"networkPolicy": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reference of the effective NetworkPolicy.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference"),
+							Description: "Name represents the name of the query parameter to match.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"ruleIndex": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Value represents the exact value of the query parameter to match.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"rule": {
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_IPBlock(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPBlock describes a particular CIDR (Ex. \"192.168.1.1/24\"). The except entry describes CIDRs that should not be included within this rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cidr": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The content of the effective rule.",
+							Description: "CIDR is an IPNet represents the IP Block.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef"),
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet"),
+						},
+					},
+					"except": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Except is a slice of IPNets that should not be included within an IP Block. Except values will be rejected if they are outside the CIDR range.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"cidr"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_IPGroupAssociation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NetworkPolicyList is a list of NetworkPolicy objects.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -1828,149 +1978,952 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyList(ref common.Reference
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"associatedGroups": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "AssociatedGroups is a list of GroupReferences that is associated with the IP address being queried.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicy"),
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupReference"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"associatedGroups"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicy", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyNodeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_IPNet(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NetworkPolicyNodeStatus is the status of a NetworkPolicy on a Node.",
+				Description: "IPNet describes an IP network.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"nodeName": {
+					"ip": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of the Node that produces the status.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "byte",
 						},
 					},
-					"generation": {
+					"prefixLength": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The generation realized by the Node.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"realizationFailure": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_KafkaProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KafkaProtocol matches Kafka requests with specific API key and topic. All fields could be used alone or together. If all fields are not provided, this matches all produce, fetch and metadata requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The flag to mark the NetworkPolicy realization is failed on the Node or not.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "APIKey represents the type of the Kafka request to match. It could be produce, fetch and metadata.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"topic": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The error message to describe why the NetworkPolicy realization is failed on the Node.",
+							Description: "Topic represents the name of a topic in the Kafka request to match.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"realizationFailure"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyPeer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NetworkPolicyPeer describes a peer of NetworkPolicyRules. It could be a list of names of AddressGroups and/or a list of IPBlock.",
+				Description: "L7Protocol defines application layer protocol to match.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"addressGroups": {
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"),
+						},
+					},
+					"grpc": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol"),
+						},
+					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol"),
+						},
+					},
+					"kafka": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.KafkaProtocol"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.KafkaProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_MulticastGroupInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MulticastGroupInfo contains the list of Pods that have joined a multicast group, for a given Node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group is the IP of the multicast group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pods is the list of Pods that have joined the multicast group.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_NamedPort(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamedPort represents a Port with a name on Pod.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port represents the Port number.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name represents the associated name with this Port number.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol for port. Must be UDP, TCP, or SCTP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_NetworkPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicy is the message format of antrea/pkg/controller/types.NetworkPolicy in an API response.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"rules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules is a list of rules to be applied to the selected GroupMembers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyRule"),
+									},
+								},
+							},
+						},
+					},
+					"appliedToGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppliedToGroups is a list of names of AppliedToGroups to which this policy applies. Cannot be set in conjunction with any NetworkPolicyRule.AppliedToGroups in Rules.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority represents the relative priority of this Network Policy as compared to other Network Policies. Priority will be unset (nil) for K8s NetworkPolicy.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"tierPriority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TierPriority represents the priority of the Tier associated with this Network Policy. The TierPriority will remain nil for K8s NetworkPolicy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"sourceRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference to the original NetworkPolicy that the internal NetworkPolicy is created for.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference"),
+						},
+					},
+					"enforcementMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementMode specifies how the rules of this policy are enforced. It will remain empty for K8s NetworkPolicy, whose rules are always enforced.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyRule", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyEvaluation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyEvaluation contains the request and response for a NetworkPolicy evaluation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectMeta was omitted by mistake when this type was first defined, and was added later on. To ensure backwards-compatibility, we had to use Protobuf field number 3 when adding the field, as 1 was already taken by the request field. This is unusual, as K8s API types always use 1 as the Protobuf field number for the metadata field, and that's also what we do for all other Antrea API types. It should only affect the wire format, and nothing else. When a new version of this API is introduced in the future (e.g., v1), we can correct this and assign 1 as the Protobuf field number for the metadata field.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"request": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyEvaluationRequest"),
+						},
+					},
+					"response": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyEvaluationResponse"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyEvaluationRequest", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyEvaluationResponse", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyEvaluationRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyEvaluationRequest is the request body of NetworkPolicy evaluation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.Entity"),
+						},
+					},
+					"destination": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.Entity"),
+						},
+					},
+					"candidatePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CandidatePolicy is an Antrea ClusterNetworkPolicy, Antrea NetworkPolicy or K8s NetworkPolicy which has not been created yet. When it is set, the evaluation returns the connections between Pods which are currently allowed and would be denied once the policy is created. Source and Destination are optional in that case and only filter the returned connections.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.Entity", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyEvaluationResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyEvaluationResponse is the response of NetworkPolicy evaluation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"networkPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reference of the effective NetworkPolicy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference"),
+						},
+					},
+					"ruleIndex": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"rule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The content of the effective rule.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef"),
+						},
+					},
+					"deniedConnections": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The connections which would be denied by the candidate NetworkPolicy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.DeniedConnection"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.DeniedConnection", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyList is a list of NetworkPolicy objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicy"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicy", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyNodeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyNodeStatus is the status of a NetworkPolicy on a Node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the Node that produces the status.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The generation realized by the Node.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"realizationFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The flag to mark the NetworkPolicy realization is failed on the Node or not.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The error message to describe why the NetworkPolicy realization is failed on the Node.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"realizationFailure"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyPeer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyPeer describes a peer of NetworkPolicyRules. It could be a list of names of AddressGroups and/or a list of IPBlock.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"addressGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A list of names of AddressGroups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"ipBlocks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A list of IPBlock.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPBlock"),
+									},
+								},
+							},
+						},
+					},
+					"fqdns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A list of exact FQDN names or FQDN wildcard expressions. This field can only be possibly set for NetworkPolicyPeer of egress rules.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"toServices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A list of ServiceReference. This field can only be possibly set for NetworkPolicyPeer of egress rules.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.ServiceReference"),
+									},
+								},
+							},
+						},
+					},
+					"labelIdentities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A list of labelIdentities selected as ingress peers for stretched policy. This field can only be possibly set for NetworkPolicyPeer of ingress rules.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int64",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPBlock", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.ServiceReference"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the NetworkPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the NetworkPolicy. It's empty for Antrea ClusterNetworkPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the NetworkPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"uid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UID of the NetworkPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyRule describes a particular set of traffic that is allowed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"direction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The direction of this rule. If it's set to In, From must be set and To must not be set. If it's set to Out, To must be set and From must not be set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "From represents sources which should be able to access the GroupMembers selected by the policy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer"),
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Description: "To represents destinations which should be able to be accessed by the GroupMembers selected by the policy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer"),
+						},
+					},
+					"services": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Services is a list of services which should be matched.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service"),
+									},
+								},
+							},
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority defines the priority of the Rule as compared to other rules in the NetworkPolicy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action specifies the action to be applied on the rule. i.e. Allow/Drop. An empty action “nil” defaults to Allow action, which would be the case for rules created for K8s Network Policy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"enableLogging": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnableLogging indicates whether or not to generate logs when rules are matched. Default to false.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"appliedToGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppliedToGroups is a list of names of AppliedToGroups to which this rule applies. Cannot be set in conjunction with NetworkPolicy.AppliedToGroups of the NetworkPolicy that this Rule is referred to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name describes the intention of this rule. Name should be unique within the policy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"l7Protocols": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7Protocols is a list of application layer protocols which should be matched.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol"),
+									},
+								},
+							},
+						},
+					},
+					"logLabel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit specifies the limits applied to the traffic matched by the rule.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRateLimit"),
+						},
+					},
+					"rejectResponse": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RejectResponse is the response sent to the client when traffic is rejected by the rule. An empty value means the default response.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enableLogging"},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRateLimit", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyStats contains the information and traffic stats of a NetworkPolicy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"networkPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reference of the NetworkPolicy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference"),
+						},
+					},
+					"trafficStats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The stats of the NetworkPolicy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"),
+						},
+					},
+					"ruleTrafficStats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The stats of the NetworkPolicy rules. It's empty for K8s NetworkPolicies as they don't have rule name to identify a rule.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.RuleTrafficStats"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference", "antrea.io/antrea/pkg/apis/stats/v1alpha1.RuleTrafficStats", "antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyStatus is the status of a NetworkPolicy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes contains statuses produced on a list of Nodes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyNodeStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyNodeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_NodeReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeReference represents a Node Reference.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of this Node.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_NodeStatsSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeStatsSummary contains stats produced on a Node. It's used by the antrea-agents to report stats to the antrea-controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A list of names of AddressGroups.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"ipBlocks": {
+					"networkPolicies": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A list of IPBlock.",
+							Description: "The TrafficStats of K8s NetworkPolicies collected from the Node.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPBlock"),
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyStats"),
 									},
 								},
 							},
 						},
 					},
-					"fqdns": {
+					"antreaClusterNetworkPolicies": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A list of exact FQDN names or FQDN wildcard expressions. This field can only be possibly set for NetworkPolicyPeer of egress rules.",
+							Description: "The TrafficStats of Antrea ClusterNetworkPolicies collected from the Node.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyStats"),
 									},
 								},
 							},
 						},
 					},
-					"toServices": {
+					"antreaNetworkPolicies": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A list of ServiceReference. This field can only be possibly set for NetworkPolicyPeer of egress rules.",
+							Description: "The TrafficStats of Antrea NetworkPolicies collected from the Node.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.ServiceReference"),
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyStats"),
 									},
 								},
 							},
 						},
 					},
-					"labelIdentities": {
+					"multicast": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A list of labelIdentities selected as ingress peers for stretched policy. This field can only be possibly set for NetworkPolicyPeer of ingress rules.",
+							Description: "Multicast group information collected from the Node.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: 0,
-										Type:    []string{"integer"},
-										Format:  "int64",
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.MulticastGroupInfo"),
 									},
 								},
 							},
@@ -1980,268 +2933,222 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyPeer(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPBlock", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.ServiceReference"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.MulticastGroupInfo", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_PaginationGetOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of the NetworkPolicy.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace of the NetworkPolicy. It's empty for Antrea ClusterNetworkPolicy.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"page": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the NetworkPolicy.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
-					"uid": {
+					"limit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UID of the NetworkPolicy.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
 				},
+				Required: []string{"page", "limit"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_PodQuarantine(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NetworkPolicyRule describes a particular set of traffic that is allowed.",
+				Description: "PodQuarantine isolates a Pod by enforcing an Antrea ClusterNetworkPolicy in the Emergency Tier, which drops all traffic of the Pod except the traffic with the allowed peers. The Namespace and name of a PodQuarantine are the ones of the quarantined Pod. Deleting it releases the Pod.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"direction": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The direction of this rule. If it's set to In, From must be set and To must not be set. If it's set to Out, To must be set and From must not be set.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"from": {
-						SchemaProps: spec.SchemaProps{
-							Description: "From represents sources which should be able to access the GroupMembers selected by the policy.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer"),
-						},
-					},
-					"to": {
-						SchemaProps: spec.SchemaProps{
-							Description: "To represents destinations which should be able to be accessed by the GroupMembers selected by the policy.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer"),
-						},
-					},
-					"services": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Services is a list of services which should be matched.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service"),
-									},
-								},
-							},
-						},
-					},
-					"priority": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Priority defines the priority of the Rule as compared to other rules in the NetworkPolicy.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"action": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Action specifies the action to be applied on the rule. i.e. Allow/Drop. An empty action “nil” defaults to Allow action, which would be the case for rules created for K8s Network Policy.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"enableLogging": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EnableLogging indicates whether or not to generate logs when rules are matched. Default to false.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"appliedToGroups": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AppliedToGroups is a list of names of AppliedToGroups to which this rule applies. Cannot be set in conjunction with NetworkPolicy.AppliedToGroups of the NetworkPolicy that this Rule is referred to.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodQuarantineSpec"),
 						},
 					},
-					"name": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name describes the intention of this rule. Name should be unique within the policy.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodQuarantineStatus"),
 						},
 					},
-					"l7Protocols": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodQuarantineSpec", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodQuarantineStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_PodQuarantinePacketCapture(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodQuarantinePacketCapture describes the PacketCapture started for a quarantined Pod.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"number": {
 						SchemaProps: spec.SchemaProps{
-							Description: "L7Protocols is a list of application layer protocols which should be matched.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol"),
-									},
-								},
-							},
+							Description: "Number of packets to capture.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"logLabel": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Timeout of the capture in seconds. Defaults to the PacketCapture default timeout.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"enableLogging"},
+				Required: []string{"number"},
 			},
 		},
-		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_PodQuarantineSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NetworkPolicyStats contains the information and traffic stats of a NetworkPolicy.",
+				Description: "PodQuarantineSpec describes the traffic still allowed for a quarantined Pod.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"networkPolicy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The reference of the NetworkPolicy.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference"),
-						},
-					},
-					"trafficStats": {
+					"allowedNamespaces": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The stats of the NetworkPolicy.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"),
+							Description: "AllowedNamespaces are the Namespaces of the Pods which can still connect to and be reached from the quarantined Pod, e.g. the Namespace of forensics tooling.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"ruleTrafficStats": {
+					"allowedCIDRs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The stats of the NetworkPolicy rules. It's empty for K8s NetworkPolicies as they don't have rule name to identify a rule.",
+							Description: "AllowedCIDRs are the IP blocks which can still connect to and be reached from the quarantined Pod.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.RuleTrafficStats"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
+					"packetCapture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PacketCapture starts capturing the packets of the quarantined Pod when set.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodQuarantinePacketCapture"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference", "antrea.io/antrea/pkg/apis/stats/v1alpha1.RuleTrafficStats", "antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodQuarantinePacketCapture"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_PodQuarantineStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NetworkPolicyStatus is the status of a NetworkPolicy.",
+				Description: "PodQuarantineStatus references the resources created to quarantine a Pod.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"policyName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name of the Antrea ClusterNetworkPolicy isolating the Pod.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"packetCaptureName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Name of the PacketCapture capturing the packets of the Pod, if any.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"nodes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Nodes contains statuses produced on a list of Nodes.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyNodeStatus"),
-									},
-								},
-							},
-						},
-					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyNodeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_NodeReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_PodReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NodeReference represents a Node Reference.",
+				Description: "PodReference represents a Pod Reference.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of this Node.",
+							Description: "The name of this Pod.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Namespace of this Pod.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2252,11 +3159,11 @@ func schema_pkg_apis_controlplane_v1beta2_NodeReference(ref common.ReferenceCall
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_NodeStatsSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_PolicyAnalysis(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NodeStatsSummary contains stats produced on a Node. It's used by the antrea-agents to report stats to the antrea-controller.",
+				Description: "PolicyAnalysis contains the request and response for an analysis of the Antrea-native policy rules, which reports the rules that can never match any traffic, or haven't matched any traffic recently.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2279,130 +3186,153 @@ func schema_pkg_apis_controlplane_v1beta2_NodeStatsSummary(ref common.ReferenceC
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"networkPolicies": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The TrafficStats of K8s NetworkPolicies collected from the Node.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyStats"),
-									},
-								},
-							},
-						},
-					},
-					"antreaClusterNetworkPolicies": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The TrafficStats of Antrea ClusterNetworkPolicies collected from the Node.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyStats"),
-									},
-								},
-							},
-						},
-					},
-					"antreaNetworkPolicies": {
+					"request": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The TrafficStats of Antrea NetworkPolicies collected from the Node.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyStats"),
-									},
-								},
-							},
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PolicyAnalysisRequest"),
 						},
 					},
-					"multicast": {
+					"response": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Multicast group information collected from the Node.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.MulticastGroupInfo"),
-									},
-								},
-							},
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PolicyAnalysisResponse"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.MulticastGroupInfo", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PolicyAnalysisRequest", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.PolicyAnalysisResponse", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_PaginationGetOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_PolicyAnalysisFinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "PolicyAnalysisFinding describes an issue found with a policy rule.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"apiVersion": {
+					"networkPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "The reference of the NetworkPolicy the rule belongs to.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference"),
+						},
+					},
+					"rule": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef"),
+						},
+					},
+					"coveringNetworkPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reference of the NetworkPolicy the higher precedence rule belongs to. It's only set for ShadowedRule and RedundantRule findings.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference"),
+						},
+					},
+					"coveringRule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The higher precedence rule which matches all the traffic of the rule. It's only set for ShadowedRule and RedundantRule findings.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human-readable description of the finding.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"page": {
+				},
+				Required: []string{"type", "networkPolicy", "rule"},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_PolicyAnalysisRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PolicyAnalysisRequest is the request body of a policy analysis.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"zeroHitWindowSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Description: "ZeroHitWindowSeconds is the length of the window, in seconds, used to report the rules which haven't matched any traffic according to the NetworkPolicyStats. The report of such rules is disabled if it is 0.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"limit": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_PolicyAnalysisResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PolicyAnalysisResponse is the response of a policy analysis.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"findings": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PolicyAnalysisFinding"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"page", "limit"},
 			},
 		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PolicyAnalysisFinding"},
 	}
 }
 
-func schema_pkg_apis_controlplane_v1beta2_PodReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_controlplane_v1beta2_RuleRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PodReference represents a Pod Reference.",
+				Description: "RuleRateLimit describes the limits applied to the traffic matched by a rule on each Node.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"connectionsPerSecond": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of this Pod.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ConnectionsPerSecond is the maximum number of new connections per second. 0 means no limit.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"namespace": {
+					"bandwidthRate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The Namespace of this Pod.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "BandwidthRate is the maximum bandwidth in kilobits per second. 0 means no limit.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"bandwidthBurst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BandwidthBurst is the maximum burst size in kilobits when the traffic exceeds BandwidthRate.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
@@ -3174,6 +4104,12 @@ func schema_pkg_apis_crd_v1beta1_AppliedTo(ref common.ReferenceCallback) common.
 							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName"),
 						},
 					},
+					"identity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Select all Pods and ExternalEntities with the workload identity matched by this field, as workloads in AppliedTo fields. Cannot be set with any other selector.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.WorkloadIdentity"),
+						},
+					},
 					"service": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Select a certain Service which matches the NamespacedName. A Service can only be set in either policy level AppliedTo field in a policy that only has ingress rules or rule level AppliedTo field in an ingress rule. Only a NodePort Service can be referred by this field. Cannot be set with any other selector.",
@@ -3190,7 +4126,7 @@ func schema_pkg_apis_crd_v1beta1_AppliedTo(ref common.ReferenceCallback) common.
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName", "antrea.io/antrea/pkg/apis/crd/v1beta1.WorkloadIdentity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -3482,12 +4418,25 @@ func schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicySpec(ref common.ReferenceCa
 							},
 						},
 					},
+					"enforcementMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementMode specifies how the rules of this policy are enforced. Defaults to Enforce. In Audit mode, the rules are not enforced: traffic matching a rule is logged, but neither denied nor allowed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expiry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expiry specifies when this policy expires. An expired policy is no longer enforced, as if it had no rule, and an Expired condition is added to its status. The policy never expires if not set.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.PolicyExpiry"),
+						},
+					},
 				},
 				Required: []string{"priority"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.Rule"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.PolicyExpiry", "antrea.io/antrea/pkg/apis/crd/v1beta1.Rule"},
 	}
 }
 
@@ -3542,6 +4491,48 @@ func schema_pkg_apis_crd_v1beta1_ControllerCondition(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_DNSProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSProtocol matches DNS queries with specific query name and record types. All fields could be used alone or together. If all fields are not provided, this matches all DNS queries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"queryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryName represents the name being resolved to match. Both exact matches and wildcards are supported (Ex. \"*.foo.com\", \"foo.bar.com\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"recordTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecordTypes is a list of record types of the query to match (Ex. \"A\", \"AAAA\", \"TXT\").",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"rejectResponseCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RejectResponseCode is the response code of the DNS response sent to the client when a DNS query does not match the rule. It could be REFUSED or NXDOMAIN, and defaults to REFUSED. It applies to the whole rule, hence must be the same in all DNS protocols of the rule.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Destination(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4000,7 +4991,79 @@ func schema_pkg_apis_crd_v1beta1_ExternalIPPoolStatus(ref common.ReferenceCallba
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolUsage"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolUsage"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_GRPCMetadataMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCMetadataMatch matches a gRPC metadata entry with a specific name and value.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name represents the name of the metadata entry to match. It is case-insensitive.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value represents the exact value of the metadata entry to match. If it is not provided, any value is matched.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_GRPCProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCProtocol matches gRPC requests with specific service, method, and metadata. All fields could be used alone or together. If all fields are not provided, this matches all gRPC requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service represents the fully-qualified name of the gRPC service to match (Ex. \"payments.Ledger\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method represents the name of the gRPC method to match (Ex. \"Read\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata is a list of metadata which must all be present in the gRPC request.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCMetadataMatch"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCMetadataMatch"},
 	}
 }
 
@@ -4195,11 +5258,17 @@ func schema_pkg_apis_crd_v1beta1_GroupSpec(ref common.ReferenceCallback) common.
 							},
 						},
 					},
+					"ipList": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPList describes a list of IP addresses and CIDRs that is read from an external source by the controller, and refreshed periodically. It is intended for large lists, e.g. threat-intelligence blocklists. IPList cannot be set as part of the AppliedTo field. Cannot be set with any other selector, IPBlocks or ServiceReference.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.IPListSource"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPBlock", "antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPBlock", "antrea.io/antrea/pkg/apis/crd/v1beta1.IPListSource", "antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -4223,11 +5292,53 @@ func schema_pkg_apis_crd_v1beta1_GroupStatus(ref common.ReferenceCallback) commo
 							},
 						},
 					},
+					"ipList": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPList reports the state of the IP list of the Group, if it has one.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.IPListStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition", "antrea.io/antrea/pkg/apis/crd/v1beta1.IPListStatus"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_HTTPHeaderMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeaderMatch matches an HTTP request header with a specific name and value. Value and Regex cannot be set at the same time. If neither is provided, any value is matched.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name represents the name of the header to match. It is case-insensitive.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value represents the exact value of the header to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"regex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Regex represents a regular expression which must match the whole value of the header. It uses the RE2 syntax and can only contain printable ASCII characters other than '/', '\"' and ';'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
 	}
 }
 
@@ -4259,7 +5370,80 @@ func schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers is a list of HTTP request headers which must all be present in the request.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch"),
+									},
+								},
+							},
+						},
+					},
+					"queryParams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryParams is a list of query parameters which must all be present in the request URI.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPQueryParamMatch"),
+									},
+								},
+							},
+						},
+					},
+					"userAgent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserAgent represents the User-Agent header of the request to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rejectStatusCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RejectStatusCode is the status code of the HTTP response sent to the client when an HTTP request does not match the rule. If it is not provided, the TCP connection is reset. It applies to the whole rule, hence must be the same in all HTTP protocols of the rule.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch", "antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPQueryParamMatch"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_HTTPQueryParamMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPQueryParamMatch matches a query parameter of an HTTP request URI with a specific name and value. If Value is not provided, any value is matched.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name represents the name of the query parameter to match.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value represents the exact value of the query parameter to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"name"},
 			},
 		},
 	}
@@ -4475,6 +5659,122 @@ func schema_pkg_apis_crd_v1beta1_IPHeader(ref common.ReferenceCallback) common.O
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_IPListConfigMapSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPListConfigMapSource refers to a key of a ConfigMap.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the ConfigMap. It is required for a ClusterGroup. For a Group, it defaults to the Namespace of the Group, and cannot be set to any other Namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the ConfigMap.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key of the list in the data of the ConfigMap.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "key"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_IPListSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPListSource describes where the IP list of a Group is read from. Exactly one of ConfigMap and URL must be set. The list must contain one IP address or CIDR per line. Empty lines and comments starting with '#' or ';' are ignored.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMap refers to a key of a ConfigMap holding the list.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.IPListConfigMapSource"),
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the HTTP or HTTPS URL the list is fetched from. It can only be set for a ClusterGroup.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"refreshInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RefreshInterval is the interval at which the list is read again from its source. It must be at least 10s. Defaults to 5m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPListConfigMapSource", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_IPListStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPListStatus represents information about the IP list of a Group.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the number of IP addresses and CIDRs in the list.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"aggregatedSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AggregatedSize is the number of CIDRs the list is aggregated into, after merging overlapping and adjacent CIDRs.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastRefreshTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRefreshTime is the last time the list was read successfully.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastRefreshError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRefreshError is the error of the last attempt to read the list, if it failed. The last list read successfully is still used in this case.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"size", "aggregatedSize"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_IPPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4733,6 +6033,33 @@ func schema_pkg_apis_crd_v1beta1_IPv6Header(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_KafkaProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KafkaProtocol matches Kafka requests with specific API key and topic. All fields could be used alone or together. If all fields are not provided, this matches all produce, fetch and metadata requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIKey represents the type of the Kafka request to match. It could be produce, fetch and metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Topic represents the name of a topic in the Kafka request to match. Only exact match is supported.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_L7Protocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4749,11 +6076,26 @@ func schema_pkg_apis_crd_v1beta1_L7Protocol(ref common.ReferenceCallback) common
 							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"),
 						},
 					},
+					"grpc": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol"),
+						},
+					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol"),
+						},
+					},
+					"kafka": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.KafkaProtocol"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.KafkaProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"},
 	}
 }
 
@@ -5019,6 +6361,12 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicyPeer(ref common.ReferenceCallback)
 							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName"),
 						},
 					},
+					"identity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Select all Pods and ExternalEntities with the workload identity matched by this field, as workloads in To/From fields. Cannot be set with any other selector except Scope.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.WorkloadIdentity"),
+						},
+					},
 					"nodeSelector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Select certain Nodes which match the label selector. A NodeSelector cannot be set with any other selector.",
@@ -5027,7 +6375,7 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicyPeer(ref common.ReferenceCallback)
 					},
 					"scope": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Define scope of the Pod/NamespaceSelector(s) of this peer. Can only be used in ingress NetworkPolicyPeers. Defaults to \"Cluster\".",
+							Description: "Define scope of the Pod/NamespaceSelector(s) or Identity of this peer. Can only be used in ingress NetworkPolicyPeers. Defaults to \"Cluster\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5036,7 +6384,7 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicyPeer(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPBlock", "antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName", "antrea.io/antrea/pkg/apis/crd/v1beta1.PeerNamespaces", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPBlock", "antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName", "antrea.io/antrea/pkg/apis/crd/v1beta1.PeerNamespaces", "antrea.io/antrea/pkg/apis/crd/v1beta1.WorkloadIdentity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -5179,12 +6527,25 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicySpec(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"enforcementMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementMode specifies how the rules of this policy are enforced. Defaults to Enforce. In Audit mode, the rules are not enforced: traffic matching a rule is logged, but neither denied nor allowed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expiry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expiry specifies when this policy expires. An expired policy is no longer enforced, as if it had no rule, and an Expired condition is added to its status. The policy never expires if not set.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.PolicyExpiry"),
+						},
+					},
 				},
 				Required: []string{"priority"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.Rule"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.PolicyExpiry", "antrea.io/antrea/pkg/apis/crd/v1beta1.Rule"},
 	}
 }
 
@@ -5219,23 +6580,51 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicyStatus(ref common.ReferenceCallbac
 							Format:      "int32",
 						},
 					},
-					"desiredNodesRealized": {
+					"desiredNodesRealized": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The total number of nodes that should realize the NetworkPolicy.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents the latest available observations of a NetworkPolicy current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyCondition"),
+									},
+								},
+							},
+						},
+					},
+					"auditedRules": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The total number of nodes that should realize the NetworkPolicy.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "The number of hits of each Drop or Reject rule when the NetworkPolicy is in Audit mode, i.e. the number of connections that would have been denied. It is only populated when the NetworkPolicyStats feature is enabled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.RuleAuditStatus"),
+									},
+								},
+							},
 						},
 					},
-					"conditions": {
+					"scheduledRules": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Represents the latest available observations of a NetworkPolicy current state.",
+							Description: "Whether each rule with a schedule is currently enforced.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyCondition"),
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.RuleScheduleStatus"),
 									},
 								},
 							},
@@ -5246,7 +6635,7 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicyStatus(ref common.ReferenceCallbac
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyCondition"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyCondition", "antrea.io/antrea/pkg/apis/crd/v1beta1.RuleAuditStatus", "antrea.io/antrea/pkg/apis/crd/v1beta1.RuleScheduleStatus"},
 	}
 }
 
@@ -5545,7 +6934,7 @@ func schema_pkg_apis_crd_v1beta1_PeerService(ref common.ReferenceCallback) commo
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PeerService refers to a Service, which can be a in-cluster Service or imported multi-cluster service.",
+				Description: "PeerService refers to a Service, which can be a in-cluster Service or imported multi-cluster service. Services can be referenced either by Name, or by ServiceSelector to select all Services with matching labels.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
@@ -5556,8 +6945,21 @@ func schema_pkg_apis_crd_v1beta1_PeerService(ref common.ReferenceCallback) commo
 					},
 					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Namespace of the referenced Service(s). For Antrea NetworkPolicies, it defaults to the Namespace of the policy. It cannot be set together with NamespaceSelector.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serviceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Select Services with matching labels. It cannot be set together with Name. The membership is updated as Services are created, deleted or relabelled.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Select the Namespaces in which Services are selected by ServiceSelector. It can only be set together with ServiceSelector. If neither Namespace nor NamespaceSelector is set, a ClusterNetworkPolicy selects Services in all Namespaces.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
 					"scope": {
@@ -5569,6 +6971,8 @@ func schema_pkg_apis_crd_v1beta1_PeerService(ref common.ReferenceCallback) commo
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -5614,6 +7018,40 @@ func schema_pkg_apis_crd_v1beta1_PodOwner(ref common.ReferenceCallback) common.O
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_PolicyExpiry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PolicyExpiry describes when an Antrea-native policy expires. Exactly one of ExpirationTime and TTL must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"expirationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTime is the time at which the policy expires.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTL is the duration after the creation of the policy at which the policy expires, e.g. \"2h30m\".",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"deleteOnExpiry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeleteOnExpiry indicates whether the policy is deleted once it expires. Defaults to false, in which case the expired policy is kept but not enforced.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Rule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5748,12 +7186,228 @@ func schema_pkg_apis_crd_v1beta1_Rule(ref common.ReferenceCallback) common.OpenA
 							},
 						},
 					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule restricts the rule to the given time windows. Outside of these windows, the rule is not enforced, as if it was not part of the policy. If not set, the rule is always enforced.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.RuleSchedule"),
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit throttles the traffic matched by the rule. It can only be set for rules with the Allow action. Traffic exceeding the limits is dropped.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.RuleRateLimit"),
+						},
+					},
+					"rejectResponse": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RejectResponse is the response sent to the client when traffic is rejected by the rule. It can only be set for rules with the Reject action. If not set, TCP connections are reset and other traffic is responded with ICMP (or ICMPv6) administratively prohibited.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"action"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPort", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPort", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService", "antrea.io/antrea/pkg/apis/crd/v1beta1.RuleRateLimit", "antrea.io/antrea/pkg/apis/crd/v1beta1.RuleSchedule"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_RuleAuditStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleAuditStatus reports the traffic matched by a rule of a NetworkPolicy in Audit mode.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the rule.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hits is the number of connections that would have been denied by the rule.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"name", "hits"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_RuleRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleRateLimit describes the limits applied to the traffic matched by a rule. The limits are enforced on each Node separately, and are shared by all the workloads on the Node to which the rule applies.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"connectionsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectionsPerSecond is the maximum number of new connections per second matched by the rule.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"bandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bandwidth is the maximum bandwidth of the traffic matched by the rule.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.Bandwidth"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.Bandwidth"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_RuleSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleSchedule describes the recurring time windows during which a rule is enforced.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA Time Zone name in which the windows are interpreted, e.g. \"America/Los_Angeles\". Defaults to \"UTC\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"windows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Windows is the list of time windows during which the rule is enforced. The rule is enforced if the current time falls in any of the windows.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.ScheduleWindow"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"windows"},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.ScheduleWindow"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_RuleScheduleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleScheduleStatus reports whether a rule with a schedule is currently enforced.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the rule.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"active": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Active is true if the current time falls in any window of the rule's schedule.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "active"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_SCTPHeader(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SCTPHeader describes spec of an SCTP header.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"srcPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SrcPort is the source port.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dstPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DstPort is the destination port.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_ScheduleWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScheduleWindow describes a recurring daily time window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the start time of the window in \"HH:MM\" 24-hour format.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the end time of the window in \"HH:MM\" 24-hour format. If End is not later than Start, the window ends on the next day.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"daysOfWeek": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DaysOfWeek restricts the window to the given days of the week, on which the window starts. The window recurs every day if not set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
 	}
 }
 
@@ -6269,11 +7923,16 @@ func schema_pkg_apis_crd_v1beta1_TransportHeader(ref common.ReferenceCallback) c
 							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TCPHeader"),
 						},
 					},
+					"sctp": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.SCTPHeader"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPEchoRequestHeader", "antrea.io/antrea/pkg/apis/crd/v1beta1.TCPHeader", "antrea.io/antrea/pkg/apis/crd/v1beta1.UDPHeader"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPEchoRequestHeader", "antrea.io/antrea/pkg/apis/crd/v1beta1.SCTPHeader", "antrea.io/antrea/pkg/apis/crd/v1beta1.TCPHeader", "antrea.io/antrea/pkg/apis/crd/v1beta1.UDPHeader"},
 	}
 }
 
@@ -6304,6 +7963,34 @@ func schema_pkg_apis_crd_v1beta1_UDPHeader(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_WorkloadIdentity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkloadIdentity describes the identity of workloads, which is derived from their ServiceAccount. The identity of Pods is the ServiceAccount they run as, and the identity of ExternalEntities is the ServiceAccount set in their spec. Exactly one of the fields must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"spiffeID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SPIFFEID is the SPIFFE ID of the workloads, as carried by their X.509 SVIDs. It must follow the format derived from the ServiceAccount of the workloads: spiffe://<trust-domain>/ns/<namespace>/sa/<service-account>. The trust domain is not interpreted, so the same SPIFFE ID selects the workloads with the same ServiceAccount in every member cluster of a ClusterSet.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serviceAccount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccount is the ServiceAccount the identity is derived from.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName"},
+	}
+}
+
 func schema_pkg_apis_stats_v1alpha1_AntreaClusterNetworkPolicyStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
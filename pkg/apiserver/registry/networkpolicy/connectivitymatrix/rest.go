@@ -0,0 +1,90 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectivitymatrix
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	"antrea.io/antrea/pkg/controller/networkpolicy"
+)
+
+type REST struct {
+	querier networkpolicy.PolicyRuleQuerier
+}
+
+var (
+	_ rest.Storage              = &REST{}
+	_ rest.Scoper               = &REST{}
+	_ rest.Creater              = &REST{}
+	_ rest.SingularNameProvider = &REST{}
+)
+
+// NewREST returns a REST object that will work against API services.
+func NewREST(querier networkpolicy.PolicyRuleQuerier) *REST {
+	return &REST{querier}
+}
+
+func (r *REST) New() runtime.Object {
+	return &controlplane.ConnectivityMatrix{}
+}
+
+func (r *REST) Destroy() {
+}
+
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	matrix, ok := obj.(*controlplane.ConnectivityMatrix)
+	if !ok {
+		return nil, errors.NewBadRequest(fmt.Sprintf("not a ConnectivityMatrix object: %T", obj))
+	}
+	if err := validateRequest(matrix.Request); err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+	response, err := r.querier.QueryConnectivityMatrix(matrix.Request)
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	matrix.Response = response
+	return matrix, nil
+}
+
+func validateRequest(request *controlplane.ConnectivityMatrixRequest) error {
+	if request == nil {
+		return fmt.Errorf("missing request for ConnectivityMatrix")
+	}
+	switch request.Protocol {
+	case "", controlplane.ProtocolTCP, controlplane.ProtocolUDP, controlplane.ProtocolSCTP:
+	default:
+		return fmt.Errorf("unsupported protocol %q, must be TCP, UDP or SCTP", request.Protocol)
+	}
+	if request.Port < 1 || request.Port > 65535 {
+		return fmt.Errorf("invalid port %d, must be between 1 and 65535", request.Port)
+	}
+	return nil
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
+
+func (r *REST) GetSingularName() string {
+	return "connectivitymatrix"
+}
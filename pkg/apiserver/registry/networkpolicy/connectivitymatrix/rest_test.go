@@ -0,0 +1,109 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectivitymatrix
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	queriermock "antrea.io/antrea/pkg/controller/networkpolicy/testing"
+)
+
+func TestREST(t *testing.T) {
+	r := NewREST(nil)
+	assert.Equal(t, &controlplane.ConnectivityMatrix{}, r.New())
+	assert.False(t, r.NamespaceScoped())
+}
+
+func TestRESTCreate(t *testing.T) {
+	request := controlplane.ConnectivityMatrixRequest{
+		Sources:      controlplane.EntitySelector{Namespace: "ns1"},
+		Destinations: controlplane.EntitySelector{Namespace: "ns2", PodSelector: &v1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+		Protocol:     controlplane.ProtocolTCP,
+		Port:         80,
+	}
+	response := &controlplane.ConnectivityMatrixResponse{Entries: []controlplane.ConnectivityMatrixEntry{{
+		Source:      controlplane.PodReference{Namespace: "ns1", Name: "pod1"},
+		Destination: controlplane.PodReference{Namespace: "ns2", Name: "pod2"},
+		Action:      crdv1beta1.RuleActionAllow,
+	}}}
+	tests := []struct {
+		name                string
+		obj                 runtime.Object
+		expectedReturnedObj runtime.Object
+		expectedErr         error
+		mockResponse        *controlplane.ConnectivityMatrixResponse
+		mockErr             error
+	}{
+		{
+			name:                "Succeed",
+			obj:                 &controlplane.ConnectivityMatrix{Request: &request},
+			expectedReturnedObj: &controlplane.ConnectivityMatrix{Request: &request, Response: response},
+			mockResponse:        response,
+		},
+		{
+			name:        "Query error",
+			obj:         &controlplane.ConnectivityMatrix{Request: &request},
+			mockErr:     fmt.Errorf("querier error"),
+			expectedErr: errors.NewInternalError(fmt.Errorf("querier error")),
+		},
+		{
+			name:        "Missing request",
+			obj:         &controlplane.ConnectivityMatrix{},
+			expectedErr: errors.NewBadRequest("missing request for ConnectivityMatrix"),
+		},
+		{
+			name:        "Unsupported protocol",
+			obj:         &controlplane.ConnectivityMatrix{Request: &controlplane.ConnectivityMatrixRequest{Protocol: controlplane.ProtocolICMP, Port: 80}},
+			expectedErr: errors.NewBadRequest(`unsupported protocol "ICMP", must be TCP, UDP or SCTP`),
+		},
+		{
+			name:        "Invalid port",
+			obj:         &controlplane.ConnectivityMatrix{Request: &controlplane.ConnectivityMatrixRequest{}},
+			expectedErr: errors.NewBadRequest("invalid port 0, must be between 1 and 65535"),
+		},
+		{
+			name: "Unexpected type",
+			obj: &controlplane.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "foo",
+				},
+			},
+			expectedErr: errors.NewBadRequest("not a ConnectivityMatrix object: *controlplane.NetworkPolicy"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockQuerier := queriermock.NewMockPolicyRuleQuerier(mockCtrl)
+			if tt.mockResponse != nil || tt.mockErr != nil {
+				mockQuerier.EXPECT().QueryConnectivityMatrix(tt.obj.(*controlplane.ConnectivityMatrix).Request).Return(tt.mockResponse, tt.mockErr)
+			}
+			r := NewREST(mockQuerier)
+			actualObj, err := r.Create(context.TODO(), tt.obj, nil, &v1.CreateOptions{})
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedReturnedObj, actualObj)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podquarantine
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+)
+
+// maxPacketCaptureTimeout is the maximum timeout of a PacketCapture in seconds.
+const maxPacketCaptureTimeout = 300
+
+// Quarantiner quarantines and releases Pods.
+type Quarantiner interface {
+	QuarantinePod(ctx context.Context, namespace, name string, spec *controlplane.PodQuarantineSpec) (*controlplane.PodQuarantineStatus, error)
+	ReleasePod(ctx context.Context, namespace, name string) error
+}
+
+type REST struct {
+	quarantiner Quarantiner
+}
+
+var (
+	_ rest.Storage              = &REST{}
+	_ rest.Scoper               = &REST{}
+	_ rest.Creater              = &REST{}
+	_ rest.GracefulDeleter      = &REST{}
+	_ rest.SingularNameProvider = &REST{}
+)
+
+// NewREST returns a REST object that will work against API services.
+func NewREST(quarantiner Quarantiner) *REST {
+	return &REST{quarantiner}
+}
+
+func (r *REST) New() runtime.Object {
+	return &controlplane.PodQuarantine{}
+}
+
+func (r *REST) Destroy() {
+}
+
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	ns, ok := request.NamespaceFrom(ctx)
+	if !ok || len(ns) == 0 {
+		return nil, errors.NewBadRequest("Namespace parameter required.")
+	}
+	quarantine, ok := obj.(*controlplane.PodQuarantine)
+	if !ok {
+		return nil, errors.NewBadRequest(fmt.Sprintf("not a PodQuarantine object: %T", obj))
+	}
+	if len(quarantine.Name) == 0 {
+		return nil, errors.NewBadRequest("Pod name required.")
+	}
+	if err := validateSpec(&quarantine.Spec); err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+	status, err := r.quarantiner.QuarantinePod(ctx, ns, quarantine.Name, &quarantine.Spec)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	quarantine.Namespace = ns
+	quarantine.Status = *status
+	return quarantine, nil
+}
+
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	ns, ok := request.NamespaceFrom(ctx)
+	if !ok || len(ns) == 0 {
+		return nil, false, errors.NewBadRequest("Namespace parameter required.")
+	}
+	if err := r.quarantiner.ReleasePod(ctx, ns, name); err != nil {
+		return nil, false, toStatusError(err)
+	}
+	return &metav1.Status{Status: metav1.StatusSuccess}, true, nil
+}
+
+func validateSpec(spec *controlplane.PodQuarantineSpec) error {
+	for _, cidr := range spec.AllowedCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("invalid allowed CIDR %q: %v", cidr, err)
+		}
+	}
+	for _, ns := range spec.AllowedNamespaces {
+		if len(ns) == 0 {
+			return fmt.Errorf("allowed Namespace cannot be empty")
+		}
+	}
+	if pc := spec.PacketCapture; pc != nil {
+		if pc.Number <= 0 {
+			return fmt.Errorf("number of packets to capture must be positive")
+		}
+		if pc.Timeout < 0 || pc.Timeout > maxPacketCaptureTimeout {
+			return fmt.Errorf("PacketCapture timeout must be between 0 and %d seconds", maxPacketCaptureTimeout)
+		}
+	}
+	return nil
+}
+
+// toStatusError preserves the API errors returned by the quarantiner, e.g. when the Pod is not
+// found, and converts other errors to internal errors.
+func toStatusError(err error) error {
+	if _, ok := err.(errors.APIStatus); ok {
+		return err
+	}
+	return errors.NewInternalError(err)
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return true
+}
+
+func (r *REST) GetSingularName() string {
+	return "podquarantine"
+}
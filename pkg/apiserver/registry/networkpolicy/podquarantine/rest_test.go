@@ -0,0 +1,156 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podquarantine
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+)
+
+type fakeQuarantiner struct {
+	quarantined map[string]*controlplane.PodQuarantineSpec
+	err         error
+}
+
+func (q *fakeQuarantiner) QuarantinePod(ctx context.Context, namespace, name string, spec *controlplane.PodQuarantineSpec) (*controlplane.PodQuarantineStatus, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	q.quarantined[namespace+"/"+name] = spec
+	return &controlplane.PodQuarantineStatus{PolicyName: "quarantine-uid1"}, nil
+}
+
+func (q *fakeQuarantiner) ReleasePod(ctx context.Context, namespace, name string) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, ok := q.quarantined[namespace+"/"+name]; !ok {
+		return errors.NewNotFound(controlplane.Resource("podquarantines"), name)
+	}
+	delete(q.quarantined, namespace+"/"+name)
+	return nil
+}
+
+func TestREST(t *testing.T) {
+	r := NewREST(nil)
+	assert.Equal(t, &controlplane.PodQuarantine{}, r.New())
+	assert.True(t, r.NamespaceScoped())
+}
+
+func TestRESTCreate(t *testing.T) {
+	spec := controlplane.PodQuarantineSpec{
+		AllowedNamespaces: []string{"forensics"},
+		AllowedCIDRs:      []string{"10.10.0.0/24"},
+		PacketCapture:     &controlplane.PodQuarantinePacketCapture{Number: 10},
+	}
+	tests := []struct {
+		name                string
+		namespace           string
+		obj                 runtime.Object
+		quarantinerErr      error
+		expectedReturnedObj runtime.Object
+		expectedErr         error
+	}{
+		{
+			name:      "Succeed",
+			namespace: "ns1",
+			obj:       &controlplane.PodQuarantine{ObjectMeta: metav1.ObjectMeta{Name: "pod1"}, Spec: spec},
+			expectedReturnedObj: &controlplane.PodQuarantine{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod1"},
+				Spec:       spec,
+				Status:     controlplane.PodQuarantineStatus{PolicyName: "quarantine-uid1"},
+			},
+		},
+		{
+			name:        "Missing Namespace",
+			obj:         &controlplane.PodQuarantine{ObjectMeta: metav1.ObjectMeta{Name: "pod1"}},
+			expectedErr: errors.NewBadRequest("Namespace parameter required."),
+		},
+		{
+			name:        "Missing name",
+			namespace:   "ns1",
+			obj:         &controlplane.PodQuarantine{},
+			expectedErr: errors.NewBadRequest("Pod name required."),
+		},
+		{
+			name:      "Invalid CIDR",
+			namespace: "ns1",
+			obj: &controlplane.PodQuarantine{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod1"},
+				Spec:       controlplane.PodQuarantineSpec{AllowedCIDRs: []string{"10.10.0.5"}},
+			},
+			expectedErr: errors.NewBadRequest(`invalid allowed CIDR "10.10.0.5": invalid CIDR address: 10.10.0.5`),
+		},
+		{
+			name:      "Invalid PacketCapture timeout",
+			namespace: "ns1",
+			obj: &controlplane.PodQuarantine{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod1"},
+				Spec:       controlplane.PodQuarantineSpec{PacketCapture: &controlplane.PodQuarantinePacketCapture{Number: 10, Timeout: 600}},
+			},
+			expectedErr: errors.NewBadRequest("PacketCapture timeout must be between 0 and 300 seconds"),
+		},
+		{
+			name:           "Pod not found",
+			namespace:      "ns1",
+			obj:            &controlplane.PodQuarantine{ObjectMeta: metav1.ObjectMeta{Name: "pod1"}},
+			quarantinerErr: errors.NewNotFound(controlplane.Resource("pods"), "pod1"),
+			expectedErr:    errors.NewNotFound(controlplane.Resource("pods"), "pod1"),
+		},
+		{
+			name:           "Quarantiner error",
+			namespace:      "ns1",
+			obj:            &controlplane.PodQuarantine{ObjectMeta: metav1.ObjectMeta{Name: "pod1"}},
+			quarantinerErr: fmt.Errorf("quarantiner error"),
+			expectedErr:    errors.NewInternalError(fmt.Errorf("quarantiner error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewREST(&fakeQuarantiner{quarantined: map[string]*controlplane.PodQuarantineSpec{}, err: tt.quarantinerErr})
+			ctx := request.WithNamespace(context.TODO(), tt.namespace)
+			actualObj, err := r.Create(ctx, tt.obj, nil, &metav1.CreateOptions{})
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.expectedErr == nil {
+				assert.Equal(t, tt.expectedReturnedObj, actualObj)
+			}
+		})
+	}
+}
+
+func TestRESTDelete(t *testing.T) {
+	quarantiner := &fakeQuarantiner{quarantined: map[string]*controlplane.PodQuarantineSpec{"ns1/pod1": {}}}
+	r := NewREST(quarantiner)
+	ctx := request.WithNamespace(context.TODO(), "ns1")
+
+	obj, deleted, err := r.Delete(ctx, "pod1", nil, &metav1.DeleteOptions{})
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, &metav1.Status{Status: metav1.StatusSuccess}, obj)
+	assert.Empty(t, quarantiner.quarantined)
+
+	_, deleted, err = r.Delete(ctx, "pod1", nil, &metav1.DeleteOptions{})
+	assert.False(t, deleted)
+	assert.True(t, errors.IsNotFound(err))
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyanalysis
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/controller/networkpolicy"
+	queriermock "antrea.io/antrea/pkg/controller/networkpolicy/testing"
+)
+
+func TestREST(t *testing.T) {
+	r := NewREST(nil)
+	assert.Equal(t, &controlplane.PolicyAnalysis{}, r.New())
+	assert.False(t, r.NamespaceScoped())
+}
+
+func TestRESTCreate(t *testing.T) {
+	request := controlplane.PolicyAnalysisRequest{ZeroHitWindowSeconds: 3600}
+	dropAction := crdv1beta1.RuleActionDrop
+	response := &controlplane.PolicyAnalysisResponse{Findings: []controlplane.PolicyAnalysisFinding{{
+		Type:          controlplane.ZeroHitRule,
+		NetworkPolicy: controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp1"},
+		Rule:          controlplane.RuleRef{Direction: controlplane.DirectionIn, Name: "rule1", Action: &dropAction},
+		Message:       "the rule hasn't matched any traffic in the last 1h0m0s",
+	}}}
+	tests := []struct {
+		name                string
+		obj                 runtime.Object
+		expectedRequest     *controlplane.PolicyAnalysisRequest
+		expectedReturnedObj runtime.Object
+		expectedErr         error
+		mockResponse        *controlplane.PolicyAnalysisResponse
+		mockErr             error
+	}{
+		{
+			name:                "Succeed",
+			obj:                 &controlplane.PolicyAnalysis{Request: &request},
+			expectedRequest:     &request,
+			expectedReturnedObj: &controlplane.PolicyAnalysis{Request: &request, Response: response},
+			mockResponse:        response,
+		},
+		{
+			name:                "Missing request",
+			obj:                 &controlplane.PolicyAnalysis{},
+			expectedRequest:     &controlplane.PolicyAnalysisRequest{},
+			expectedReturnedObj: &controlplane.PolicyAnalysis{Request: &controlplane.PolicyAnalysisRequest{}, Response: &controlplane.PolicyAnalysisResponse{}},
+			mockResponse:        &controlplane.PolicyAnalysisResponse{},
+		},
+		{
+			name:            "Analysis error",
+			obj:             &controlplane.PolicyAnalysis{Request: &request},
+			expectedRequest: &request,
+			mockErr:         fmt.Errorf("analyzer error"),
+			expectedErr:     errors.NewInternalError(fmt.Errorf("analyzer error")),
+		},
+		{
+			name:            "Stats unavailable",
+			obj:             &controlplane.PolicyAnalysis{Request: &request},
+			expectedRequest: &request,
+			mockErr:         networkpolicy.ErrRuleStatsUnavailable,
+			expectedErr:     errors.NewBadRequest(networkpolicy.ErrRuleStatsUnavailable.Error()),
+		},
+		{
+			name:        "Negative window",
+			obj:         &controlplane.PolicyAnalysis{Request: &controlplane.PolicyAnalysisRequest{ZeroHitWindowSeconds: -1}},
+			expectedErr: errors.NewBadRequest("invalid zeroHitWindowSeconds -1, must not be negative"),
+		},
+		{
+			name: "Unexpected type",
+			obj: &controlplane.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "foo",
+				},
+			},
+			expectedErr: errors.NewBadRequest("not a PolicyAnalysis object: *controlplane.NetworkPolicy"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockAnalyzer := queriermock.NewMockPolicyAnalyzer(mockCtrl)
+			if tt.expectedRequest != nil {
+				mockAnalyzer.EXPECT().AnalyzePolicies(tt.expectedRequest).Return(tt.mockResponse, tt.mockErr)
+			}
+			r := NewREST(mockAnalyzer)
+			actualObj, err := r.Create(context.TODO(), tt.obj, nil, &v1.CreateOptions{})
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedReturnedObj, actualObj)
+		})
+	}
+}
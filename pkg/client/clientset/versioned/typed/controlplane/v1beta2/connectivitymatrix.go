@@ -0,0 +1,56 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	context "context"
+
+	controlplanev1beta2 "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	scheme "antrea.io/antrea/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// ConnectivityMatricesGetter has a method to return a ConnectivityMatrixInterface.
+// A group's client should implement this interface.
+type ConnectivityMatricesGetter interface {
+	ConnectivityMatrices() ConnectivityMatrixInterface
+}
+
+// ConnectivityMatrixInterface has methods to work with ConnectivityMatrix resources.
+type ConnectivityMatrixInterface interface {
+	Create(ctx context.Context, connectivityMatrix *controlplanev1beta2.ConnectivityMatrix, opts v1.CreateOptions) (*controlplanev1beta2.ConnectivityMatrix, error)
+	ConnectivityMatrixExpansion
+}
+
+// connectivityMatrices implements ConnectivityMatrixInterface
+type connectivityMatrices struct {
+	*gentype.Client[*controlplanev1beta2.ConnectivityMatrix]
+}
+
+// newConnectivityMatrices returns a ConnectivityMatrices
+func newConnectivityMatrices(c *ControlplaneV1beta2Client) *connectivityMatrices {
+	return &connectivityMatrices{
+		gentype.NewClient[*controlplanev1beta2.ConnectivityMatrix](
+			"connectivitymatrices",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *controlplanev1beta2.ConnectivityMatrix { return &controlplanev1beta2.ConnectivityMatrix{} },
+		),
+	}
+}
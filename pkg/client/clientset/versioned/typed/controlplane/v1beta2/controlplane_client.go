@@ -29,6 +29,7 @@ type ControlplaneV1beta2Interface interface {
 	AddressGroupsGetter
 	AppliedToGroupsGetter
 	ClusterGroupMembersGetter
+	ConnectivityMatricesGetter
 	EgressGroupsGetter
 	GroupAssociationsGetter
 	GroupMembersGetter
@@ -36,6 +37,8 @@ type ControlplaneV1beta2Interface interface {
 	NetworkPoliciesGetter
 	NetworkPolicyEvaluationsGetter
 	NodeStatsSummariesGetter
+	PodQuarantinesGetter
+	PolicyAnalysesGetter
 	SupportBundleCollectionsGetter
 }
 
@@ -56,6 +59,10 @@ func (c *ControlplaneV1beta2Client) ClusterGroupMembers() ClusterGroupMembersInt
 	return newClusterGroupMembers(c)
 }
 
+func (c *ControlplaneV1beta2Client) ConnectivityMatrices() ConnectivityMatrixInterface {
+	return newConnectivityMatrices(c)
+}
+
 func (c *ControlplaneV1beta2Client) EgressGroups() EgressGroupInterface {
 	return newEgressGroups(c)
 }
@@ -84,6 +91,14 @@ func (c *ControlplaneV1beta2Client) NodeStatsSummaries() NodeStatsSummaryInterfa
 	return newNodeStatsSummaries(c)
 }
 
+func (c *ControlplaneV1beta2Client) PodQuarantines(namespace string) PodQuarantineInterface {
+	return newPodQuarantines(c, namespace)
+}
+
+func (c *ControlplaneV1beta2Client) PolicyAnalyses() PolicyAnalysisInterface {
+	return newPolicyAnalyses(c)
+}
+
 func (c *ControlplaneV1beta2Client) SupportBundleCollections() SupportBundleCollectionInterface {
 	return newSupportBundleCollections(c)
 }
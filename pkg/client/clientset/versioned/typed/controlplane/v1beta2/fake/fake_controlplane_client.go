@@ -38,6 +38,10 @@ func (c *FakeControlplaneV1beta2) ClusterGroupMembers() v1beta2.ClusterGroupMemb
 	return newFakeClusterGroupMembers(c)
 }
 
+func (c *FakeControlplaneV1beta2) ConnectivityMatrices() v1beta2.ConnectivityMatrixInterface {
+	return newFakeConnectivityMatrices(c)
+}
+
 func (c *FakeControlplaneV1beta2) EgressGroups() v1beta2.EgressGroupInterface {
 	return newFakeEgressGroups(c)
 }
@@ -66,6 +70,14 @@ func (c *FakeControlplaneV1beta2) NodeStatsSummaries() v1beta2.NodeStatsSummaryI
 	return newFakeNodeStatsSummaries(c)
 }
 
+func (c *FakeControlplaneV1beta2) PodQuarantines(namespace string) v1beta2.PodQuarantineInterface {
+	return newFakePodQuarantines(c, namespace)
+}
+
+func (c *FakeControlplaneV1beta2) PolicyAnalyses() v1beta2.PolicyAnalysisInterface {
+	return newFakePolicyAnalyses(c)
+}
+
 func (c *FakeControlplaneV1beta2) SupportBundleCollections() v1beta2.SupportBundleCollectionInterface {
 	return newFakeSupportBundleCollections(c)
 }
@@ -0,0 +1,42 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta2 "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	controlplanev1beta2 "antrea.io/antrea/pkg/client/clientset/versioned/typed/controlplane/v1beta2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakePolicyAnalyses implements PolicyAnalysisInterface
+type fakePolicyAnalyses struct {
+	*gentype.FakeClient[*v1beta2.PolicyAnalysis]
+	Fake *FakeControlplaneV1beta2
+}
+
+func newFakePolicyAnalyses(fake *FakeControlplaneV1beta2) controlplanev1beta2.PolicyAnalysisInterface {
+	return &fakePolicyAnalyses{
+		gentype.NewFakeClient[*v1beta2.PolicyAnalysis](
+			fake.Fake,
+			"",
+			v1beta2.SchemeGroupVersion.WithResource("policyanalyses"),
+			v1beta2.SchemeGroupVersion.WithKind("PolicyAnalysis"),
+			func() *v1beta2.PolicyAnalysis { return &v1beta2.PolicyAnalysis{} },
+		),
+		fake,
+	}
+}
@@ -20,6 +20,8 @@ type AddressGroupExpansion interface{}
 
 type AppliedToGroupExpansion interface{}
 
+type ConnectivityMatrixExpansion interface{}
+
 type EgressGroupExpansion interface{}
 
 type GroupAssociationExpansion interface{}
@@ -29,3 +31,7 @@ type IPGroupAssociationExpansion interface{}
 type NetworkPolicyEvaluationExpansion interface{}
 
 type NodeStatsSummaryExpansion interface{}
+
+type PodQuarantineExpansion interface{}
+
+type PolicyAnalysisExpansion interface{}
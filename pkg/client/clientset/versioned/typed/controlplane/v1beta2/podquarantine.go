@@ -0,0 +1,57 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	context "context"
+
+	controlplanev1beta2 "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	scheme "antrea.io/antrea/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// PodQuarantinesGetter has a method to return a PodQuarantineInterface.
+// A group's client should implement this interface.
+type PodQuarantinesGetter interface {
+	PodQuarantines(namespace string) PodQuarantineInterface
+}
+
+// PodQuarantineInterface has methods to work with PodQuarantine resources.
+type PodQuarantineInterface interface {
+	Create(ctx context.Context, podQuarantine *controlplanev1beta2.PodQuarantine, opts v1.CreateOptions) (*controlplanev1beta2.PodQuarantine, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	PodQuarantineExpansion
+}
+
+// podQuarantines implements PodQuarantineInterface
+type podQuarantines struct {
+	*gentype.Client[*controlplanev1beta2.PodQuarantine]
+}
+
+// newPodQuarantines returns a PodQuarantines
+func newPodQuarantines(c *ControlplaneV1beta2Client, namespace string) *podQuarantines {
+	return &podQuarantines{
+		gentype.NewClient[*controlplanev1beta2.PodQuarantine](
+			"podquarantines",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *controlplanev1beta2.PodQuarantine { return &controlplanev1beta2.PodQuarantine{} },
+		),
+	}
+}
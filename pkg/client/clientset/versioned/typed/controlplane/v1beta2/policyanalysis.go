@@ -0,0 +1,56 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	context "context"
+
+	controlplanev1beta2 "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	scheme "antrea.io/antrea/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// PolicyAnalysesGetter has a method to return a PolicyAnalysisInterface.
+// A group's client should implement this interface.
+type PolicyAnalysesGetter interface {
+	PolicyAnalyses() PolicyAnalysisInterface
+}
+
+// PolicyAnalysisInterface has methods to work with PolicyAnalysis resources.
+type PolicyAnalysisInterface interface {
+	Create(ctx context.Context, policyAnalysis *controlplanev1beta2.PolicyAnalysis, opts v1.CreateOptions) (*controlplanev1beta2.PolicyAnalysis, error)
+	PolicyAnalysisExpansion
+}
+
+// policyAnalyses implements PolicyAnalysisInterface
+type policyAnalyses struct {
+	*gentype.Client[*controlplanev1beta2.PolicyAnalysis]
+}
+
+// newPolicyAnalyses returns a PolicyAnalyses
+func newPolicyAnalyses(c *ControlplaneV1beta2Client) *policyAnalyses {
+	return &policyAnalyses{
+		gentype.NewClient[*controlplanev1beta2.PolicyAnalysis](
+			"policyanalyses",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *controlplanev1beta2.PolicyAnalysis { return &controlplanev1beta2.PolicyAnalysis{} },
+		),
+	}
+}
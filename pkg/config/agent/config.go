@@ -285,6 +285,14 @@ type FlowExporterConfig struct {
 	// "udp" L4 transport protocols.
 	// Defaults to "flow-aggregator/flow-aggregator:4739:tcp".
 	FlowCollectorAddr string `yaml:"flowCollectorAddr,omitempty"`
+	// Shard flow records across the replicas of the flow collector, by consistent hashing
+	// of the connection 5-tuple, instead of sending them to the collector's Service IP.
+	// Both directions of a connection are sent to the same replica. This is required
+	// to run the Flow Aggregator with multiple replicas in Aggregate mode. The replicas
+	// are discovered from the EndpointSlices of the Service, so flowCollectorAddr must be
+	// a Service reference (<Service namespace>/<Service name>).
+	// Defaults to false.
+	FlowCollectorSharding bool `yaml:"flowCollectorSharding,omitempty"`
 	// Provide flow poll interval in format "0s". This determines how often flow
 	// exporter dumps connections in conntrack module. Flow poll interval should
 	// be greater than or equal to 1s(one second).
@@ -50,6 +50,8 @@ type ControllerConfig struct {
 	// Enable metrics exposure via Prometheus. Initializes Prometheus metrics listener
 	// Defaults to true.
 	EnablePrometheusMetrics *bool `yaml:"enablePrometheusMetrics,omitempty"`
+	// NetworkPolicy rule metrics configuration.
+	NetworkPolicyRuleMetrics NetworkPolicyRuleMetricsConfig `yaml:"networkPolicyRuleMetrics"`
 	// Indicates whether to use auto-generated self-signed TLS certificate.
 	// If false, a Secret named "antrea-controller-tls" must be provided with the following keys:
 	//   ca.crt: <CA certificate>
@@ -86,6 +88,19 @@ type MulticlusterConfig struct {
 	Namespace string `yaml:"namespace,omitempty"`
 }
 
+type NetworkPolicyRuleMetricsConfig struct {
+	// Expose the traffic stats of NetworkPolicy rules collected from the antrea-agents as Prometheus metrics,
+	// labelled with the policy, the rule name and the rule action. It requires enablePrometheusMetrics to be
+	// true and the NetworkPolicyStats feature gate to be enabled.
+	// Defaults to false.
+	Enable bool `yaml:"enable,omitempty"`
+	// The maximum number of NetworkPolicy rules exposed with their own labels, to bound the cardinality of the
+	// metrics. The stats of additional rules are aggregated into series whose policy and rule labels are set to
+	// "_overflow".
+	// Defaults to 1000.
+	MaxRules int `yaml:"maxRules,omitempty"`
+}
+
 type IPsecCSRSignerConfig struct {
 	// Indicates whether to use auto-generated self-signed CA certificate.
 	// If false, a Secret named "antrea-ipsec-ca" must be provided with the following keys:
@@ -52,6 +52,10 @@ type FlowAggregatorConfig struct {
 	// Defaults to "90s". Valid time units are "ns", "us" (or "µs"), "ms", "s",
 	// "m", "h".
 	InactiveFlowRecordTimeout string `yaml:"inactiveFlowRecordTimeout,omitempty"`
+	// Checkpoint contains configuration options for periodically saving the state of the
+	// aggregation process to a local file, so that partially correlated flow records can be
+	// restored when the flow aggregator restarts. Only applicable in Aggregate mode.
+	Checkpoint CheckpointConfig `yaml:"checkpoint,omitempty"`
 	// Transport protocol over which the aggregator collects IPFIX records from all Agents.
 	// Defaults to "tls"
 	AggregatorTransportProtocol AggregatorTransportProtocol `yaml:"aggregatorTransportProtocol,omitempty"`
@@ -68,13 +72,39 @@ type FlowAggregatorConfig struct {
 	ClickHouse ClickHouseConfig `yaml:"clickHouse,omitempty"`
 	// S3Uploader contains configuration options for uploading flow records to AWS S3.
 	S3Uploader S3UploaderConfig `yaml:"s3Uploader,omitempty"`
+	// Kafka contains configuration options for publishing flow records to a Kafka topic.
+	Kafka KafkaConfig `yaml:"kafka,omitempty"`
+	// OTLP contains configuration options for exporting flow records to an OpenTelemetry
+	// collector.
+	OTLP OTLPConfig `yaml:"otlp,omitempty"`
 	// FlowLogger contains configuration options for writing flow records to a local log file.
 	FlowLogger FlowLoggerConfig `yaml:"flowLogger,omitempty"`
+	// PolicyRecommendation contains configuration options for recommending Antrea-native
+	// policies based on the connections observed in flow records.
+	PolicyRecommendation PolicyRecommendationConfig `yaml:"policyRecommendation,omitempty"`
 	// Provide a ClusterID to be added to records. By default this ID is an autogenerated UUID
 	// which can be found in the antrea-cluster-identity ConfigMap
 	ClusterID string `yaml:"clusterID,omitempty"`
 }
 
+type CheckpointConfig struct {
+	// Enable is the switch to enable checkpointing of the aggregation state.
+	Enable bool `yaml:"enable,omitempty"`
+	// Path is the path of the checkpoint file. It should be on a volume which persists across
+	// restarts of the flow aggregator container.
+	// Defaults to "/var/lib/flow-aggregator/checkpoint".
+	Path string `yaml:"path,omitempty"`
+	// Interval is the interval at which the aggregation state is saved to the checkpoint file.
+	// The state is also saved when the flow aggregator stops. Defaults to "30s". Valid time
+	// units are "ns", "us" (or "µs"), "ms", "s", "m", "h". Min value allowed is "1s".
+	Interval string `yaml:"interval,omitempty"`
+	// MaxStaleness is the maximum time elapsed since a flow record was last updated, for the
+	// record to be restored from the checkpoint and correlated with subsequent records. Older
+	// records are exported as they are, instead of being dropped. Defaults to "5m". Valid time
+	// units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	MaxStaleness string `yaml:"maxStaleness,omitempty"`
+}
+
 type RecordContentsConfig struct {
 	PodLabels bool `yaml:"podLabels,omitempty"`
 }
@@ -115,6 +145,9 @@ type FlowCollectorConfig struct {
 	MaxIPFIXMsgSize int32 `yaml:"maxIPFIXMsgSize,omitempty"`
 	// TLS / mTLS configuration when exporting to the flowCollector.
 	TLS FlowCollectorTLSConfig `yaml:"tls,omitempty"`
+	// Selection of the flow records to export to the flow collector. By default, all flows
+	// are exported.
+	FlowRecordSelectionConfig `yaml:",inline"`
 	// Include the names of K8s objects (Pods, Nodes, ...) as information elements in exported records.
 	// Defaults to true.
 	IncludeK8sNames *bool `yaml:"includeK8sNames,omitempty"`
@@ -165,6 +198,9 @@ type ClickHouseConfig struct {
 	CommitInterval string `yaml:"commitInterval,omitempty"`
 	// TLS configuration options, when using TLS to connect to the ClickHouse service.
 	TLS ClickHouseTLSConfig `yaml:"tls,omitempty"`
+	// Selection of the flow records to insert into the database. By default, all flows are
+	// inserted.
+	FlowRecordSelectionConfig `yaml:",inline"`
 }
 
 type ClickHouseTLSConfig struct {
@@ -205,6 +241,164 @@ type S3UploaderConfig struct {
 	MaxRecordsPerFile int32 `yaml:"maxRecordsPerFile,omitempty"`
 	// UploadInterval is the duration between each file upload to S3.
 	UploadInterval string `yaml:"uploadInterval,omitempty"`
+	// Selection of the flow records to upload. By default, all flows are uploaded.
+	FlowRecordSelectionConfig `yaml:",inline"`
+}
+
+type KafkaRecordFormat string
+
+const (
+	KafkaRecordFormatProtobuf KafkaRecordFormat = "Protobuf"
+	KafkaRecordFormatJSON     KafkaRecordFormat = "JSON"
+)
+
+type KafkaPartitioning string
+
+const (
+	// With Cluster partitioning, all the flow records exported by a Flow Aggregator are
+	// published to the same partition, which preserves their order.
+	KafkaPartitioningCluster KafkaPartitioning = "Cluster"
+	// With Namespace partitioning, flow records are assigned to partitions based on the
+	// Namespace of their source Pod, or of their destination Pod if the source is not a Pod.
+	KafkaPartitioningNamespace KafkaPartitioning = "Namespace"
+	// With FiveTuple partitioning, flow records are assigned to partitions based on a hash of
+	// their 5-tuple. Both directions of a connection are published to the same partition.
+	KafkaPartitioningFiveTuple KafkaPartitioning = "FiveTuple"
+)
+
+type KafkaSASLMechanism string
+
+const (
+	KafkaSASLMechanismPlain       KafkaSASLMechanism = "PLAIN"
+	KafkaSASLMechanismScramSHA256 KafkaSASLMechanism = "SCRAM-SHA-256"
+	KafkaSASLMechanismScramSHA512 KafkaSASLMechanism = "SCRAM-SHA-512"
+)
+
+type KafkaConfig struct {
+	// Enable is the switch to enable publishing flow records to Kafka.
+	Enable bool `yaml:"enable,omitempty"`
+	// Brokers is the list of Kafka brokers used to bootstrap the connection to the Kafka
+	// cluster, with format <host>:<port>. If this field is empty, initialization will fail.
+	Brokers []string `yaml:"brokers,omitempty"`
+	// Topic is the Kafka topic to which flow records are published. Defaults to "antrea-flows".
+	Topic string `yaml:"topic,omitempty"`
+	// RecordFormat defines the encoding of the flow records published to Kafka. Supported
+	// formats are "Protobuf" and "JSON". In both cases, a message contains one flow record,
+	// which follows the Flow message schema defined in pkg/apis/flow/v1alpha1/flow.proto.
+	// Defaults to "Protobuf".
+	RecordFormat KafkaRecordFormat `yaml:"recordFormat,omitempty"`
+	// Partitioning defines how flow records are assigned to the partitions of the topic.
+	// Supported values are "Cluster", "Namespace" and "FiveTuple". Defaults to "FiveTuple".
+	Partitioning KafkaPartitioning `yaml:"partitioning,omitempty"`
+	// Compression is the compression codec applied to batches of flow records. Supported
+	// values are "none", "gzip", "snappy", "lz4" and "zstd". Defaults to "none".
+	Compression string `yaml:"compression,omitempty"`
+	// BatchSize is the maximum number of flow records published in a single batch.
+	// Defaults to 1000.
+	BatchSize int32 `yaml:"batchSize,omitempty"`
+	// BatchTimeout is the maximum duration for which flow records are buffered before being
+	// published, even if the batch is not full. Defaults to "1s". Valid time units are "ns",
+	// "us" (or "µs"), "ms", "s", "m", "h".
+	BatchTimeout string `yaml:"batchTimeout,omitempty"`
+	// TLS configuration options, when using TLS to connect to the Kafka brokers.
+	TLS KafkaTLSConfig `yaml:"tls,omitempty"`
+	// SASL configuration options, when authenticating to the Kafka brokers with SASL.
+	SASL KafkaSASLConfig `yaml:"sasl,omitempty"`
+	// Selection of the flow records to publish. By default, all flows are published.
+	FlowRecordSelectionConfig `yaml:",inline"`
+}
+
+type KafkaTLSConfig struct {
+	// Enable TLS.
+	Enable bool `yaml:"enable,omitempty"`
+	// Name of the Secret containing the CA certificate used to authenticate the Kafka
+	// brokers. Default root CAs will be used if this field is empty. The Secret must be
+	// created in the Namespace in which the Flow Aggregator is deployed, and it must contain
+	// the ca.crt key.
+	CASecretName string `yaml:"caSecretName,omitempty"`
+	// ServerName is used to verify the hostname on the returned certificates. If this field
+	// is omitted, the hostname of the broker being connected to will be used.
+	ServerName string `yaml:"serverName,omitempty"`
+	// Name of the Secret containing the client's certificate and private key for mTLS. If
+	// omitted, client authentication will be disabled. The Secret must be created in Namespace
+	// in which the Flow Aggregator is deployed, and it must be of type kubernetes.io/tls and
+	// contain the tls.crt and tls.key keys.
+	ClientSecretName string `yaml:"clientSecretName,omitempty"`
+	// InsecureSkipVerify determines whether to skip the verification of the brokers'
+	// certificate chain and host name. Default is false.
+	InsecureSkipVerify bool `yaml:"insecureSkipVerify,omitempty"`
+	// TLS min version.
+	MinVersion string `yaml:"minVersion,omitempty"`
+}
+
+type KafkaSASLConfig struct {
+	// Mechanism is the SASL mechanism used to authenticate to the Kafka brokers. Supported
+	// mechanisms are "PLAIN", "SCRAM-SHA-256" and "SCRAM-SHA-512". SASL authentication is
+	// disabled if this field is empty. The username and password are read from the
+	// KAFKA_USERNAME and KAFKA_PASSWORD environment variables, which are populated from the
+	// flow-aggregator-kafka-credentials Secret.
+	Mechanism KafkaSASLMechanism `yaml:"mechanism,omitempty"`
+}
+
+type OTLPProtocol string
+
+const (
+	OTLPProtocolGRPC OTLPProtocol = "gRPC"
+	OTLPProtocolHTTP OTLPProtocol = "HTTP"
+)
+
+type OTLPConfig struct {
+	// Enable is the switch to enable exporting flow records to an OpenTelemetry collector.
+	Enable bool `yaml:"enable,omitempty"`
+	// Endpoint is the address of the OTLP receiver of the collector, with format <host>:<port>.
+	// If this field is empty, initialization will fail.
+	Endpoint string `yaml:"endpoint,omitempty"`
+	// Protocol is the OTLP transport used to export flow records. Supported values are "gRPC"
+	// and "HTTP". With "HTTP", the binary Protobuf encoding is used, and logs and metrics are
+	// sent to the /v1/logs and /v1/metrics paths respectively. Defaults to "gRPC".
+	Protocol OTLPProtocol `yaml:"protocol,omitempty"`
+	// ExportInterval is the maximum duration for which flow records are buffered before being
+	// exported, even if the batch is not full. It is also the interval at which metrics are
+	// exported. Defaults to "5s". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	ExportInterval string `yaml:"exportInterval,omitempty"`
+	// BatchSize is the maximum number of log records exported in a single request.
+	// Defaults to 1000.
+	BatchSize int32 `yaml:"batchSize,omitempty"`
+	// Metrics configuration options, when deriving metrics from flow records.
+	Metrics OTLPMetricsConfig `yaml:"metrics,omitempty"`
+	// TLS configuration options, when using TLS to connect to the collector.
+	TLS OTLPTLSConfig `yaml:"tls,omitempty"`
+	// Selection of the flow records to export. Metrics are computed from the selected flow
+	// records only. By default, all flows are exported.
+	FlowRecordSelectionConfig `yaml:",inline"`
+}
+
+type OTLPMetricsConfig struct {
+	// Enable is the switch to enable exporting the number of bytes and packets exchanged
+	// between each pair of Namespaces as OTLP metrics, in addition to the log records.
+	Enable bool `yaml:"enable,omitempty"`
+}
+
+type OTLPTLSConfig struct {
+	// Enable TLS.
+	Enable bool `yaml:"enable,omitempty"`
+	// Name of the Secret containing the CA certificate used to authenticate the collector.
+	// Default root CAs will be used if this field is empty. The Secret must be created in the
+	// Namespace in which the Flow Aggregator is deployed, and it must contain the ca.crt key.
+	CASecretName string `yaml:"caSecretName,omitempty"`
+	// ServerName is used to verify the hostname on the returned certificates. If this field
+	// is omitted, the host of the endpoint will be used.
+	ServerName string `yaml:"serverName,omitempty"`
+	// Name of the Secret containing the client's certificate and private key for mTLS. If
+	// omitted, client authentication will be disabled. The Secret must be created in Namespace
+	// in which the Flow Aggregator is deployed, and it must be of type kubernetes.io/tls and
+	// contain the tls.crt and tls.key keys.
+	ClientSecretName string `yaml:"clientSecretName,omitempty"`
+	// InsecureSkipVerify determines whether to skip the verification of the collector's
+	// certificate chain and host name. Default is false.
+	InsecureSkipVerify bool `yaml:"insecureSkipVerify,omitempty"`
+	// TLS min version.
+	MinVersion string `yaml:"minVersion,omitempty"`
 }
 
 type FlowLoggerConfig struct {
@@ -226,15 +420,19 @@ type FlowLoggerConfig struct {
 	// RecordFormat defines the format of the flow records logged to file. Only "CSV" is
 	// supported at the moment.
 	RecordFormat string `yaml:"recordFormat,omitempty"`
-	// Filters can be used to select which flow records to log to file. The provided filters are
-	// OR-ed to determine whether a specific flow should be logged. By default, all flows are
-	// logged.
-	Filters []FlowFilter `yaml:"filters,omitempty"`
+	// Selection of the flow records to log to file. By default, all flows are logged.
+	FlowRecordSelectionConfig `yaml:",inline"`
 	// PrettyPrint enables conversion of some numeric fields to a more meaningful string
 	// representation.
 	PrettyPrint *bool `yaml:"prettyPrint,omitempty"`
 }
 
+type PolicyRecommendationConfig struct {
+	// Enable is the switch to enable recommending policies from the connections observed in
+	// flow records. The recommended policies can be retrieved with antctl.
+	Enable bool `yaml:"enable,omitempty"`
+}
+
 type NetworkPolicyRuleAction string
 
 const (
@@ -242,10 +440,86 @@ const (
 	NetworkPolicyRuleActionAllow  NetworkPolicyRuleAction = "Allow"
 	NetworkPolicyRuleActionDrop   NetworkPolicyRuleAction = "Drop"
 	NetworkPolicyRuleActionReject NetworkPolicyRuleAction = "Reject"
+	NetworkPolicyRuleActionAudit  NetworkPolicyRuleAction = "Audit"
+)
+
+type FlowType string
+
+const (
+	FlowTypeIntraNode    FlowType = "IntraNode"
+	FlowTypeInterNode    FlowType = "InterNode"
+	FlowTypeToExternal   FlowType = "ToExternal"
+	FlowTypeFromExternal FlowType = "FromExternal"
 )
 
+type FlowSamplingMode string
+
+const (
+	// FlowSamplingModeHash selects flow records based on a hash of the connection's 5-tuple,
+	// which means that either all or none of the records for a given connection (in both
+	// directions) are selected.
+	FlowSamplingModeHash FlowSamplingMode = "Hash"
+	// FlowSamplingModeCount selects every N-th flow record, regardless of the connection it
+	// belongs to.
+	FlowSamplingModeCount FlowSamplingMode = "Count"
+)
+
+// FlowRecordSelectionConfig determines which flow records are sent to a given exporter. Flow
+// records are first matched against Filters and ExcludeFilters, and the remaining records are
+// then sampled.
+type FlowRecordSelectionConfig struct {
+	// Filters can be used to select which flow records to export. The provided filters are
+	// OR-ed to determine whether a specific flow should be exported. By default, all flows are
+	// selected.
+	Filters []FlowFilter `yaml:"filters,omitempty"`
+	// ExcludeFilters can be used to discard some flow records. A flow matching any of the
+	// provided filters will not be exported, even if it is selected by Filters.
+	ExcludeFilters []FlowFilter `yaml:"excludeFilters,omitempty"`
+	// Sampling can be used to only export a fraction of the selected flow records.
+	Sampling FlowSamplingConfig `yaml:"sampling,omitempty"`
+}
+
+type FlowSamplingConfig struct {
+	// Rate is the sampling rate: 1 in Rate flow records will be exported. 0 and 1 both mean
+	// that all flow records are exported.
+	Rate int32 `yaml:"rate,omitempty"`
+	// Mode is the sampling mode, either "Hash" or "Count". Defaults to "Hash".
+	Mode FlowSamplingMode `yaml:"mode,omitempty"`
+}
+
 // FlowFilter will match a flow if all individual conditions are fulfilled.
 type FlowFilter struct {
+	// SourcePodNamespaces supports filtering based on the Namespace of the source Pod. By
+	// default, all Namespaces are considered, as well as flows whose source is not a Pod.
+	SourcePodNamespaces []string `yaml:"sourcePodNamespaces,omitempty"`
+	// DestinationPodNamespaces supports filtering based on the Namespace of the destination
+	// Pod. By default, all Namespaces are considered, as well as flows whose destination is not
+	// a Pod.
+	DestinationPodNamespaces []string `yaml:"destinationPodNamespaces,omitempty"`
+	// SourcePodSelector supports filtering based on the labels of the source Pod, using the
+	// Kubernetes label selector syntax (e.g., "app=nginx,tier notin (frontend)"). It requires
+	// recordContents.podLabels to be true.
+	SourcePodSelector string `yaml:"sourcePodSelector,omitempty"`
+	// DestinationPodSelector supports filtering based on the labels of the destination Pod,
+	// using the Kubernetes label selector syntax. It requires recordContents.podLabels to be
+	// true.
+	DestinationPodSelector string `yaml:"destinationPodSelector,omitempty"`
+	// Protocols supports filtering based on the transport protocol of the flow. Supported
+	// values are "TCP", "UDP", "SCTP", "ICMP" and "ICMPv6". By default, all protocols are
+	// considered.
+	Protocols []string `yaml:"protocols,omitempty"`
+	// SourcePorts supports filtering based on the transport source port of the flow.
+	SourcePorts []int32 `yaml:"sourcePorts,omitempty"`
+	// DestinationPorts supports filtering based on the transport destination port of the
+	// flow. Note that for Service traffic, this is the port of the selected endpoint.
+	DestinationPorts []int32 `yaml:"destinationPorts,omitempty"`
+	// SourceCIDRs supports filtering based on the source IP address of the flow.
+	SourceCIDRs []string `yaml:"sourceCIDRs,omitempty"`
+	// DestinationCIDRs supports filtering based on the destination IP address of the flow.
+	DestinationCIDRs []string `yaml:"destinationCIDRs,omitempty"`
+	// FlowTypes supports filtering based on the flow type. Supported values are "IntraNode",
+	// "InterNode", "ToExternal" and "FromExternal". By default, all flow types are considered.
+	FlowTypes []FlowType `yaml:"flowTypes,omitempty"`
 	// IngressNetworkPolicyRuleActions supports filtering based on the action name for the
 	// ingress policy rule applied to the flow. By default, all actions are considered.
 	IngressNetworkPolicyRuleActions []NetworkPolicyRuleAction `yaml:"ingressNetworkPolicyRuleActions,omitempty"`
@@ -35,6 +35,11 @@ const (
 	MinValidIPFIXMsgSize                  = 512
 	MaxValidIPFIXMsgSize                  = 65535
 
+	DefaultCheckpointPath         = "/var/lib/flow-aggregator/checkpoint"
+	DefaultCheckpointInterval     = "30s"
+	MinCheckpointInterval         = 1 * time.Second
+	DefaultCheckpointMaxStaleness = "5m"
+
 	DefaultClickHouseDatabase       = "default"
 	DefaultClickHouseCommitInterval = "8s"
 	MinClickHouseCommitInterval     = 1 * time.Second
@@ -46,9 +51,24 @@ const (
 	DefaultS3UploadInterval    = "60s"
 	MinS3CommitInterval        = 1 * time.Second
 
+	DefaultKafkaTopic        = "antrea-flows"
+	DefaultKafkaRecordFormat = KafkaRecordFormatProtobuf
+	DefaultKafkaPartitioning = KafkaPartitioningFiveTuple
+	DefaultKafkaCompression  = "none"
+	DefaultKafkaBatchSize    = 1000
+	DefaultKafkaBatchTimeout = "1s"
+	MinKafkaBatchTimeout     = 10 * time.Millisecond
+
+	DefaultOTLPProtocol       = OTLPProtocolGRPC
+	DefaultOTLPExportInterval = "5s"
+	DefaultOTLPBatchSize      = 1000
+	MinOTLPExportInterval     = 100 * time.Millisecond
+
 	DefaultLoggerMaxSize      = 100
 	DefaultLoggerMaxBackups   = 3
 	DefaultLoggerRecordFormat = "CSV"
+
+	DefaultFlowSamplingMode = FlowSamplingModeHash
 )
 
 func SetConfigDefaults(flowAggregatorConf *FlowAggregatorConfig) {
@@ -61,6 +81,15 @@ func SetConfigDefaults(flowAggregatorConf *FlowAggregatorConfig) {
 	if flowAggregatorConf.InactiveFlowRecordTimeout == "" {
 		flowAggregatorConf.InactiveFlowRecordTimeout = DefaultInactiveFlowRecordTimeout
 	}
+	if flowAggregatorConf.Checkpoint.Path == "" {
+		flowAggregatorConf.Checkpoint.Path = DefaultCheckpointPath
+	}
+	if flowAggregatorConf.Checkpoint.Interval == "" {
+		flowAggregatorConf.Checkpoint.Interval = DefaultCheckpointInterval
+	}
+	if flowAggregatorConf.Checkpoint.MaxStaleness == "" {
+		flowAggregatorConf.Checkpoint.MaxStaleness = DefaultCheckpointMaxStaleness
+	}
 	if flowAggregatorConf.AggregatorTransportProtocol == "" {
 		flowAggregatorConf.AggregatorTransportProtocol = DefaultAggregatorTransportProtocol
 	}
@@ -103,6 +132,33 @@ func SetConfigDefaults(flowAggregatorConf *FlowAggregatorConfig) {
 	if flowAggregatorConf.S3Uploader.UploadInterval == "" {
 		flowAggregatorConf.S3Uploader.UploadInterval = DefaultS3UploadInterval
 	}
+	if flowAggregatorConf.Kafka.Topic == "" {
+		flowAggregatorConf.Kafka.Topic = DefaultKafkaTopic
+	}
+	if flowAggregatorConf.Kafka.RecordFormat == "" {
+		flowAggregatorConf.Kafka.RecordFormat = DefaultKafkaRecordFormat
+	}
+	if flowAggregatorConf.Kafka.Partitioning == "" {
+		flowAggregatorConf.Kafka.Partitioning = DefaultKafkaPartitioning
+	}
+	if flowAggregatorConf.Kafka.Compression == "" {
+		flowAggregatorConf.Kafka.Compression = DefaultKafkaCompression
+	}
+	if flowAggregatorConf.Kafka.BatchSize == 0 {
+		flowAggregatorConf.Kafka.BatchSize = DefaultKafkaBatchSize
+	}
+	if flowAggregatorConf.Kafka.BatchTimeout == "" {
+		flowAggregatorConf.Kafka.BatchTimeout = DefaultKafkaBatchTimeout
+	}
+	if flowAggregatorConf.OTLP.Protocol == "" {
+		flowAggregatorConf.OTLP.Protocol = DefaultOTLPProtocol
+	}
+	if flowAggregatorConf.OTLP.ExportInterval == "" {
+		flowAggregatorConf.OTLP.ExportInterval = DefaultOTLPExportInterval
+	}
+	if flowAggregatorConf.OTLP.BatchSize == 0 {
+		flowAggregatorConf.OTLP.BatchSize = DefaultOTLPBatchSize
+	}
 	if flowAggregatorConf.FlowLogger.Path == "" {
 		flowAggregatorConf.FlowLogger.Path = filepath.Join(os.TempDir(), "antrea-flows.log")
 	}
@@ -121,4 +177,16 @@ func SetConfigDefaults(flowAggregatorConf *FlowAggregatorConfig) {
 	if flowAggregatorConf.FlowLogger.PrettyPrint == nil {
 		flowAggregatorConf.FlowLogger.PrettyPrint = ptr.To(true)
 	}
+	for _, selection := range []*FlowRecordSelectionConfig{
+		&flowAggregatorConf.FlowCollector.FlowRecordSelectionConfig,
+		&flowAggregatorConf.ClickHouse.FlowRecordSelectionConfig,
+		&flowAggregatorConf.S3Uploader.FlowRecordSelectionConfig,
+		&flowAggregatorConf.Kafka.FlowRecordSelectionConfig,
+		&flowAggregatorConf.OTLP.FlowRecordSelectionConfig,
+		&flowAggregatorConf.FlowLogger.FlowRecordSelectionConfig,
+	} {
+		if selection.Sampling.Mode == "" {
+			selection.Sampling.Mode = DefaultFlowSamplingMode
+		}
+	}
 }
@@ -189,7 +189,8 @@ func newController(objects, crdObjects []runtime.Object) *egressController {
 	groupingController := grouping.NewGroupEntityController(groupEntityIndex,
 		informerFactory.Core().V1().Pods(),
 		informerFactory.Core().V1().Namespaces(),
-		crdInformerFactory.Crd().V1alpha2().ExternalEntities())
+		crdInformerFactory.Crd().V1alpha2().ExternalEntities(),
+		informerFactory.Core().V1().Services())
 	controller := NewEgressController(crdClient, groupEntityIndex, egressInformer, externalIPAllocator, egressGroupStore)
 	return &egressController{
 		controller,
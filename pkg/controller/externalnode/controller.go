@@ -285,7 +285,7 @@ func (c *ExternalNodeController) updateExternalNode(preEn *v1alpha1.ExternalNode
 	} else {
 		preIPs := sets.New[string](preEn.Spec.Interfaces[0].IPs...)
 		curIPs := sets.New[string](curEn.Spec.Interfaces[0].IPs...)
-		if (!reflect.DeepEqual(preEn.Labels, curEn.Labels)) || (!preIPs.Equal(curIPs)) {
+		if (!reflect.DeepEqual(preEn.Labels, curEn.Labels)) || (!preIPs.Equal(curIPs)) || preEn.Spec.ServiceAccountName != curEn.Spec.ServiceAccountName {
 			updatedEE, err := genExternalEntity(curEEName, curEn)
 			if err != nil {
 				return err
@@ -387,8 +387,9 @@ func genExternalEntity(eeName string, en *v1alpha1.ExternalNode) (*v1alpha2.Exte
 			Labels:          en.Labels,
 		},
 		Spec: v1alpha2.ExternalEntitySpec{
-			Endpoints:    endpoints,
-			ExternalNode: en.Name,
+			Endpoints:          endpoints,
+			ExternalNode:       en.Name,
+			ServiceAccountName: en.Spec.ServiceAccountName,
 		},
 	}
 	return ee, nil
@@ -227,6 +227,63 @@ func TestUpdateExternalNode(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "update-service-account",
+			externalNode: &v1alpha1.ExternalNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: "ns1", Labels: map[string]string{"en": "vm1"}},
+				Spec: v1alpha1.ExternalNodeSpec{
+					Interfaces: []v1alpha1.NetworkInterface{{IPs: []string{"1.1.1.2"}}},
+				},
+			},
+			existingEntity: &v1alpha2.ExternalEntity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "vm1",
+					Namespace: "ns1",
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "crd.antrea.io/v1alpha1",
+							Kind:       "ExternalNode",
+							Name:       "vm1",
+						},
+					},
+					Labels: map[string]string{"en": "vm1"},
+				},
+				Spec: v1alpha2.ExternalEntitySpec{
+					Endpoints: []v1alpha2.Endpoint{
+						{IP: "1.1.1.2"},
+					},
+					ExternalNode: "vm1",
+				},
+			},
+			updatedExternalNode: &v1alpha1.ExternalNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: "ns1", Labels: map[string]string{"en": "vm1"}},
+				Spec: v1alpha1.ExternalNodeSpec{
+					Interfaces:         []v1alpha1.NetworkInterface{{IPs: []string{"1.1.1.2"}}},
+					ServiceAccountName: "db",
+				},
+			},
+			expectedEntity: &v1alpha2.ExternalEntity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "vm1",
+					Namespace: "ns1",
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "crd.antrea.io/v1alpha1",
+							Kind:       "ExternalNode",
+							Name:       "vm1",
+						},
+					},
+					Labels: map[string]string{"en": "vm1"},
+				},
+				Spec: v1alpha2.ExternalEntitySpec{
+					Endpoints: []v1alpha2.Endpoint{
+						{IP: "1.1.1.2"},
+					},
+					ExternalNode:       "vm1",
+					ServiceAccountName: "db",
+				},
+			},
+		},
 		{
 			name: "update-interface-name",
 			externalNode: &v1alpha1.ExternalNode{
@@ -112,13 +112,20 @@ type GroupEntityController struct {
 	// namespaceAddEvents tracks the number of Namespace Add events that have been processed.
 	namespaceAddEvents *eventsCounter
 
+	serviceInformer coreinformers.ServiceInformer
+	// serviceListerSynced is a function which returns true if the Service shared informer has been synced at least once.
+	serviceListerSynced cache.InformerSynced
+	// serviceAddEvents tracks the number of Service Add events that have been processed.
+	serviceAddEvents *eventsCounter
+
 	groupEntityIndex *GroupEntityIndex
 }
 
 func NewGroupEntityController(groupEntityIndex *GroupEntityIndex,
 	podInformer coreinformers.PodInformer,
 	namespaceInformer coreinformers.NamespaceInformer,
-	externalEntityInformer crdv1a2informers.ExternalEntityInformer) *GroupEntityController {
+	externalEntityInformer crdv1a2informers.ExternalEntityInformer,
+	serviceInformer coreinformers.ServiceInformer) *GroupEntityController {
 	c := &GroupEntityController{
 		groupEntityIndex:           groupEntityIndex,
 		podInformer:                podInformer,
@@ -130,6 +137,9 @@ func NewGroupEntityController(groupEntityIndex *GroupEntityIndex,
 		externalEntityInformer:     externalEntityInformer,
 		externalEntityListerSynced: externalEntityInformer.Informer().HasSynced,
 		externalEntityAddEvents:    new(eventsCounter),
+		serviceInformer:            serviceInformer,
+		serviceListerSynced:        serviceInformer.Informer().HasSynced,
+		serviceAddEvents:           new(eventsCounter),
 	}
 	// Add handlers for Pod events.
 	podInformer.Informer().AddEventHandlerWithResyncPeriod(
@@ -159,6 +169,15 @@ func NewGroupEntityController(groupEntityIndex *GroupEntityIndex,
 			},
 			resyncPeriod,
 		)
+		// Add handlers for Service events, which are used by Antrea-native policies selecting Services by labels.
+		serviceInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    c.addService,
+				UpdateFunc: c.updateService,
+				DeleteFunc: c.deleteService,
+			},
+			resyncPeriod,
+		)
 	}
 	return c
 }
@@ -168,9 +187,9 @@ func (c *GroupEntityController) Run(stopCh <-chan struct{}) {
 	defer klog.Infof("Shutting down %s", controllerName)
 
 	cacheSyncs := []cache.InformerSynced{c.podListerSynced, c.namespaceListerSynced}
-	// Wait for externalEntityListerSynced when AntreaPolicy feature gate is enabled.
+	// Wait for externalEntityListerSynced and serviceListerSynced when AntreaPolicy feature gate is enabled.
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
-		cacheSyncs = append(cacheSyncs, c.externalEntityListerSynced)
+		cacheSyncs = append(cacheSyncs, c.externalEntityListerSynced, c.serviceListerSynced)
 	}
 	if !cache.WaitForNamedCacheSync(controllerName, stopCh, cacheSyncs...) {
 		return
@@ -180,8 +199,10 @@ func (c *GroupEntityController) Run(stopCh <-chan struct{}) {
 	initialPodCount := len(c.podInformer.Informer().GetStore().List())
 	initialNamespaceCount := len(c.namespaceInformer.Informer().GetStore().List())
 	initialExternalEntityCount := 0
+	initialServiceCount := 0
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		initialExternalEntityCount = len(c.externalEntityInformer.Informer().GetStore().List())
+		initialServiceCount = len(c.serviceInformer.Informer().GetStore().List())
 	}
 
 	// Wait until all event handlers process the initial resources before setting groupEntityIndex as synced.
@@ -196,6 +217,9 @@ func (c *GroupEntityController) Run(stopCh <-chan struct{}) {
 			if uint64(initialExternalEntityCount) > c.externalEntityAddEvents.Load() {
 				return false, nil
 			}
+			if uint64(initialServiceCount) > c.serviceAddEvents.Load() {
+				return false, nil
+			}
 		}
 		return true, nil
 	}); err == nil {
@@ -297,3 +321,34 @@ func (c *GroupEntityController) deleteExternalEntity(old interface{}) {
 	klog.V(2).Infof("Processing ExternalEntity %s/%s DELETE event, labels: %v", ee.GetNamespace(), ee.GetName(), ee.GetLabels())
 	c.groupEntityIndex.DeleteExternalEntity(ee)
 }
+
+func (c *GroupEntityController) addService(obj interface{}) {
+	service := obj.(*v1.Service)
+	klog.V(2).InfoS("Processing Service ADD event", "service", klog.KObj(service), "labels", service.Labels)
+	c.groupEntityIndex.AddService(service)
+	c.serviceAddEvents.Increment()
+}
+
+func (c *GroupEntityController) updateService(_, curObj interface{}) {
+	curService := curObj.(*v1.Service)
+	klog.V(2).InfoS("Processing Service UPDATE event", "service", klog.KObj(curService), "labels", curService.Labels)
+	c.groupEntityIndex.AddService(curService)
+}
+
+func (c *GroupEntityController) deleteService(old interface{}) {
+	service, ok := old.(*v1.Service)
+	if !ok {
+		tombstone, ok := old.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting Service, invalid type: %v", old)
+			return
+		}
+		service, ok = tombstone.Obj.(*v1.Service)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting Service, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	klog.V(2).InfoS("Processing Service DELETE event", "service", klog.KObj(service), "labels", service.Labels)
+	c.groupEntityIndex.DeleteService(service)
+}
@@ -40,6 +40,7 @@ func TestGroupEntityControllerRun(t *testing.T) {
 		initialPods             []*v1.Pod
 		initialExternalEntities []*v1alpha2.ExternalEntity
 		initialNamespaces       []*v1.Namespace
+		initialServices         []*v1.Service
 		initialGroups           []*group
 		antreaPolicyEnabled     bool
 	}{
@@ -48,7 +49,8 @@ func TestGroupEntityControllerRun(t *testing.T) {
 			initialPods:             []*v1.Pod{podFoo1, podFoo2, podBar1, podFoo1InOtherNamespace},
 			initialExternalEntities: []*v1alpha2.ExternalEntity{eeFoo1, eeFoo2, eeBar1, eeFoo1InOtherNamespace},
 			initialNamespaces:       []*v1.Namespace{nsDefault, nsOther},
-			initialGroups:           []*group{groupPodFooType1, groupPodFooType2, groupPodFooAllNamespaceType1, groupEEFooType1, groupEEFooType2, groupEEFooAllNamespaceType1},
+			initialServices:         []*v1.Service{svcFoo1, svcBar1, svcFoo1InOtherNamespace},
+			initialGroups:           []*group{groupPodFooType1, groupPodFooType2, groupPodFooAllNamespaceType1, groupEEFooType1, groupEEFooType2, groupEEFooAllNamespaceType1, groupSvcFooType1},
 			antreaPolicyEnabled:     true,
 		},
 		{
@@ -76,6 +78,9 @@ func TestGroupEntityControllerRun(t *testing.T) {
 			for _, namespace := range tt.initialNamespaces {
 				objs = append(objs, namespace)
 			}
+			for _, service := range tt.initialServices {
+				objs = append(objs, service)
+			}
 			var crdObjs []runtime.Object
 			for _, externalEntity := range tt.initialExternalEntities {
 				crdObjs = append(crdObjs, externalEntity)
@@ -91,7 +96,7 @@ func TestGroupEntityControllerRun(t *testing.T) {
 			stopCh := make(chan struct{})
 			defer close(stopCh)
 
-			c := NewGroupEntityController(index, informerFactory.Core().V1().Pods(), informerFactory.Core().V1().Namespaces(), crdInformerFactory.Crd().V1alpha2().ExternalEntities())
+			c := NewGroupEntityController(index, informerFactory.Core().V1().Pods(), informerFactory.Core().V1().Namespaces(), crdInformerFactory.Crd().V1alpha2().ExternalEntities(), informerFactory.Core().V1().Services())
 			assert.False(t, index.HasSynced(), "GroupEntityIndex has been synced before starting InformerFactories")
 
 			informerFactory.Start(stopCh)
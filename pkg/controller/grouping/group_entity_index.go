@@ -60,13 +60,19 @@ type Interface interface {
 	AddGroup(groupType GroupType, name string, selector *types.GroupSelector)
 	// DeleteGroup deletes a group from the index.
 	DeleteGroup(groupType GroupType, name string)
-	// AddEventHandler registers an eventHandler for the given type of groups. When any Pod/ExternelEntity/Namespace
+	// AddEventHandler registers an eventHandler for the given type of groups. When any Pod/ExternelEntity/Service/Namespace
 	// update affects the given kind of groups, the eventHandler will be called with the affected groups.
 	// The eventHandler is supposed to execute quickly and not perform blocking operation. Blocking operation should be
 	// deferred to a routine that is triggered by the eventHandler, like the eventHandler + workqueue pattern.
 	AddEventHandler(groupType GroupType, handler eventHandler)
 	// GetEntities returns the selected Pods or ExternalEntities for the given group.
 	GetEntities(groupType GroupType, name string) ([]*v1.Pod, []*v1alpha2.ExternalEntity)
+	// GetEntitiesBySelector returns the Pods or ExternalEntities selected by the given selector. Unlike AddGroup, it
+	// doesn't add anything to the index, hence it can be used to evaluate selectors of objects which don't exist yet.
+	GetEntitiesBySelector(selector *types.GroupSelector) ([]*v1.Pod, []*v1alpha2.ExternalEntity)
+	// GetServices returns the selected Services for the given group. Only groups with ServiceSelector set select
+	// Services.
+	GetServices(groupType GroupType, name string) []*v1.Service
 	// GetGroupsForPod returns the groups that select the given Pod.
 	GetGroupsForPod(namespace, name string) (map[GroupType][]string, bool)
 	// GetGroupsForExternalEntity returns the groups that select the given ExternalEntity.
@@ -83,6 +89,12 @@ type Interface interface {
 	// DeleteExternalEntity deletes an ExternalEntity from the index. If any existing groups are affected, eventHandlers
 	// will be called with the affected groups.
 	DeleteExternalEntity(ee *v1alpha2.ExternalEntity)
+	// AddService adds or updates a Service to the index. If any existing groups are affected, eventHandlers will be
+	// called with the affected groups.
+	AddService(service *v1.Service)
+	// DeleteService deletes a Service from the index. If any existing groups are affected, eventHandlers will be
+	// called with the affected groups.
+	DeleteService(service *v1.Service)
 	// AddNamespace adds or updates a Namespace to the index. If any existing groups are affected, eventHandlers will be
 	// called with the affected groups.
 	AddNamespace(namespace *v1.Namespace)
@@ -91,22 +103,23 @@ type Interface interface {
 	DeleteNamespace(namespace *v1.Namespace)
 	// Run starts the index.
 	Run(stopCh <-chan struct{})
-	// HasSynced returns true if the interface has been initialized with the full lists of Pods, Namespaces, and
-	// ExternalEntities.
+	// HasSynced returns true if the interface has been initialized with the full lists of Pods, Namespaces,
+	// ExternalEntities, and Services.
 	HasSynced() bool
 }
 
-// entityType is an internal type used to differentiate Pod from ExternalEntity.
+// entityType is an internal type used to differentiate Pod, ExternalEntity, and Service.
 type entityType int
 
 const (
 	podEntityType entityType = iota
 	externalEntityType
+	serviceEntityType
 )
 
-// entityItem contains an entity (either Pod or ExternalEntity) and some relevant information.
+// entityItem contains an entity (a Pod, an ExternalEntity, or a Service) and some relevant information.
 type entityItem struct {
-	// entity is either a Pod or an ExternalEntity.
+	// entity is a Pod, an ExternalEntity, or a Service.
 	entity metav1.Object
 	// labelItemKey is the key of the labelItem that the entityItem is associated with.
 	// entityItems will be associated with the same labelItem if they have same Namespace, entityType, and labels.
@@ -211,9 +224,9 @@ func NewGroupEntityIndex() *GroupEntityIndex {
 		entityItems:       map[string]*entityItem{},
 		groupItems:        map[string]*groupItem{},
 		labelItems:        map[string]*labelItem{},
-		labelItemIndex:    map[entityType]map[string]sets.Set[string]{podEntityType: {}, externalEntityType: {}},
+		labelItemIndex:    map[entityType]map[string]sets.Set[string]{podEntityType: {}, externalEntityType: {}, serviceEntityType: {}},
 		selectorItems:     map[string]*selectorItem{},
-		selectorItemIndex: map[entityType]map[string]sets.Set[string]{podEntityType: {}, externalEntityType: {}},
+		selectorItemIndex: map[entityType]map[string]sets.Set[string]{podEntityType: {}, externalEntityType: {}, serviceEntityType: {}},
 		namespaceLabels:   map[string]labels.Set{},
 		eventHandlers:     map[GroupType][]eventHandler{},
 		eventChan:         make(chan string, eventChanSize),
@@ -235,10 +248,68 @@ func (i *GroupEntityIndex) GetEntities(groupType GroupType, name string) ([]*v1.
 
 	// Get the selectorItem the group is associated with.
 	sItem := i.selectorItems[gItem.selectorItemKey]
+	// Collect the entities of the labelItems the selectorItem matches.
+	return i.getEntitiesForLabelItems(sItem.labelItemKeys)
+}
+
+func (i *GroupEntityIndex) GetServices(groupType GroupType, name string) []*v1.Service {
+	gKey := getGroupItemKey(groupType, name)
+
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	gItem, exists := i.groupItems[gKey]
+	if !exists {
+		return nil
+	}
+
+	var services []*v1.Service
+	sItem := i.selectorItems[gItem.selectorItemKey]
+	for lKey := range sItem.labelItemKeys {
+		lItem := i.labelItems[lKey]
+		for entityItemKey := range lItem.entityItemKeys {
+			if service, ok := i.entityItems[entityItemKey].entity.(*v1.Service); ok {
+				services = append(services, service)
+			}
+		}
+	}
+	return services
+}
+
+func (i *GroupEntityIndex) GetEntitiesBySelector(selector *types.GroupSelector) ([]*v1.Pod, []*v1alpha2.ExternalEntity) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	// Reuse the matching result if a group with the same selector already exists.
+	if sItem, exists := i.selectorItems[getSelectorItemKey(selector)]; exists {
+		return i.getEntitiesForLabelItems(sItem.labelItemKeys)
+	}
+	entityType := getSelectorEntityType(selector)
+	labelItemKeys := sets.New[string]()
+	matchLabelItems := func(potentialLabelItemKeys sets.Set[string]) {
+		for lKey := range potentialLabelItemKeys {
+			lItem := i.labelItems[lKey]
+			if i.match(lItem.entityType, lItem.labels, lItem.namespace, selector) {
+				labelItemKeys.Insert(lKey)
+			}
+		}
+	}
+	// Scan potential labelItems in the same way as createSelectorItem, without associating them with the selector.
+	if selector.Namespace != "" {
+		matchLabelItems(i.labelItemIndex[entityType][selector.Namespace])
+	} else {
+		for _, potentialLabelItemKeys := range i.labelItemIndex[entityType] {
+			matchLabelItems(potentialLabelItemKeys)
+		}
+	}
+	return i.getEntitiesForLabelItems(labelItemKeys)
+}
+
+// getEntitiesForLabelItems returns the Pods and ExternalEntities sharing the given labelItems.
+func (i *GroupEntityIndex) getEntitiesForLabelItems(labelItemKeys sets.Set[string]) ([]*v1.Pod, []*v1alpha2.ExternalEntity) {
 	var pods []*v1.Pod
 	var externalEntities []*v1alpha2.ExternalEntity
-	// Get the keys of the labelItems the selectorItem matches.
-	for lKey := range sItem.labelItemKeys {
+	for lKey := range labelItemKeys {
 		lItem := i.labelItems[lKey]
 		// Collect the entityItems that share the labelItem.
 		for entityItemKey := range lItem.entityItemKeys {
@@ -313,12 +384,7 @@ func (i *GroupEntityIndex) AddNamespace(namespace *v1.Namespace) {
 			if sItem.selector.NamespaceSelector == nil || sItem.selector.NamespaceSelector.Empty() {
 				continue
 			}
-			// By default, the selector selects Pods. It selects ExternalEntities only if ExternalEntitySelector is set
-			// explicitly.
-			entityType := podEntityType
-			if sItem.selector.ExternalEntitySelector != nil {
-				entityType = externalEntityType
-			}
+			entityType := getSelectorEntityType(sItem.selector)
 			// Only labelItems in this Namespace may be affected.
 			if i.scanLabelItems(i.labelItemIndex[entityType][namespace.Name], sItem) {
 				// Notify watchers if the selectorItem is updated.
@@ -413,7 +479,21 @@ func (i *GroupEntityIndex) AddPod(pod *v1.Pod) {
 }
 
 func (i *GroupEntityIndex) AddExternalEntity(ee *v1alpha2.ExternalEntity) {
-	i.addEntity(externalEntityType, ee, ee.Labels)
+	if ee.Spec.ServiceAccountName == "" {
+		i.addEntity(externalEntityType, ee, ee.Labels)
+		return
+	}
+	// Like Pods, ExternalEntities with a ServiceAccount can be selected by their ServiceAccount.
+	labels := make(map[string]string, len(ee.Labels)+1)
+	for k, v := range ee.GetLabels() {
+		labels[k] = v
+	}
+	labels[CustomLabelKeyPrefix+CustomLabelKeyServiceAccount] = ee.Spec.ServiceAccountName
+	i.addEntity(externalEntityType, ee, labels)
+}
+
+func (i *GroupEntityIndex) AddService(service *v1.Service) {
+	i.addEntity(serviceEntityType, service, service.Labels)
 }
 
 func (i *GroupEntityIndex) addEntity(entityType entityType, entity metav1.Object, labels map[string]string) {
@@ -485,6 +565,10 @@ func (i *GroupEntityIndex) DeleteExternalEntity(ee *v1alpha2.ExternalEntity) {
 	i.deleteEntity(externalEntityType, ee)
 }
 
+func (i *GroupEntityIndex) DeleteService(service *v1.Service) {
+	i.deleteEntity(serviceEntityType, service)
+}
+
 func (i *GroupEntityIndex) deleteEntity(entityType entityType, entity metav1.Object) {
 	eKey := getEntityItemKey(entityType, entity)
 
@@ -519,10 +603,7 @@ func (i *GroupEntityIndex) deleteGroupFromSelectorItem(sKey, gKey string) *selec
 	delete(i.selectorItems, sKey)
 
 	// Delete it from the selectorItemIndex.
-	entityType := podEntityType
-	if sItem.selector.ExternalEntitySelector != nil {
-		entityType = externalEntityType
-	}
+	entityType := getSelectorEntityType(sItem.selector)
 	i.selectorItemIndex[entityType][sItem.selector.Namespace].Delete(sKey)
 	if len(i.selectorItemIndex[entityType][sItem.selector.Namespace]) == 0 {
 		delete(i.selectorItemIndex[entityType], sItem.selector.Namespace)
@@ -547,10 +628,7 @@ func (i *GroupEntityIndex) createSelectorItem(gItem *groupItem) *selectorItem {
 	// Create the selectorItem.
 	i.selectorItems[gItem.selectorItemKey] = sItem
 	// Add it to the selectorItemIndex.
-	entityType := podEntityType
-	if gItem.selector.ExternalEntitySelector != nil {
-		entityType = externalEntityType
-	}
+	entityType := getSelectorEntityType(gItem.selector)
 	selectorItemKeys, exists := i.selectorItemIndex[entityType][sItem.selector.Namespace]
 	if !exists {
 		selectorItemKeys = sets.New[string]()
@@ -696,8 +774,11 @@ func (i *GroupEntityIndex) setSynced(synced bool) {
 
 func (i *GroupEntityIndex) match(entityType entityType, label labels.Set, namespace string, sel *types.GroupSelector) bool {
 	objSelector := sel.PodSelector
-	if entityType == externalEntityType {
+	switch entityType {
+	case externalEntityType:
 		objSelector = sel.ExternalEntitySelector
+	case serviceEntityType:
+		objSelector = sel.ServiceSelector
 	}
 	if sel.Namespace != "" {
 		if sel.Namespace != namespace {
@@ -770,6 +851,18 @@ func entityAttrsUpdated(oldEntity, newEntity metav1.Object) bool {
 	return false
 }
 
+// getSelectorEntityType returns the type of the entities the given selector selects. By default, the selector selects
+// Pods. It selects ExternalEntities or Services only if ExternalEntitySelector or ServiceSelector is set explicitly.
+func getSelectorEntityType(selector *types.GroupSelector) entityType {
+	if selector.ExternalEntitySelector != nil {
+		return externalEntityType
+	}
+	if selector.ServiceSelector != nil {
+		return serviceEntityType
+	}
+	return podEntityType
+}
+
 // getEntityItemKey returns the entity key used in entityItems.
 func getEntityItemKey(entityType entityType, entity metav1.Object) string {
 	return fmt.Sprint(entityType) + "/" + entity.GetNamespace() + "/" + entity.GetName()
@@ -43,6 +43,11 @@ var (
 	eeFoo2                 = newExternalEntity("default", "eeFoo2", map[string]string{"app": "foo"})
 	eeBar1                 = newExternalEntity("default", "eeBar1", map[string]string{"app": "bar"})
 	eeFoo1InOtherNamespace = newExternalEntity("other", "eeFoo1", map[string]string{"app": "foo"})
+	// Fake Services
+	svcFoo1                 = newService("default", "svcFoo1", map[string]string{"app": "foo"})
+	svcFoo2                 = newService("default", "svcFoo2", map[string]string{"app": "foo"})
+	svcBar1                 = newService("default", "svcBar1", map[string]string{"app": "bar"})
+	svcFoo1InOtherNamespace = newService("other", "svcFoo1", map[string]string{"app": "foo"})
 	// Fake Namespaces
 	nsDefault = newNamespace("default", map[string]string{"company": "default"})
 	nsOther   = newNamespace("other", map[string]string{"company": "other"})
@@ -55,6 +60,8 @@ var (
 	groupPodFooAllNamespaceType1 = &group{groupType: groupType1, groupName: "groupPodFooAllNamespaceType1", groupSelector: types.NewGroupSelector("", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil, nil, nil)}
 	groupPodAllNamespaceType1    = &group{groupType: groupType1, groupName: "groupPodAllNamespaceType1", groupSelector: types.NewGroupSelector("", nil, &metav1.LabelSelector{}, nil, nil)}
 	groupEEFooAllNamespaceType1  = &group{groupType: groupType1, groupName: "groupEEFooAllNamespaceType1", groupSelector: types.NewGroupSelector("", nil, &metav1.LabelSelector{}, &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil)}
+	groupSvcFooType1             = &group{groupType: groupType1, groupName: "groupSvcFooType1", groupSelector: types.NewServiceGroupSelector("default", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil)}
+	groupSvcFooAllNamespaceType2 = &group{groupType: groupType2, groupName: "groupSvcFooAllNamespaceType2", groupSelector: types.NewServiceGroupSelector("", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, &metav1.LabelSelector{})}
 )
 
 type group struct {
@@ -110,6 +117,16 @@ func newExternalEntity(namespace, name string, labels map[string]string) *v1alph
 	}
 }
 
+func newService(namespace, name string, labels map[string]string) *v1.Service {
+	return &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels:    labels,
+		},
+	}
+}
+
 func TestGroupEntityIndexGetEntities(t *testing.T) {
 	tests := []struct {
 		name                     string
@@ -186,6 +203,135 @@ func TestGroupEntityIndexGetEntities(t *testing.T) {
 	}
 }
 
+func TestGroupEntityIndexGetServices(t *testing.T) {
+	tests := []struct {
+		name               string
+		inputGroupSelector *types.GroupSelector
+		expectedServices   []*v1.Service
+	}{
+		{
+			name:               "namespace scoped service selector",
+			inputGroupSelector: types.NewServiceGroupSelector("default", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil),
+			expectedServices:   []*v1.Service{svcFoo1, svcFoo2},
+		},
+		{
+			name:               "cluster scoped service selector",
+			inputGroupSelector: types.NewServiceGroupSelector("", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, &metav1.LabelSelector{}),
+			expectedServices:   []*v1.Service{svcFoo1, svcFoo2, svcFoo1InOtherNamespace},
+		},
+		{
+			name:               "service selector with namespaceSelector",
+			inputGroupSelector: types.NewServiceGroupSelector("", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, &metav1.LabelSelector{MatchLabels: nsOther.Labels}),
+			expectedServices:   []*v1.Service{svcFoo1InOtherNamespace},
+		},
+		{
+			name:               "pod selector",
+			inputGroupSelector: types.NewGroupSelector("default", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil, nil, nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := NewGroupEntityIndex()
+			for _, ns := range []*v1.Namespace{nsDefault, nsOther} {
+				index.AddNamespace(ns)
+			}
+			for _, pod := range []*v1.Pod{podFoo1, podFoo2, podBar1, podFoo1InOtherNamespace} {
+				index.AddPod(pod)
+			}
+			for _, svc := range []*v1.Service{svcFoo1, svcFoo2, svcBar1, svcFoo1InOtherNamespace} {
+				index.AddService(svc)
+			}
+			index.AddGroup(groupType1, "group", tt.inputGroupSelector)
+
+			assert.ElementsMatch(t, tt.expectedServices, index.GetServices(groupType1, "group"))
+		})
+	}
+}
+
+func TestGroupEntityIndexGetEntitiesBySelector(t *testing.T) {
+	tests := []struct {
+		name                     string
+		existingGroups           []*group
+		inputGroupSelector       *types.GroupSelector
+		expectedPods             []*v1.Pod
+		expectedExternalEntities []*v1alpha2.ExternalEntity
+	}{
+		{
+			name:               "namespace scoped pod selector",
+			inputGroupSelector: types.NewGroupSelector("default", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil, nil, nil),
+			expectedPods:       []*v1.Pod{podFoo1, podFoo2},
+		},
+		{
+			name:                     "cluster scoped externalEntity selector",
+			inputGroupSelector:       types.NewGroupSelector("", nil, nil, &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil),
+			expectedExternalEntities: []*v1alpha2.ExternalEntity{eeFoo1, eeFoo2, eeFoo1InOtherNamespace},
+		},
+		{
+			name:               "cluster scoped pod selector with namespaceSelector",
+			inputGroupSelector: types.NewGroupSelector("", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, &metav1.LabelSelector{MatchLabels: nsOther.Labels}, nil, nil),
+			expectedPods:       []*v1.Pod{podFoo1InOtherNamespace},
+		},
+		{
+			name:               "all pods",
+			inputGroupSelector: types.NewGroupSelector("", &metav1.LabelSelector{}, nil, nil, nil),
+			expectedPods:       []*v1.Pod{podFoo1, podFoo2, podBar1, podFoo1InOtherNamespace},
+		},
+		{
+			name:               "selector of existing group",
+			existingGroups:     []*group{groupPodFooAllNamespaceType1},
+			inputGroupSelector: types.NewGroupSelector("", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil, nil, nil),
+			expectedPods:       []*v1.Pod{podFoo1, podFoo2, podFoo1InOtherNamespace},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := NewGroupEntityIndex()
+			for _, ns := range []*v1.Namespace{nsDefault, nsOther} {
+				index.AddNamespace(ns)
+			}
+			for _, pod := range []*v1.Pod{podFoo1, podFoo2, podBar1, podFoo1InOtherNamespace} {
+				index.AddPod(pod)
+			}
+			for _, ee := range []*v1alpha2.ExternalEntity{eeFoo1, eeFoo2, eeBar1, eeFoo1InOtherNamespace} {
+				index.AddExternalEntity(ee)
+			}
+			for _, g := range tt.existingGroups {
+				index.AddGroup(g.groupType, g.groupName, g.groupSelector)
+			}
+
+			pods, ees := index.GetEntitiesBySelector(tt.inputGroupSelector)
+			assert.ElementsMatch(t, tt.expectedPods, pods)
+			assert.ElementsMatch(t, tt.expectedExternalEntities, ees)
+			// The index must not be modified by the query.
+			assert.Len(t, index.groupItems, len(tt.existingGroups))
+			assert.Len(t, index.selectorItems, len(tt.existingGroups))
+		})
+	}
+}
+
+func TestGroupEntityIndexGetEntitiesByServiceAccount(t *testing.T) {
+	saSelector := &metav1.LabelSelector{MatchLabels: map[string]string{CustomLabelKeyPrefix + CustomLabelKeyServiceAccount: "sa1"}}
+	podSA1 := copyAndMutatePod(podFoo1, func(pod *v1.Pod) { pod.Spec.ServiceAccountName = "sa1" })
+	podSA2 := copyAndMutatePod(podFoo2, func(pod *v1.Pod) { pod.Spec.ServiceAccountName = "sa2" })
+	eeSA1 := copyAndMutateExternalEntity(eeFoo1, func(ee *v1alpha2.ExternalEntity) { ee.Spec.ServiceAccountName = "sa1" })
+
+	index := NewGroupEntityIndex()
+	index.AddNamespace(nsDefault)
+	index.AddPod(podSA1)
+	index.AddPod(podSA2)
+	index.AddExternalEntity(eeSA1)
+	index.AddExternalEntity(eeFoo2)
+
+	pods, ees := index.GetEntitiesBySelector(types.NewGroupSelector("default", saSelector, nil, nil, nil))
+	assert.ElementsMatch(t, []*v1.Pod{podSA1}, pods)
+	assert.Empty(t, ees)
+	pods, ees = index.GetEntitiesBySelector(types.NewGroupSelector("default", nil, nil, saSelector, nil))
+	assert.Empty(t, pods)
+	assert.ElementsMatch(t, []*v1alpha2.ExternalEntity{eeSA1}, ees)
+	// The original labels of the ExternalEntity must not be modified.
+	assert.Equal(t, map[string]string{"app": "foo"}, eeSA1.Labels)
+}
+
 func TestGroupEntityIndexGetGroups(t *testing.T) {
 	index := NewGroupEntityIndex()
 	pods := []*v1.Pod{podFoo1, podFoo2, podBar1, podFoo1InOtherNamespace}
@@ -408,6 +554,7 @@ func TestGroupEntityIndexEventHandlers(t *testing.T) {
 		existingPods             []*v1.Pod
 		existingNamespaces       []*v1.Namespace
 		existingExternalEntities []*v1alpha2.ExternalEntity
+		existingServices         []*v1.Service
 		existingGroups           []*group
 		inputEvent               func(*GroupEntityIndex)
 		addedPod                 *v1.Pod
@@ -549,6 +696,37 @@ func TestGroupEntityIndexEventHandlers(t *testing.T) {
 			},
 			expectedGroupsCalled: map[GroupType][]string{groupType1: {"groupCompanyDefault", "groupCompanyOther"}},
 		},
+		{
+			name:                 "add a new service",
+			existingNamespaces:   []*v1.Namespace{nsDefault, nsOther},
+			existingPods:         []*v1.Pod{podFoo1, podBar1},
+			existingServices:     []*v1.Service{svcFoo1, svcBar1},
+			existingGroups:       []*group{groupPodFooType1, groupSvcFooType1, groupSvcFooAllNamespaceType2},
+			inputEvent:           func(i *GroupEntityIndex) { i.AddService(svcFoo1InOtherNamespace) },
+			expectedGroupsCalled: map[GroupType][]string{groupType2: {groupSvcFooAllNamespaceType2.groupName}},
+		},
+		{
+			name:               "update an existing service's labels",
+			existingNamespaces: []*v1.Namespace{nsDefault, nsOther},
+			existingPods:       []*v1.Pod{podFoo1, podBar1},
+			existingServices:   []*v1.Service{svcFoo1, svcBar1},
+			existingGroups:     []*group{groupPodFooType1, groupSvcFooType1, groupSvcFooAllNamespaceType2},
+			inputEvent: func(i *GroupEntityIndex) {
+				svc := svcBar1.DeepCopy()
+				svc.Labels = map[string]string{"app": "foo"}
+				i.AddService(svc)
+			},
+			expectedGroupsCalled: map[GroupType][]string{groupType1: {groupSvcFooType1.groupName}, groupType2: {groupSvcFooAllNamespaceType2.groupName}},
+		},
+		{
+			name:                 "delete an existing service",
+			existingNamespaces:   []*v1.Namespace{nsDefault, nsOther},
+			existingPods:         []*v1.Pod{podFoo1, podBar1},
+			existingServices:     []*v1.Service{svcFoo1, svcBar1},
+			existingGroups:       []*group{groupPodFooType1, groupSvcFooType1, groupSvcFooAllNamespaceType2},
+			inputEvent:           func(i *GroupEntityIndex) { i.DeleteService(svcFoo1) },
+			expectedGroupsCalled: map[GroupType][]string{groupType1: {groupSvcFooType1.groupName}, groupType2: {groupSvcFooAllNamespaceType2.groupName}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -578,6 +756,9 @@ func TestGroupEntityIndexEventHandlers(t *testing.T) {
 			for _, ee := range tt.existingExternalEntities {
 				index.AddExternalEntity(ee)
 			}
+			for _, svc := range tt.existingServices {
+				index.AddService(svc)
+			}
 			for _, group := range tt.existingGroups {
 				index.AddGroup(group.groupType, group.groupName, group.groupSelector)
 			}
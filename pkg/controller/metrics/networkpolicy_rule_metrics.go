@@ -0,0 +1,213 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync"
+
+	"k8s.io/component-base/metrics"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
+)
+
+const (
+	// overflowLabelValue is the value of the policy and rule labels of the series which aggregate the stats of
+	// the rules exceeding the maximum number of rules exposed with their own labels.
+	overflowLabelValue = "_overflow"
+	// unknownAction is the value of the action label when the action of a rule can't be determined, e.g. when
+	// the rule has been removed from the policy.
+	unknownAction = "Unknown"
+	// auditActionPrefix prefixes the action label of the rules of policies in Audit mode, which are not enforced,
+	// e.g. "AuditDrop".
+	auditActionPrefix = "Audit"
+)
+
+var ruleMetricLabels = []string{"policy_type", "policy_namespace", "policy_name", "rule_name", "action"}
+
+var (
+	NetworkPolicyRulePackets = metrics.NewCounterVec(&metrics.CounterOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "network_policy_rule_packets_total",
+		Help:           "The total number of packets matching a NetworkPolicy rule, as reported by the antrea-agents",
+		StabilityLevel: metrics.ALPHA,
+	}, ruleMetricLabels)
+	NetworkPolicyRuleBytes = metrics.NewCounterVec(&metrics.CounterOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "network_policy_rule_bytes_total",
+		Help:           "The total number of bytes matching a NetworkPolicy rule, as reported by the antrea-agents",
+		StabilityLevel: metrics.ALPHA,
+	}, ruleMetricLabels)
+	NetworkPolicyRuleSessions = metrics.NewCounterVec(&metrics.CounterOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "network_policy_rule_sessions_total",
+		Help:           "The total number of sessions matching a NetworkPolicy rule, as reported by the antrea-agents",
+		StabilityLevel: metrics.ALPHA,
+	}, ruleMetricLabels)
+)
+
+// ruleSeries identifies the series of a NetworkPolicy rule.
+type ruleSeries struct {
+	policyType controlplane.NetworkPolicyType
+	namespace  string
+	name       string
+	rule       string
+	action     string
+}
+
+func (s ruleSeries) labelValues() []string {
+	return []string{string(s.policyType), s.namespace, s.name, s.rule, s.action}
+}
+
+type policyKey struct {
+	policyType controlplane.NetworkPolicyType
+	namespace  string
+	name       string
+}
+
+// NetworkPolicyRuleMetrics exposes the traffic stats of NetworkPolicy rules collected from the antrea-agents as
+// Prometheus counters, labelled with the policy, the rule name and the rule action. To bound the cardinality of the
+// metrics, at most maxRules rules get their own series, the stats of additional rules are added to per policy type
+// and action series whose policy and rule labels are set to "_overflow". A rule has a single series, which is
+// replaced when the action of the rule changes. The series of a policy are removed when it is deleted, which lets
+// other rules get their own series.
+// It implements pkg/controller/stats.RuleMetricsRecorder.
+type NetworkPolicyRuleMetrics struct {
+	acnpLister crdlisters.ClusterNetworkPolicyLister
+	annpLister crdlisters.NetworkPolicyLister
+	maxRules   int
+
+	mutex sync.Mutex
+	// policySeries stores the series of each policy exposed with their own labels, keyed by rule name.
+	policySeries map[policyKey]map[string]ruleSeries
+	// numSeries is the total number of series in policySeries.
+	numSeries int
+}
+
+func NewNetworkPolicyRuleMetrics(acnpLister crdlisters.ClusterNetworkPolicyLister, annpLister crdlisters.NetworkPolicyLister, maxRules int) *NetworkPolicyRuleMetrics {
+	return &NetworkPolicyRuleMetrics{
+		acnpLister:   acnpLister,
+		annpLister:   annpLister,
+		maxRules:     maxRules,
+		policySeries: map[policyKey]map[string]ruleSeries{},
+	}
+}
+
+// RecordNetworkPolicyStats adds the stats of a NetworkPolicy reported by an antrea-agent to the counters of its rules.
+// K8s NetworkPolicies don't have rule names, their stats are added to a single series with an empty rule name.
+func (m *NetworkPolicyRuleMetrics) RecordNetworkPolicyStats(policy *controlplane.NetworkPolicyReference, stats *statsv1alpha1.TrafficStats, ruleStats []statsv1alpha1.RuleTrafficStats) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	// Antrea agents which are not updated yet only report the overall stats of Antrea-native policies.
+	if len(ruleStats) == 0 {
+		if !isZero(stats) {
+			m.record(ruleSeries{policy.Type, policy.Namespace, policy.Name, "", m.getRuleAction(policy, "")}, stats)
+		}
+		return
+	}
+	for i := range ruleStats {
+		if isZero(&ruleStats[i].TrafficStats) {
+			continue
+		}
+		m.record(ruleSeries{policy.Type, policy.Namespace, policy.Name, ruleStats[i].Name, m.getRuleAction(policy, ruleStats[i].Name)}, &ruleStats[i].TrafficStats)
+	}
+}
+
+// DeleteNetworkPolicy removes the series of a deleted NetworkPolicy.
+func (m *NetworkPolicyRuleMetrics) DeleteNetworkPolicy(policyType controlplane.NetworkPolicyType, namespace, name string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	key := policyKey{policyType, namespace, name}
+	for _, series := range m.policySeries[key] {
+		deleteSeries(series)
+		m.numSeries--
+	}
+	delete(m.policySeries, key)
+}
+
+func (m *NetworkPolicyRuleMetrics) record(series ruleSeries, stats *statsv1alpha1.TrafficStats) {
+	key := policyKey{series.policyType, series.namespace, series.name}
+	if oldSeries, exists := m.policySeries[key][series.rule]; exists {
+		// The action of the rule has changed, its series is replaced so that the rule keeps a single series.
+		if oldSeries != series {
+			deleteSeries(oldSeries)
+			m.policySeries[key][series.rule] = series
+		}
+	} else if m.numSeries >= m.maxRules {
+		series = ruleSeries{policyType: series.policyType, name: overflowLabelValue, rule: overflowLabelValue, action: series.action}
+	} else {
+		if m.policySeries[key] == nil {
+			m.policySeries[key] = map[string]ruleSeries{}
+		}
+		m.policySeries[key][series.rule] = series
+		m.numSeries++
+	}
+	labelValues := series.labelValues()
+	NetworkPolicyRulePackets.WithLabelValues(labelValues...).Add(float64(stats.Packets))
+	NetworkPolicyRuleBytes.WithLabelValues(labelValues...).Add(float64(stats.Bytes))
+	NetworkPolicyRuleSessions.WithLabelValues(labelValues...).Add(float64(stats.Sessions))
+}
+
+// getRuleAction returns the action of the rule with the provided name. K8s NetworkPolicy rules always allow traffic.
+// The action of the rules of policies in Audit mode is prefixed with "Audit".
+func (m *NetworkPolicyRuleMetrics) getRuleAction(ref *controlplane.NetworkPolicyReference, ruleName string) string {
+	var ingressRules, egressRules []crdv1beta1.Rule
+	var enforcementMode crdv1beta1.PolicyEnforcementMode
+	switch ref.Type {
+	case controlplane.K8sNetworkPolicy:
+		return string(crdv1beta1.RuleActionAllow)
+	case controlplane.AntreaClusterNetworkPolicy:
+		acnp, err := m.acnpLister.Get(ref.Name)
+		if err != nil {
+			return unknownAction
+		}
+		ingressRules, egressRules, enforcementMode = acnp.Spec.Ingress, acnp.Spec.Egress, acnp.Spec.EnforcementMode
+	case controlplane.AntreaNetworkPolicy:
+		annp, err := m.annpLister.NetworkPolicies(ref.Namespace).Get(ref.Name)
+		if err != nil {
+			return unknownAction
+		}
+		ingressRules, egressRules, enforcementMode = annp.Spec.Ingress, annp.Spec.Egress, annp.Spec.EnforcementMode
+	default:
+		return unknownAction
+	}
+	for _, rules := range [][]crdv1beta1.Rule{ingressRules, egressRules} {
+		for _, rule := range rules {
+			if rule.Name == ruleName && rule.Action != nil {
+				if enforcementMode == crdv1beta1.PolicyEnforcementModeAudit {
+					return auditActionPrefix + string(*rule.Action)
+				}
+				return string(*rule.Action)
+			}
+		}
+	}
+	return unknownAction
+}
+
+func deleteSeries(series ruleSeries) {
+	labelValues := series.labelValues()
+	NetworkPolicyRulePackets.DeleteLabelValues(labelValues...)
+	NetworkPolicyRuleBytes.DeleteLabelValues(labelValues...)
+	NetworkPolicyRuleSessions.DeleteLabelValues(labelValues...)
+}
+
+func isZero(stats *statsv1alpha1.TrafficStats) bool {
+	return stats.Packets == 0 && stats.Bytes == 0 && stats.Sessions == 0
+}
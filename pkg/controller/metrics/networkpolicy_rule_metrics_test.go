@@ -0,0 +1,181 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/component-base/metrics/testutil"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
+)
+
+const ruleMetricsHeader = `
+# HELP antrea_controller_network_policy_rule_packets_total [ALPHA] The total number of packets matching a NetworkPolicy rule, as reported by the antrea-agents
+# TYPE antrea_controller_network_policy_rule_packets_total counter
+`
+
+var (
+	npRef   = &controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: "ns1", Name: "np1"}
+	acnpRef = &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp1"}
+	annpRef = &controlplane.NetworkPolicyReference{Type: controlplane.AntreaNetworkPolicy, Namespace: "ns1", Name: "annp1"}
+)
+
+var registerRuleMetricsOnce sync.Once
+
+func newTestNetworkPolicyRuleMetrics(maxRules int) *NetworkPolicyRuleMetrics {
+	registerRuleMetricsOnce.Do(func() {
+		legacyregistry.MustRegister(NetworkPolicyRulePackets, NetworkPolicyRuleBytes, NetworkPolicyRuleSessions)
+	})
+	NetworkPolicyRulePackets.Reset()
+	NetworkPolicyRuleBytes.Reset()
+	NetworkPolicyRuleSessions.Reset()
+	acnpIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	acnpIndexer.Add(&crdv1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "acnp1"},
+		Spec: crdv1beta1.ClusterNetworkPolicySpec{
+			Ingress: []crdv1beta1.Rule{{Name: "drop-web", Action: ptr.To(crdv1beta1.RuleActionDrop)}},
+			Egress:  []crdv1beta1.Rule{{Name: "allow-dns", Action: ptr.To(crdv1beta1.RuleActionAllow)}},
+		},
+	})
+	annpIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	annpIndexer.Add(&crdv1beta1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "annp1"},
+		Spec: crdv1beta1.NetworkPolicySpec{
+			Ingress:         []crdv1beta1.Rule{{Name: "reject-db", Action: ptr.To(crdv1beta1.RuleActionReject)}},
+			EnforcementMode: crdv1beta1.PolicyEnforcementModeAudit,
+		},
+	})
+	return NewNetworkPolicyRuleMetrics(crdlisters.NewClusterNetworkPolicyLister(acnpIndexer), crdlisters.NewNetworkPolicyLister(annpIndexer), maxRules)
+}
+
+func newTrafficStats(packets int64) statsv1alpha1.TrafficStats {
+	return statsv1alpha1.TrafficStats{Packets: packets, Bytes: packets * 100, Sessions: 1}
+}
+
+func TestNetworkPolicyRuleMetrics(t *testing.T) {
+	npStats := newTrafficStats(1)
+	acnpRuleStats := []statsv1alpha1.RuleTrafficStats{
+		{Name: "drop-web", TrafficStats: newTrafficStats(2)},
+		{Name: "allow-dns", TrafficStats: newTrafficStats(3)},
+		{Name: "removed-rule", TrafficStats: newTrafficStats(4)},
+		{Name: "idle-rule"},
+	}
+	annpRuleStats := []statsv1alpha1.RuleTrafficStats{
+		{Name: "reject-db", TrafficStats: newTrafficStats(5)},
+	}
+
+	tests := []struct {
+		name            string
+		maxRules        int
+		deletedPolicies []*controlplane.NetworkPolicyReference
+		expectedPackets string
+	}{
+		{
+			name:     "all rules exposed",
+			maxRules: 10,
+			expectedPackets: `
+antrea_controller_network_policy_rule_packets_total{action="Allow",policy_name="acnp1",policy_namespace="",policy_type="AntreaClusterNetworkPolicy",rule_name="allow-dns"} 6
+antrea_controller_network_policy_rule_packets_total{action="Drop",policy_name="acnp1",policy_namespace="",policy_type="AntreaClusterNetworkPolicy",rule_name="drop-web"} 4
+antrea_controller_network_policy_rule_packets_total{action="Unknown",policy_name="acnp1",policy_namespace="",policy_type="AntreaClusterNetworkPolicy",rule_name="removed-rule"} 8
+antrea_controller_network_policy_rule_packets_total{action="AuditReject",policy_name="annp1",policy_namespace="ns1",policy_type="AntreaNetworkPolicy",rule_name="reject-db"} 10
+antrea_controller_network_policy_rule_packets_total{action="Allow",policy_name="np1",policy_namespace="ns1",policy_type="K8sNetworkPolicy",rule_name=""} 2
+`,
+		},
+		{
+			name:     "rules exceeding maxRules",
+			maxRules: 2,
+			expectedPackets: `
+antrea_controller_network_policy_rule_packets_total{action="Allow",policy_name="_overflow",policy_namespace="",policy_type="AntreaClusterNetworkPolicy",rule_name="_overflow"} 6
+antrea_controller_network_policy_rule_packets_total{action="Drop",policy_name="acnp1",policy_namespace="",policy_type="AntreaClusterNetworkPolicy",rule_name="drop-web"} 4
+antrea_controller_network_policy_rule_packets_total{action="Unknown",policy_name="_overflow",policy_namespace="",policy_type="AntreaClusterNetworkPolicy",rule_name="_overflow"} 8
+antrea_controller_network_policy_rule_packets_total{action="AuditReject",policy_name="_overflow",policy_namespace="",policy_type="AntreaNetworkPolicy",rule_name="_overflow"} 10
+antrea_controller_network_policy_rule_packets_total{action="Allow",policy_name="np1",policy_namespace="ns1",policy_type="K8sNetworkPolicy",rule_name=""} 2
+`,
+		},
+		{
+			name:            "deleted policies",
+			maxRules:        10,
+			deletedPolicies: []*controlplane.NetworkPolicyReference{npRef, acnpRef},
+			expectedPackets: `
+antrea_controller_network_policy_rule_packets_total{action="AuditReject",policy_name="annp1",policy_namespace="ns1",policy_type="AntreaNetworkPolicy",rule_name="reject-db"} 10
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestNetworkPolicyRuleMetrics(tt.maxRules)
+			// Stats of two antrea-agents.
+			for i := 0; i < 2; i++ {
+				m.RecordNetworkPolicyStats(npRef, &npStats, nil)
+				m.RecordNetworkPolicyStats(acnpRef, &statsv1alpha1.TrafficStats{}, acnpRuleStats)
+				m.RecordNetworkPolicyStats(annpRef, &statsv1alpha1.TrafficStats{}, annpRuleStats)
+			}
+			for _, ref := range tt.deletedPolicies {
+				m.DeleteNetworkPolicy(ref.Type, ref.Namespace, ref.Name)
+			}
+			err := testutil.GatherAndCompare(legacyregistry.DefaultGatherer, strings.NewReader(ruleMetricsHeader+tt.expectedPackets), "antrea_controller_network_policy_rule_packets_total")
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNetworkPolicyRuleMetricsReleaseSeries(t *testing.T) {
+	m := newTestNetworkPolicyRuleMetrics(1)
+	npStats := newTrafficStats(1)
+	m.RecordNetworkPolicyStats(npRef, &npStats, nil)
+	m.DeleteNetworkPolicy(npRef.Type, npRef.Namespace, npRef.Name)
+	// The series of the deleted policy must be released for other rules.
+	m.RecordNetworkPolicyStats(annpRef, &statsv1alpha1.TrafficStats{}, []statsv1alpha1.RuleTrafficStats{{Name: "reject-db", TrafficStats: newTrafficStats(5)}})
+	expected := ruleMetricsHeader + `
+antrea_controller_network_policy_rule_packets_total{action="AuditReject",policy_name="annp1",policy_namespace="ns1",policy_type="AntreaNetworkPolicy",rule_name="reject-db"} 5
+`
+	err := testutil.GatherAndCompare(legacyregistry.DefaultGatherer, strings.NewReader(expected), "antrea_controller_network_policy_rule_packets_total")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, m.numSeries)
+}
+
+func TestNetworkPolicyRuleMetricsActionChange(t *testing.T) {
+	m := newTestNetworkPolicyRuleMetrics(1)
+	ruleStats := []statsv1alpha1.RuleTrafficStats{{Name: "drop-web", TrafficStats: newTrafficStats(2)}}
+	m.RecordNetworkPolicyStats(acnpRef, &statsv1alpha1.TrafficStats{}, ruleStats)
+	// The action of the rule is updated.
+	acnpIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	acnpIndexer.Add(&crdv1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "acnp1"},
+		Spec: crdv1beta1.ClusterNetworkPolicySpec{
+			Ingress: []crdv1beta1.Rule{{Name: "drop-web", Action: ptr.To(crdv1beta1.RuleActionAllow)}},
+		},
+	})
+	m.acnpLister = crdlisters.NewClusterNetworkPolicyLister(acnpIndexer)
+	m.RecordNetworkPolicyStats(acnpRef, &statsv1alpha1.TrafficStats{}, ruleStats)
+	// The series with the previous action is replaced, and doesn't use a series of maxRules.
+	expected := ruleMetricsHeader + `
+antrea_controller_network_policy_rule_packets_total{action="Allow",policy_name="acnp1",policy_namespace="",policy_type="AntreaClusterNetworkPolicy",rule_name="drop-web"} 2
+`
+	err := testutil.GatherAndCompare(legacyregistry.DefaultGatherer, strings.NewReader(expected), "antrea_controller_network_policy_rule_packets_total")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, m.numSeries)
+}
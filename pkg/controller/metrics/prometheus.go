@@ -156,4 +156,13 @@ func InitializePrometheusMetrics() {
 	if err := legacyregistry.Register(AntreaClusterNetworkPolicyStatusUpdates); err != nil {
 		klog.Errorf("Failed to register antrea_controller_acnp_status_updates with Prometheus: %s", err.Error())
 	}
+	if err := legacyregistry.Register(NetworkPolicyRulePackets); err != nil {
+		klog.Errorf("Failed to register antrea_controller_network_policy_rule_packets_total with Prometheus: %s", err.Error())
+	}
+	if err := legacyregistry.Register(NetworkPolicyRuleBytes); err != nil {
+		klog.Errorf("Failed to register antrea_controller_network_policy_rule_bytes_total with Prometheus: %s", err.Error())
+	}
+	if err := legacyregistry.Register(NetworkPolicyRuleSessions); err != nil {
+		klog.Errorf("Failed to register antrea_controller_network_policy_rule_sessions_total with Prometheus: %s", err.Error())
+	}
 }
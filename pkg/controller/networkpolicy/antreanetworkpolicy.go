@@ -77,8 +77,29 @@ func (n *NetworkPolicyController) deleteANNP(old interface{}) {
 // processAntreaNetworkPolicy creates an internal NetworkPolicy instance
 // corresponding to the crdv1beta1.NetworkPolicy object. This method
 // does not commit the internal NetworkPolicy in store, instead returns an
-// instance to the caller.
+// instance to the caller. The selectors no longer used by the policy are
+// removed from the labelIdentityInterface and the groupingInterface.
 func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.NetworkPolicy) (*antreatypes.NetworkPolicy, map[string]*antreatypes.AppliedToGroup, map[string]*antreatypes.AddressGroup) {
+	internalNetworkPolicy, appliedToGroups, addressGroups, selectorKeys := n.computeAntreaNetworkPolicy(np)
+	n.removeStalePolicySelectors(selectorKeys, internalNetworkPolicyKeyFunc(np))
+	return internalNetworkPolicy, appliedToGroups, addressGroups
+}
+
+// computeAntreaNetworkPolicy is like processAntreaNetworkPolicy, but it returns the keys of the selectors used by
+// the policy instead of removing the stale selectors of the policy.
+func (n *NetworkPolicyController) computeAntreaNetworkPolicy(np *crdv1beta1.NetworkPolicy) (*antreatypes.NetworkPolicy, map[string]*antreatypes.AppliedToGroup, map[string]*antreatypes.AddressGroup, policySelectorKeys) {
+	expirationTime, expired := evaluatePolicyExpiry(np.Spec.Expiry, np.CreationTimestamp, n.clock.Now())
+	if expired {
+		// An expired policy is no longer enforced, so it doesn't refer to any group or selector.
+		sourceRef := &controlplane.NetworkPolicyReference{
+			Type:      controlplane.AntreaNetworkPolicy,
+			Namespace: np.Namespace,
+			Name:      np.Name,
+			UID:       np.UID,
+		}
+		internalNetworkPolicy := newExpiredInternalNetworkPolicy(sourceRef, np.Generation, np.Spec.Priority, n.getTierPriority(np.Spec.Tier), np.Spec.EnforcementMode, expirationTime)
+		return internalNetworkPolicy, map[string]*antreatypes.AppliedToGroup{}, map[string]*antreatypes.AddressGroup{}, policySelectorKeys{}
+	}
 	appliedToPerRule := len(np.Spec.AppliedTo) == 0
 	// appliedToGroups tracks all distinct appliedToGroups referred to by the Antrea NetworkPolicy,
 	// either in the spec section or in ingress/egress rules.
@@ -88,14 +109,22 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 	rules := make([]controlplane.NetworkPolicyRule, 0, len(np.Spec.Ingress)+len(np.Spec.Egress))
 	// clusterSetScopeSelectorKeys keeps track of all the ClusterSet-scoped selector keys of the policy.
 	// During policy peer processing, any ClusterSet-scoped selector will be registered with the
-	// labelIdentityInterface and added to this set. By the end of the function, this set will
-	// be used to remove any stale selector from the policy in the labelIdentityInterface.
+	// labelIdentityInterface and added to this set. The set is returned to the caller, which
+	// uses it to remove any stale selector from the policy in the labelIdentityInterface.
 	var clusterSetScopeSelectorKeys sets.Set[string]
+	// serviceSelectorKeys keeps track of all the Service selector keys in toServices of the policy. It's returned to
+	// the caller, which uses it to remove any stale Service selector of the policy from the groupingInterface.
+	var serviceSelectorKeys sets.Set[string]
 	// Create AppliedToGroup for each AppliedTo present in AntreaNetworkPolicy spec.
 	atgs := n.processAppliedTo(np.Namespace, np.Spec.AppliedTo)
 	appliedToGroups = mergeAppliedToGroups(appliedToGroups, atgs...)
+	schedules := newRuleScheduleTracker(n.clock.Now())
 	// Compute NetworkPolicyRule for Ingress Rule.
 	for idx, ingressRule := range np.Spec.Ingress {
+		// Rules outside their scheduled windows are not enforced.
+		if !schedules.isActive(&ingressRule) {
+			continue
+		}
 		// Set default action to ALLOW to allow traffic.
 		services, namedPortExists := toAntreaServicesForCRD(ingressRule.Ports, ingressRule.Protocols)
 		// Create AppliedToGroup for each AppliedTo present in the ingress rule.
@@ -117,10 +146,15 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 			AppliedToGroups: getAppliedToGroupNames(atgs),
 			L7Protocols:     toAntreaL7ProtocolsForCRD(ingressRule.L7Protocols),
 			LogLabel:        ingressRule.LogLabel,
+			RateLimit:       toAntreaRateLimitForCRD(ingressRule.RateLimit),
+			RejectResponse:  ingressRule.RejectResponse,
 		})
 	}
 	// Compute NetworkPolicyRule for Egress Rule.
 	for idx, egressRule := range np.Spec.Egress {
+		if !schedules.isActive(&egressRule) {
+			continue
+		}
 		// Set default action to ALLOW to allow traffic.
 		services, namedPortExists := toAntreaServicesForCRD(egressRule.Ports, egressRule.Protocols)
 		// Create AppliedToGroup for each AppliedTo present in the egress rule.
@@ -128,7 +162,9 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 		appliedToGroups = mergeAppliedToGroups(appliedToGroups, atgs...)
 		var peer *controlplane.NetworkPolicyPeer
 		if egressRule.ToServices != nil {
-			peer = n.svcRefToPeerForCRD(egressRule.ToServices, np.Namespace)
+			var selKeys sets.Set[string]
+			peer, selKeys = n.svcRefToPeerForCRD(egressRule.ToServices, np.Namespace, internalNetworkPolicyKeyFunc(np))
+			serviceSelectorKeys = serviceSelectorKeys.Union(selKeys)
 		} else {
 			var ags []*antreatypes.AddressGroup
 			var selKeys sets.Set[string]
@@ -149,6 +185,8 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 			AppliedToGroups: getAppliedToGroupNames(atgs),
 			L7Protocols:     toAntreaL7ProtocolsForCRD(egressRule.L7Protocols),
 			LogLabel:        egressRule.LogLabel,
+			RateLimit:       toAntreaRateLimitForCRD(egressRule.RateLimit),
+			RejectResponse:  egressRule.RejectResponse,
 		})
 	}
 	tierPriority := n.getTierPriority(np.Spec.Tier)
@@ -159,19 +197,20 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 			Name:      np.Name,
 			UID:       np.UID,
 		},
-		Name:             internalNetworkPolicyKeyFunc(np),
-		UID:              np.UID,
-		Generation:       np.Generation,
-		AppliedToGroups:  sets.List(sets.KeySet(appliedToGroups)),
-		Rules:            rules,
-		Priority:         &np.Spec.Priority,
-		TierPriority:     &tierPriority,
-		AppliedToPerRule: appliedToPerRule,
+		Name:                   internalNetworkPolicyKeyFunc(np),
+		UID:                    np.UID,
+		Generation:             np.Generation,
+		AppliedToGroups:        sets.List(sets.KeySet(appliedToGroups)),
+		Rules:                  rules,
+		Priority:               &np.Spec.Priority,
+		TierPriority:           &tierPriority,
+		AppliedToPerRule:       appliedToPerRule,
+		EnforcementMode:        np.Spec.EnforcementMode,
+		ScheduledRules:         schedules.statuses,
+		NextScheduleTransition: schedules.nextTransition,
+		ExpirationTime:         expirationTime,
 	}
-	if n.stretchNPEnabled {
-		n.labelIdentityInterface.RemoveStalePolicySelectors(clusterSetScopeSelectorKeys, internalNetworkPolicyKeyFunc(np))
-	}
-	return internalNetworkPolicy, appliedToGroups, addressGroups
+	return internalNetworkPolicy, appliedToGroups, addressGroups, policySelectorKeys{clusterSetScope: clusterSetScopeSelectorKeys, service: serviceSelectorKeys}
 }
 
 func (n *NetworkPolicyController) processAppliedTo(namespace string, appliedTo []crdv1beta1.AppliedTo) []*antreatypes.AppliedToGroup {
@@ -180,6 +219,12 @@ func (n *NetworkPolicyController) processAppliedTo(namespace string, appliedTo [
 		var atg *antreatypes.AppliedToGroup
 		if at.Group != "" {
 			atg = n.createAppliedToGroupForGroup(namespace, at.Group)
+		} else if at.Identity != nil {
+			atgs, err := n.createAppliedToGroupsForWorkloadIdentity(at.Identity)
+			if err != nil {
+				klog.ErrorS(err, "Failed to process identity in appliedTo", "namespace", namespace)
+			}
+			appliedToGroups = append(appliedToGroups, atgs...)
 		} else {
 			atg = n.createAppliedToGroup(namespace, at.PodSelector, at.NamespaceSelector, at.ExternalEntitySelector, nil)
 		}
@@ -16,11 +16,13 @@ package networkpolicy
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 
 	"antrea.io/antrea/multicluster/controllers/multicluster/common"
@@ -750,6 +752,86 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 	}
 }
 
+func TestProcessAntreaNetworkPolicyWithSchedule(t *testing.T) {
+	p10 := float64(10)
+	allowAction := crdv1beta1.RuleActionAllow
+	annp := &crdv1beta1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "npA", UID: "uidA"},
+		Spec: crdv1beta1.NetworkPolicySpec{
+			AppliedTo: []crdv1beta1.AppliedTo{
+				{PodSelector: &selectorA},
+			},
+			Priority: p10,
+			Ingress: []crdv1beta1.Rule{
+				{
+					Name:   "allow-backup",
+					From:   []crdv1beta1.NetworkPolicyPeer{{PodSelector: &selectorB}},
+					Action: &allowAction,
+					Schedule: &crdv1beta1.RuleSchedule{
+						Windows: []crdv1beta1.ScheduleWindow{{Start: "01:00", End: "03:00"}},
+					},
+				},
+				{
+					Name:   "allow-client",
+					From:   []crdv1beta1.NetworkPolicyPeer{{PodSelector: &selectorC}},
+					Action: &allowAction,
+				},
+			},
+		},
+	}
+	backupRule := controlplane.NetworkPolicyRule{
+		Direction: controlplane.DirectionIn,
+		From: controlplane.NetworkPolicyPeer{
+			AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("ns1", &selectorB, nil, nil, nil).NormalizedName)},
+		},
+		Name:     "allow-backup",
+		Priority: 0,
+		Action:   &allowAction,
+	}
+	clientRule := controlplane.NetworkPolicyRule{
+		Direction: controlplane.DirectionIn,
+		From: controlplane.NetworkPolicyPeer{
+			AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("ns1", &selectorC, nil, nil, nil).NormalizedName)},
+		},
+		Name:     "allow-client",
+		Priority: 1,
+		Action:   &allowAction,
+	}
+	tests := []struct {
+		name                   string
+		now                    time.Time
+		expectedRules          []controlplane.NetworkPolicyRule
+		expectedScheduledRules []crdv1beta1.RuleScheduleStatus
+		expectedNextTransition time.Time
+	}{
+		{
+			name:                   "in window",
+			now:                    time.Date(2025, 3, 5, 2, 0, 0, 0, time.UTC),
+			expectedRules:          []controlplane.NetworkPolicyRule{backupRule, clientRule},
+			expectedScheduledRules: []crdv1beta1.RuleScheduleStatus{{Name: "allow-backup", Active: true}},
+			expectedNextTransition: time.Date(2025, 3, 5, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:                   "out of window",
+			now:                    time.Date(2025, 3, 5, 4, 0, 0, 0, time.UTC),
+			expectedRules:          []controlplane.NetworkPolicyRule{clientRule},
+			expectedScheduledRules: []crdv1beta1.RuleScheduleStatus{{Name: "allow-backup", Active: false}},
+			expectedNextTransition: time.Date(2025, 3, 6, 1, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, c := newController(nil, nil)
+			c.clock = clocktesting.NewFakeClock(tt.now)
+			actualPolicy, _, actualAddressGroups := c.processAntreaNetworkPolicy(annp)
+			assert.Equal(t, tt.expectedRules, actualPolicy.Rules)
+			assert.Equal(t, tt.expectedScheduledRules, actualPolicy.ScheduledRules)
+			assert.True(t, tt.expectedNextTransition.Equal(actualPolicy.NextScheduleTransition))
+			assert.Equal(t, len(tt.expectedRules), len(actualAddressGroups))
+		})
+	}
+}
+
 func TestAddANNP(t *testing.T) {
 	_, npc := newController(nil, nil)
 	annp := getANNP()
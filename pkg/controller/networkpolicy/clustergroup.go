@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"reflect"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -83,7 +84,10 @@ func (c *NetworkPolicyController) updateClusterGroup(oldObj, curObj interface{})
 		}
 		return !oldChildGroups.Equal(newChildGroups)
 	}
-	if !ipBlocksUpdated() && !svcRefUpdated() && !selectorUpdated() && !childGroupsUpdated() {
+	ipListUpdated := func() bool {
+		return !reflect.DeepEqual(oldGroup.IPList, newGroup.IPList)
+	}
+	if !ipBlocksUpdated() && !svcRefUpdated() && !selectorUpdated() && !childGroupsUpdated() && !ipListUpdated() {
 		// No change in the contents of the ClusterGroup. No need to enqueue for further sync.
 		return
 	}
@@ -127,6 +131,12 @@ func (c *NetworkPolicyController) processClusterGroup(cg *crdv1beta1.ClusterGrou
 		}
 		return &internalGroup
 	}
+	if cg.Spec.IPList != nil {
+		internalGroup.IPList = cg.Spec.IPList.DeepCopy()
+		// The IPBlocks are populated once the IP list has been read from its source.
+		internalGroup.IPBlocks, internalGroup.IPNets = c.getIPListBlocks(internalGroupKeyFunc(cg), internalGroup.IPList)
+		return &internalGroup
+	}
 	if len(cg.Spec.IPBlocks) > 0 {
 		for i := range cg.Spec.IPBlocks {
 			ipb, _ := toAntreaIPBlockForCRD(&cg.Spec.IPBlocks[i])
@@ -206,6 +216,7 @@ func (c *NetworkPolicyController) syncInternalClusterGroup(grp *antreatypes.Grou
 		return nil
 	}
 	selectorUpdated := c.processServiceReference(grp)
+	ipListUpdated, ipListStatus := c.syncIPList(grp.SourceReference.ToGroupName(), grp)
 	if grp.Selector != nil {
 		c.groupingInterface.AddGroup(internalGroupType, grp.SourceReference.ToGroupName(), grp.Selector)
 	} else {
@@ -218,6 +229,7 @@ func (c *NetworkPolicyController) syncInternalClusterGroup(grp *antreatypes.Grou
 	//   1. It does not have child groups. The group members are immediately considered
 	//      computed during syncInternalGroup, as the group selector is finalized.
 	//   2. All its child groups are created and realized.
+	//   3. Its IP list, if any, has been read successfully at least once.
 	if len(grp.ChildGroups) > 0 {
 		for _, cgName := range grp.ChildGroups {
 			internalGroup, found, _ := c.internalGroupStore.Get(cgName)
@@ -227,16 +239,25 @@ func (c *NetworkPolicyController) syncInternalClusterGroup(grp *antreatypes.Grou
 			}
 		}
 	}
+	if ipListStatus != nil && ipListStatus.LastRefreshTime.IsZero() {
+		membersComputed = false
+	}
 	if membersComputed {
 		klog.V(4).InfoS("Updating GroupMembersComputed Status for ClusterGroup", "ClusterGroup", cg.Name)
-		err = c.updateClusterGroupStatus(cg, v1.ConditionTrue)
+		err = c.updateClusterGroupStatus(cg, v1.ConditionTrue, ipListStatus)
 		if err != nil {
 			klog.Errorf("Failed to update ClusterGroup %s GroupMembersComputed condition to %s: %v", cg.Name, v1.ConditionTrue, err)
 		} else {
 			membersComputedStatus = v1.ConditionTrue
 		}
+	} else if ipListStatus != nil {
+		// Report why the IP list couldn't be read.
+		err = c.updateClusterGroupStatus(cg, v1.ConditionFalse, ipListStatus)
+		if err != nil {
+			klog.ErrorS(err, "Failed to update ClusterGroup IP list status", "ClusterGroup", cg.Name)
+		}
 	}
-	if selectorUpdated || membersComputedStatus != originalMembersComputedStatus {
+	if selectorUpdated || ipListUpdated || membersComputedStatus != originalMembersComputedStatus {
 		// Update the internal Group object in the store with the new selector and status.
 		updatedGrp := &antreatypes.Group{
 			UID:              grp.UID,
@@ -247,6 +268,7 @@ func (c *NetworkPolicyController) syncInternalClusterGroup(grp *antreatypes.Grou
 			IPNets:           grp.IPNets,
 			ServiceReference: grp.ServiceReference,
 			ChildGroups:      grp.ChildGroups,
+			IPList:           grp.IPList,
 		}
 		klog.V(2).InfoS("Updating existing internal Group", "internalGroup", grp.SourceReference.ToGroupName())
 		c.internalGroupStore.Update(updatedGrp)
@@ -306,18 +328,23 @@ func (c *NetworkPolicyController) triggerCNPUpdates(cg string) {
 }
 
 // updateClusterGroupStatus updates the Status subresource for a ClusterGroup.
-func (c *NetworkPolicyController) updateClusterGroupStatus(cg *crdv1beta1.ClusterGroup, cStatus v1.ConditionStatus) error {
+func (c *NetworkPolicyController) updateClusterGroupStatus(cg *crdv1beta1.ClusterGroup, cStatus v1.ConditionStatus, ipListStatus *crdv1beta1.IPListStatus) error {
 	condStatus := crdv1beta1.GroupCondition{
 		Status: cStatus,
 		Type:   crdv1beta1.GroupMembersComputed,
 	}
-	if groupMembersComputedConditionEqual(cg.Status.Conditions, condStatus) {
-		// There is no change in conditions.
+	conditionsEqual := groupMembersComputedConditionEqual(cg.Status.Conditions, condStatus)
+	if conditionsEqual && ipListStatusEqual(cg.Status.IPList, ipListStatus) {
+		// There is no change in conditions and in the status of the IP list.
 		return nil
 	}
-	condStatus.LastTransitionTime = metav1.Now()
 	status := crdv1beta1.GroupStatus{
-		Conditions: []crdv1beta1.GroupCondition{condStatus},
+		Conditions: cg.Status.Conditions,
+		IPList:     ipListStatus,
+	}
+	if !conditionsEqual {
+		condStatus.LastTransitionTime = metav1.Now()
+		status.Conditions = []crdv1beta1.GroupCondition{condStatus}
 	}
 	klog.V(4).Infof("Updating ClusterGroup %s status to %#v", cg.Name, condStatus)
 	toUpdate := cg.DeepCopy()
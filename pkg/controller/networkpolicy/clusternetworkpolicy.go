@@ -190,6 +190,7 @@ func (n *NetworkPolicyController) addNamespace(obj interface{}) {
 	defer n.heartbeat("addNamespace")
 	namespace := obj.(*v1.Namespace)
 	klog.V(2).Infof("Processing Namespace %s ADD event, labels: %v", namespace.Name, namespace.Labels)
+	n.enqueueNamespaceProfile(namespace)
 	affectedACNPs := n.filterPerNamespaceRuleACNPsByNSLabels(namespace.Labels)
 	for cnpName := range affectedACNPs {
 		// Ignore the ClusterNetworkPolicy if it has been removed during the process.
@@ -230,6 +231,10 @@ func (n *NetworkPolicyController) updateNamespace(oldObj, curObj interface{}) {
 			n.enqueueInternalNetworkPolicy(getKNPReference(np))
 		}
 	}
+
+	if namespaceProfileUpdated(oldNamespace, curNamespace) {
+		n.enqueueInternalNetworkPolicy(getNamespaceProfileReference(curNamespace))
+	}
 }
 
 // deleteNamespace receives Namespace DELETE events and triggers all ClusterNetworkPolicies that have a
@@ -250,6 +255,7 @@ func (n *NetworkPolicyController) deleteNamespace(old interface{}) {
 	}
 	defer n.heartbeat("deleteNamespace")
 	klog.V(2).Infof("Processing Namespace %s DELETE event, labels: %v", namespace.Name, namespace.Labels)
+	n.enqueueNamespaceProfile(namespace)
 	affectedACNPs := n.filterPerNamespaceRuleACNPsByNSLabels(namespace.Labels)
 	for _, cnpName := range sets.List(affectedACNPs) {
 		// Ignore the ClusterNetworkPolicy if it has been removed during the process.
@@ -359,8 +365,28 @@ func (c *NetworkPolicyController) updateNode(oldObj, newObj interface{}) {
 // does not commit the internal NetworkPolicy in store, instead returns an
 // instance to the caller wherein, it will be either stored as a new Object
 // in case of ADD event or modified and store the updated instance, in case
-// of an UPDATE event.
+// of an UPDATE event. The selectors no longer used by the policy are removed
+// from the labelIdentityInterface and the groupingInterface.
 func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.ClusterNetworkPolicy) (*antreatypes.NetworkPolicy, map[string]*antreatypes.AppliedToGroup, map[string]*antreatypes.AddressGroup) {
+	internalNetworkPolicy, appliedToGroups, addressGroups, selectorKeys := n.computeClusterNetworkPolicy(cnp)
+	n.removeStalePolicySelectors(selectorKeys, internalNetworkPolicyKeyFunc(cnp))
+	return internalNetworkPolicy, appliedToGroups, addressGroups
+}
+
+// computeClusterNetworkPolicy is like processClusterNetworkPolicy, but it returns the keys of the selectors used by
+// the policy instead of removing the stale selectors of the policy.
+func (n *NetworkPolicyController) computeClusterNetworkPolicy(cnp *crdv1beta1.ClusterNetworkPolicy) (*antreatypes.NetworkPolicy, map[string]*antreatypes.AppliedToGroup, map[string]*antreatypes.AddressGroup, policySelectorKeys) {
+	expirationTime, expired := evaluatePolicyExpiry(cnp.Spec.Expiry, cnp.CreationTimestamp, n.clock.Now())
+	if expired {
+		// An expired policy is no longer enforced, so it doesn't refer to any group or selector.
+		sourceRef := &controlplane.NetworkPolicyReference{
+			Type: controlplane.AntreaClusterNetworkPolicy,
+			Name: cnp.Name,
+			UID:  cnp.UID,
+		}
+		internalNetworkPolicy := newExpiredInternalNetworkPolicy(sourceRef, cnp.Generation, cnp.Spec.Priority, n.getTierPriority(cnp.Spec.Tier), cnp.Spec.EnforcementMode, expirationTime)
+		return internalNetworkPolicy, map[string]*antreatypes.AppliedToGroup{}, map[string]*antreatypes.AddressGroup{}, policySelectorKeys{}
+	}
 	hasPerNamespaceRule := hasPerNamespaceRule(cnp)
 	// If one of the ACNP rule is a per-namespace rule (a peer in that rule has namespaces.Match set
 	// to Self), the policy will need to be converted to appliedTo per rule policy, as the appliedTo
@@ -379,9 +405,12 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 	labelsPerAffectedNS := map[string]labels.Set{}
 	// clusterSetScopeSelectorKeys keeps track of all the ClusterSet-scoped selector keys of the policy.
 	// During policy peer processing, any ClusterSet-scoped selector will be registered with the
-	// labelIdentityInterface and added to this set. By the end of the function, this set will
-	// be used to remove any stale selector from the policy in the labelIdentityInterface.
+	// labelIdentityInterface and added to this set. The set is returned to the caller, which
+	// uses it to remove any stale selector from the policy in the labelIdentityInterface.
 	var clusterSetScopeSelectorKeys sets.Set[string]
+	// serviceSelectorKeys keeps track of all the Service selector keys in toServices of the policy. It's returned to
+	// the caller, which uses it to remove any stale Service selector of the policy from the groupingInterface.
+	var serviceSelectorKeys sets.Set[string]
 	if hasPerNamespaceRule && len(cnp.Spec.AppliedTo) > 0 {
 		for _, at := range cnp.Spec.AppliedTo {
 			if at.ServiceAccount != nil {
@@ -400,9 +429,14 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 		}
 	}
 	var rules []controlplane.NetworkPolicyRule
+	schedules := newRuleScheduleTracker(n.clock.Now())
 	processRules := func(cnpRules []crdv1beta1.Rule, direction controlplane.Direction) {
 		for idx := range cnpRules {
 			cnpRule := &cnpRules[idx]
+			// Rules outside their scheduled windows are not enforced.
+			if !schedules.isActive(cnpRule) {
+				continue
+			}
 			services, namedPortExists := toAntreaServicesForCRD(cnpRule.Ports, cnpRule.Protocols)
 			clusterPeers, perNSPeers, nsLabelPeers := splitPeersByScope(cnpRule, direction)
 			priority := int32(idx)
@@ -417,6 +451,8 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 					AppliedToGroups: getAppliedToGroupNames(ruleAppliedTos),
 					L7Protocols:     toAntreaL7ProtocolsForCRD(cnpRule.L7Protocols),
 					LogLabel:        cnpRule.LogLabel,
+					RateLimit:       toAntreaRateLimitForCRD(cnpRule.RateLimit),
+					RejectResponse:  cnpRule.RejectResponse,
 				}
 				switch dir {
 				case controlplane.DirectionIn:
@@ -440,7 +476,9 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 				ruleATGs := n.processClusterAppliedTo(ruleAppliedTos)
 				klog.V(4).InfoS("Adding a new cluster-level rule", "appliedTos", ruleATGs, "ClusterNetworkPolicy", klog.KObj(cnp))
 				if cnpRule.ToServices != nil {
-					addRule(n.svcRefToPeerForCRD(cnpRule.ToServices, ""), nil, direction, ruleATGs)
+					peer, selKeys := n.svcRefToPeerForCRD(cnpRule.ToServices, "", internalNetworkPolicyKeyFunc(cnp))
+					serviceSelectorKeys = serviceSelectorKeys.Union(selKeys)
+					addRule(peer, nil, direction, ruleATGs)
 				} else {
 					peer, ags, selKeys := n.toAntreaPeerForCRD(clusterPeers, cnp, direction, namedPortExists)
 					if selKeys != nil {
@@ -536,17 +574,18 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 			Name: cnp.Name,
 			UID:  cnp.UID,
 		},
-		UID:              cnp.UID,
-		AppliedToGroups:  sets.List(sets.KeySet(appliedToGroups)),
-		Rules:            rules,
-		Priority:         &cnp.Spec.Priority,
-		TierPriority:     &tierPriority,
-		AppliedToPerRule: appliedToPerRule,
-	}
-	if n.stretchNPEnabled {
-		n.labelIdentityInterface.RemoveStalePolicySelectors(clusterSetScopeSelectorKeys, internalNetworkPolicyKeyFunc(cnp))
-	}
-	return internalNetworkPolicy, appliedToGroups, addressGroups
+		UID:                    cnp.UID,
+		AppliedToGroups:        sets.List(sets.KeySet(appliedToGroups)),
+		Rules:                  rules,
+		Priority:               &cnp.Spec.Priority,
+		TierPriority:           &tierPriority,
+		AppliedToPerRule:       appliedToPerRule,
+		EnforcementMode:        cnp.Spec.EnforcementMode,
+		ScheduledRules:         schedules.statuses,
+		NextScheduleTransition: schedules.nextTransition,
+		ExpirationTime:         expirationTime,
+	}
+	return internalNetworkPolicy, appliedToGroups, addressGroups, policySelectorKeys{clusterSetScope: clusterSetScopeSelectorKeys, service: serviceSelectorKeys}
 }
 
 // serviceAccountNameToPodSelector returns a PodSelector which could be used to
@@ -710,6 +749,12 @@ func (n *NetworkPolicyController) processClusterAppliedTo(appliedTo []crdv1beta1
 			atg = n.createAppliedToGroupForService(at.Service)
 		} else if at.ServiceAccount != nil {
 			atg = n.createAppliedToGroup(at.ServiceAccount.Namespace, serviceAccountNameToPodSelector(at.ServiceAccount.Name), nil, nil, nil)
+		} else if at.Identity != nil {
+			atgs, err := n.createAppliedToGroupsForWorkloadIdentity(at.Identity)
+			if err != nil {
+				klog.ErrorS(err, "Failed to process identity in appliedTo")
+			}
+			appliedToGroups = append(appliedToGroups, atgs...)
 		} else {
 			atg = n.createAppliedToGroup("", at.PodSelector, at.NamespaceSelector, at.ExternalEntitySelector, nil)
 		}
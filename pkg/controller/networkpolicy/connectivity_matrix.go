@@ -0,0 +1,212 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+// maxConnectivityMatrixSize is the maximum number of source and destination Pod pairs which can be
+// evaluated by a single ConnectivityMatrix request, to bound the time and memory needed to compute
+// the response.
+const maxConnectivityMatrixSize = 10000
+
+// QuerySelectedPods returns the Pods selected by the provided EntitySelector, sorted by Namespace and
+// name. Pods which can't be NetworkPolicy group members are excluded.
+func (eq *EndpointQuerierImpl) QuerySelectedPods(selector *controlplane.EntitySelector) ([]*v1.Pod, error) {
+	if selector.Namespace != "" && selector.NamespaceSelector != nil {
+		return nil, fmt.Errorf("namespace and namespaceSelector cannot be set at the same time")
+	}
+	podSelector := selector.PodSelector
+	if podSelector == nil {
+		podSelector = &metav1.LabelSelector{}
+	}
+	// NewGroupSelector ignores invalid label selectors, validate them first.
+	if _, err := metav1.LabelSelectorAsSelector(podSelector); err != nil {
+		return nil, fmt.Errorf("invalid podSelector: %w", err)
+	}
+	if _, err := metav1.LabelSelectorAsSelector(selector.NamespaceSelector); err != nil {
+		return nil, fmt.Errorf("invalid namespaceSelector: %w", err)
+	}
+	pods, err := eq.getCandidateGroupPods(antreatypes.NewGroupSelector(selector.Namespace, podSelector, selector.NamespaceSelector, nil, nil), "")
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return k8s.NamespacedName(pods[i].Namespace, pods[i].Name) < k8s.NamespacedName(pods[j].Namespace, pods[j].Name)
+	})
+	return pods, nil
+}
+
+// QueryConnectivityMatrix returns the effective action, along with the effective NetworkPolicy rule, of
+// the connections from each selected source Pod to each selected destination Pod, for the requested
+// protocol and destination port. Source port restrictions of the rules are not taken into account.
+func (eq *policyRuleQuerier) QueryConnectivityMatrix(request *controlplane.ConnectivityMatrixRequest) (*controlplane.ConnectivityMatrixResponse, error) {
+	srcPods, err := eq.endpointQuerier.QuerySelectedPods(&request.Sources)
+	if err != nil {
+		return nil, fmt.Errorf("error when selecting source Pods: %w", err)
+	}
+	dstPods, err := eq.endpointQuerier.QuerySelectedPods(&request.Destinations)
+	if err != nil {
+		return nil, fmt.Errorf("error when selecting destination Pods: %w", err)
+	}
+	if size := len(srcPods) * len(dstPods); size > maxConnectivityMatrixSize {
+		return nil, fmt.Errorf("the request selects %d source and destination Pod pairs, which exceeds the maximum of %d, please use more specific selectors", size, maxConnectivityMatrixSize)
+	}
+	protocol := request.Protocol
+	if protocol == "" {
+		protocol = controlplane.ProtocolTCP
+	}
+
+	// The rules of a Pod are queried only once, as most Pods are both sources and destinations.
+	endpointRules := make(map[string]*antreatypes.EndpointNetworkPolicyRules)
+	queryRules := func(pod *v1.Pod) (*antreatypes.EndpointNetworkPolicyRules, error) {
+		key := k8s.NamespacedName(pod.Namespace, pod.Name)
+		if rules, ok := endpointRules[key]; ok {
+			return rules, nil
+		}
+		rules, err := eq.endpointQuerier.QueryNetworkPolicyRules(pod.Namespace, pod.Name)
+		if err != nil {
+			return nil, err
+		}
+		endpointRules[key] = rules
+		return rules, nil
+	}
+
+	entries := make([]controlplane.ConnectivityMatrixEntry, 0, len(srcPods)*len(dstPods))
+	for _, srcPod := range srcPods {
+		srcRules, err := queryRules(srcPod)
+		if err != nil {
+			return nil, err
+		}
+		for _, dstPod := range dstPods {
+			if srcPod.Namespace == dstPod.Namespace && srcPod.Name == dstPod.Name {
+				continue
+			}
+			dstRules, err := queryRules(dstPod)
+			if err != nil {
+				return nil, err
+			}
+			var rule *antreatypes.RuleInfo
+			if srcRules != nil && dstRules != nil {
+				// Only keep the rules which match the connection. Named ports are always resolved
+				// against the destination Pod, for both ingress and egress rules.
+				rule = predictEndpointsRules(
+					&antreatypes.EndpointNetworkPolicyRules{
+						AppliedPolicies:           srcRules.AppliedPolicies,
+						EndpointAsIngressSrcRules: filterRulesByService(srcRules.EndpointAsIngressSrcRules, protocol, request.Port, dstPod),
+					},
+					&antreatypes.EndpointNetworkPolicyRules{
+						AppliedPolicies:          dstRules.AppliedPolicies,
+						EndpointAsEgressDstRules: filterRulesByService(dstRules.EndpointAsEgressDstRules, protocol, request.Port, dstPod),
+					},
+				)
+			}
+			entries = append(entries, newConnectivityMatrixEntry(srcPod, dstPod, rule))
+		}
+	}
+	return &controlplane.ConnectivityMatrixResponse{Entries: entries}, nil
+}
+
+// newConnectivityMatrixEntry returns the ConnectivityMatrixEntry of the connections from srcPod to
+// dstPod, given the effective rule. A nil rule means the connections are allowed.
+func newConnectivityMatrixEntry(srcPod, dstPod *v1.Pod, rule *antreatypes.RuleInfo) controlplane.ConnectivityMatrixEntry {
+	entry := controlplane.ConnectivityMatrixEntry{
+		Source:      controlplane.PodReference{Namespace: srcPod.Namespace, Name: srcPod.Name},
+		Destination: controlplane.PodReference{Namespace: dstPod.Namespace, Name: dstPod.Name},
+		Action:      crdv1beta1.RuleActionAllow,
+	}
+	// The rules of NetworkPolicies in Audit mode are not enforced, hence they never determine the action.
+	if rule == nil || isAuditPolicy(rule.Policy) {
+		return entry
+	}
+	if isDeniedByRule(rule) {
+		entry.Action = crdv1beta1.RuleActionDrop
+		if rule.Rule.Action != nil && *rule.Rule.Action == crdv1beta1.RuleActionReject {
+			entry.Action = crdv1beta1.RuleActionReject
+		}
+	}
+	policyRef := *rule.Policy.SourceRef
+	entry.NetworkPolicy = &policyRef
+	entry.RuleIndex = rule.Index
+	entry.Rule = &controlplane.RuleRef{
+		Direction: rule.Rule.Direction,
+		Name:      rule.Rule.Name,
+		Action:    rule.Rule.Action,
+	}
+	return entry
+}
+
+// filterRulesByService returns the rules whose Services match the provided protocol and destination
+// port. Rules without Services match all connections.
+func filterRulesByService(rules []*antreatypes.RuleInfo, protocol controlplane.Protocol, port int32, dstPod *v1.Pod) []*antreatypes.RuleInfo {
+	var filtered []*antreatypes.RuleInfo
+	for _, rule := range rules {
+		if len(rule.Rule.Services) == 0 {
+			filtered = append(filtered, rule)
+			continue
+		}
+		for i := range rule.Rule.Services {
+			if serviceMatches(&rule.Rule.Services[i], protocol, port, dstPod) {
+				filtered = append(filtered, rule)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// serviceMatches returns true if the provided Service matches the connections to the given protocol
+// and port of dstPod. Named ports are resolved using the container ports of dstPod.
+func serviceMatches(service *controlplane.Service, protocol controlplane.Protocol, port int32, dstPod *v1.Pod) bool {
+	serviceProtocol := controlplane.ProtocolTCP
+	if service.Protocol != nil {
+		serviceProtocol = *service.Protocol
+	}
+	if serviceProtocol != protocol {
+		return false
+	}
+	if service.Port == nil {
+		return true
+	}
+	if service.Port.Type == intstr.Int {
+		if service.EndPort != nil {
+			return port >= service.Port.IntVal && port <= *service.EndPort
+		}
+		return port == service.Port.IntVal
+	}
+	for _, container := range dstPod.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			containerProtocol := v1.ProtocolTCP
+			if containerPort.Protocol != "" {
+				containerProtocol = containerPort.Protocol
+			}
+			if containerPort.Name == service.Port.StrVal && string(containerProtocol) == string(protocol) && containerPort.ContainerPort == port {
+				return true
+			}
+		}
+	}
+	return false
+}
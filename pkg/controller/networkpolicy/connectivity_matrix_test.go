@@ -0,0 +1,309 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func newMatrixTestPod(name, ip string, ports ...corev1.ContainerPort) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "matrix", Name: name, Labels: map[string]string{"app": name}},
+		Spec: corev1.PodSpec{
+			NodeName:   "nodeA",
+			Containers: []corev1.Container{{Name: name, Ports: ports}},
+		},
+		Status: corev1.PodStatus{
+			PodIP:  ip,
+			PodIPs: []corev1.PodIP{{IP: ip}},
+		},
+	}
+}
+
+func TestQueryConnectivityMatrix(t *testing.T) {
+	matrixNamespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "matrix", UID: "matrixUID", Labels: map[string]string{"env": "test"}}}
+	webPod := newMatrixTestPod("web", "10.0.0.1", corev1.ContainerPort{Name: "http", ContainerPort: 8080})
+	dbPod := newMatrixTestPod("db", "10.0.0.2", corev1.ContainerPort{Name: "postgres", ContainerPort: 5432})
+	clientPod := newMatrixTestPod("client", "10.0.0.3")
+	allowWebToDB := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "matrix", Name: "allow-web-to-db", UID: "uid-allow-web-to-db"},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From:  []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}}},
+				Ports: []networkingv1.NetworkPolicyPort{{Port: ptr.To(intstr.FromInt32(5432))}},
+			}},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+	allowClientToWeb := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "matrix", Name: "allow-client-to-web", UID: "uid-allow-client-to-web"},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From:  []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "client"}}}},
+				Ports: []networkingv1.NetworkPolicyPort{{Port: ptr.To(intstr.FromString("http"))}},
+			}},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+	podRef := func(pod *corev1.Pod) controlplane.PodReference {
+		return controlplane.PodReference{Namespace: pod.Namespace, Name: pod.Name}
+	}
+	allowWebToDBRef := &controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: "matrix", Name: "allow-web-to-db", UID: "uid-allow-web-to-db"}
+	allowClientToWebRef := &controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: "matrix", Name: "allow-client-to-web", UID: "uid-allow-client-to-web"}
+	allowRule := &controlplane.RuleRef{Direction: controlplane.DirectionIn, Action: ptr.To(crdv1beta1.RuleActionAllow)}
+	isolationRule := &controlplane.RuleRef{Direction: controlplane.DirectionIn}
+
+	testCases := []struct {
+		name             string
+		request          *controlplane.ConnectivityMatrixRequest
+		expectedResponse *controlplane.ConnectivityMatrixResponse
+		expectedErr      string
+	}{
+		{
+			name: "all Pods of Namespace with default protocol",
+			request: &controlplane.ConnectivityMatrixRequest{
+				Sources:      controlplane.EntitySelector{Namespace: "matrix"},
+				Destinations: controlplane.EntitySelector{Namespace: "matrix"},
+				Port:         5432,
+			},
+			expectedResponse: &controlplane.ConnectivityMatrixResponse{Entries: []controlplane.ConnectivityMatrixEntry{
+				{Source: podRef(clientPod), Destination: podRef(dbPod), Action: crdv1beta1.RuleActionDrop, NetworkPolicy: allowWebToDBRef, RuleIndex: math.MaxInt32, Rule: isolationRule},
+				{Source: podRef(clientPod), Destination: podRef(webPod), Action: crdv1beta1.RuleActionDrop, NetworkPolicy: allowClientToWebRef, RuleIndex: math.MaxInt32, Rule: isolationRule},
+				{Source: podRef(dbPod), Destination: podRef(clientPod), Action: crdv1beta1.RuleActionAllow},
+				{Source: podRef(dbPod), Destination: podRef(webPod), Action: crdv1beta1.RuleActionDrop, NetworkPolicy: allowClientToWebRef, RuleIndex: math.MaxInt32, Rule: isolationRule},
+				{Source: podRef(webPod), Destination: podRef(clientPod), Action: crdv1beta1.RuleActionAllow},
+				{Source: podRef(webPod), Destination: podRef(dbPod), Action: crdv1beta1.RuleActionAllow, NetworkPolicy: allowWebToDBRef, Rule: allowRule},
+			}},
+		},
+		{
+			name: "named port with label selectors",
+			request: &controlplane.ConnectivityMatrixRequest{
+				Sources:      controlplane.EntitySelector{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "test"}}},
+				Destinations: controlplane.EntitySelector{Namespace: "matrix", PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+				Protocol:     controlplane.ProtocolTCP,
+				Port:         8080,
+			},
+			expectedResponse: &controlplane.ConnectivityMatrixResponse{Entries: []controlplane.ConnectivityMatrixEntry{
+				{Source: podRef(clientPod), Destination: podRef(webPod), Action: crdv1beta1.RuleActionAllow, NetworkPolicy: allowClientToWebRef, Rule: allowRule},
+				{Source: podRef(dbPod), Destination: podRef(webPod), Action: crdv1beta1.RuleActionDrop, NetworkPolicy: allowClientToWebRef, RuleIndex: math.MaxInt32, Rule: isolationRule},
+			}},
+		},
+		{
+			name: "protocol not matching rule",
+			request: &controlplane.ConnectivityMatrixRequest{
+				Sources:      controlplane.EntitySelector{Namespace: "matrix", PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+				Destinations: controlplane.EntitySelector{Namespace: "matrix", PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+				Protocol:     controlplane.ProtocolUDP,
+				Port:         5432,
+			},
+			expectedResponse: &controlplane.ConnectivityMatrixResponse{Entries: []controlplane.ConnectivityMatrixEntry{
+				{Source: podRef(webPod), Destination: podRef(dbPod), Action: crdv1beta1.RuleActionDrop, NetworkPolicy: allowWebToDBRef, RuleIndex: math.MaxInt32, Rule: isolationRule},
+			}},
+		},
+		{
+			name: "no selected Pod",
+			request: &controlplane.ConnectivityMatrixRequest{
+				Sources:      controlplane.EntitySelector{Namespace: "other"},
+				Destinations: controlplane.EntitySelector{},
+				Port:         80,
+			},
+			expectedResponse: &controlplane.ConnectivityMatrixResponse{Entries: []controlplane.ConnectivityMatrixEntry{}},
+		},
+		{
+			name: "invalid source selector",
+			request: &controlplane.ConnectivityMatrixRequest{
+				Sources: controlplane.EntitySelector{Namespace: "matrix", NamespaceSelector: &metav1.LabelSelector{}},
+				Port:    80,
+			},
+			expectedErr: "error when selecting source Pods: namespace and namespaceSelector cannot be set at the same time",
+		},
+		{
+			name: "invalid destination selector",
+			request: &controlplane.ConnectivityMatrixRequest{
+				Destinations: controlplane.EntitySelector{PodSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Unknown"}}}},
+				Port:         80,
+			},
+			expectedErr: "error when selecting destination Pods: invalid podSelector",
+		},
+	}
+	policyRuleQuerier := NewPolicyRuleQuerier(makeControllerAndEndpointQuerier(matrixNamespace, webPod, dbPod, clientPod, allowWebToDB, allowClientToWeb))
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := policyRuleQuerier.QueryConnectivityMatrix(tc.request)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResponse, response)
+		})
+	}
+}
+
+func TestNewConnectivityMatrixEntry(t *testing.T) {
+	srcPod, dstPod := newMatrixTestPod("client", "10.0.0.1"), newMatrixTestPod("web", "10.0.0.2")
+	policyRef := &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp", UID: "uid-acnp"}
+	policy := &antreatypes.NetworkPolicy{SourceRef: policyRef}
+	auditPolicy := &antreatypes.NetworkPolicy{SourceRef: policyRef, EnforcementMode: crdv1beta1.PolicyEnforcementModeAudit}
+	testCases := []struct {
+		name          string
+		rule          *antreatypes.RuleInfo
+		expectedEntry controlplane.ConnectivityMatrixEntry
+	}{
+		{
+			name: "no rule",
+			expectedEntry: controlplane.ConnectivityMatrixEntry{
+				Source:      controlplane.PodReference{Namespace: "matrix", Name: "client"},
+				Destination: controlplane.PodReference{Namespace: "matrix", Name: "web"},
+				Action:      crdv1beta1.RuleActionAllow,
+			},
+		},
+		{
+			name: "reject rule",
+			rule: &antreatypes.RuleInfo{Policy: policy, Index: 1, Rule: &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionOut, Name: "reject", Action: ptr.To(crdv1beta1.RuleActionReject)}},
+			expectedEntry: controlplane.ConnectivityMatrixEntry{
+				Source:        controlplane.PodReference{Namespace: "matrix", Name: "client"},
+				Destination:   controlplane.PodReference{Namespace: "matrix", Name: "web"},
+				Action:        crdv1beta1.RuleActionReject,
+				NetworkPolicy: policyRef,
+				RuleIndex:     1,
+				Rule:          &controlplane.RuleRef{Direction: controlplane.DirectionOut, Name: "reject", Action: ptr.To(crdv1beta1.RuleActionReject)},
+			},
+		},
+		{
+			name: "drop rule of policy in Audit mode",
+			rule: &antreatypes.RuleInfo{Policy: auditPolicy, Index: 1, Rule: &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionOut, Name: "drop", Action: ptr.To(crdv1beta1.RuleActionDrop)}},
+			expectedEntry: controlplane.ConnectivityMatrixEntry{
+				Source:      controlplane.PodReference{Namespace: "matrix", Name: "client"},
+				Destination: controlplane.PodReference{Namespace: "matrix", Name: "web"},
+				Action:      crdv1beta1.RuleActionAllow,
+			},
+		},
+		{
+			name: "pass rule",
+			rule: &antreatypes.RuleInfo{Policy: policy, Rule: &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionIn, Name: "pass", Action: ptr.To(crdv1beta1.RuleActionPass)}},
+			expectedEntry: controlplane.ConnectivityMatrixEntry{
+				Source:        controlplane.PodReference{Namespace: "matrix", Name: "client"},
+				Destination:   controlplane.PodReference{Namespace: "matrix", Name: "web"},
+				Action:        crdv1beta1.RuleActionAllow,
+				NetworkPolicy: policyRef,
+				Rule:          &controlplane.RuleRef{Direction: controlplane.DirectionIn, Name: "pass", Action: ptr.To(crdv1beta1.RuleActionPass)},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedEntry, newConnectivityMatrixEntry(srcPod, dstPod, tc.rule))
+		})
+	}
+}
+
+func TestServiceMatches(t *testing.T) {
+	dstPod := newMatrixTestPod("web", "10.0.0.1",
+		corev1.ContainerPort{Name: "http", ContainerPort: 8080},
+		corev1.ContainerPort{Name: "dns", ContainerPort: 53, Protocol: corev1.ProtocolUDP},
+	)
+	testCases := []struct {
+		name     string
+		service  controlplane.Service
+		protocol controlplane.Protocol
+		port     int32
+		expected bool
+	}{
+		{
+			name:     "default protocol and any port",
+			service:  controlplane.Service{},
+			protocol: controlplane.ProtocolTCP,
+			port:     80,
+			expected: true,
+		},
+		{
+			name:     "different protocol",
+			service:  controlplane.Service{Protocol: ptr.To(controlplane.ProtocolUDP)},
+			protocol: controlplane.ProtocolTCP,
+			port:     80,
+			expected: false,
+		},
+		{
+			name:     "matching port number",
+			service:  controlplane.Service{Protocol: ptr.To(controlplane.ProtocolSCTP), Port: ptr.To(intstr.FromInt32(80))},
+			protocol: controlplane.ProtocolSCTP,
+			port:     80,
+			expected: true,
+		},
+		{
+			name:     "different port number",
+			service:  controlplane.Service{Port: ptr.To(intstr.FromInt32(80))},
+			protocol: controlplane.ProtocolTCP,
+			port:     81,
+			expected: false,
+		},
+		{
+			name:     "port in range",
+			service:  controlplane.Service{Port: ptr.To(intstr.FromInt32(80)), EndPort: ptr.To[int32](90)},
+			protocol: controlplane.ProtocolTCP,
+			port:     90,
+			expected: true,
+		},
+		{
+			name:     "port out of range",
+			service:  controlplane.Service{Port: ptr.To(intstr.FromInt32(80)), EndPort: ptr.To[int32](90)},
+			protocol: controlplane.ProtocolTCP,
+			port:     91,
+			expected: false,
+		},
+		{
+			name:     "matching named port",
+			service:  controlplane.Service{Protocol: ptr.To(controlplane.ProtocolUDP), Port: ptr.To(intstr.FromString("dns"))},
+			protocol: controlplane.ProtocolUDP,
+			port:     53,
+			expected: true,
+		},
+		{
+			name:     "named port with different number",
+			service:  controlplane.Service{Port: ptr.To(intstr.FromString("http"))},
+			protocol: controlplane.ProtocolTCP,
+			port:     80,
+			expected: false,
+		},
+		{
+			name:     "unknown named port",
+			service:  controlplane.Service{Port: ptr.To(intstr.FromString("https"))},
+			protocol: controlplane.ProtocolTCP,
+			port:     8443,
+			expected: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, serviceMatches(&tc.service, tc.protocol, tc.port, dstPod))
+		})
+	}
+}
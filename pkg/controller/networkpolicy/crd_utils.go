@@ -119,13 +119,83 @@ func toAntreaL7ProtocolsForCRD(l7Protocols []crdv1beta1.L7Protocol) []controlpla
 	var antreaL7Protocols []controlplane.L7Protocol
 	for _, l7p := range l7Protocols {
 		antreaL7Protocols = append(antreaL7Protocols, controlplane.L7Protocol{
-			HTTP: (*controlplane.HTTPProtocol)(l7p.HTTP),
-			TLS:  (*controlplane.TLSProtocol)(l7p.TLS),
+			HTTP:  toAntreaHTTPProtocolForCRD(l7p.HTTP),
+			TLS:   (*controlplane.TLSProtocol)(l7p.TLS),
+			GRPC:  toAntreaGRPCProtocolForCRD(l7p.GRPC),
+			DNS:   toAntreaDNSProtocolForCRD(l7p.DNS),
+			Kafka: toAntreaKafkaProtocolForCRD(l7p.Kafka),
 		})
 	}
 	return antreaL7Protocols
 }
 
+// toAntreaHTTPProtocolForCRD converts a v1beta1.HTTPProtocol object to an
+// Antrea HTTPProtocol object.
+func toAntreaHTTPProtocolForCRD(http *crdv1beta1.HTTPProtocol) *controlplane.HTTPProtocol {
+	if http == nil {
+		return nil
+	}
+	antreaHTTP := &controlplane.HTTPProtocol{
+		Host:             http.Host,
+		Method:           http.Method,
+		Path:             http.Path,
+		UserAgent:        http.UserAgent,
+		RejectStatusCode: http.RejectStatusCode,
+	}
+	for _, h := range http.Headers {
+		antreaHTTP.Headers = append(antreaHTTP.Headers, controlplane.HTTPHeaderMatch(h))
+	}
+	for _, q := range http.QueryParams {
+		antreaHTTP.QueryParams = append(antreaHTTP.QueryParams, controlplane.HTTPQueryParamMatch(q))
+	}
+	return antreaHTTP
+}
+
+// toAntreaGRPCProtocolForCRD converts a v1beta1.GRPCProtocol object to an
+// Antrea GRPCProtocol object.
+func toAntreaGRPCProtocolForCRD(grpc *crdv1beta1.GRPCProtocol) *controlplane.GRPCProtocol {
+	if grpc == nil {
+		return nil
+	}
+	antreaGRPC := &controlplane.GRPCProtocol{
+		Service: grpc.Service,
+		Method:  grpc.Method,
+	}
+	for _, m := range grpc.Metadata {
+		antreaGRPC.Metadata = append(antreaGRPC.Metadata, controlplane.GRPCMetadataMatch(m))
+	}
+	return antreaGRPC
+}
+
+// toAntreaDNSProtocolForCRD converts a v1beta1.DNSProtocol object to an
+// Antrea DNSProtocol object.
+func toAntreaDNSProtocolForCRD(dns *crdv1beta1.DNSProtocol) *controlplane.DNSProtocol {
+	if dns == nil {
+		return nil
+	}
+	rejectResponseCode := dns.RejectResponseCode
+	if rejectResponseCode == "" {
+		rejectResponseCode = crdv1beta1.DNSResponseCodeRefused
+	}
+	return &controlplane.DNSProtocol{
+		QueryName:          dns.QueryName,
+		RecordTypes:        dns.RecordTypes,
+		RejectResponseCode: string(rejectResponseCode),
+	}
+}
+
+// toAntreaKafkaProtocolForCRD converts a v1beta1.KafkaProtocol object to an
+// Antrea KafkaProtocol object.
+func toAntreaKafkaProtocolForCRD(kafka *crdv1beta1.KafkaProtocol) *controlplane.KafkaProtocol {
+	if kafka == nil {
+		return nil
+	}
+	return &controlplane.KafkaProtocol{
+		APIKey: string(kafka.APIKey),
+		Topic:  kafka.Topic,
+	}
+}
+
 // toAntreaIPBlockForCRD converts a crdv1beta1.IPBlock to an Antrea IPBlock.
 func toAntreaIPBlockForCRD(ipBlock *crdv1beta1.IPBlock) (*controlplane.IPBlock, error) {
 	// Convert the allowed IPBlock to networkpolicy.IPNet.
@@ -210,6 +280,8 @@ func (n *NetworkPolicyController) toAntreaPeerForCRD(peers []crdv1beta1.NetworkP
 		// - reference to a Group/ClusterGroup
 		// - IPBlocks
 		// - FQDNs
+		// - ServiceAccount
+		// - identity (in-cluster scope or ClusterSet scope)
 		if peer.IPBlock != nil {
 			ipBlock, err := toAntreaIPBlockForCRD(peer.IPBlock)
 			if err != nil {
@@ -228,6 +300,13 @@ func (n *NetworkPolicyController) toAntreaPeerForCRD(peers []crdv1beta1.NetworkP
 		} else if peer.ServiceAccount != nil {
 			addressGroup := n.createAddressGroup(peer.ServiceAccount.Namespace, serviceAccountNameToPodSelector(peer.ServiceAccount.Name), nil, nil, nil)
 			addressGroups = append(addressGroups, addressGroup)
+		} else if peer.Identity != nil {
+			identityAddressGroups, err := n.createAddressGroupsForWorkloadIdentity(peer.Identity)
+			if err != nil {
+				klog.ErrorS(err, "Failed to process identity peer", "policy", klog.KObj(np))
+				continue
+			}
+			addressGroups = append(addressGroups, identityAddressGroups...)
 		} else if peer.NodeSelector != nil {
 			addressGroup := n.createAddressGroup("", nil, nil, nil, peer.NodeSelector)
 			addressGroups = append(addressGroups, addressGroup)
@@ -237,6 +316,12 @@ func (n *NetworkPolicyController) toAntreaPeerForCRD(peers []crdv1beta1.NetworkP
 		}
 		if n.stretchNPEnabled && peer.Scope == crdv1beta1.ScopeClusterSet {
 			newClusterSetScopeSelector := antreatypes.NewGroupSelector(np.GetNamespace(), peer.PodSelector, peer.NamespaceSelector, nil, nil)
+			if peer.Identity != nil {
+				// The identity is valid, otherwise the peer would have been skipped above. The ServiceAccount
+				// of Pods is part of their LabelIdentities.
+				namespace, selector, _ := workloadIdentityToSelector(peer.Identity)
+				newClusterSetScopeSelector = antreatypes.NewGroupSelector(namespace, selector, nil, nil, nil)
+			}
 			clusterSetScopeSelectorKeys.Insert(newClusterSetScopeSelector.NormalizedName)
 			// In addition to getting the matched Label Identity IDs, AddSelector also registers the selector
 			// with the labelIdentityInterface.
@@ -291,9 +376,25 @@ func (n *NetworkPolicyController) toNamespacedPeerForCRD(peers []crdv1beta1.Netw
 // svcRefToPeerForCRD creates an Antrea controlplane NetworkPolicyPeer from ServiceReferences in ToServices
 // or ToMulticlusterServices field of a crdv1beta1 NetworkPolicyPeer. For ANNP NetworkPolicyPeers, if
 // Namespace is not provided in the ServiceReference, the policy's Namespace will be assumed.
-func (n *NetworkPolicyController) svcRefToPeerForCRD(svcRefs []crdv1beta1.PeerService, defaultNamespace string) *controlplane.NetworkPolicyPeer {
+// Services selected by a ServiceSelector are resolved to ServiceReferences, and the selector is registered
+// with the groupingInterface for the given policy. The normalized names of the selectors are returned.
+func (n *NetworkPolicyController) svcRefToPeerForCRD(svcRefs []crdv1beta1.PeerService, defaultNamespace string, policyKey string) (*controlplane.NetworkPolicyPeer, sets.Set[string]) {
 	var controlplaneSvcRefs []controlplane.ServiceReference
+	var serviceSelectorKeys sets.Set[string]
 	for _, svcRef := range svcRefs {
+		if svcRef.ServiceSelector != nil {
+			namespace := svcRef.Namespace
+			if namespace == "" && svcRef.NamespaceSelector == nil {
+				namespace = defaultNamespace
+			}
+			selector := antreatypes.NewServiceGroupSelector(namespace, svcRef.ServiceSelector, svcRef.NamespaceSelector)
+			if serviceSelectorKeys == nil {
+				serviceSelectorKeys = sets.New[string]()
+			}
+			serviceSelectorKeys.Insert(selector.NormalizedName)
+			controlplaneSvcRefs = append(controlplaneSvcRefs, n.addServiceSelector(selector, policyKey)...)
+			continue
+		}
 		svcNS, svcName := defaultNamespace, svcRef.Name
 		if svcRef.Namespace != "" {
 			svcNS = svcRef.Namespace
@@ -311,7 +412,7 @@ func (n *NetworkPolicyController) svcRefToPeerForCRD(svcRefs []crdv1beta1.PeerSe
 			Name:      svcName,
 		})
 	}
-	return &controlplane.NetworkPolicyPeer{ToServices: controlplaneSvcRefs}
+	return &controlplane.NetworkPolicyPeer{ToServices: controlplaneSvcRefs}, serviceSelectorKeys
 }
 
 // createAppliedToGroupForService creates an AppliedToGroup object corresponding to a Service.
@@ -351,9 +452,9 @@ func (n *NetworkPolicyController) createAppliedToGroupForGroup(namespace, group
 	// Pods and ignore the IPBlocks, instead of reporting errors and asking users to remove IPBlocks from child Groups,
 	// as the Group could also be used as AddressGroup.
 	// To keep the behavior consistent regarding IPBlocks, we ignore Groups containing only IPBlocks when it's used as
-	// AppliedTo.
-	if len(intGrp.IPBlocks) > 0 {
-		klog.V(2).InfoS("Group with IPBlocks can not be used as AppliedTo", "Group", key)
+	// AppliedTo. The same applies to Groups defined with an IP list.
+	if len(intGrp.IPBlocks) > 0 || intGrp.IPList != nil {
+		klog.V(2).InfoS("Group with IPBlocks or IP list can not be used as AppliedTo", "Group", key)
 		return nil
 	}
 	return &antreatypes.AppliedToGroup{UID: intGrp.UID, Name: key, SourceGroup: key}
@@ -403,6 +504,7 @@ func (n *NetworkPolicyController) syncInternalGroup(key string) error {
 	if !found {
 		klog.V(2).InfoS("Internal group not found", "internalGroup", key)
 		n.groupingInterface.DeleteGroup(internalGroupType, key)
+		n.deleteIPList(key)
 		return nil
 	}
 	grp := grpObj.(*antreatypes.Group)
@@ -218,6 +218,26 @@ func TestToAntreaL7ProtocolsForCRD(t *testing.T) {
 				{HTTP: &controlplane.HTTPProtocol{Host: "test.com", Method: "GET", Path: "/admin"}},
 			},
 		},
+		{
+			[]crdv1beta1.L7Protocol{
+				{HTTP: &crdv1beta1.HTTPProtocol{
+					Path:             "/api/*",
+					Headers:          []crdv1beta1.HTTPHeaderMatch{{Name: "X-Tenant-ID", Regex: "[a-z]+"}},
+					QueryParams:      []crdv1beta1.HTTPQueryParamMatch{{Name: "version", Value: "2"}},
+					UserAgent:        "curl/*",
+					RejectStatusCode: 403,
+				}},
+			},
+			[]controlplane.L7Protocol{
+				{HTTP: &controlplane.HTTPProtocol{
+					Path:             "/api/*",
+					Headers:          []controlplane.HTTPHeaderMatch{{Name: "X-Tenant-ID", Regex: "[a-z]+"}},
+					QueryParams:      []controlplane.HTTPQueryParamMatch{{Name: "version", Value: "2"}},
+					UserAgent:        "curl/*",
+					RejectStatusCode: 403,
+				}},
+			},
+		},
 		{
 			[]crdv1beta1.L7Protocol{
 				{TLS: &crdv1beta1.TLSProtocol{SNI: "test.com"}},
@@ -226,6 +246,46 @@ func TestToAntreaL7ProtocolsForCRD(t *testing.T) {
 				{TLS: &controlplane.TLSProtocol{SNI: "test.com"}},
 			},
 		},
+		{
+			[]crdv1beta1.L7Protocol{
+				{GRPC: &crdv1beta1.GRPCProtocol{
+					Service:  "payments.Ledger",
+					Method:   "Read",
+					Metadata: []crdv1beta1.GRPCMetadataMatch{{Name: "x-tenant-id", Value: "foo"}},
+				}},
+			},
+			[]controlplane.L7Protocol{
+				{GRPC: &controlplane.GRPCProtocol{
+					Service:  "payments.Ledger",
+					Method:   "Read",
+					Metadata: []controlplane.GRPCMetadataMatch{{Name: "x-tenant-id", Value: "foo"}},
+				}},
+			},
+		},
+		{
+			[]crdv1beta1.L7Protocol{
+				{DNS: &crdv1beta1.DNSProtocol{QueryName: "*.test.com", RecordTypes: []string{"A", "AAAA"}}},
+			},
+			[]controlplane.L7Protocol{
+				{DNS: &controlplane.DNSProtocol{QueryName: "*.test.com", RecordTypes: []string{"A", "AAAA"}, RejectResponseCode: "REFUSED"}},
+			},
+		},
+		{
+			[]crdv1beta1.L7Protocol{
+				{DNS: &crdv1beta1.DNSProtocol{QueryName: "test.com", RejectResponseCode: crdv1beta1.DNSResponseCodeNXDomain}},
+			},
+			[]controlplane.L7Protocol{
+				{DNS: &controlplane.DNSProtocol{QueryName: "test.com", RejectResponseCode: "NXDOMAIN"}},
+			},
+		},
+		{
+			[]crdv1beta1.L7Protocol{
+				{Kafka: &crdv1beta1.KafkaProtocol{APIKey: crdv1beta1.KafkaAPIKeyProduce, Topic: "orders"}},
+			},
+			[]controlplane.L7Protocol{
+				{Kafka: &controlplane.KafkaProtocol{APIKey: "produce", Topic: "orders"}},
+			},
+		},
 	}
 	for _, table := range tables {
 		gotValue := toAntreaL7ProtocolsForCRD(table.l7Protocol)
@@ -293,6 +353,7 @@ func TestToAntreaPeerForCRD(t *testing.T) {
 	selectorB := metav1.LabelSelector{MatchLabels: map[string]string{"foo2": "bar2"}}
 	selectorC := metav1.LabelSelector{MatchLabels: map[string]string{"foo3": "bar3"}}
 	selectorAll := metav1.LabelSelector{}
+	selectorSA := metav1.LabelSelector{MatchLabels: map[string]string{"internal.antrea.io/service-account": "sa1"}}
 	matchAllPodsPeer := matchAllPeer
 	matchAllPodsPeer.AddressGroups = []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorAll, nil, nil).NormalizedName)}
 	// cgA with selector present in cache
@@ -469,6 +530,49 @@ func TestToAntreaPeerForCRD(t *testing.T) {
 			direction:       controlplane.DirectionIn,
 			clusterSetScope: true,
 		},
+		{
+			name: "identity-peer",
+			inPeers: []crdv1beta1.NetworkPolicyPeer{
+				{
+					Identity: &crdv1beta1.WorkloadIdentity{SPIFFEID: "spiffe://cluster.local/ns/testing/sa/sa1"},
+				},
+			},
+			outPeer: controlplane.NetworkPolicyPeer{
+				AddressGroups: []string{
+					getNormalizedUID(antreatypes.NewGroupSelector("testing", &selectorSA, nil, nil, nil).NormalizedName),
+					getNormalizedUID(antreatypes.NewGroupSelector("testing", nil, nil, &selectorSA, nil).NormalizedName),
+				},
+			},
+			direction: controlplane.DirectionIn,
+		},
+		{
+			name: "invalid-identity-peer",
+			inPeers: []crdv1beta1.NetworkPolicyPeer{
+				{
+					Identity: &crdv1beta1.WorkloadIdentity{SPIFFEID: "spiffe://cluster.local/sa1"},
+				},
+			},
+			outPeer:   controlplane.NetworkPolicyPeer{},
+			direction: controlplane.DirectionIn,
+		},
+		{
+			name: "stretched-identity-peer",
+			inPeers: []crdv1beta1.NetworkPolicyPeer{
+				{
+					Identity: &crdv1beta1.WorkloadIdentity{ServiceAccount: &crdv1beta1.NamespacedName{Namespace: "testing", Name: "sa1"}},
+					Scope:    crdv1beta1.ScopeClusterSet,
+				},
+			},
+			outPeer: controlplane.NetworkPolicyPeer{
+				LabelIdentities: []uint32{3},
+				AddressGroups: []string{
+					getNormalizedUID(antreatypes.NewGroupSelector("testing", &selectorSA, nil, nil, nil).NormalizedName),
+					getNormalizedUID(antreatypes.NewGroupSelector("testing", nil, nil, &selectorSA, nil).NormalizedName),
+				},
+			},
+			direction:       controlplane.DirectionIn,
+			clusterSetScope: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -481,6 +585,8 @@ func TestToAntreaPeerForCRD(t *testing.T) {
 				labelIdentityB := "ns:kubernetes.io/metadata.name=testing,purpose=test&pod:foo2=bar2"
 				npc.labelIdentityInterface.AddLabelIdentity(labelIdentityA, 1)
 				npc.labelIdentityInterface.AddLabelIdentity(labelIdentityB, 2)
+				labelIdentityC := "ns:kubernetes.io/metadata.name=testing,purpose=test&pod:app=db,internal.antrea.io/service-account=sa1"
+				npc.labelIdentityInterface.AddLabelIdentity(labelIdentityC, 3)
 			}
 			actualPeer, _, _ := npc.toAntreaPeerForCRD(tt.inPeers, testCNPObj, tt.direction, tt.namedPortExists)
 			if !reflect.DeepEqual(tt.outPeer.AddressGroups, actualPeer.AddressGroups) {
@@ -22,6 +22,8 @@ import (
 	"math"
 	"sort"
 
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -36,6 +38,12 @@ type EndpointQuerier interface {
 	// QueryNetworkPolicyRules returns the list of NetworkPolicies which apply to the provided Pod,
 	// along with the list of NetworkPolicy ingress/egress rules which select the provided Pod.
 	QueryNetworkPolicyRules(namespace, podName string) (*antreatypes.EndpointNetworkPolicyRules, error)
+	// QueryNetworkPolicyImpact returns the connections between Pods which are currently allowed and would be
+	// denied if the provided candidate NetworkPolicy was created. Only the connections whose source and
+	// destination Pods match the provided filters are returned, a nil filter matches all Pods.
+	QueryNetworkPolicyImpact(policy runtime.Object, source, destination *controlplane.PodReference) ([]controlplane.DeniedConnection, error)
+	// QuerySelectedPods returns the Pods selected by the provided EntitySelector, sorted by Namespace and name.
+	QuerySelectedPods(selector *controlplane.EntitySelector) ([]*v1.Pod, error)
 }
 
 // EndpointQuerierImpl implements the EndpointQuerier interface
@@ -53,6 +61,7 @@ func NewEndpointQuerier(networkPolicyController *NetworkPolicyController) *Endpo
 // PolicyRuleQuerier handles requests for querying effective policy rule on entities.
 type PolicyRuleQuerier interface {
 	QueryNetworkPolicyEvaluation(entities *controlplane.NetworkPolicyEvaluationRequest) (*controlplane.NetworkPolicyEvaluationResponse, error)
+	QueryConnectivityMatrix(request *controlplane.ConnectivityMatrixRequest) (*controlplane.ConnectivityMatrixResponse, error)
 }
 
 // policyRuleQuerier implements the PolicyRuleQuerier interface
@@ -151,7 +160,7 @@ func (eq *EndpointQuerierImpl) QueryNetworkPolicyRules(namespace, podName string
 				for _, addressGroupTrial := range rule.To.AddressGroups {
 					if addressGroupTrial == string(addressGroup.(*antreatypes.AddressGroup).UID) {
 						egress = append(egress, &antreatypes.RuleInfo{Policy: policy.(*antreatypes.NetworkPolicy), Index: egressIndex,
-							Rule: &controlplane.NetworkPolicyRule{Direction: rule.Direction, Name: rule.Name, Action: rule.Action}})
+							Rule: &controlplane.NetworkPolicyRule{Direction: rule.Direction, Name: rule.Name, Action: rule.Action, Services: rule.Services}})
 						// an AddressGroup can only be referenced in a rule once
 						break
 					}
@@ -159,7 +168,7 @@ func (eq *EndpointQuerierImpl) QueryNetworkPolicyRules(namespace, podName string
 				for _, addressGroupTrial := range rule.From.AddressGroups {
 					if addressGroupTrial == string(addressGroup.(*antreatypes.AddressGroup).UID) {
 						ingress = append(ingress, &antreatypes.RuleInfo{Policy: policy.(*antreatypes.NetworkPolicy), Index: ingressIndex,
-							Rule: &controlplane.NetworkPolicyRule{Direction: rule.Direction, Name: rule.Name, Action: rule.Action}})
+							Rule: &controlplane.NetworkPolicyRule{Direction: rule.Direction, Name: rule.Name, Action: rule.Action, Services: rule.Services}})
 						// an AddressGroup can only be referenced in a rule once
 						break
 					}
@@ -203,21 +212,27 @@ func processEndpointAppliedRules(appliedPolicies []*antreatypes.NetworkPolicy, i
 	return policyUIDs, isolationRules
 }
 
+// isAuditPolicy returns true if the NetworkPolicy is in Audit mode, in which case its rules are not enforced.
+func isAuditPolicy(policy *antreatypes.NetworkPolicy) bool {
+	return policy.EnforcementMode == crdv1beta1.PolicyEnforcementModeAudit
+}
+
 // predictEndpointsRules returns the predicted rules effective from srcEndpoints to dstEndpoints.
 // Rules returned satisfy a. in source applied policies and destination egress rules,
 // or b. in source ingress rules and destination applied policies or c. applied to KNP default isolation.
+// The rules of NetworkPolicies in Audit mode are ignored, as they are never effective.
 func predictEndpointsRules(srcEndpointRules, dstEndpointRules *antreatypes.EndpointNetworkPolicyRules) (commonRule *antreatypes.RuleInfo) {
 	commonRules := make([]*antreatypes.RuleInfo, 0)
 	if srcEndpointRules != nil && dstEndpointRules != nil {
 		srcPolicies, srcIsolated := processEndpointAppliedRules(srcEndpointRules.AppliedPolicies, true)
 		dstPolicies, dstIsolated := processEndpointAppliedRules(dstEndpointRules.AppliedPolicies, false)
 		for _, rule := range dstEndpointRules.EndpointAsEgressDstRules {
-			if srcPolicies.Has(rule.Policy.SourceRef.UID) {
+			if srcPolicies.Has(rule.Policy.SourceRef.UID) && !isAuditPolicy(rule.Policy) {
 				commonRules = append(commonRules, rule)
 			}
 		}
 		for _, rule := range srcEndpointRules.EndpointAsIngressSrcRules {
-			if dstPolicies.Has(rule.Policy.SourceRef.UID) {
+			if dstPolicies.Has(rule.Policy.SourceRef.UID) && !isAuditPolicy(rule.Policy) {
 				commonRules = append(commonRules, rule)
 			}
 		}
@@ -289,8 +304,21 @@ func predictEndpointsRules(srcEndpointRules, dstEndpointRules *antreatypes.Endpo
 }
 
 // QueryNetworkPolicyEvaluation returns the effective NetworkPolicy rule on given
-// source and destination entities.
+// source and destination entities. If a candidate NetworkPolicy is provided, it
+// returns the connections which would be denied by the candidate NetworkPolicy
+// instead, and the source and destination entities are optional filters.
 func (eq *policyRuleQuerier) QueryNetworkPolicyEvaluation(entities *controlplane.NetworkPolicyEvaluationRequest) (*controlplane.NetworkPolicyEvaluationResponse, error) {
+	if entities.CandidatePolicy != nil {
+		policy, err := decodeCandidatePolicy(entities.CandidatePolicy)
+		if err != nil {
+			return nil, err
+		}
+		deniedConnections, err := eq.endpointQuerier.QueryNetworkPolicyImpact(policy, entities.Source.Pod, entities.Destination.Pod)
+		if err != nil {
+			return nil, err
+		}
+		return &controlplane.NetworkPolicyEvaluationResponse{DeniedConnections: deniedConnections}, nil
+	}
 	if entities.Source.Pod == nil || entities.Destination.Pod == nil || entities.Source.Pod.Name == "" || entities.Destination.Pod.Name == "" {
 		return nil, errors.New("invalid NetworkPolicyEvaluation request entities")
 	}
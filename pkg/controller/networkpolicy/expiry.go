@@ -0,0 +1,124 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+// evaluatePolicyExpiry returns the time at which a policy with the given expiry expires, and
+// whether it has expired at the given time. A zero time is returned if the policy never expires.
+// The TTL is relative to the creation of the policy, or to the given time if the policy hasn't
+// been created yet, e.g. when it's validated or simulated.
+func evaluatePolicyExpiry(expiry *crdv1beta1.PolicyExpiry, creationTimestamp metav1.Time, now time.Time) (time.Time, bool) {
+	if expiry == nil {
+		return time.Time{}, false
+	}
+	var expirationTime time.Time
+	if expiry.ExpirationTime != nil {
+		expirationTime = expiry.ExpirationTime.Time
+	} else if expiry.TTL != nil {
+		createdAt := creationTimestamp.Time
+		if createdAt.IsZero() {
+			createdAt = now
+		}
+		expirationTime = createdAt.Add(expiry.TTL.Duration)
+	} else {
+		return time.Time{}, false
+	}
+	return expirationTime, !now.Before(expirationTime)
+}
+
+// validatePolicyExpiry validates the expiry of a policy.
+func validatePolicyExpiry(expiry *crdv1beta1.PolicyExpiry) error {
+	if (expiry.ExpirationTime == nil) == (expiry.TTL == nil) {
+		return fmt.Errorf("exactly one of expirationTime and ttl must be set")
+	}
+	if expiry.TTL != nil && expiry.TTL.Duration <= 0 {
+		return fmt.Errorf("ttl must be positive")
+	}
+	return nil
+}
+
+// newExpiredInternalNetworkPolicy returns the internal NetworkPolicy of an expired Antrea-native
+// policy. It has no rules and no AppliedToGroups, so that the policy is no longer enforced on any
+// Node.
+func newExpiredInternalNetworkPolicy(sourceRef *controlplane.NetworkPolicyReference, generation int64, priority float64, tierPriority int32, enforcementMode crdv1beta1.PolicyEnforcementMode, expirationTime time.Time) *antreatypes.NetworkPolicy {
+	return &antreatypes.NetworkPolicy{
+		SourceRef:       sourceRef,
+		Name:            string(sourceRef.UID),
+		UID:             sourceRef.UID,
+		Generation:      generation,
+		Priority:        &priority,
+		TierPriority:    &tierPriority,
+		EnforcementMode: enforcementMode,
+		Expired:         true,
+		ExpirationTime:  expirationTime,
+	}
+}
+
+// handleExpiredPolicy records an Event for an expired Antrea-native policy, and deletes the policy
+// if requested by its expiry. The Event is only recorded when the policy expires, i.e. when it was
+// not already known to be expired, either from the previous internal NetworkPolicy or from the
+// Expired condition in its status.
+func (n *NetworkPolicyController) handleExpiredPolicy(obj runtime.Object, internalNP *antreatypes.NetworkPolicy, expiry *crdv1beta1.PolicyExpiry, alreadyExpired bool) error {
+	ref := internalNP.SourceRef
+	if !alreadyExpired {
+		n.eventRecorder.Eventf(obj, v1.EventTypeNormal, "PolicyExpired", "Policy expired at %s and is no longer enforced", internalNP.ExpirationTime.UTC().Format(time.RFC3339))
+	}
+	if !expiry.DeleteOnExpiry {
+		return nil
+	}
+	// The UID precondition prevents deleting a policy recreated with the same name.
+	deleteOptions := metav1.DeleteOptions{Preconditions: metav1.NewUIDPreconditions(string(ref.UID))}
+	var err error
+	if ref.Type == controlplane.AntreaClusterNetworkPolicy {
+		err = n.crdClient.CrdV1beta1().ClusterNetworkPolicies().Delete(context.TODO(), ref.Name, deleteOptions)
+	} else {
+		err = n.crdClient.CrdV1beta1().NetworkPolicies(ref.Namespace).Delete(context.TODO(), ref.Name, deleteOptions)
+	}
+	if err != nil {
+		// The policy has been deleted or recreated in the meantime.
+		if errors.IsNotFound(err) || errors.IsConflict(err) {
+			return nil
+		}
+		return fmt.Errorf("error when deleting expired policy %s: %w", ref.ToString(), err)
+	}
+	klog.InfoS("Deleted expired policy", "policy", ref.ToString())
+	n.eventRecorder.Event(obj, v1.EventTypeNormal, "PolicyDeleted", "Deleted expired policy")
+	return nil
+}
+
+// hasExpiredCondition returns true if the status has a true Expired condition.
+func hasExpiredCondition(status *crdv1beta1.NetworkPolicyStatus) bool {
+	for _, condition := range status.Conditions {
+		if condition.Type == crdv1beta1.NetworkPolicyConditionExpired && condition.Status == metav1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
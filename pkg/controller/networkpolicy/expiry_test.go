@@ -0,0 +1,123 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+func TestEvaluatePolicyExpiry(t *testing.T) {
+	createdAt := time.Date(2025, 3, 5, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name                   string
+		expiry                 *crdv1beta1.PolicyExpiry
+		creationTimestamp      time.Time
+		now                    time.Time
+		expectedExpirationTime time.Time
+		expectedExpired        bool
+	}{
+		{
+			name:              "no expiry",
+			creationTimestamp: createdAt,
+			now:               createdAt.Add(time.Hour),
+		},
+		{
+			name:                   "before expiration time",
+			expiry:                 &crdv1beta1.PolicyExpiry{ExpirationTime: &metav1.Time{Time: createdAt.Add(2 * time.Hour)}},
+			creationTimestamp:      createdAt,
+			now:                    createdAt.Add(time.Hour),
+			expectedExpirationTime: createdAt.Add(2 * time.Hour),
+		},
+		{
+			name:                   "at expiration time",
+			expiry:                 &crdv1beta1.PolicyExpiry{ExpirationTime: &metav1.Time{Time: createdAt.Add(2 * time.Hour)}},
+			creationTimestamp:      createdAt,
+			now:                    createdAt.Add(2 * time.Hour),
+			expectedExpirationTime: createdAt.Add(2 * time.Hour),
+			expectedExpired:        true,
+		},
+		{
+			name:                   "ttl not elapsed",
+			expiry:                 &crdv1beta1.PolicyExpiry{TTL: &metav1.Duration{Duration: 30 * time.Minute}},
+			creationTimestamp:      createdAt,
+			now:                    createdAt.Add(10 * time.Minute),
+			expectedExpirationTime: createdAt.Add(30 * time.Minute),
+		},
+		{
+			name:                   "ttl elapsed",
+			expiry:                 &crdv1beta1.PolicyExpiry{TTL: &metav1.Duration{Duration: 30 * time.Minute}},
+			creationTimestamp:      createdAt,
+			now:                    createdAt.Add(time.Hour),
+			expectedExpirationTime: createdAt.Add(30 * time.Minute),
+			expectedExpired:        true,
+		},
+		{
+			name:                   "ttl of policy not created yet",
+			expiry:                 &crdv1beta1.PolicyExpiry{TTL: &metav1.Duration{Duration: 30 * time.Minute}},
+			now:                    createdAt,
+			expectedExpirationTime: createdAt.Add(30 * time.Minute),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expirationTime, expired := evaluatePolicyExpiry(tt.expiry, metav1.Time{Time: tt.creationTimestamp}, tt.now)
+			assert.Equal(t, tt.expectedExpirationTime, expirationTime)
+			assert.Equal(t, tt.expectedExpired, expired)
+		})
+	}
+}
+
+func TestValidatePolicyExpiry(t *testing.T) {
+	tests := []struct {
+		name        string
+		expiry      *crdv1beta1.PolicyExpiry
+		expectedErr string
+	}{
+		{
+			name:   "expiration time",
+			expiry: &crdv1beta1.PolicyExpiry{ExpirationTime: &metav1.Time{Time: time.Date(2025, 3, 5, 10, 0, 0, 0, time.UTC)}},
+		},
+		{
+			name:   "ttl",
+			expiry: &crdv1beta1.PolicyExpiry{TTL: &metav1.Duration{Duration: time.Hour}, DeleteOnExpiry: true},
+		},
+		{
+			name:        "none set",
+			expiry:      &crdv1beta1.PolicyExpiry{DeleteOnExpiry: true},
+			expectedErr: "exactly one of expirationTime and ttl must be set",
+		},
+		{
+			name:        "zero ttl",
+			expiry:      &crdv1beta1.PolicyExpiry{TTL: &metav1.Duration{}},
+			expectedErr: "ttl must be positive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePolicyExpiry(tt.expiry)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ package networkpolicy
 
 import (
 	"context"
+	"reflect"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -81,7 +82,10 @@ func (n *NetworkPolicyController) updateGroup(oldObj, curObj interface{}) {
 		}
 		return !oldChildGroups.Equal(newChildGroups)
 	}
-	if !ipBlocksUpdated() && !svcRefUpdated() && !selectorUpdated() && !childGroupsUpdated() {
+	ipListUpdated := func() bool {
+		return !reflect.DeepEqual(oldGroup.IPList, newGroup.IPList)
+	}
+	if !ipBlocksUpdated() && !svcRefUpdated() && !selectorUpdated() && !childGroupsUpdated() && !ipListUpdated() {
 		// No change in the contents of the Group. No need to enqueue for further sync.
 		return
 	}
@@ -125,6 +129,15 @@ func (n *NetworkPolicyController) processGroup(g *crdv1beta1.Group) *antreatypes
 		}
 		return &internalGroup
 	}
+	if g.Spec.IPList != nil {
+		internalGroup.IPList = g.Spec.IPList.DeepCopy()
+		if internalGroup.IPList.ConfigMap != nil && internalGroup.IPList.ConfigMap.Namespace == "" {
+			internalGroup.IPList.ConfigMap.Namespace = g.Namespace
+		}
+		// The IPBlocks are populated once the IP list has been read from its source.
+		internalGroup.IPBlocks, internalGroup.IPNets = n.getIPListBlocks(internalGroupKeyFunc(g), internalGroup.IPList)
+		return &internalGroup
+	}
 	if len(g.Spec.IPBlocks) > 0 {
 		for i := range g.Spec.IPBlocks {
 			ipb, _ := toAntreaIPBlockForCRD(&g.Spec.IPBlocks[i])
@@ -165,6 +178,7 @@ func (n *NetworkPolicyController) syncInternalNamespacedGroup(grp *antreatypes.G
 	}
 	key := internalGroupKeyFunc(g)
 	selectorUpdated := n.processServiceReference(grp)
+	ipListUpdated, ipListStatus := n.syncIPList(key, grp)
 	if grp.Selector != nil {
 		n.groupingInterface.AddGroup(internalGroupType, key, grp.Selector)
 	} else {
@@ -177,6 +191,7 @@ func (n *NetworkPolicyController) syncInternalNamespacedGroup(grp *antreatypes.G
 	//   1. It does not have child groups. The group members are immediately considered
 	//      computed during syncInternalGroup, as the group selector is finalized.
 	//   2. All its child groups are created and realized.
+	//   3. Its IP list, if any, has been read successfully at least once.
 	if len(grp.ChildGroups) > 0 {
 		for _, cgName := range grp.ChildGroups {
 			internalGroup, found, _ := n.internalGroupStore.Get(k8s.NamespacedName(grp.SourceReference.Namespace, cgName))
@@ -186,16 +201,25 @@ func (n *NetworkPolicyController) syncInternalNamespacedGroup(grp *antreatypes.G
 			}
 		}
 	}
+	if ipListStatus != nil && ipListStatus.LastRefreshTime.IsZero() {
+		membersComputed = false
+	}
 	if membersComputed {
 		klog.V(4).InfoS("Updating GroupMembersComputed Status for Group", "Group", key)
-		err = n.updateGroupStatus(g, v1.ConditionTrue)
+		err = n.updateGroupStatus(g, v1.ConditionTrue, ipListStatus)
 		if err != nil {
 			klog.Errorf("Failed to update Group %s/%s GroupMembersComputed condition to %s: %v", g.Namespace, g.Name, v1.ConditionTrue, err)
 		} else {
 			membersComputedStatus = v1.ConditionTrue
 		}
+	} else if ipListStatus != nil {
+		// Report why the IP list couldn't be read.
+		err = n.updateGroupStatus(g, v1.ConditionFalse, ipListStatus)
+		if err != nil {
+			klog.ErrorS(err, "Failed to update Group IP list status", "Group", key)
+		}
 	}
-	if selectorUpdated || membersComputedStatus != originalMembersComputedStatus {
+	if selectorUpdated || ipListUpdated || membersComputedStatus != originalMembersComputedStatus {
 		// Update the internal Group object in the store with the new selector and status.
 		updatedGrp := &antreatypes.Group{
 			UID:              grp.UID,
@@ -206,6 +230,7 @@ func (n *NetworkPolicyController) syncInternalNamespacedGroup(grp *antreatypes.G
 			IPNets:           grp.IPNets,
 			ServiceReference: grp.ServiceReference,
 			ChildGroups:      grp.ChildGroups,
+			IPList:           grp.IPList,
 		}
 		klog.V(2).InfoS("Updating existing internal Group", "internalGroup", grp.SourceReference.ToGroupName())
 		n.internalGroupStore.Update(updatedGrp)
@@ -223,18 +248,23 @@ func (n *NetworkPolicyController) triggerANNPUpdates(g string) {
 }
 
 // updateGroupStatus updates the Status subresource for a Group.
-func (n *NetworkPolicyController) updateGroupStatus(g *crdv1beta1.Group, cStatus v1.ConditionStatus) error {
+func (n *NetworkPolicyController) updateGroupStatus(g *crdv1beta1.Group, cStatus v1.ConditionStatus, ipListStatus *crdv1beta1.IPListStatus) error {
 	condStatus := crdv1beta1.GroupCondition{
 		Status: cStatus,
 		Type:   crdv1beta1.GroupMembersComputed,
 	}
-	if groupMembersComputedConditionEqual(g.Status.Conditions, condStatus) {
-		// There is no change in conditions.
+	conditionsEqual := groupMembersComputedConditionEqual(g.Status.Conditions, condStatus)
+	if conditionsEqual && ipListStatusEqual(g.Status.IPList, ipListStatus) {
+		// There is no change in conditions and in the status of the IP list.
 		return nil
 	}
-	condStatus.LastTransitionTime = metav1.Now()
 	status := crdv1beta1.GroupStatus{
-		Conditions: []crdv1beta1.GroupCondition{condStatus},
+		Conditions: g.Status.Conditions,
+		IPList:     ipListStatus,
+	}
+	if !conditionsEqual {
+		condStatus.LastTransitionTime = metav1.Now()
+		status.Conditions = []crdv1beta1.GroupCondition{condStatus}
 	}
 	klog.V(4).InfoS("Updating Group status", "Group", internalGroupKeyFunc(g), "status", condStatus)
 	toUpdate := g.DeepCopy()
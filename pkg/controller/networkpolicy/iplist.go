@@ -0,0 +1,307 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+	"antrea.io/antrea/pkg/util/ip"
+)
+
+const (
+	// defaultIPListRefreshInterval is the interval at which an IP list is read again from its source
+	// when no refresh interval is specified.
+	defaultIPListRefreshInterval = 5 * time.Minute
+	// minIPListRefreshInterval is the minimum refresh interval of an IP list.
+	minIPListRefreshInterval = 10 * time.Second
+	// ipListFetchTimeout is the timeout of the requests fetching IP lists from URLs.
+	ipListFetchTimeout = 10 * time.Second
+	// maxIPListBytes is the maximum size of an IP list fetched from a URL.
+	maxIPListBytes = 16 << 20
+	// maxIPListSize is the maximum number of entries of an IP list.
+	maxIPListSize = 500000
+)
+
+// ipListState is the state of the IP list of an internal Group.
+type ipListState struct {
+	// source is the source the list is read from. The state is reset when the source changes.
+	source crdv1beta1.IPListSource
+	// size is the number of entries of the list.
+	size int
+	// ipBlocks and ipNets are the CIDRs the list is aggregated into.
+	ipBlocks []controlplane.IPBlock
+	ipNets   []*net.IPNet
+	// lastRefreshTime is the last time the list was read successfully.
+	lastRefreshTime metav1.Time
+	// lastAttemptTime is the last time the controller attempted to read the list.
+	lastAttemptTime time.Time
+	// lastError is the error of the last attempt, if it failed.
+	lastError error
+	// refreshing is true while the list is being read.
+	refreshing bool
+}
+
+// getIPListRefreshInterval returns the interval at which the given IP list must be read again.
+func getIPListRefreshInterval(source *crdv1beta1.IPListSource) time.Duration {
+	if source.RefreshInterval == nil {
+		return defaultIPListRefreshInterval
+	}
+	return source.RefreshInterval.Duration
+}
+
+// validateIPListSource validates the IP list of a ClusterGroup or a Group. groupNamespace is empty
+// for a ClusterGroup.
+func validateIPListSource(source *crdv1beta1.IPListSource, groupNamespace string) (string, bool) {
+	if (source.ConfigMap == nil) == (source.URL == "") {
+		return "exactly one of configMap and url must be set in ipList", false
+	}
+	if cm := source.ConfigMap; cm != nil {
+		if cm.Name == "" || cm.Key == "" {
+			return "name and key of the ConfigMap must be set in ipList", false
+		}
+		if groupNamespace == "" && cm.Namespace == "" {
+			return "namespace of the ConfigMap must be set in the ipList of a ClusterGroup", false
+		}
+		if groupNamespace != "" && cm.Namespace != "" && cm.Namespace != groupNamespace {
+			return fmt.Sprintf("the ConfigMap of ipList must be in the Namespace of the Group %s", groupNamespace), false
+		}
+	} else {
+		// Fetching URLs on behalf of Namespace users would let them make antrea-controller send
+		// requests to arbitrary endpoints, including internal ones.
+		if groupNamespace != "" {
+			return "url can only be set in the ipList of a ClusterGroup", false
+		}
+		u, err := url.Parse(source.URL)
+		if err != nil {
+			return fmt.Sprintf("invalid url %q in ipList: %v", source.URL, err), false
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Sprintf("invalid url %q in ipList: it must be an absolute HTTP or HTTPS URL", source.URL), false
+		}
+	}
+	if source.RefreshInterval != nil && source.RefreshInterval.Duration < minIPListRefreshInterval {
+		return fmt.Sprintf("refreshInterval of ipList must be at least %v", minIPListRefreshInterval), false
+	}
+	return "", true
+}
+
+// parseIPList parses an IP list, which contains one IP address or CIDR per line. Empty lines and
+// comments starting with '#' or ';' are ignored. It returns the number of entries of the list, and
+// the smallest list of CIDRs covering the same addresses.
+func parseIPList(content string) (int, []netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		entry := scanner.Text()
+		if i := strings.IndexAny(entry, "#;"); i >= 0 {
+			entry = entry[:i]
+		}
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		var prefix netip.Prefix
+		if strings.Contains(entry, "/") {
+			prefix, _ = netip.ParsePrefix(entry)
+		} else if addr, err := netip.ParseAddr(entry); err == nil && addr.Zone() == "" {
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		if !prefix.IsValid() {
+			// The entry isn't included in the error, as the list may come from an untrusted source
+			// and the error is reported in the status of the group.
+			return 0, nil, fmt.Errorf("invalid IP address or CIDR at line %d", lineNum)
+		}
+		if len(prefixes) == maxIPListSize {
+			return 0, nil, fmt.Errorf("the list has more than %d entries", maxIPListSize)
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, nil, err
+	}
+	size := len(prefixes)
+	return size, ip.AggregatePrefixes(prefixes), nil
+}
+
+// prefixesToIPBlocks converts a list of CIDRs to the IPBlocks and the IPNets of an internal Group.
+func prefixesToIPBlocks(prefixes []netip.Prefix) ([]controlplane.IPBlock, []*net.IPNet) {
+	ipBlocks := make([]controlplane.IPBlock, 0, len(prefixes))
+	ipNets := make([]*net.IPNet, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		addr := net.IP(prefix.Addr().AsSlice())
+		ipBlocks = append(ipBlocks, controlplane.IPBlock{
+			CIDR: controlplane.IPNet{IP: controlplane.IPAddress(addr.To16()), PrefixLength: int32(prefix.Bits())},
+		})
+		ipNets = append(ipNets, &net.IPNet{IP: addr, Mask: net.CIDRMask(prefix.Bits(), prefix.Addr().BitLen())})
+	}
+	return ipBlocks, ipNets
+}
+
+// readIPList reads the content of an IP list from its source.
+func (n *NetworkPolicyController) readIPList(source *crdv1beta1.IPListSource) (string, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), ipListFetchTimeout)
+	defer cancel()
+	if cm := source.ConfigMap; cm != nil {
+		configMap, err := n.kubeClient.CoreV1().ConfigMaps(cm.Namespace).Get(ctx, cm.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("error getting ConfigMap %s/%s: %w", cm.Namespace, cm.Name, err)
+		}
+		content, ok := configMap.Data[cm.Key]
+		if !ok {
+			return "", fmt.Errorf("key %s not found in ConfigMap %s/%s", cm.Key, cm.Namespace, cm.Name)
+		}
+		return content, nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source.URL, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := n.ipListHTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error fetching %s: %w", source.URL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error fetching %s: unexpected status code %d", source.URL, resp.StatusCode)
+	}
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxIPListBytes+1))
+	if err != nil {
+		return "", fmt.Errorf("error reading %s: %w", source.URL, err)
+	}
+	if len(content) > maxIPListBytes {
+		return "", fmt.Errorf("error reading %s: the list is larger than %d bytes", source.URL, maxIPListBytes)
+	}
+	return string(content), nil
+}
+
+// getIPListBlocks returns the IPBlocks and the IPNets of the internal Group with the given key, if
+// its IP list has already been read from the given source.
+func (n *NetworkPolicyController) getIPListBlocks(key string, source *crdv1beta1.IPListSource) ([]controlplane.IPBlock, []*net.IPNet) {
+	n.ipListMutex.RLock()
+	defer n.ipListMutex.RUnlock()
+	state, exists := n.ipLists[key]
+	if !exists || !reflect.DeepEqual(state.source, *source) {
+		return nil, nil
+	}
+	return state.ipBlocks, state.ipNets
+}
+
+// deleteIPList deletes the state of the IP list of the internal Group with the given key.
+func (n *NetworkPolicyController) deleteIPList(key string) {
+	n.ipListMutex.Lock()
+	defer n.ipListMutex.Unlock()
+	delete(n.ipLists, key)
+}
+
+// syncIPList starts reading the IP list of an internal Group from its source if it has not been
+// read yet, if its source has changed, or if its refresh interval has elapsed, and schedules the
+// next sync of the Group accordingly. The list is read asynchronously, so that slow sources don't
+// block the workers processing internal Groups, and the Group is synced again once it has been
+// read. syncIPList sets the IPBlocks and the IPNets of the Group to the last list read
+// successfully, and returns whether they have changed, along with the status of the list. A nil
+// status is returned if the Group has no IP list.
+func (n *NetworkPolicyController) syncIPList(key string, grp *antreatypes.Group) (bool, *crdv1beta1.IPListStatus) {
+	if grp.IPList == nil {
+		n.deleteIPList(key)
+		return false, nil
+	}
+	now := n.clock.Now()
+	refreshInterval := getIPListRefreshInterval(grp.IPList)
+
+	n.ipListMutex.Lock()
+	state, exists := n.ipLists[key]
+	if !exists || !reflect.DeepEqual(state.source, *grp.IPList) {
+		state = &ipListState{source: *grp.IPList.DeepCopy()}
+		n.ipLists[key] = state
+	}
+	if !state.refreshing && (state.lastAttemptTime.IsZero() || !now.Before(state.lastAttemptTime.Add(refreshInterval))) {
+		state.lastAttemptTime = now
+		state.refreshing = true
+		go n.refreshIPList(key, state)
+	}
+	nextAttemptTime := state.lastAttemptTime.Add(refreshInterval)
+	ipBlocks, ipNets := state.ipBlocks, state.ipNets
+	status := &crdv1beta1.IPListStatus{
+		Size:            int32(state.size),
+		AggregatedSize:  int32(len(state.ipBlocks)),
+		LastRefreshTime: state.lastRefreshTime,
+	}
+	if state.lastError != nil {
+		status.LastRefreshError = state.lastError.Error()
+	}
+	n.ipListMutex.Unlock()
+	n.internalGroupQueue.AddAfter(key, nextAttemptTime.Sub(now))
+
+	updated := !reflect.DeepEqual(grp.IPBlocks, ipBlocks)
+	if updated {
+		grp.IPBlocks, grp.IPNets = ipBlocks, ipNets
+	}
+	return updated, status
+}
+
+// refreshIPList reads the IP list of the internal Group with the given key from its source, and
+// updates the given state with it, unless the state has been replaced or deleted in the meantime.
+// The Group is then enqueued, so that it's synced with the new list.
+func (n *NetworkPolicyController) refreshIPList(key string, state *ipListState) {
+	size, prefixes, err := func() (int, []netip.Prefix, error) {
+		content, err := n.readIPList(&state.source)
+		if err != nil {
+			return 0, nil, err
+		}
+		return parseIPList(content)
+	}()
+
+	n.ipListMutex.Lock()
+	defer n.ipListMutex.Unlock()
+	state.refreshing = false
+	if n.ipLists[key] != state {
+		return
+	}
+	if err != nil {
+		klog.ErrorS(err, "Failed to read IP list, keeping the last list read successfully", "internalGroup", key)
+		state.lastError = err
+	} else {
+		state.size = size
+		state.ipBlocks, state.ipNets = prefixesToIPBlocks(prefixes)
+		state.lastRefreshTime = metav1.NewTime(n.clock.Now()).Rfc3339Copy()
+		state.lastError = nil
+		klog.V(2).InfoS("Read IP list", "internalGroup", key, "size", size, "aggregatedSize", len(prefixes))
+	}
+	n.internalGroupQueue.Add(key)
+}
+
+// ipListStatusEqual checks whether two statuses of IP lists are equal.
+func ipListStatusEqual(a, b *crdv1beta1.IPListStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Size == b.Size && a.AggregatedSize == b.AggregatedSize && a.LastRefreshTime.Equal(&b.LastRefreshTime) && a.LastRefreshError == b.LastRefreshError
+}
@@ -0,0 +1,326 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func TestParseIPList(t *testing.T) {
+	tests := []struct {
+		name             string
+		content          string
+		expectedSize     int
+		expectedPrefixes []string
+		expectedErr      string
+	}{
+		{
+			name:             "empty",
+			content:          "# no entry\n\n",
+			expectedPrefixes: []string{},
+		},
+		{
+			name: "addresses and CIDRs",
+			content: `# Blocklist
+10.0.0.0/24 ; SBL1
+10.0.1.0/24
+192.168.1.1
+192.168.1.1   # duplicated
+2001:db8::1
+2001:db8::/64
+`,
+			expectedSize:     6,
+			expectedPrefixes: []string{"10.0.0.0/23", "192.168.1.1/32", "2001:db8::/64"},
+		},
+		{
+			name:        "invalid entry",
+			content:     "10.0.0.0/24\n10.0.1.0/33\n",
+			expectedErr: "invalid IP address or CIDR at line 2",
+		},
+		{
+			name:        "zoned address",
+			content:     "fe80::1%eth0\n",
+			expectedErr: "invalid IP address or CIDR at line 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, prefixes, err := parseIPList(tt.content)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSize, size)
+			expectedPrefixes := make([]netip.Prefix, 0, len(tt.expectedPrefixes))
+			for _, p := range tt.expectedPrefixes {
+				expectedPrefixes = append(expectedPrefixes, netip.MustParsePrefix(p))
+			}
+			assert.Equal(t, expectedPrefixes, prefixes)
+		})
+	}
+}
+
+func TestValidateIPListSource(t *testing.T) {
+	tests := []struct {
+		name           string
+		source         *crdv1beta1.IPListSource
+		groupNamespace string
+		expectedReason string
+	}{
+		{
+			name: "valid ConfigMap for ClusterGroup",
+			source: &crdv1beta1.IPListSource{
+				ConfigMap: &crdv1beta1.IPListConfigMapSource{Namespace: "kube-system", Name: "blocklist", Key: "ips"},
+			},
+		},
+		{
+			name: "valid ConfigMap for Group",
+			source: &crdv1beta1.IPListSource{
+				ConfigMap: &crdv1beta1.IPListConfigMapSource{Name: "blocklist", Key: "ips"},
+			},
+			groupNamespace: "ns1",
+		},
+		{
+			name: "valid URL",
+			source: &crdv1beta1.IPListSource{
+				URL:             "https://example.com/drop.txt",
+				RefreshInterval: &metav1.Duration{Duration: time.Hour},
+			},
+		},
+		{
+			name: "ConfigMap and URL",
+			source: &crdv1beta1.IPListSource{
+				ConfigMap: &crdv1beta1.IPListConfigMapSource{Namespace: "kube-system", Name: "blocklist", Key: "ips"},
+				URL:       "https://example.com/drop.txt",
+			},
+			expectedReason: "exactly one of configMap and url must be set in ipList",
+		},
+		{
+			name: "ConfigMap without key",
+			source: &crdv1beta1.IPListSource{
+				ConfigMap: &crdv1beta1.IPListConfigMapSource{Namespace: "kube-system", Name: "blocklist"},
+			},
+			expectedReason: "name and key of the ConfigMap must be set in ipList",
+		},
+		{
+			name: "ConfigMap without Namespace for ClusterGroup",
+			source: &crdv1beta1.IPListSource{
+				ConfigMap: &crdv1beta1.IPListConfigMapSource{Name: "blocklist", Key: "ips"},
+			},
+			expectedReason: "namespace of the ConfigMap must be set in the ipList of a ClusterGroup",
+		},
+		{
+			name: "ConfigMap in other Namespace for Group",
+			source: &crdv1beta1.IPListSource{
+				ConfigMap: &crdv1beta1.IPListConfigMapSource{Namespace: "ns2", Name: "blocklist", Key: "ips"},
+			},
+			groupNamespace: "ns1",
+			expectedReason: "the ConfigMap of ipList must be in the Namespace of the Group ns1",
+		},
+		{
+			name:           "URL for Group",
+			source:         &crdv1beta1.IPListSource{URL: "https://example.com/drop.txt"},
+			groupNamespace: "ns1",
+			expectedReason: "url can only be set in the ipList of a ClusterGroup",
+		},
+		{
+			name:           "relative URL",
+			source:         &crdv1beta1.IPListSource{URL: "/drop.txt"},
+			expectedReason: `invalid url "/drop.txt" in ipList: it must be an absolute HTTP or HTTPS URL`,
+		},
+		{
+			name: "short refresh interval",
+			source: &crdv1beta1.IPListSource{
+				URL:             "https://example.com/drop.txt",
+				RefreshInterval: &metav1.Duration{Duration: time.Second},
+			},
+			expectedReason: "refreshInterval of ipList must be at least 10s",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, allowed := validateIPListSource(tt.source, tt.groupNamespace)
+			assert.Equal(t, tt.expectedReason, reason)
+			assert.Equal(t, tt.expectedReason == "", allowed)
+		})
+	}
+}
+
+func newIPBlock(cidr string) controlplane.IPBlock {
+	ipNet, _ := cidrStrToIPNet(cidr)
+	return controlplane.IPBlock{CIDR: *ipNet}
+}
+
+// syncInternalGroupWithIPList syncs the internal Group with the given key, waits for the IP list to be read if the
+// sync started reading it, and syncs the internal Group again with the list.
+func syncInternalGroupWithIPList(t *testing.T, npc *networkPolicyController, key string) {
+	require.NoError(t, npc.syncInternalGroup(key))
+	require.Eventually(t, func() bool {
+		npc.ipListMutex.RLock()
+		defer npc.ipListMutex.RUnlock()
+		return !npc.ipLists[key].refreshing
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, npc.syncInternalGroup(key))
+}
+
+func TestSyncInternalGroupWithIPListFromConfigMap(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "blocklist", Namespace: "kube-system"},
+		Data:       map[string]string{"ips": "10.0.0.0/24\n10.0.1.0/24\n"},
+	}
+	cg := &crdv1beta1.ClusterGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "cgA", UID: "uidA"},
+		Spec: crdv1beta1.GroupSpec{
+			IPList: &crdv1beta1.IPListSource{
+				ConfigMap:       &crdv1beta1.IPListConfigMapSource{Namespace: "kube-system", Name: "blocklist", Key: "ips"},
+				RefreshInterval: &metav1.Duration{Duration: time.Minute},
+			},
+		},
+	}
+	client, npc := newControllerWithoutEventHandler([]runtime.Object{cm}, []runtime.Object{cg})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	npc.crdInformerFactory.Start(stopCh)
+	npc.crdInformerFactory.WaitForCacheSync(stopCh)
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	fakeClock := clocktesting.NewFakeClock(now)
+	npc.clock = fakeClock
+
+	key := internalGroupKeyFunc(cg)
+	checkGroup := func(expectedIPBlocks []controlplane.IPBlock, expectedStatus *crdv1beta1.IPListStatus) {
+		obj, found, _ := npc.internalGroupStore.Get(key)
+		require.True(t, found)
+		assert.Equal(t, expectedIPBlocks, obj.(*antreatypes.Group).IPBlocks)
+		updatedCG, err := npc.crdClient.CrdV1beta1().ClusterGroups().Get(context.TODO(), cg.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, expectedStatus, updatedCG.Status.IPList)
+	}
+
+	npc.addClusterGroup(cg)
+	// The list is read asynchronously, the group members are not computed until it has been read.
+	require.NoError(t, npc.syncInternalGroup(key))
+	updatedCG, err := npc.crdClient.CrdV1beta1().ClusterGroups().Get(context.TODO(), cg.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, updatedCG.Status.Conditions, 1)
+	assert.Equal(t, corev1.ConditionFalse, updatedCG.Status.Conditions[0].Status)
+	syncInternalGroupWithIPList(t, npc, key)
+	checkGroup([]controlplane.IPBlock{newIPBlock("10.0.0.0/23")}, &crdv1beta1.IPListStatus{
+		Size:            2,
+		AggregatedSize:  1,
+		LastRefreshTime: metav1.NewTime(now),
+	})
+
+	// The list is not read again before the refresh interval elapses.
+	cm.Data["ips"] = "10.0.0.0/24\n10.0.2.0/24\n192.168.0.1\n"
+	_, err = client.CoreV1().ConfigMaps(cm.Namespace).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	fakeClock.Step(30 * time.Second)
+	syncInternalGroupWithIPList(t, npc, key)
+	checkGroup([]controlplane.IPBlock{newIPBlock("10.0.0.0/23")}, &crdv1beta1.IPListStatus{
+		Size:            2,
+		AggregatedSize:  1,
+		LastRefreshTime: metav1.NewTime(now),
+	})
+
+	fakeClock.Step(30 * time.Second)
+	syncInternalGroupWithIPList(t, npc, key)
+	checkGroup([]controlplane.IPBlock{newIPBlock("10.0.0.0/24"), newIPBlock("10.0.2.0/24"), newIPBlock("192.168.0.1/32")}, &crdv1beta1.IPListStatus{
+		Size:            3,
+		AggregatedSize:  3,
+		LastRefreshTime: metav1.NewTime(now.Add(time.Minute)),
+	})
+
+	// The last list read successfully is kept when the list cannot be read.
+	require.NoError(t, client.CoreV1().ConfigMaps(cm.Namespace).Delete(context.TODO(), cm.Name, metav1.DeleteOptions{}))
+	fakeClock.Step(time.Minute)
+	syncInternalGroupWithIPList(t, npc, key)
+	checkGroup([]controlplane.IPBlock{newIPBlock("10.0.0.0/24"), newIPBlock("10.0.2.0/24"), newIPBlock("192.168.0.1/32")}, &crdv1beta1.IPListStatus{
+		Size:             3,
+		AggregatedSize:   3,
+		LastRefreshTime:  metav1.NewTime(now.Add(time.Minute)),
+		LastRefreshError: `error getting ConfigMap kube-system/blocklist: configmaps "blocklist" not found`,
+	})
+}
+
+func TestSyncInternalGroupWithIPListFromURL(t *testing.T) {
+	statusCode := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+		fmt.Fprint(w, "# Blocklist\n10.10.0.0/16\n10.20.0.1\n")
+	}))
+	defer server.Close()
+	cg := &crdv1beta1.ClusterGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "cgA", UID: "uidA"},
+		Spec: crdv1beta1.GroupSpec{
+			IPList: &crdv1beta1.IPListSource{URL: server.URL},
+		},
+	}
+	_, npc := newController(nil, []runtime.Object{cg})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	npc.crdInformerFactory.Start(stopCh)
+	npc.crdInformerFactory.WaitForCacheSync(stopCh)
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	fakeClock := clocktesting.NewFakeClock(now)
+	npc.clock = fakeClock
+
+	key := internalGroupKeyFunc(cg)
+	statusCode = http.StatusServiceUnavailable
+	npc.addClusterGroup(cg)
+	syncInternalGroupWithIPList(t, npc, key)
+	obj, found, _ := npc.internalGroupStore.Get(key)
+	require.True(t, found)
+	assert.Empty(t, obj.(*antreatypes.Group).IPBlocks)
+	updatedCG, err := npc.crdClient.CrdV1beta1().ClusterGroups().Get(context.TODO(), cg.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, corev1.ConditionFalse, updatedCG.Status.Conditions[0].Status)
+	// The response isn't included in the error.
+	assert.Equal(t, fmt.Sprintf("error fetching %s: unexpected status code 503", server.URL), updatedCG.Status.IPList.LastRefreshError)
+
+	// The list is read again at the next sync after the default refresh interval.
+	statusCode = http.StatusOK
+	fakeClock.Step(defaultIPListRefreshInterval)
+	syncInternalGroupWithIPList(t, npc, key)
+	obj, found, _ = npc.internalGroupStore.Get(key)
+	require.True(t, found)
+	assert.Equal(t, []controlplane.IPBlock{newIPBlock("10.10.0.0/16"), newIPBlock("10.20.0.1/32")}, obj.(*antreatypes.Group).IPBlocks)
+	assert.Equal(t, corev1.ConditionTrue, obj.(*antreatypes.Group).MembersComputed)
+	updatedCG, err = npc.crdClient.CrdV1beta1().ClusterGroups().Get(context.TODO(), cg.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, corev1.ConditionTrue, updatedCG.Status.Conditions[0].Status)
+	assert.Equal(t, &crdv1beta1.IPListStatus{
+		Size:            2,
+		AggregatedSize:  2,
+		LastRefreshTime: metav1.NewTime(now.Add(defaultIPListRefreshInterval)),
+	}, updatedCG.Status.IPList)
+}
@@ -0,0 +1,189 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+const (
+	// NamespaceProfileAnnotationKey can be added to a Namespace to apply a policy profile to it.
+	NamespaceProfileAnnotationKey = "policy.antrea.io/profile"
+	// NamespaceProfileAllowAnnotationKey can be added to a Namespace to configure the traffic allowed by its policy
+	// profile, as a comma-separated list of exceptions. The DNS exception is used when it's not set.
+	NamespaceProfileAllowAnnotationKey = "policy.antrea.io/profile-allow"
+
+	// NamespaceProfileDefaultDeny is the profile which drops all the traffic of the Pods in the Namespace, except
+	// the traffic allowed by its exceptions.
+	NamespaceProfileDefaultDeny = "default-deny"
+
+	// NamespaceProfileAllowDNS allows the Pods in the Namespace to send DNS queries to any destination.
+	NamespaceProfileAllowDNS = "dns"
+	// NamespaceProfileAllowSameNamespace allows the Pods in the Namespace to communicate with each other.
+	NamespaceProfileAllowSameNamespace = "same-namespace"
+	// namespaceProfileAllowNone disables all the exceptions.
+	namespaceProfileAllowNone = "none"
+)
+
+var (
+	// The policies generated for Namespace profiles are enforced in the same Tier as the BaselineAdminNetworkPolicy,
+	// after it, so that they have the lowest precedence of all policies.
+	namespaceProfileTierPriority = banpTierPriority
+	namespaceProfilePriority     = float64(2)
+
+	namespaceProfileDefaultExceptions = sets.New[string](NamespaceProfileAllowDNS)
+	namespaceProfileValidExceptions   = sets.New[string](NamespaceProfileAllowDNS, NamespaceProfileAllowSameNamespace)
+
+	dnsPort = intstr.FromInt32(53)
+)
+
+// getNamespaceProfileReference returns the reference of the policy generated for the profile of a Namespace. The UID of
+// the Namespace is used as the UID of the policy, so that a Namespace re-created with the same name gets a new policy.
+func getNamespaceProfileReference(namespace *v1.Namespace) *controlplane.NetworkPolicyReference {
+	return &controlplane.NetworkPolicyReference{
+		Type:      controlplane.NamespaceProfile,
+		Namespace: namespace.Name,
+		Name:      NamespaceProfileDefaultDeny,
+		UID:       namespace.UID,
+	}
+}
+
+// hasNamespaceProfile returns whether a policy must be generated for the profile of a Namespace.
+func hasNamespaceProfile(namespace *v1.Namespace) bool {
+	return namespace.Annotations[NamespaceProfileAnnotationKey] == NamespaceProfileDefaultDeny
+}
+
+// namespaceProfileUpdated returns whether the annotations defining the profile of a Namespace have changed.
+func namespaceProfileUpdated(oldNamespace, curNamespace *v1.Namespace) bool {
+	return oldNamespace.Annotations[NamespaceProfileAnnotationKey] != curNamespace.Annotations[NamespaceProfileAnnotationKey] ||
+		oldNamespace.Annotations[NamespaceProfileAllowAnnotationKey] != curNamespace.Annotations[NamespaceProfileAllowAnnotationKey]
+}
+
+// enqueueNamespaceProfile enqueues the policy generated for the profile of a Namespace if the Namespace has or had a
+// profile.
+func (n *NetworkPolicyController) enqueueNamespaceProfile(namespace *v1.Namespace) {
+	profile, exists := namespace.Annotations[NamespaceProfileAnnotationKey]
+	if !exists {
+		return
+	}
+	if profile != NamespaceProfileDefaultDeny {
+		klog.InfoS("Ignoring unknown Namespace profile", "namespace", namespace.Name, "profile", profile)
+	}
+	n.enqueueInternalNetworkPolicy(getNamespaceProfileReference(namespace))
+}
+
+// getNamespaceProfileExceptions returns the exceptions of the profile of a Namespace. Unknown exceptions are ignored.
+func getNamespaceProfileExceptions(namespace *v1.Namespace) sets.Set[string] {
+	value, exists := namespace.Annotations[NamespaceProfileAllowAnnotationKey]
+	if !exists {
+		return namespaceProfileDefaultExceptions.Clone()
+	}
+	exceptions := sets.New[string]()
+	for _, exception := range strings.Split(value, ",") {
+		exception = strings.TrimSpace(exception)
+		if exception == "" || exception == namespaceProfileAllowNone {
+			continue
+		}
+		if !namespaceProfileValidExceptions.Has(exception) {
+			klog.InfoS("Ignoring unknown exception of Namespace profile", "namespace", namespace.Name, "exception", exception)
+			continue
+		}
+		exceptions.Insert(exception)
+	}
+	return exceptions
+}
+
+// processNamespaceProfile generates the internal NetworkPolicy enforcing the profile of a Namespace. It's applied to all
+// the Pods in the Namespace. Its allow rules implement the exceptions of the profile, and are followed by rules dropping
+// all the other ingress and egress traffic.
+func (n *NetworkPolicyController) processNamespaceProfile(namespace *v1.Namespace) (*antreatypes.NetworkPolicy, map[string]*antreatypes.AppliedToGroup, map[string]*antreatypes.AddressGroup) {
+	exceptions := getNamespaceProfileExceptions(namespace)
+	appliedToGroup := n.createAppliedToGroup(namespace.Name, &metav1.LabelSelector{}, nil, nil, nil)
+	appliedToGroups := map[string]*antreatypes.AppliedToGroup{appliedToGroup.Name: appliedToGroup}
+	addressGroups := map[string]*antreatypes.AddressGroup{}
+	allowAction := crdv1beta1.RuleActionAllow
+	dropAction := crdv1beta1.RuleActionDrop
+	var ingressRules, egressRules []controlplane.NetworkPolicyRule
+
+	if exceptions.Has(NamespaceProfileAllowDNS) {
+		protocolUDP, protocolTCP := controlplane.ProtocolUDP, controlplane.ProtocolTCP
+		egressRules = append(egressRules, controlplane.NetworkPolicyRule{
+			Direction: controlplane.DirectionOut,
+			To:        matchAllPeer,
+			Services: []controlplane.Service{
+				{Protocol: &protocolUDP, Port: &dnsPort},
+				{Protocol: &protocolTCP, Port: &dnsPort},
+			},
+			Name:   "allow-dns",
+			Action: &allowAction,
+		})
+	}
+	if exceptions.Has(NamespaceProfileAllowSameNamespace) {
+		addressGroup := n.createAddressGroup(namespace.Name, &metav1.LabelSelector{}, nil, nil, nil)
+		addressGroups[addressGroup.Name] = addressGroup
+		ingressRules = append(ingressRules, controlplane.NetworkPolicyRule{
+			Direction: controlplane.DirectionIn,
+			From:      controlplane.NetworkPolicyPeer{AddressGroups: []string{addressGroup.Name}},
+			Name:      "allow-same-namespace-ingress",
+			Action:    &allowAction,
+		})
+		egressRules = append(egressRules, controlplane.NetworkPolicyRule{
+			Direction: controlplane.DirectionOut,
+			To:        controlplane.NetworkPolicyPeer{AddressGroups: []string{addressGroup.Name}},
+			Name:      "allow-same-namespace-egress",
+			Action:    &allowAction,
+		})
+	}
+	ingressRules = append(ingressRules, controlplane.NetworkPolicyRule{
+		Direction: controlplane.DirectionIn,
+		From:      matchAllPeer,
+		Name:      "default-deny-ingress",
+		Action:    &dropAction,
+	})
+	egressRules = append(egressRules, controlplane.NetworkPolicyRule{
+		Direction: controlplane.DirectionOut,
+		To:        matchAllPeer,
+		Name:      "default-deny-egress",
+		Action:    &dropAction,
+	})
+
+	var rules []controlplane.NetworkPolicyRule
+	for _, directionRules := range [][]controlplane.NetworkPolicyRule{ingressRules, egressRules} {
+		for idx := range directionRules {
+			directionRules[idx].Priority = int32(idx)
+			rules = append(rules, directionRules[idx])
+		}
+	}
+	internalNetworkPolicy := &antreatypes.NetworkPolicy{
+		Name:            internalNetworkPolicyKeyFunc(namespace),
+		SourceRef:       getNamespaceProfileReference(namespace),
+		UID:             namespace.UID,
+		AppliedToGroups: sets.List(sets.KeySet(appliedToGroups)),
+		Rules:           rules,
+		Priority:        &namespaceProfilePriority,
+		TierPriority:    &namespaceProfileTierPriority,
+	}
+	return internalNetworkPolicy, appliedToGroups, addressGroups
+}
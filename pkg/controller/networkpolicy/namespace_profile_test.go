@@ -0,0 +1,253 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func newNamespaceWithProfile(annotations map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "nsA", UID: "uidNsA", Annotations: annotations},
+	}
+}
+
+func TestGetNamespaceProfileExceptions(t *testing.T) {
+	tests := []struct {
+		name               string
+		annotations        map[string]string
+		expectedExceptions sets.Set[string]
+	}{
+		{
+			name:               "default exceptions",
+			annotations:        map[string]string{NamespaceProfileAnnotationKey: NamespaceProfileDefaultDeny},
+			expectedExceptions: sets.New[string](NamespaceProfileAllowDNS),
+		},
+		{
+			name: "all exceptions",
+			annotations: map[string]string{
+				NamespaceProfileAnnotationKey:      NamespaceProfileDefaultDeny,
+				NamespaceProfileAllowAnnotationKey: "same-namespace, dns",
+			},
+			expectedExceptions: sets.New[string](NamespaceProfileAllowDNS, NamespaceProfileAllowSameNamespace),
+		},
+		{
+			name: "no exception",
+			annotations: map[string]string{
+				NamespaceProfileAnnotationKey:      NamespaceProfileDefaultDeny,
+				NamespaceProfileAllowAnnotationKey: "none",
+			},
+			expectedExceptions: sets.New[string](),
+		},
+		{
+			name: "empty exceptions",
+			annotations: map[string]string{
+				NamespaceProfileAnnotationKey:      NamespaceProfileDefaultDeny,
+				NamespaceProfileAllowAnnotationKey: "",
+			},
+			expectedExceptions: sets.New[string](),
+		},
+		{
+			name: "unknown exception",
+			annotations: map[string]string{
+				NamespaceProfileAnnotationKey:      NamespaceProfileDefaultDeny,
+				NamespaceProfileAllowAnnotationKey: "same-namespace,ntp",
+			},
+			expectedExceptions: sets.New[string](NamespaceProfileAllowSameNamespace),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedExceptions, getNamespaceProfileExceptions(newNamespaceWithProfile(tt.annotations)))
+		})
+	}
+}
+
+func TestProcessNamespaceProfile(t *testing.T) {
+	protocolUDP := controlplane.ProtocolUDP
+	allowAction := crdv1beta1.RuleActionAllow
+	dropAction := crdv1beta1.RuleActionDrop
+	nsSelector := &metav1.LabelSelector{}
+	nsGroup := getNormalizedUID(antreatypes.NewGroupSelector("nsA", nsSelector, nil, nil, nil).NormalizedName)
+	allowDNSRule := controlplane.NetworkPolicyRule{
+		Direction: controlplane.DirectionOut,
+		To:        matchAllPeer,
+		Services: []controlplane.Service{
+			{Protocol: &protocolUDP, Port: &dnsPort},
+			{Protocol: &protocolTCP, Port: &dnsPort},
+		},
+		Name:   "allow-dns",
+		Action: &allowAction,
+	}
+	tests := []struct {
+		name                  string
+		annotations           map[string]string
+		expectedRules         []controlplane.NetworkPolicyRule
+		expectedAddressGroups int
+	}{
+		{
+			name:        "default exceptions",
+			annotations: map[string]string{NamespaceProfileAnnotationKey: NamespaceProfileDefaultDeny},
+			expectedRules: []controlplane.NetworkPolicyRule{
+				{Direction: controlplane.DirectionIn, From: matchAllPeer, Name: "default-deny-ingress", Priority: 0, Action: &dropAction},
+				allowDNSRule,
+				{Direction: controlplane.DirectionOut, To: matchAllPeer, Name: "default-deny-egress", Priority: 1, Action: &dropAction},
+			},
+		},
+		{
+			name: "all exceptions",
+			annotations: map[string]string{
+				NamespaceProfileAnnotationKey:      NamespaceProfileDefaultDeny,
+				NamespaceProfileAllowAnnotationKey: "dns,same-namespace",
+			},
+			expectedRules: []controlplane.NetworkPolicyRule{
+				{
+					Direction: controlplane.DirectionIn,
+					From:      controlplane.NetworkPolicyPeer{AddressGroups: []string{nsGroup}},
+					Name:      "allow-same-namespace-ingress",
+					Priority:  0,
+					Action:    &allowAction,
+				},
+				{Direction: controlplane.DirectionIn, From: matchAllPeer, Name: "default-deny-ingress", Priority: 1, Action: &dropAction},
+				allowDNSRule,
+				{
+					Direction: controlplane.DirectionOut,
+					To:        controlplane.NetworkPolicyPeer{AddressGroups: []string{nsGroup}},
+					Name:      "allow-same-namespace-egress",
+					Priority:  1,
+					Action:    &allowAction,
+				},
+				{Direction: controlplane.DirectionOut, To: matchAllPeer, Name: "default-deny-egress", Priority: 2, Action: &dropAction},
+			},
+			expectedAddressGroups: 1,
+		},
+		{
+			name: "no exception",
+			annotations: map[string]string{
+				NamespaceProfileAnnotationKey:      NamespaceProfileDefaultDeny,
+				NamespaceProfileAllowAnnotationKey: "none",
+			},
+			expectedRules: []controlplane.NetworkPolicyRule{
+				{Direction: controlplane.DirectionIn, From: matchAllPeer, Name: "default-deny-ingress", Priority: 0, Action: &dropAction},
+				{Direction: controlplane.DirectionOut, To: matchAllPeer, Name: "default-deny-egress", Priority: 0, Action: &dropAction},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, c := newController(nil, nil)
+			actualPolicy, actualAppliedToGroups, actualAddressGroups := c.processNamespaceProfile(newNamespaceWithProfile(tt.annotations))
+			assert.Equal(t, "uidNsA", actualPolicy.Name)
+			assert.Equal(t, &controlplane.NetworkPolicyReference{
+				Type:      controlplane.NamespaceProfile,
+				Namespace: "nsA",
+				Name:      NamespaceProfileDefaultDeny,
+				UID:       "uidNsA",
+			}, actualPolicy.SourceRef)
+			assert.Equal(t, &namespaceProfilePriority, actualPolicy.Priority)
+			assert.Equal(t, &namespaceProfileTierPriority, actualPolicy.TierPriority)
+			assert.Equal(t, tt.expectedRules, actualPolicy.Rules)
+			assert.Equal(t, []string{nsGroup}, actualPolicy.AppliedToGroups)
+			assert.Len(t, actualAppliedToGroups, 1)
+			assert.Len(t, actualAddressGroups, tt.expectedAddressGroups)
+		})
+	}
+}
+
+func TestSyncInternalNetworkPolicyForNamespaceProfile(t *testing.T) {
+	_, c := newController(nil, nil)
+	namespace := newNamespaceWithProfile(map[string]string{NamespaceProfileAnnotationKey: NamespaceProfileDefaultDeny})
+	c.namespaceStore.Add(namespace)
+	ref := getNamespaceProfileReference(namespace)
+	require.NoError(t, c.syncInternalNetworkPolicy(ref))
+	obj, exists, _ := c.internalNetworkPolicyStore.Get("uidNsA")
+	require.True(t, exists)
+	assert.Len(t, obj.(*antreatypes.NetworkPolicy).Rules, 3)
+
+	// Updating the exceptions should update the internal NetworkPolicy.
+	updatedNamespace := namespace.DeepCopy()
+	updatedNamespace.Annotations[NamespaceProfileAllowAnnotationKey] = "none"
+	c.namespaceStore.Update(updatedNamespace)
+	require.NoError(t, c.syncInternalNetworkPolicy(ref))
+	obj, exists, _ = c.internalNetworkPolicyStore.Get("uidNsA")
+	require.True(t, exists)
+	assert.Len(t, obj.(*antreatypes.NetworkPolicy).Rules, 2)
+
+	// Removing the profile should delete the internal NetworkPolicy.
+	updatedNamespace = updatedNamespace.DeepCopy()
+	delete(updatedNamespace.Annotations, NamespaceProfileAnnotationKey)
+	c.namespaceStore.Update(updatedNamespace)
+	require.NoError(t, c.syncInternalNetworkPolicy(ref))
+	_, exists, _ = c.internalNetworkPolicyStore.Get("uidNsA")
+	assert.False(t, exists)
+}
+
+func TestUpdateNamespaceWithProfile(t *testing.T) {
+	namespace := newNamespaceWithProfile(nil)
+	tests := []struct {
+		name           string
+		oldAnnotations map[string]string
+		curAnnotations map[string]string
+		expectEnqueued bool
+	}{
+		{
+			name:           "profile added",
+			curAnnotations: map[string]string{NamespaceProfileAnnotationKey: NamespaceProfileDefaultDeny},
+			expectEnqueued: true,
+		},
+		{
+			name:           "profile removed",
+			oldAnnotations: map[string]string{NamespaceProfileAnnotationKey: NamespaceProfileDefaultDeny},
+			expectEnqueued: true,
+		},
+		{
+			name:           "exceptions updated",
+			oldAnnotations: map[string]string{NamespaceProfileAnnotationKey: NamespaceProfileDefaultDeny},
+			curAnnotations: map[string]string{NamespaceProfileAnnotationKey: NamespaceProfileDefaultDeny, NamespaceProfileAllowAnnotationKey: "none"},
+			expectEnqueued: true,
+		},
+		{
+			name:           "other annotation updated",
+			oldAnnotations: map[string]string{NamespaceProfileAnnotationKey: NamespaceProfileDefaultDeny},
+			curAnnotations: map[string]string{NamespaceProfileAnnotationKey: NamespaceProfileDefaultDeny, "foo": "bar"},
+			expectEnqueued: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, c := newControllerWithoutEventHandler(nil, nil)
+			oldNamespace, curNamespace := namespace.DeepCopy(), namespace.DeepCopy()
+			oldNamespace.Annotations, curNamespace.Annotations = tt.oldAnnotations, tt.curAnnotations
+			c.updateNamespace(oldNamespace, curNamespace)
+			if tt.expectEnqueued {
+				require.Equal(t, 1, c.internalNetworkPolicyQueue.Len())
+				item, _ := c.internalNetworkPolicyQueue.Get()
+				assert.Equal(t, *getNamespaceProfileReference(namespace), item)
+			} else {
+				assert.Equal(t, 0, c.internalNetworkPolicyQueue.Len())
+			}
+		})
+	}
+}
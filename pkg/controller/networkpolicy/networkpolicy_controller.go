@@ -20,6 +20,7 @@ package networkpolicy
 import (
 	"fmt"
 	"net"
+	"net/http"
 	"reflect"
 	"strconv"
 	"strings"
@@ -38,11 +39,14 @@ import (
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	networkinginformers "k8s.io/client-go/informers/networking/v1"
 	clientset "k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	networkinglisters "k8s.io/client-go/listers/networking/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 	policyinformers "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions/apis/v1alpha1"
 	policylisters "sigs.k8s.io/network-policy-api/pkg/client/listers/apis/v1alpha1"
 
@@ -51,6 +55,7 @@ import (
 	secv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/apiserver/storage"
 	"antrea.io/antrea/pkg/client/clientset/versioned"
+	"antrea.io/antrea/pkg/client/clientset/versioned/scheme"
 	crdv1b1informers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1beta1"
 	crdv1b1listers "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
 	"antrea.io/antrea/pkg/controller/grouping"
@@ -92,6 +97,9 @@ const (
 	appliedToGroupType grouping.GroupType = "appliedToGroup"
 	addressGroupType   grouping.GroupType = "addressGroup"
 	internalGroupType  grouping.GroupType = "internalGroup"
+	// serviceGroupType is used for the Service selectors in toServices of Antrea-native policies. The groups are
+	// named after the normalized name of the selectors.
+	serviceGroupType grouping.GroupType = "serviceGroup"
 
 	perNamespaceRuleIndex      = "hasPerNamespaceRule"
 	namespaceRuleLabelKeyIndex = "namespaceRuleLabelKeys"
@@ -254,6 +262,22 @@ type NetworkPolicyController struct {
 	// Added as a member to the struct to allow injection for testing.
 	groupingInterfaceSynced func() bool
 
+	// serviceSelectorMutex protects policyServiceSelectors and serviceSelectorPolicies.
+	serviceSelectorMutex sync.Mutex
+	// policyServiceSelectors maps the key of an internal NetworkPolicy to the normalized names of the Service
+	// selectors used in its toServices peers.
+	policyServiceSelectors map[string]sets.Set[string]
+	// serviceSelectorPolicies maps the normalized name of a Service selector to the keys of the internal
+	// NetworkPolicies using it.
+	serviceSelectorPolicies map[string]sets.Set[string]
+
+	// ipListMutex protects ipLists and the states stored in it, which are updated when the lists are read.
+	ipListMutex sync.RWMutex
+	// ipLists maps the key of an internal Group defined with an IP list to the state of the list.
+	ipLists map[string]*ipListState
+	// ipListHTTPClient is used to fetch the IP lists sourced from URLs.
+	ipListHTTPClient *http.Client
+
 	labelIdentityInterface labelidentity.Interface
 	// Enable Stretched Networkpolicy feature which allows Antrea-native policies to select peer
 	// from other clusters in a ClusterSet.
@@ -261,6 +285,12 @@ type NetworkPolicyController struct {
 	// heartbeatCh is an internal channel for testing. It's used to know whether all tasks have been
 	// processed, and to count executions of each function.
 	heartbeatCh chan heartbeat
+	// clock is used to evaluate the schedules of rules and the expiry of policies. It can be overridden for testing.
+	clock clock.Clock
+
+	eventBroadcaster record.EventBroadcaster
+	// eventRecorder is used to record the Events of Antrea-native policies, e.g. when they expire.
+	eventRecorder record.EventRecorder
 }
 
 type heartbeat struct {
@@ -415,6 +445,8 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 	internalNetworkPolicyStore storage.Interface,
 	internalGroupStore storage.Interface,
 	stretchedNPEnabled bool) *NetworkPolicyController {
+	eventBroadcaster := record.NewBroadcaster()
+	eventRecorder := eventBroadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: controllerName})
 	n := &NetworkPolicyController{
 		kubeClient:                     kubeClient,
 		crdClient:                      crdClient,
@@ -457,13 +489,21 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 		),
 		groupingInterface:       groupingInterface,
 		groupingInterfaceSynced: groupingInterface.HasSynced,
+		policyServiceSelectors:  map[string]sets.Set[string]{},
+		serviceSelectorPolicies: map[string]sets.Set[string]{},
+		ipLists:                 map[string]*ipListState{},
+		ipListHTTPClient:        &http.Client{Timeout: ipListFetchTimeout},
 		labelIdentityInterface:  labelIdentityInterface,
 		stretchNPEnabled:        stretchedNPEnabled,
 		appliedToGroupNotifier:  newNotifier(),
+		clock:                   clock.RealClock{},
+		eventBroadcaster:        eventBroadcaster,
+		eventRecorder:           eventRecorder,
 	}
 	n.groupingInterface.AddEventHandler(appliedToGroupType, n.enqueueAppliedToGroup)
 	n.groupingInterface.AddEventHandler(addressGroupType, n.enqueueAddressGroup)
 	n.groupingInterface.AddEventHandler(internalGroupType, n.enqueueInternalGroup)
+	n.groupingInterface.AddEventHandler(serviceGroupType, n.triggerPolicyResyncForServiceSelector)
 	n.labelIdentityInterface.AddEventHandler(n.triggerPolicyResyncForLabelIdentityUpdates)
 	// Add handlers for NetworkPolicy events.
 	n.namespaceInformer = namespaceInformer
@@ -964,6 +1004,12 @@ func (n *NetworkPolicyController) Run(stopCh <-chan struct{}) {
 	klog.Infof("Starting %s", controllerName)
 	defer klog.Infof("Shutting down %s", controllerName)
 
+	n.eventBroadcaster.StartStructuredLogging(0)
+	n.eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+		Interface: n.kubeClient.CoreV1().Events(""),
+	})
+	defer n.eventBroadcaster.Shutdown()
+
 	cacheSyncs := []cache.InformerSynced{n.networkPolicyListerSynced, n.groupingInterfaceSynced}
 	// Only wait for acnpListerSynced and annpListerSynced when AntreaPolicy feature gate is enabled.
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
@@ -1509,6 +1555,10 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key *controlplane.Ne
 	var newInternalNetworkPolicy *antreatypes.NetworkPolicy
 	var newAppliedToGroups map[string]*antreatypes.AppliedToGroup
 	var newAddressGroups map[string]*antreatypes.AddressGroup
+	// handleExpiry is set for expired Antrea-native policies, it's called once the internal NetworkPolicy has
+	// been updated, so that the policy stops being enforced even if it can't be deleted. wasExpired indicates
+	// whether the previous internal NetworkPolicy had already expired.
+	var handleExpiry func(wasExpired bool) error
 
 	switch key.Type {
 	case controlplane.AntreaClusterNetworkPolicy:
@@ -1521,6 +1571,11 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key *controlplane.Ne
 			return nil
 		}
 		newInternalNetworkPolicy, newAppliedToGroups, newAddressGroups = n.processClusterNetworkPolicy(acnp)
+		if newInternalNetworkPolicy.Expired {
+			handleExpiry = func(wasExpired bool) error {
+				return n.handleExpiredPolicy(acnp, newInternalNetworkPolicy, acnp.Spec.Expiry, wasExpired || hasExpiredCondition(&acnp.Status))
+			}
+		}
 	case controlplane.AntreaNetworkPolicy:
 		annp, err := n.annpLister.NetworkPolicies(key.Namespace).Get(key.Name)
 		if err != nil || annp.UID != key.UID {
@@ -1528,6 +1583,11 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key *controlplane.Ne
 			return nil
 		}
 		newInternalNetworkPolicy, newAppliedToGroups, newAddressGroups = n.processAntreaNetworkPolicy(annp)
+		if newInternalNetworkPolicy.Expired {
+			handleExpiry = func(wasExpired bool) error {
+				return n.handleExpiredPolicy(annp, newInternalNetworkPolicy, annp.Spec.Expiry, wasExpired || hasExpiredCondition(&annp.Status))
+			}
+		}
 	case controlplane.K8sNetworkPolicy:
 		knp, err := n.networkPolicyLister.NetworkPolicies(key.Namespace).Get(key.Name)
 		if err != nil || knp.UID != key.UID {
@@ -1549,6 +1609,21 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key *controlplane.Ne
 			return nil
 		}
 		newInternalNetworkPolicy, newAppliedToGroups, newAddressGroups = n.processBaselineAdminNetworkPolicy(banp)
+	case controlplane.NamespaceProfile:
+		namespace, err := n.namespaceLister.Get(key.Namespace)
+		if err != nil || namespace.UID != key.UID || !hasNamespaceProfile(namespace) {
+			n.deleteInternalNetworkPolicy(internalNetworkPolicyName)
+			return nil
+		}
+		newInternalNetworkPolicy, newAppliedToGroups, newAddressGroups = n.processNamespaceProfile(namespace)
+	}
+	// Sync the NetworkPolicy again when any of its scheduled rules needs to be activated or deactivated.
+	if !newInternalNetworkPolicy.NextScheduleTransition.IsZero() {
+		n.internalNetworkPolicyQueue.AddAfter(*key, newInternalNetworkPolicy.NextScheduleTransition.Sub(n.clock.Now()))
+	}
+	// Sync the NetworkPolicy again when it expires.
+	if !newInternalNetworkPolicy.Expired && !newInternalNetworkPolicy.ExpirationTime.IsZero() {
+		n.internalNetworkPolicyQueue.AddAfter(*key, newInternalNetworkPolicy.ExpirationTime.Sub(n.clock.Now()))
 	}
 
 	// The NetworkPolicy must subscribe to the updates of AppliedToGroups before calculating span based on them,
@@ -1675,6 +1750,9 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key *controlplane.Ne
 			n.appliedToGroupNotifier.unsubscribe(name, internalNetworkPolicyName)
 		}
 	}
+	if handleExpiry != nil {
+		return handleExpiry(oldInternalPolicyExists && oldInternalNetworkPolicy.Expired)
+	}
 	return nil
 }
 
@@ -1699,6 +1777,7 @@ func (n *NetworkPolicyController) deleteInternalNetworkPolicy(name string) {
 	if n.stretchNPEnabled && internalNetworkPolicy.SourceRef.Type != controlplane.K8sNetworkPolicy {
 		n.labelIdentityInterface.DeletePolicySelectors(internalNetworkPolicy.Name)
 	}
+	n.removeStaleServiceSelectors(nil, internalNetworkPolicy.Name)
 	// Enqueue AddressGroups previously used by this NetworkPolicy as their span may change due to the removal.
 	for agName := range internalNetworkPolicy.GetAddressGroups() {
 		n.enqueueAddressGroup(agName)
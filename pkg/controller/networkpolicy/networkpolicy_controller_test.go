@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"reflect"
 	"sync"
 	"testing"
@@ -28,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -40,7 +42,10 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	fakepolicyversioned "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned/fake"
 	policyv1a1informers "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions"
@@ -125,7 +130,8 @@ func newController(k8sObjects, crdObjects []runtime.Object) (*fake.Clientset, *n
 	groupingController := grouping.NewGroupEntityController(groupEntityIndex,
 		informerFactory.Core().V1().Pods(),
 		informerFactory.Core().V1().Namespaces(),
-		crdInformerFactory.Crd().V1alpha2().ExternalEntities())
+		crdInformerFactory.Crd().V1alpha2().ExternalEntities(),
+		informerFactory.Core().V1().Services())
 	labelIndex := labelidentity.NewLabelIdentityIndex()
 	labelIdentityController := labelidentity.NewLabelIdentityController(
 		labelIndex,
@@ -253,8 +259,14 @@ func newControllerWithoutEventHandler(k8sObjects, crdObjects []runtime.Object) (
 				Name: "internalGroup",
 			},
 		),
-		groupingInterface:      groupEntityIndex,
-		appliedToGroupNotifier: newNotifier(),
+		groupingInterface:       groupEntityIndex,
+		policyServiceSelectors:  map[string]sets.Set[string]{},
+		serviceSelectorPolicies: map[string]sets.Set[string]{},
+		ipLists:                 map[string]*ipListState{},
+		ipListHTTPClient:        &http.Client{Timeout: ipListFetchTimeout},
+		appliedToGroupNotifier:  newNotifier(),
+		clock:                   clock.RealClock{},
+		eventRecorder:           record.NewFakeRecorder(100),
 	}
 	npController.tierInformer.Informer().AddIndexers(tierIndexers)
 	npController.acnpInformer.Informer().AddIndexers(acnpIndexers)
@@ -3505,6 +3517,107 @@ func TestSyncInternalNetworkPolicyWithSameName(t *testing.T) {
 	checkGroupItemExistence(t, c.appliedToGroupStore)
 }
 
+func TestSyncInternalNetworkPolicyWithExpiry(t *testing.T) {
+	createdAt := time.Date(2025, 3, 5, 10, 0, 0, 0, time.UTC)
+	acnp := &v1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "quarantine", UID: "uidA", CreationTimestamp: metav1.Time{Time: createdAt}},
+		Spec: v1beta1.ClusterNetworkPolicySpec{
+			AppliedTo: []v1beta1.AppliedTo{{PodSelector: &selectorA}},
+			Priority:  1,
+			Ingress: []v1beta1.Rule{
+				{
+					Action: ptr.To(v1beta1.RuleActionDrop),
+					From:   []v1beta1.NetworkPolicyPeer{{PodSelector: &selectorB}},
+				},
+			},
+			Expiry: &v1beta1.PolicyExpiry{
+				TTL:            &metav1.Duration{Duration: time.Hour},
+				DeleteOnExpiry: true,
+			},
+		},
+	}
+	_, c := newController(nil, []runtime.Object{acnp})
+	fakeClock := clocktesting.NewFakeClock(createdAt.Add(30 * time.Minute))
+	c.clock = fakeClock
+	c.acnpStore.Add(acnp)
+	acnpRef := getACNPReference(acnp)
+
+	// The policy is enforced before it expires.
+	require.NoError(t, c.syncInternalNetworkPolicy(acnpRef))
+	obj, exists, _ := c.internalNetworkPolicyStore.Get(string(acnp.UID))
+	require.True(t, exists)
+	internalNP := obj.(*antreatypes.NetworkPolicy)
+	assert.False(t, internalNP.Expired)
+	assert.Equal(t, createdAt.Add(time.Hour), internalNP.ExpirationTime)
+	assert.Len(t, internalNP.Rules, 1)
+	assert.Len(t, internalNP.AppliedToGroups, 1)
+	assert.Equal(t, 1, len(c.appliedToGroupStore.List()))
+	assert.Equal(t, 1, len(c.addressGroupStore.List()))
+
+	// The policy is no longer enforced and is deleted once it expires.
+	fakeClock.SetTime(createdAt.Add(2 * time.Hour))
+	require.NoError(t, c.syncInternalNetworkPolicy(acnpRef))
+	obj, exists, _ = c.internalNetworkPolicyStore.Get(string(acnp.UID))
+	require.True(t, exists)
+	internalNP = obj.(*antreatypes.NetworkPolicy)
+	assert.True(t, internalNP.Expired)
+	assert.Empty(t, internalNP.Rules)
+	assert.Empty(t, internalNP.AppliedToGroups)
+	assert.Empty(t, c.appliedToGroupStore.List())
+	assert.Empty(t, c.addressGroupStore.List())
+	_, err := c.crdClient.CrdV1beta1().ClusterNetworkPolicies().Get(context.TODO(), acnp.Name, metav1.GetOptions{})
+	assert.True(t, errors.IsNotFound(err))
+	events := c.eventRecorder.(*record.FakeRecorder).Events
+	require.Len(t, events, 2)
+	assert.Equal(t, "Normal PolicyExpired Policy expired at 2025-03-05T11:00:00Z and is no longer enforced", <-events)
+	assert.Equal(t, "Normal PolicyDeleted Deleted expired policy", <-events)
+}
+
+func TestSyncInternalNetworkPolicyWithExpiryEventOnce(t *testing.T) {
+	expirationTime := time.Date(2025, 3, 5, 11, 0, 0, 0, time.UTC)
+	annp := &v1beta1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "quarantine", UID: "uidA"},
+		Spec: v1beta1.NetworkPolicySpec{
+			AppliedTo: []v1beta1.AppliedTo{{PodSelector: &selectorA}},
+			Priority:  1,
+			Ingress: []v1beta1.Rule{
+				{
+					Action: ptr.To(v1beta1.RuleActionDrop),
+					From:   []v1beta1.NetworkPolicyPeer{{PodSelector: &selectorB}},
+				},
+			},
+			Expiry: &v1beta1.PolicyExpiry{
+				ExpirationTime: &metav1.Time{Time: expirationTime},
+			},
+		},
+	}
+	_, c := newController(nil, []runtime.Object{annp})
+	c.clock = clocktesting.NewFakeClock(expirationTime.Add(time.Minute))
+	c.annpStore.Add(annp)
+	annpRef := getANNPReference(annp)
+
+	// The Event is only recorded when the policy expires, not every time it's synced afterwards.
+	require.NoError(t, c.syncInternalNetworkPolicy(annpRef))
+	require.NoError(t, c.syncInternalNetworkPolicy(annpRef))
+	obj, exists, _ := c.internalNetworkPolicyStore.Get(string(annp.UID))
+	require.True(t, exists)
+	assert.True(t, obj.(*antreatypes.NetworkPolicy).Expired)
+	_, err := c.crdClient.CrdV1beta1().NetworkPolicies(annp.Namespace).Get(context.TODO(), annp.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	events := c.eventRecorder.(*record.FakeRecorder).Events
+	require.Len(t, events, 1)
+	assert.Equal(t, "Normal PolicyExpired Policy expired at 2025-03-05T11:00:00Z and is no longer enforced", <-events)
+
+	// The Event is not recorded after a restart either, once the Expired condition has been set.
+	_, c = newController(nil, []runtime.Object{annp})
+	c.clock = clocktesting.NewFakeClock(expirationTime.Add(time.Minute))
+	expiredANNP := annp.DeepCopy()
+	expiredANNP.Status.Conditions = []v1beta1.NetworkPolicyCondition{{Type: v1beta1.NetworkPolicyConditionExpired, Status: metav1.ConditionTrue}}
+	c.annpStore.Add(expiredANNP)
+	require.NoError(t, c.syncInternalNetworkPolicy(annpRef))
+	assert.Empty(t, c.eventRecorder.(*record.FakeRecorder).Events)
+}
+
 // TestSyncInternalNetworkPolicyConcurrently verifies SyncInternalNetworkPolicy can create and delete AppliedToGroups
 // and AddressGroups correctly when concurrently processing multiple NetworkPolicies that refer to the same groups.
 func TestSyncInternalNetworkPolicyConcurrently(t *testing.T) {
@@ -0,0 +1,584 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+// ErrRuleStatsUnavailable is returned when the rules with zero hits are requested while the
+// NetworkPolicyStats feature is disabled.
+var ErrRuleStatsUnavailable = errors.New("rules with zero hits cannot be reported as the NetworkPolicyStats feature is disabled")
+
+// PolicyAnalyzer analyzes the rules of the Antrea-native policies.
+type PolicyAnalyzer interface {
+	AnalyzePolicies(request *controlplane.PolicyAnalysisRequest) (*controlplane.PolicyAnalysisResponse, error)
+}
+
+// RuleHitProvider provides the aggregated traffic stats of Antrea-native policies, along with the
+// last time each of their rules matched traffic.
+type RuleHitProvider interface {
+	RuleStatsProvider
+	// GetRuleLastHitTime returns the last time the provided rule of the provided policy was
+	// reported to have matched traffic. It returns the zero time if the rule hasn't matched any
+	// traffic since the stats of the policy started.
+	GetRuleLastHitTime(policy *controlplane.NetworkPolicyReference, ruleName string) time.Time
+}
+
+// policyAnalyzer implements the PolicyAnalyzer interface.
+type policyAnalyzer struct {
+	networkPolicyController *NetworkPolicyController
+	// ruleHitProvider is nil if the NetworkPolicyStats feature is disabled.
+	ruleHitProvider RuleHitProvider
+}
+
+// NewPolicyAnalyzer returns a new *policyAnalyzer.
+func NewPolicyAnalyzer(networkPolicyController *NetworkPolicyController, ruleHitProvider RuleHitProvider) *policyAnalyzer {
+	return &policyAnalyzer{
+		networkPolicyController: networkPolicyController,
+		ruleHitProvider:         ruleHitProvider,
+	}
+}
+
+// analyzedRule is a rule of an Antrea-native policy, along with the members it currently applies to
+// and the peer members it currently selects.
+type analyzedRule struct {
+	policy *antreatypes.NetworkPolicy
+	rule   *controlplane.NetworkPolicyRule
+	// action is the effective action of the rule. Drop and Reject rules of policies in Audit mode
+	// behave like Pass rules.
+	action      crdv1beta1.RuleAction
+	appliedTo   controlplane.GroupMemberSet
+	peer        *controlplane.NetworkPolicyPeer
+	peerMembers controlplane.GroupMemberSet
+}
+
+// ruleKey identifies a rule of a policy. A rule of an Antrea-native policy can be converted to
+// multiple internal rules, e.g. when its peers are scoped per Namespace, which share the same key.
+type ruleKey struct {
+	policyUID types.UID
+	direction controlplane.Direction
+	priority  int32
+}
+
+func (r *analyzedRule) key() ruleKey {
+	return ruleKey{policyUID: r.policy.UID, direction: r.rule.Direction, priority: r.rule.Priority}
+}
+
+func (r *analyzedRule) isBaseline() bool {
+	return *r.policy.TierPriority >= crdv1beta1.BaselineTierPriority
+}
+
+// matchesNothing returns true if the rule currently matches no traffic.
+func (r *analyzedRule) matchesNothing() bool {
+	if len(r.appliedTo) == 0 {
+		return true
+	}
+	return len(r.peerMembers) == 0 && len(r.peer.IPBlocks) == 0 && len(r.peer.FQDNs) == 0 &&
+		len(r.peer.ToServices) == 0 && len(r.peer.LabelIdentities) == 0
+}
+
+// precedes returns true if the rule is always evaluated before the other rule. The order of rules of
+// different policies with the same Tier and priority is not defined, so none of them precedes the
+// others.
+func (r *analyzedRule) precedes(o *analyzedRule) bool {
+	if *r.policy.TierPriority != *o.policy.TierPriority {
+		return *r.policy.TierPriority < *o.policy.TierPriority
+	}
+	if *r.policy.Priority != *o.policy.Priority {
+		return *r.policy.Priority < *o.policy.Priority
+	}
+	return r.policy.UID == o.policy.UID && r.rule.Priority < o.rule.Priority
+}
+
+func (r *analyzedRule) ruleRef() *controlplane.RuleRef {
+	return &controlplane.RuleRef{Direction: r.rule.Direction, Name: r.rule.Name, Action: r.rule.Action}
+}
+
+// AnalyzePolicies analyzes the rules of all the Antrea-native policies, based on the current members
+// of their groups, and reports:
+//   - the rules whose traffic is all matched by a single higher precedence rule, either with a
+//     different action (shadowed rules), or with the same action (redundant rules).
+//   - the Pass rules applied to Pods which are not selected by any K8s NetworkPolicy or Baseline
+//     Tier rule, which then behave like Allow rules.
+//   - the rules which haven't matched any traffic during the requested window, if any.
+//
+// Rules whose peers are FQDNs, Services or label identities can only be covered by a rule matching
+// all peers, and rules with Layer 7 protocols are never considered as covering other rules.
+func (a *policyAnalyzer) AnalyzePolicies(request *controlplane.PolicyAnalysisRequest) (*controlplane.PolicyAnalysisResponse, error) {
+	if request.ZeroHitWindowSeconds > 0 && a.ruleHitProvider == nil {
+		return nil, ErrRuleStatsUnavailable
+	}
+	n := a.networkPolicyController
+	appliedToMembers := map[string]controlplane.GroupMemberSet{}
+	getAppliedToMembers := func(groupNames []string) controlplane.GroupMemberSet {
+		members := controlplane.GroupMemberSet{}
+		for _, name := range groupNames {
+			groupMembers, ok := appliedToMembers[name]
+			if !ok {
+				groupMembers = controlplane.GroupMemberSet{}
+				if obj, found, _ := n.appliedToGroupStore.Get(name); found {
+					for _, nodeMembers := range obj.(*antreatypes.AppliedToGroup).GroupMemberByNode {
+						groupMembers.Merge(nodeMembers)
+					}
+				}
+				appliedToMembers[name] = groupMembers
+			}
+			members.Merge(groupMembers)
+		}
+		return members
+	}
+	getAddressMembers := func(groupNames []string) controlplane.GroupMemberSet {
+		members := controlplane.GroupMemberSet{}
+		for _, name := range groupNames {
+			if obj, found, _ := n.addressGroupStore.Get(name); found {
+				members.Merge(obj.(*antreatypes.AddressGroup).GroupMembers)
+			}
+		}
+		return members
+	}
+
+	var rules []*analyzedRule
+	// k8sNPMembers tracks the members selected by K8s NetworkPolicies in each direction.
+	k8sNPMembers := map[controlplane.Direction]controlplane.GroupMemberSet{
+		controlplane.DirectionIn:  {},
+		controlplane.DirectionOut: {},
+	}
+	for _, obj := range n.internalNetworkPolicyStore.List() {
+		policy := obj.(*antreatypes.NetworkPolicy)
+		if policy.SourceRef.Type == controlplane.K8sNetworkPolicy {
+			members := getAppliedToMembers(policy.AppliedToGroups)
+			for _, rule := range policy.Rules {
+				k8sNPMembers[rule.Direction].Merge(members)
+			}
+			continue
+		}
+		if policy.TierPriority == nil || policy.Priority == nil {
+			continue
+		}
+		for i := range policy.Rules {
+			rule := &policy.Rules[i]
+			appliedToGroups := rule.AppliedToGroups
+			if len(appliedToGroups) == 0 {
+				appliedToGroups = policy.AppliedToGroups
+			}
+			peer := &rule.From
+			if rule.Direction == controlplane.DirectionOut {
+				peer = &rule.To
+			}
+			action := crdv1beta1.RuleActionAllow
+			if rule.Action != nil {
+				action = *rule.Action
+			}
+			if policy.EnforcementMode == crdv1beta1.PolicyEnforcementModeAudit && (action == crdv1beta1.RuleActionDrop || action == crdv1beta1.RuleActionReject) {
+				action = crdv1beta1.RuleActionPass
+			}
+			rules = append(rules, &analyzedRule{
+				policy:      policy,
+				rule:        rule,
+				action:      action,
+				appliedTo:   getAppliedToMembers(appliedToGroups),
+				peer:        peer,
+				peerMembers: getAddressMembers(peer.AddressGroups),
+			})
+		}
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		ri, rj := rules[i], rules[j]
+		if *ri.policy.TierPriority != *rj.policy.TierPriority {
+			return *ri.policy.TierPriority < *rj.policy.TierPriority
+		}
+		if *ri.policy.Priority != *rj.policy.Priority {
+			return *ri.policy.Priority < *rj.policy.Priority
+		}
+		if ri.policy.Name != rj.policy.Name {
+			return ri.policy.Name < rj.policy.Name
+		}
+		return ri.rule.Priority < rj.rule.Priority
+	})
+
+	// A rule converted to multiple internal rules is only reported if all of them are covered.
+	var keys []ruleKey
+	coveredRules := map[ruleKey][]*controlplane.PolicyAnalysisFinding{}
+	ruleCounts := map[ruleKey]int{}
+	// covered tracks the internal rules covered by a preceding rule, which never match any traffic
+	// and thus can't cover other rules.
+	covered := make([]bool, len(rules))
+	for i, rule := range rules {
+		key := rule.key()
+		if ruleCounts[key] == 0 {
+			keys = append(keys, key)
+		}
+		ruleCounts[key]++
+		if rule.matchesNothing() {
+			continue
+		}
+		if finding := findCoveringRule(rules[:i], covered, rule); finding != nil {
+			coveredRules[key] = append(coveredRules[key], finding)
+			covered[i] = true
+		}
+	}
+	var findings []controlplane.PolicyAnalysisFinding
+	for _, key := range keys {
+		if len(coveredRules[key]) == ruleCounts[key] {
+			findings = append(findings, *coveredRules[key][0])
+		}
+	}
+
+	// Only the first internal rule of each rule needs to be checked for the remaining findings, as
+	// they share the same action and the same Pods.
+	reported := map[ruleKey]bool{}
+	for _, rule := range rules {
+		key := rule.key()
+		if reported[key] || rule.rule.Action == nil || *rule.rule.Action != crdv1beta1.RuleActionPass || len(coveredRules[key]) == ruleCounts[key] {
+			continue
+		}
+		reported[key] = true
+		if isPassEvaluatedLater(rules, rule, k8sNPMembers[rule.rule.Direction]) {
+			continue
+		}
+		findings = append(findings, controlplane.PolicyAnalysisFinding{
+			Type:          controlplane.PassFallThroughRule,
+			NetworkPolicy: *rule.policy.SourceRef,
+			Rule:          *rule.ruleRef(),
+			Message:       "no K8s NetworkPolicy or Baseline Tier rule applies to the endpoints selected by the rule, the traffic passed by it is allowed",
+		})
+	}
+
+	if request.ZeroHitWindowSeconds > 0 {
+		findings = append(findings, a.findZeroHitRules(rules, time.Duration(request.ZeroHitWindowSeconds)*time.Second)...)
+	}
+	return &controlplane.PolicyAnalysisResponse{Findings: findings}, nil
+}
+
+// findCoveringRule returns a ShadowedRule or RedundantRule finding if the traffic of the rule is all
+// matched by one of the provided rules which precedes it and isn't covered itself, otherwise nil.
+func findCoveringRule(precedingRules []*analyzedRule, covered []bool, rule *analyzedRule) *controlplane.PolicyAnalysisFinding {
+	for i, coveringRule := range precedingRules {
+		if covered[i] || coveringRule.rule.Direction != rule.rule.Direction || !coveringRule.precedes(rule) || !coveringRule.covers(rule) {
+			continue
+		}
+		// Pass rules skip the rules of regular Tiers, but not the ones of the Baseline Tier.
+		if coveringRule.action == crdv1beta1.RuleActionPass && rule.isBaseline() {
+			continue
+		}
+		finding := &controlplane.PolicyAnalysisFinding{
+			Type:                  controlplane.ShadowedRule,
+			NetworkPolicy:         *rule.policy.SourceRef,
+			Rule:                  *rule.ruleRef(),
+			CoveringNetworkPolicy: coveringRule.policy.SourceRef,
+			CoveringRule:          coveringRule.ruleRef(),
+		}
+		coveringRuleName := fmt.Sprintf("rule %q of %s", coveringRule.rule.Name, coveringRule.policy.SourceRef.ToString())
+		if coveringRule.action == rule.action {
+			finding.Type = controlplane.RedundantRule
+			finding.Message = fmt.Sprintf("all traffic matched by the rule is matched first by %s with the same action", coveringRuleName)
+		} else {
+			finding.Message = fmt.Sprintf("all traffic matched by the rule is matched first by %s with action %s", coveringRuleName, coveringRule.action)
+		}
+		return finding
+	}
+	return nil
+}
+
+// isPassEvaluatedLater returns true if any endpoint selected by the Pass rule is selected by a K8s
+// NetworkPolicy, or by a Baseline Tier rule in the same direction.
+func isPassEvaluatedLater(rules []*analyzedRule, passRule *analyzedRule, k8sNPMembers controlplane.GroupMemberSet) bool {
+	if hasCommonMember(passRule.appliedTo, k8sNPMembers) {
+		return true
+	}
+	for _, rule := range rules {
+		if rule.isBaseline() && rule.rule.Direction == passRule.rule.Direction && hasCommonMember(passRule.appliedTo, rule.appliedTo) {
+			return true
+		}
+	}
+	return false
+}
+
+// findZeroHitRules returns the ZeroHitRule findings of the rules of Antrea ClusterNetworkPolicies and
+// Antrea NetworkPolicies which haven't matched any traffic during the provided window. Policies whose
+// stats started during the window are skipped as the stats don't cover the whole window.
+func (a *policyAnalyzer) findZeroHitRules(rules []*analyzedRule, window time.Duration) []controlplane.PolicyAnalysisFinding {
+	windowStart := a.networkPolicyController.clock.Now().Add(-window)
+	var findings []controlplane.PolicyAnalysisFinding
+	reported := map[ruleKey]bool{}
+	for _, rule := range rules {
+		key := rule.key()
+		if reported[key] {
+			continue
+		}
+		reported[key] = true
+		policyRef := rule.policy.SourceRef
+		var statsStart time.Time
+		switch policyRef.Type {
+		case controlplane.AntreaClusterNetworkPolicy:
+			stats, found := a.ruleHitProvider.GetAntreaClusterNetworkPolicyStats(policyRef.Name)
+			if !found {
+				continue
+			}
+			statsStart = stats.CreationTimestamp.Time
+		case controlplane.AntreaNetworkPolicy:
+			stats, found := a.ruleHitProvider.GetAntreaNetworkPolicyStats(policyRef.Namespace, policyRef.Name)
+			if !found {
+				continue
+			}
+			statsStart = stats.CreationTimestamp.Time
+		default:
+			continue
+		}
+		if statsStart.After(windowStart) || !a.ruleHitProvider.GetRuleLastHitTime(policyRef, rule.rule.Name).Before(windowStart) {
+			continue
+		}
+		findings = append(findings, controlplane.PolicyAnalysisFinding{
+			Type:          controlplane.ZeroHitRule,
+			NetworkPolicy: *policyRef,
+			Rule:          *rule.ruleRef(),
+			Message:       fmt.Sprintf("the rule hasn't matched any traffic in the last %s", window),
+		})
+	}
+	return findings
+}
+
+// covers returns true if all the traffic matched by the other rule is matched by the rule.
+func (r *analyzedRule) covers(o *analyzedRule) bool {
+	// Layer 7 rules only match part of the traffic of their Layer 4 matches.
+	if len(r.rule.L7Protocols) > 0 {
+		return false
+	}
+	return r.appliedTo.IsSuperset(o.appliedTo) && r.coversPeer(o) && servicesCover(r.rule.Services, o.rule.Services)
+}
+
+// coversPeer returns true if all the peers selected by the other rule are selected by the rule.
+func (r *analyzedRule) coversPeer(o *analyzedRule) bool {
+	if matchesAllIPs(r.peer.IPBlocks) {
+		return true
+	}
+	// The addresses of FQDNs, Services and label identities are not known by the controller.
+	if len(o.peer.FQDNs) > 0 || len(o.peer.ToServices) > 0 || len(o.peer.LabelIdentities) > 0 {
+		return false
+	}
+	for i := range o.peer.IPBlocks {
+		if !ipBlocksCover(r.peer.IPBlocks, &o.peer.IPBlocks[i]) {
+			return false
+		}
+	}
+	for _, member := range o.peerMembers {
+		if r.peerMembers.Has(member) {
+			continue
+		}
+		if len(member.IPs) == 0 {
+			return false
+		}
+		for _, ip := range member.IPs {
+			if !ipBlocksContain(r.peer.IPBlocks, net.IP(ip)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matchesAllIPs returns true if the IPBlocks include both the IPv4 and IPv6 any addresses without
+// exceptions.
+func matchesAllIPs(ipBlocks []controlplane.IPBlock) bool {
+	var ipv4Any, ipv6Any bool
+	for _, ipBlock := range ipBlocks {
+		if ipBlock.CIDR.PrefixLength != 0 || len(ipBlock.Except) > 0 {
+			continue
+		}
+		if net.IP(ipBlock.CIDR.IP).To4() != nil {
+			ipv4Any = true
+		} else {
+			ipv6Any = true
+		}
+	}
+	return ipv4Any && ipv6Any
+}
+
+func ipNetToNetIPNet(ipNet *controlplane.IPNet) *net.IPNet {
+	ip := net.IP(ipNet.IP)
+	ipLen := net.IPv4len
+	if ip.To4() == nil {
+		ipLen = net.IPv6len
+	}
+	mask := net.CIDRMask(int(ipNet.PrefixLength), 8*ipLen)
+	return &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+}
+
+// ipNetContains returns true if the first IPNet contains the second one.
+func ipNetContains(ipNet1, ipNet2 *controlplane.IPNet) bool {
+	n1, n2 := ipNetToNetIPNet(ipNet1), ipNetToNetIPNet(ipNet2)
+	ones1, bits1 := n1.Mask.Size()
+	ones2, bits2 := n2.Mask.Size()
+	return bits1 == bits2 && ones1 <= ones2 && n1.Contains(n2.IP)
+}
+
+// ipBlocksContain returns true if the IP is in any of the IPBlocks.
+func ipBlocksContain(ipBlocks []controlplane.IPBlock, ip net.IP) bool {
+	for _, ipBlock := range ipBlocks {
+		if !ipNetToNetIPNet(&ipBlock.CIDR).Contains(ip) {
+			continue
+		}
+		excepted := false
+		for i := range ipBlock.Except {
+			if ipNetToNetIPNet(&ipBlock.Except[i]).Contains(ip) {
+				excepted = true
+				break
+			}
+		}
+		if !excepted {
+			return true
+		}
+	}
+	return false
+}
+
+// ipBlocksCover returns true if all the addresses of the IPBlock are in a single one of the IPBlocks.
+func ipBlocksCover(ipBlocks []controlplane.IPBlock, o *controlplane.IPBlock) bool {
+	for _, ipBlock := range ipBlocks {
+		if !ipNetContains(&ipBlock.CIDR, &o.CIDR) {
+			continue
+		}
+		covered := true
+		for i := range ipBlock.Except {
+			except := &ipBlock.Except[i]
+			// An exception overlapping the other IPBlock must be excepted by it too.
+			if !ipNetContains(except, &o.CIDR) && !ipNetContains(&o.CIDR, except) {
+				continue
+			}
+			excepted := false
+			for j := range o.Except {
+				if ipNetContains(&o.Except[j], except) {
+					excepted = true
+					break
+				}
+			}
+			if !excepted {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			return true
+		}
+	}
+	return false
+}
+
+// servicesCover returns true if all the traffic matched by the other Services is matched by the
+// Services. Empty Services match all traffic.
+func servicesCover(services, o []controlplane.Service) bool {
+	if len(services) == 0 {
+		return true
+	}
+	if len(o) == 0 {
+		return false
+	}
+	for i := range o {
+		covered := false
+		for j := range services {
+			if serviceCovers(&services[j], &o[i]) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+func serviceCovers(s, o *controlplane.Service) bool {
+	protocol := func(s *controlplane.Service) controlplane.Protocol {
+		if s.Protocol == nil {
+			return controlplane.ProtocolTCP
+		}
+		return *s.Protocol
+	}
+	if protocol(s) != protocol(o) {
+		return false
+	}
+	switch protocol(s) {
+	case controlplane.ProtocolICMP:
+		if s.ICMPType == nil {
+			return true
+		}
+		if o.ICMPType == nil || *s.ICMPType != *o.ICMPType {
+			return false
+		}
+		return s.ICMPCode == nil || (o.ICMPCode != nil && *s.ICMPCode == *o.ICMPCode)
+	case controlplane.ProtocolIGMP:
+		if s.IGMPType == nil {
+			return true
+		}
+		return o.IGMPType != nil && *s.IGMPType == *o.IGMPType && s.GroupAddress == o.GroupAddress
+	}
+	if s.Port != nil {
+		if o.Port == nil {
+			return false
+		}
+		if s.Port.Type == intstr.String || o.Port.Type == intstr.String {
+			// Named ports can only be compared by name.
+			if s.Port.Type != o.Port.Type || s.Port.StrVal != o.Port.StrVal {
+				return false
+			}
+		} else if !portRangeContains(s.Port.IntVal, s.EndPort, o.Port.IntVal, o.EndPort) {
+			return false
+		}
+	}
+	if s.SrcPort != nil {
+		if o.SrcPort == nil || !portRangeContains(*s.SrcPort, s.SrcEndPort, *o.SrcPort, o.SrcEndPort) {
+			return false
+		}
+	}
+	return true
+}
+
+// portRangeContains returns true if the first port range contains the second one. A nil end port
+// means the range only includes its start port.
+func portRangeContains(start int32, end *int32, oStart int32, oEnd *int32) bool {
+	rangeEnd := func(start int32, end *int32) int32 {
+		if end == nil {
+			return start
+		}
+		return *end
+	}
+	return start <= oStart && rangeEnd(oStart, oEnd) <= rangeEnd(start, end)
+}
+
+// hasCommonMember returns true if the GroupMemberSets have any common member.
+func hasCommonMember(s, o controlplane.GroupMemberSet) bool {
+	if len(s) > len(o) {
+		s, o = o, s
+	}
+	for _, member := range s {
+		if o.Has(member) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,514 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func newAnalyzedPolicy(policyType controlplane.NetworkPolicyType, name string, tierPriority int32, priority float64, appliedToGroups []string, rules ...controlplane.NetworkPolicyRule) *antreatypes.NetworkPolicy {
+	policy := &antreatypes.NetworkPolicy{
+		Name:            name,
+		UID:             types.UID("uid-" + name),
+		SourceRef:       &controlplane.NetworkPolicyReference{Type: policyType, Name: name, UID: types.UID("uid-" + name)},
+		AppliedToGroups: appliedToGroups,
+		Rules:           rules,
+	}
+	if policyType == controlplane.AntreaNetworkPolicy || policyType == controlplane.K8sNetworkPolicy {
+		policy.SourceRef.Namespace = "ns1"
+	}
+	if policyType != controlplane.K8sNetworkPolicy {
+		policy.TierPriority = &tierPriority
+		policy.Priority = &priority
+	}
+	return policy
+}
+
+func mustCIDRStrToIPNet(cidr string) *controlplane.IPNet {
+	ipNet, err := cidrStrToIPNet(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return ipNet
+}
+
+func newAnalyzedRule(direction controlplane.Direction, name string, priority int32, action crdv1beta1.RuleAction, peer controlplane.NetworkPolicyPeer, services ...controlplane.Service) controlplane.NetworkPolicyRule {
+	rule := controlplane.NetworkPolicyRule{Direction: direction, Name: name, Priority: priority, Action: &action, Services: services}
+	if direction == controlplane.DirectionIn {
+		rule.From = peer
+	} else {
+		rule.To = peer
+	}
+	return rule
+}
+
+func TestAnalyzePolicies(t *testing.T) {
+	pod1 := &controlplane.GroupMember{Pod: &controlplane.PodReference{Namespace: "ns1", Name: "pod1"}, IPs: []controlplane.IPAddress{ipStrToIPAddress("10.0.0.1")}}
+	pod2 := &controlplane.GroupMember{Pod: &controlplane.PodReference{Namespace: "ns1", Name: "pod2"}, IPs: []controlplane.IPAddress{ipStrToIPAddress("10.0.0.2")}}
+	pod3 := &controlplane.GroupMember{Pod: &controlplane.PodReference{Namespace: "ns2", Name: "pod3"}, IPs: []controlplane.IPAddress{ipStrToIPAddress("10.0.1.3")}}
+	appliedToGroups := []*antreatypes.AppliedToGroup{
+		{Name: "atgAll", UID: "atgAll", GroupMemberByNode: map[string]controlplane.GroupMemberSet{"node1": controlplane.NewGroupMemberSet(pod1), "node2": controlplane.NewGroupMemberSet(pod2)}},
+		{Name: "atgPod1", UID: "atgPod1", GroupMemberByNode: map[string]controlplane.GroupMemberSet{"node1": controlplane.NewGroupMemberSet(pod1)}},
+		{Name: "atgPod2", UID: "atgPod2", GroupMemberByNode: map[string]controlplane.GroupMemberSet{"node2": controlplane.NewGroupMemberSet(pod2)}},
+	}
+	addressGroups := []*antreatypes.AddressGroup{
+		{Name: "agPod3", UID: "agPod3", GroupMembers: controlplane.NewGroupMemberSet(pod3)},
+		{Name: "agEmpty", UID: "agEmpty", GroupMembers: controlplane.NewGroupMemberSet()},
+	}
+	pod3Peer := controlplane.NetworkPolicyPeer{AddressGroups: []string{"agPod3"}}
+	tcp80 := controlplane.Service{Protocol: ptr.To(controlplane.ProtocolTCP), Port: ptr.To(intstr.FromInt32(80))}
+	baselinePriority := crdv1beta1.BaselineTierPriority
+	ruleRef := func(direction controlplane.Direction, name string, action crdv1beta1.RuleAction) *controlplane.RuleRef {
+		return &controlplane.RuleRef{Direction: direction, Name: name, Action: &action}
+	}
+	acnpRef := func(name string) *controlplane.NetworkPolicyReference {
+		return &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: name, UID: types.UID("uid-" + name)}
+	}
+
+	tests := []struct {
+		name             string
+		policies         []*antreatypes.NetworkPolicy
+		expectedFindings []controlplane.PolicyAnalysisFinding
+	}{
+		{
+			name: "rule shadowed by a higher Tier rule",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "deny-all", 50, 1, []string{"atgAll"},
+					newAnalyzedRule(controlplane.DirectionIn, "drop-all", 0, crdv1beta1.RuleActionDrop, matchAllPeer)),
+				newAnalyzedPolicy(controlplane.AntreaNetworkPolicy, "allow-web", 250, 1, []string{"atgPod1"},
+					newAnalyzedRule(controlplane.DirectionIn, "allow-pod3", 0, crdv1beta1.RuleActionAllow, pod3Peer, tcp80)),
+			},
+			expectedFindings: []controlplane.PolicyAnalysisFinding{{
+				Type:                  controlplane.ShadowedRule,
+				NetworkPolicy:         controlplane.NetworkPolicyReference{Type: controlplane.AntreaNetworkPolicy, Namespace: "ns1", Name: "allow-web", UID: "uid-allow-web"},
+				Rule:                  *ruleRef(controlplane.DirectionIn, "allow-pod3", crdv1beta1.RuleActionAllow),
+				CoveringNetworkPolicy: acnpRef("deny-all"),
+				CoveringRule:          ruleRef(controlplane.DirectionIn, "drop-all", crdv1beta1.RuleActionDrop),
+				Message:               `all traffic matched by the rule is matched first by rule "drop-all" of AntreaClusterNetworkPolicy:deny-all with action Drop`,
+			}},
+		},
+		{
+			name: "redundant rule in the same policy",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp1", 250, 1, []string{"atgAll"},
+					newAnalyzedRule(controlplane.DirectionOut, "allow-pod3", 0, crdv1beta1.RuleActionAllow, pod3Peer),
+					newAnalyzedRule(controlplane.DirectionOut, "allow-pod3-80", 1, crdv1beta1.RuleActionAllow, pod3Peer, tcp80)),
+			},
+			expectedFindings: []controlplane.PolicyAnalysisFinding{{
+				Type:                  controlplane.RedundantRule,
+				NetworkPolicy:         *acnpRef("acnp1"),
+				Rule:                  *ruleRef(controlplane.DirectionOut, "allow-pod3-80", crdv1beta1.RuleActionAllow),
+				CoveringNetworkPolicy: acnpRef("acnp1"),
+				CoveringRule:          ruleRef(controlplane.DirectionOut, "allow-pod3", crdv1beta1.RuleActionAllow),
+				Message:               `all traffic matched by the rule is matched first by rule "allow-pod3" of AntreaClusterNetworkPolicy:acnp1 with the same action`,
+			}},
+		},
+		{
+			name: "rules not covered",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp1", 250, 1, []string{"atgPod1"},
+					newAnalyzedRule(controlplane.DirectionIn, "drop-80", 0, crdv1beta1.RuleActionDrop, matchAllPeer, tcp80)),
+				// Applied to more Pods.
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp2", 250, 2, []string{"atgAll"},
+					newAnalyzedRule(controlplane.DirectionIn, "allow-80", 0, crdv1beta1.RuleActionAllow, pod3Peer, tcp80)),
+				// Matching more ports.
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp3", 250, 3, []string{"atgPod1"},
+					newAnalyzedRule(controlplane.DirectionIn, "allow-all-ports", 0, crdv1beta1.RuleActionAllow, pod3Peer)),
+				// In another direction.
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp4", 250, 4, []string{"atgPod1"},
+					newAnalyzedRule(controlplane.DirectionOut, "allow-egress-80", 0, crdv1beta1.RuleActionAllow, pod3Peer, tcp80)),
+			},
+		},
+		{
+			name: "policies with the same priority",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp1", 250, 1, []string{"atgAll"},
+					newAnalyzedRule(controlplane.DirectionIn, "drop-all", 0, crdv1beta1.RuleActionDrop, matchAllPeer)),
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp2", 250, 1, []string{"atgAll"},
+					newAnalyzedRule(controlplane.DirectionIn, "allow-all", 0, crdv1beta1.RuleActionAllow, matchAllPeer)),
+			},
+		},
+		{
+			name: "peer covered by IPBlock",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp1", 250, 1, []string{"atgAll"},
+					newAnalyzedRule(controlplane.DirectionIn, "reject-cidr", 0, crdv1beta1.RuleActionReject, controlplane.NetworkPolicyPeer{
+						IPBlocks: []controlplane.IPBlock{{CIDR: *mustCIDRStrToIPNet("10.0.0.0/16"), Except: []controlplane.IPNet{*mustCIDRStrToIPNet("10.0.0.0/24")}}},
+					})),
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp2", 250, 2, []string{"atgPod2"},
+					newAnalyzedRule(controlplane.DirectionIn, "allow-pod3", 0, crdv1beta1.RuleActionAllow, pod3Peer)),
+				// The exception of the covering IPBlock overlaps the IPBlock.
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp3", 250, 3, []string{"atgPod2"},
+					newAnalyzedRule(controlplane.DirectionIn, "allow-cidr", 0, crdv1beta1.RuleActionAllow, controlplane.NetworkPolicyPeer{
+						IPBlocks: []controlplane.IPBlock{{CIDR: *mustCIDRStrToIPNet("10.0.0.0/23")}},
+					})),
+			},
+			expectedFindings: []controlplane.PolicyAnalysisFinding{{
+				Type:                  controlplane.ShadowedRule,
+				NetworkPolicy:         *acnpRef("acnp2"),
+				Rule:                  *ruleRef(controlplane.DirectionIn, "allow-pod3", crdv1beta1.RuleActionAllow),
+				CoveringNetworkPolicy: acnpRef("acnp1"),
+				CoveringRule:          ruleRef(controlplane.DirectionIn, "reject-cidr", crdv1beta1.RuleActionReject),
+				Message:               `all traffic matched by the rule is matched first by rule "reject-cidr" of AntreaClusterNetworkPolicy:acnp1 with action Reject`,
+			}},
+		},
+		{
+			name: "FQDN peers and L7 rules",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp1", 250, 1, []string{"atgAll"},
+					newAnalyzedRule(controlplane.DirectionOut, "drop-pod3", 0, crdv1beta1.RuleActionDrop, pod3Peer)),
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp2", 250, 2, []string{"atgAll"},
+					newAnalyzedRule(controlplane.DirectionOut, "allow-fqdn", 0, crdv1beta1.RuleActionAllow, controlplane.NetworkPolicyPeer{FQDNs: []string{"*.example.com"}})),
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp3", 250, 3, []string{"atgAll"},
+					func() controlplane.NetworkPolicyRule {
+						rule := newAnalyzedRule(controlplane.DirectionIn, "allow-http", 0, crdv1beta1.RuleActionAllow, matchAllPeer)
+						rule.L7Protocols = []controlplane.L7Protocol{{HTTP: &controlplane.HTTPProtocol{Method: "GET"}}}
+						return rule
+					}(),
+					newAnalyzedRule(controlplane.DirectionIn, "drop-all", 1, crdv1beta1.RuleActionDrop, matchAllPeer)),
+			},
+		},
+		{
+			name: "rule matching nothing",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp1", 250, 1, []string{"atgAll"},
+					newAnalyzedRule(controlplane.DirectionIn, "drop-all", 0, crdv1beta1.RuleActionDrop, matchAllPeer),
+					newAnalyzedRule(controlplane.DirectionIn, "allow-empty", 1, crdv1beta1.RuleActionAllow, controlplane.NetworkPolicyPeer{AddressGroups: []string{"agEmpty"}})),
+			},
+		},
+		{
+			name: "rule partially covered",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp1", 250, 1, []string{"atgPod1"},
+					newAnalyzedRule(controlplane.DirectionIn, "drop-pod3", 0, crdv1beta1.RuleActionDrop, pod3Peer)),
+				// A rule with per-Namespace peers is converted to multiple internal rules.
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp2", 250, 2, nil,
+					func() controlplane.NetworkPolicyRule {
+						rule := newAnalyzedRule(controlplane.DirectionIn, "allow-same-ns", 0, crdv1beta1.RuleActionAllow, pod3Peer)
+						rule.AppliedToGroups = []string{"atgPod1"}
+						return rule
+					}(),
+					func() controlplane.NetworkPolicyRule {
+						rule := newAnalyzedRule(controlplane.DirectionIn, "allow-same-ns", 0, crdv1beta1.RuleActionAllow, pod3Peer)
+						rule.AppliedToGroups = []string{"atgPod2"}
+						return rule
+					}()),
+			},
+		},
+		{
+			name: "Pass rule shadowing regular Tiers",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp1", 50, 1, []string{"atgAll"},
+					newAnalyzedRule(controlplane.DirectionIn, "pass-all", 0, crdv1beta1.RuleActionPass, matchAllPeer)),
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp2", 250, 1, []string{"atgPod1"},
+					newAnalyzedRule(controlplane.DirectionIn, "drop-pod3", 0, crdv1beta1.RuleActionDrop, pod3Peer)),
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp3", baselinePriority, 1, []string{"atgPod1"},
+					newAnalyzedRule(controlplane.DirectionIn, "baseline-drop-pod3", 0, crdv1beta1.RuleActionDrop, pod3Peer)),
+			},
+			expectedFindings: []controlplane.PolicyAnalysisFinding{{
+				Type:                  controlplane.ShadowedRule,
+				NetworkPolicy:         *acnpRef("acnp2"),
+				Rule:                  *ruleRef(controlplane.DirectionIn, "drop-pod3", crdv1beta1.RuleActionDrop),
+				CoveringNetworkPolicy: acnpRef("acnp1"),
+				CoveringRule:          ruleRef(controlplane.DirectionIn, "pass-all", crdv1beta1.RuleActionPass),
+				Message:               `all traffic matched by the rule is matched first by rule "pass-all" of AntreaClusterNetworkPolicy:acnp1 with action Pass`,
+			}},
+		},
+		{
+			name: "Drop rule of policy in Audit mode",
+			policies: []*antreatypes.NetworkPolicy{
+				func() *antreatypes.NetworkPolicy {
+					policy := newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp1", 50, 1, []string{"atgAll"},
+						newAnalyzedRule(controlplane.DirectionIn, "drop-all", 0, crdv1beta1.RuleActionDrop, matchAllPeer))
+					policy.EnforcementMode = crdv1beta1.PolicyEnforcementModeAudit
+					return policy
+				}(),
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp2", baselinePriority, 1, []string{"atgPod1"},
+					newAnalyzedRule(controlplane.DirectionIn, "baseline-drop-pod3", 0, crdv1beta1.RuleActionDrop, pod3Peer)),
+			},
+		},
+		{
+			name: "Pass rules falling through",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp1", 50, 1, []string{"atgPod1"},
+					newAnalyzedRule(controlplane.DirectionIn, "pass-pod3", 0, crdv1beta1.RuleActionPass, pod3Peer)),
+				newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp2", 50, 2, []string{"atgPod2"},
+					newAnalyzedRule(controlplane.DirectionIn, "pass-pod3", 0, crdv1beta1.RuleActionPass, pod3Peer),
+					newAnalyzedRule(controlplane.DirectionOut, "pass-pod3-egress", 1, crdv1beta1.RuleActionPass, pod3Peer)),
+				// Only ingress rules are defined for Pod2.
+				newAnalyzedPolicy(controlplane.K8sNetworkPolicy, "knp1", 0, 0, []string{"atgPod2"},
+					controlplane.NetworkPolicyRule{Direction: controlplane.DirectionIn, From: pod3Peer, Priority: defaultRulePriority, Action: &defaultAction}),
+			},
+			expectedFindings: []controlplane.PolicyAnalysisFinding{
+				{
+					Type:          controlplane.PassFallThroughRule,
+					NetworkPolicy: *acnpRef("acnp1"),
+					Rule:          *ruleRef(controlplane.DirectionIn, "pass-pod3", crdv1beta1.RuleActionPass),
+					Message:       "no K8s NetworkPolicy or Baseline Tier rule applies to the endpoints selected by the rule, the traffic passed by it is allowed",
+				},
+				{
+					Type:          controlplane.PassFallThroughRule,
+					NetworkPolicy: *acnpRef("acnp2"),
+					Rule:          *ruleRef(controlplane.DirectionOut, "pass-pod3-egress", crdv1beta1.RuleActionPass),
+					Message:       "no K8s NetworkPolicy or Baseline Tier rule applies to the endpoints selected by the rule, the traffic passed by it is allowed",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, npc := newController(nil, nil)
+			for _, atg := range appliedToGroups {
+				require.NoError(t, npc.appliedToGroupStore.Create(atg))
+			}
+			for _, ag := range addressGroups {
+				require.NoError(t, npc.addressGroupStore.Create(ag))
+			}
+			for _, policy := range tt.policies {
+				require.NoError(t, npc.internalNetworkPolicyStore.Create(policy))
+			}
+			response, err := NewPolicyAnalyzer(npc.NetworkPolicyController, nil).AnalyzePolicies(&controlplane.PolicyAnalysisRequest{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFindings, response.Findings)
+		})
+	}
+}
+
+type fakeRuleHitProvider struct {
+	statsStartTime   time.Time
+	ruleLastHitTimes map[string]time.Time
+}
+
+func (p *fakeRuleHitProvider) GetAntreaClusterNetworkPolicyStats(name string) (*statsv1alpha1.AntreaClusterNetworkPolicyStats, bool) {
+	return &statsv1alpha1.AntreaClusterNetworkPolicyStats{ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(p.statsStartTime)}}, true
+}
+
+func (p *fakeRuleHitProvider) GetAntreaNetworkPolicyStats(namespace, name string) (*statsv1alpha1.AntreaNetworkPolicyStats, bool) {
+	return nil, false
+}
+
+func (p *fakeRuleHitProvider) GetRuleLastHitTime(policy *controlplane.NetworkPolicyReference, ruleName string) time.Time {
+	return p.ruleLastHitTimes[ruleName]
+}
+
+func TestAnalyzePoliciesZeroHitRules(t *testing.T) {
+	now := time.Now()
+	policy := newAnalyzedPolicy(controlplane.AntreaClusterNetworkPolicy, "acnp1", 250, 1, []string{"atgAll"},
+		newAnalyzedRule(controlplane.DirectionIn, "hit-recently", 0, crdv1beta1.RuleActionAllow, matchAllPeer, controlplane.Service{Port: ptr.To(intstr.FromInt32(80))}),
+		newAnalyzedRule(controlplane.DirectionIn, "hit-long-ago", 1, crdv1beta1.RuleActionAllow, matchAllPeer, controlplane.Service{Port: ptr.To(intstr.FromInt32(81))}),
+		newAnalyzedRule(controlplane.DirectionIn, "never-hit", 2, crdv1beta1.RuleActionAllow, matchAllPeer, controlplane.Service{Port: ptr.To(intstr.FromInt32(82))}))
+	ruleLastHitTimes := map[string]time.Time{
+		"hit-recently": now.Add(-time.Minute),
+		"hit-long-ago": now.Add(-2 * time.Hour),
+	}
+	zeroHitFinding := func(name string) controlplane.PolicyAnalysisFinding {
+		action := crdv1beta1.RuleActionAllow
+		return controlplane.PolicyAnalysisFinding{
+			Type:          controlplane.ZeroHitRule,
+			NetworkPolicy: *policy.SourceRef,
+			Rule:          controlplane.RuleRef{Direction: controlplane.DirectionIn, Name: name, Action: &action},
+			Message:       "the rule hasn't matched any traffic in the last 1h0m0s",
+		}
+	}
+
+	tests := []struct {
+		name             string
+		ruleHitProvider  RuleHitProvider
+		windowSeconds    int64
+		expectedFindings []controlplane.PolicyAnalysisFinding
+		expectedErr      error
+	}{
+		{
+			name:             "stats covering the window",
+			ruleHitProvider:  &fakeRuleHitProvider{statsStartTime: now.Add(-3 * time.Hour), ruleLastHitTimes: ruleLastHitTimes},
+			windowSeconds:    3600,
+			expectedFindings: []controlplane.PolicyAnalysisFinding{zeroHitFinding("hit-long-ago"), zeroHitFinding("never-hit")},
+		},
+		{
+			name:            "stats started during the window",
+			ruleHitProvider: &fakeRuleHitProvider{statsStartTime: now.Add(-30 * time.Minute), ruleLastHitTimes: ruleLastHitTimes},
+			windowSeconds:   3600,
+		},
+		{
+			name:            "report disabled",
+			ruleHitProvider: &fakeRuleHitProvider{statsStartTime: now.Add(-3 * time.Hour), ruleLastHitTimes: ruleLastHitTimes},
+		},
+		{
+			name:          "stats unavailable",
+			windowSeconds: 3600,
+			expectedErr:   ErrRuleStatsUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, npc := newController(nil, nil)
+			npc.clock = clocktesting.NewFakeClock(now)
+			require.NoError(t, npc.appliedToGroupStore.Create(&antreatypes.AppliedToGroup{
+				Name:              "atgAll",
+				UID:               "atgAll",
+				GroupMemberByNode: map[string]controlplane.GroupMemberSet{"node1": controlplane.NewGroupMemberSet(&controlplane.GroupMember{Pod: &controlplane.PodReference{Namespace: "ns1", Name: "pod1"}})},
+			}))
+			require.NoError(t, npc.internalNetworkPolicyStore.Create(policy))
+			response, err := NewPolicyAnalyzer(npc.NetworkPolicyController, tt.ruleHitProvider).AnalyzePolicies(&controlplane.PolicyAnalysisRequest{ZeroHitWindowSeconds: tt.windowSeconds})
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFindings, response.Findings)
+		})
+	}
+}
+
+func TestServicesCover(t *testing.T) {
+	tcp := ptr.To(controlplane.ProtocolTCP)
+	udp := ptr.To(controlplane.ProtocolUDP)
+	icmp := ptr.To(controlplane.ProtocolICMP)
+	tests := []struct {
+		name     string
+		services []controlplane.Service
+		other    []controlplane.Service
+		expected bool
+	}{
+		{
+			name:     "all traffic",
+			other:    []controlplane.Service{{Protocol: tcp, Port: ptr.To(intstr.FromInt32(80))}},
+			expected: true,
+		},
+		{
+			name:     "all traffic not covered",
+			services: []controlplane.Service{{Protocol: tcp}},
+		},
+		{
+			name:     "port range",
+			services: []controlplane.Service{{Protocol: tcp, Port: ptr.To(intstr.FromInt32(8000)), EndPort: ptr.To[int32](9000)}},
+			other:    []controlplane.Service{{Port: ptr.To(intstr.FromInt32(8080))}, {Protocol: tcp, Port: ptr.To(intstr.FromInt32(8100)), EndPort: ptr.To[int32](8200)}},
+			expected: true,
+		},
+		{
+			name:     "port range not covered",
+			services: []controlplane.Service{{Protocol: tcp, Port: ptr.To(intstr.FromInt32(8000)), EndPort: ptr.To[int32](9000)}},
+			other:    []controlplane.Service{{Protocol: tcp, Port: ptr.To(intstr.FromInt32(8100)), EndPort: ptr.To[int32](9100)}},
+		},
+		{
+			name:     "different protocols",
+			services: []controlplane.Service{{Protocol: tcp}},
+			other:    []controlplane.Service{{Protocol: udp, Port: ptr.To(intstr.FromInt32(53))}},
+		},
+		{
+			name:     "named port",
+			services: []controlplane.Service{{Protocol: tcp, Port: ptr.To(intstr.FromString("http"))}},
+			other:    []controlplane.Service{{Protocol: tcp, Port: ptr.To(intstr.FromString("http"))}},
+			expected: true,
+		},
+		{
+			name:     "named port and number",
+			services: []controlplane.Service{{Protocol: tcp, Port: ptr.To(intstr.FromString("http"))}},
+			other:    []controlplane.Service{{Protocol: tcp, Port: ptr.To(intstr.FromInt32(80))}},
+		},
+		{
+			name:     "source port",
+			services: []controlplane.Service{{Protocol: tcp, SrcPort: ptr.To[int32](1000), SrcEndPort: ptr.To[int32](2000)}},
+			other:    []controlplane.Service{{Protocol: tcp, Port: ptr.To(intstr.FromInt32(80)), SrcPort: ptr.To[int32](1500)}},
+			expected: true,
+		},
+		{
+			name:     "source port not covered",
+			services: []controlplane.Service{{Protocol: tcp, SrcPort: ptr.To[int32](1000), SrcEndPort: ptr.To[int32](2000)}},
+			other:    []controlplane.Service{{Protocol: tcp, Port: ptr.To(intstr.FromInt32(80))}},
+		},
+		{
+			name:     "ICMP type",
+			services: []controlplane.Service{{Protocol: icmp, ICMPType: ptr.To[int32](8)}},
+			other:    []controlplane.Service{{Protocol: icmp, ICMPType: ptr.To[int32](8), ICMPCode: ptr.To[int32](0)}},
+			expected: true,
+		},
+		{
+			name:     "ICMP code not covered",
+			services: []controlplane.Service{{Protocol: icmp, ICMPType: ptr.To[int32](8), ICMPCode: ptr.To[int32](0)}},
+			other:    []controlplane.Service{{Protocol: icmp, ICMPType: ptr.To[int32](8)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, servicesCover(tt.services, tt.other))
+		})
+	}
+}
+
+func TestIPBlocksCover(t *testing.T) {
+	tests := []struct {
+		name     string
+		ipBlocks []controlplane.IPBlock
+		other    controlplane.IPBlock
+		expected bool
+	}{
+		{
+			name:     "contained CIDR",
+			ipBlocks: []controlplane.IPBlock{{CIDR: *mustCIDRStrToIPNet("10.0.0.0/16")}},
+			other:    controlplane.IPBlock{CIDR: *mustCIDRStrToIPNet("10.0.1.0/24")},
+			expected: true,
+		},
+		{
+			name:     "larger CIDR",
+			ipBlocks: []controlplane.IPBlock{{CIDR: *mustCIDRStrToIPNet("10.0.0.0/16")}},
+			other:    controlplane.IPBlock{CIDR: *mustCIDRStrToIPNet("10.0.0.0/8")},
+		},
+		{
+			name:     "different IP family",
+			ipBlocks: []controlplane.IPBlock{{CIDR: *mustCIDRStrToIPNet("0.0.0.0/0")}},
+			other:    controlplane.IPBlock{CIDR: *mustCIDRStrToIPNet("fd00::/64")},
+		},
+		{
+			name:     "exception outside the CIDR",
+			ipBlocks: []controlplane.IPBlock{{CIDR: *mustCIDRStrToIPNet("10.0.0.0/16"), Except: []controlplane.IPNet{*mustCIDRStrToIPNet("10.0.2.0/24")}}},
+			other:    controlplane.IPBlock{CIDR: *mustCIDRStrToIPNet("10.0.1.0/24")},
+			expected: true,
+		},
+		{
+			name:     "exception inside the CIDR",
+			ipBlocks: []controlplane.IPBlock{{CIDR: *mustCIDRStrToIPNet("10.0.0.0/16"), Except: []controlplane.IPNet{*mustCIDRStrToIPNet("10.0.1.128/25")}}},
+			other:    controlplane.IPBlock{CIDR: *mustCIDRStrToIPNet("10.0.1.0/24")},
+		},
+		{
+			name:     "exception also excepted",
+			ipBlocks: []controlplane.IPBlock{{CIDR: *mustCIDRStrToIPNet("10.0.0.0/16"), Except: []controlplane.IPNet{*mustCIDRStrToIPNet("10.0.1.128/25")}}},
+			other:    controlplane.IPBlock{CIDR: *mustCIDRStrToIPNet("10.0.1.0/24"), Except: []controlplane.IPNet{*mustCIDRStrToIPNet("10.0.1.0/24")}},
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ipBlocksCover(tt.ipBlocks, &tt.other))
+		})
+	}
+	assert.True(t, ipBlocksContain([]controlplane.IPBlock{{CIDR: *mustCIDRStrToIPNet("10.0.0.0/16")}}, net.ParseIP("10.0.0.1")))
+	assert.False(t, ipBlocksContain([]controlplane.IPBlock{{CIDR: *mustCIDRStrToIPNet("10.0.0.0/16"), Except: []controlplane.IPNet{*mustCIDRStrToIPNet("10.0.0.0/24")}}}, net.ParseIP("10.0.0.1")))
+}
@@ -0,0 +1,357 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+// maxPolicyImpactSize is the maximum number of source and destination Pod pairs which can be evaluated
+// to compute the impact of a candidate NetworkPolicy, to bound the time needed to compute the response.
+const maxPolicyImpactSize = 10000
+
+// QueryNetworkPolicyImpact returns the connections between Pods which are currently allowed and would be
+// denied if the provided candidate NetworkPolicy was created. If a NetworkPolicy of the same kind, Namespace
+// and name already exists, the candidate NetworkPolicy is evaluated as an update of it. The candidate
+// NetworkPolicy is processed in the same way as a created one, but neither its internal NetworkPolicy nor
+// its groups are added to the stores or to the grouping index, and the selectors registered for the existing
+// NetworkPolicy are left untouched. As the ClusterSet scoped selectors and the Service selectors are registered
+// while processing the peers of a policy, candidate Antrea-native policies using them are not supported.
+func (eq *EndpointQuerierImpl) QueryNetworkPolicyImpact(policy runtime.Object, source, destination *controlplane.PodReference) ([]controlplane.DeniedConnection, error) {
+	n := eq.networkPolicyController
+	var candidate *antreatypes.NetworkPolicy
+	var appliedToGroups map[string]*antreatypes.AppliedToGroup
+	var addressGroups map[string]*antreatypes.AddressGroup
+	switch p := policy.(type) {
+	case *crdv1beta1.ClusterNetworkPolicy:
+		// ClusterSet scoped selectors would be registered with the labelIdentityInterface.
+		if n.stretchNPEnabled && hasClusterSetScopedPeer(p.Spec.Ingress) {
+			return nil, errors.New("candidate NetworkPolicy with ClusterSet scoped peers is not supported")
+		}
+		// Service selectors would be registered with the groupingInterface.
+		if hasServiceSelectorPeer(p.Spec.Egress) {
+			return nil, errors.New("candidate NetworkPolicy with serviceSelector in toServices is not supported")
+		}
+		candidate, appliedToGroups, addressGroups, _ = n.computeClusterNetworkPolicy(p)
+	case *crdv1beta1.NetworkPolicy:
+		if n.stretchNPEnabled && hasClusterSetScopedPeer(p.Spec.Ingress) {
+			return nil, errors.New("candidate NetworkPolicy with ClusterSet scoped peers is not supported")
+		}
+		if hasServiceSelectorPeer(p.Spec.Egress) {
+			return nil, errors.New("candidate NetworkPolicy with serviceSelector in toServices is not supported")
+		}
+		candidate, appliedToGroups, addressGroups, _ = n.computeAntreaNetworkPolicy(p)
+	case *networkingv1.NetworkPolicy:
+		candidate, appliedToGroups, addressGroups = n.processNetworkPolicy(p)
+	default:
+		return nil, fmt.Errorf("unsupported candidate NetworkPolicy type %T", policy)
+	}
+
+	// The candidate NetworkPolicy applies to the Pods selected by its AppliedToGroups, either in the spec
+	// section or in rules. Like QueryNetworkPolicyRules, this doesn't differentiate per-rule AppliedTo.
+	appliedToPods := map[string]*v1.Pod{}
+	for _, atg := range appliedToGroups {
+		pods, err := eq.getCandidateGroupPods(atg.Selector, atg.SourceGroup)
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range pods {
+			appliedToPods[k8s.NamespacedName(pod.Namespace, pod.Name)] = pod
+		}
+	}
+	if len(appliedToPods) == 0 {
+		return nil, nil
+	}
+	addressGroupPods := map[string]sets.Set[string]{}
+	for _, ag := range addressGroups {
+		pods, err := eq.getCandidateGroupPods(ag.Selector, ag.SourceGroup)
+		if err != nil {
+			return nil, err
+		}
+		podKeys := sets.New[string]()
+		for _, pod := range pods {
+			podKeys.Insert(k8s.NamespacedName(pod.Namespace, pod.Name))
+		}
+		addressGroupPods[ag.Name] = podKeys
+	}
+	// Compute the candidate rules which select each Pod as a peer, in the same way as QueryNetworkPolicyRules.
+	candidateIngressSrcRules := map[string][]*antreatypes.RuleInfo{}
+	candidateEgressDstRules := map[string][]*antreatypes.RuleInfo{}
+	egressIndex, ingressIndex := int32(0), int32(0)
+	for _, rule := range candidate.Rules {
+		ruleInfo := &antreatypes.RuleInfo{Policy: candidate, Rule: &controlplane.NetworkPolicyRule{Direction: rule.Direction, Name: rule.Name, Action: rule.Action}}
+		if rule.Direction == controlplane.DirectionIn {
+			ruleInfo.Index = ingressIndex
+			for _, ag := range rule.From.AddressGroups {
+				for podKey := range addressGroupPods[ag] {
+					candidateIngressSrcRules[podKey] = append(candidateIngressSrcRules[podKey], ruleInfo)
+				}
+			}
+			ingressIndex++
+		} else {
+			ruleInfo.Index = egressIndex
+			for _, ag := range rule.To.AddressGroups {
+				for podKey := range addressGroupPods[ag] {
+					candidateEgressDstRules[podKey] = append(candidateEgressDstRules[podKey], ruleInfo)
+				}
+			}
+			egressIndex++
+		}
+	}
+
+	// Any Pod may communicate with the Pods the candidate NetworkPolicy applies to.
+	allPods, err := eq.getCandidateGroupPods(antreatypes.NewGroupSelector("", &metav1.LabelSelector{}, nil, nil, nil), "")
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(allPods, func(i, j int) bool {
+		return k8s.NamespacedName(allPods[i].Namespace, allPods[i].Name) < k8s.NamespacedName(allPods[j].Namespace, allPods[j].Name)
+	})
+	// Connections between Pods the candidate NetworkPolicy doesn't apply to can't be affected, hence only the
+	// connections from or to the Pods it applies to are evaluated.
+	var srcPods, dstPods, appliedToSrcPods, appliedToDstPods []*v1.Pod
+	evaluatedPods := map[string]*v1.Pod{}
+	for _, pod := range allPods {
+		podKey := k8s.NamespacedName(pod.Namespace, pod.Name)
+		_, isAppliedTo := appliedToPods[podKey]
+		if matchPodReference(source, pod) {
+			srcPods = append(srcPods, pod)
+			evaluatedPods[podKey] = pod
+			if isAppliedTo {
+				appliedToSrcPods = append(appliedToSrcPods, pod)
+			}
+		}
+		if matchPodReference(destination, pod) {
+			dstPods = append(dstPods, pod)
+			evaluatedPods[podKey] = pod
+			if isAppliedTo {
+				appliedToDstPods = append(appliedToDstPods, pod)
+			}
+		}
+	}
+	if size := len(srcPods)*len(appliedToDstPods) + len(appliedToSrcPods)*len(dstPods) - len(appliedToSrcPods)*len(appliedToDstPods); size > maxPolicyImpactSize {
+		return nil, fmt.Errorf("the candidate NetworkPolicy affects %d source and destination Pod pairs, which exceeds the maximum of %d, please specify a source or destination Pod", size, maxPolicyImpactSize)
+	}
+	currentRules := make(map[string]*antreatypes.EndpointNetworkPolicyRules, len(evaluatedPods))
+	candidateRules := make(map[string]*antreatypes.EndpointNetworkPolicyRules, len(evaluatedPods))
+	for podKey, pod := range evaluatedPods {
+		rules, err := eq.QueryNetworkPolicyRules(pod.Namespace, pod.Name)
+		if err != nil {
+			return nil, err
+		}
+		currentRules[podKey] = rules
+		simulatedRules := &antreatypes.EndpointNetworkPolicyRules{Namespace: pod.Namespace, Name: pod.Name}
+		if rules != nil {
+			// The candidate NetworkPolicy replaces the existing NetworkPolicy with the same identity, if any.
+			for _, p := range rules.AppliedPolicies {
+				if !isSamePolicy(p.SourceRef, candidate.SourceRef) {
+					simulatedRules.AppliedPolicies = append(simulatedRules.AppliedPolicies, p)
+				}
+			}
+			simulatedRules.EndpointAsIngressSrcRules = filterRulesOfPolicy(rules.EndpointAsIngressSrcRules, candidate.SourceRef)
+			simulatedRules.EndpointAsEgressDstRules = filterRulesOfPolicy(rules.EndpointAsEgressDstRules, candidate.SourceRef)
+		}
+		if _, ok := appliedToPods[podKey]; ok {
+			simulatedRules.AppliedPolicies = append(simulatedRules.AppliedPolicies, candidate)
+		}
+		simulatedRules.EndpointAsIngressSrcRules = append(simulatedRules.EndpointAsIngressSrcRules, candidateIngressSrcRules[podKey]...)
+		simulatedRules.EndpointAsEgressDstRules = append(simulatedRules.EndpointAsEgressDstRules, candidateEgressDstRules[podKey]...)
+		candidateRules[podKey] = simulatedRules
+	}
+
+	var deniedConnections []controlplane.DeniedConnection
+	evaluate := func(srcPod, dstPod *v1.Pod) {
+		srcKey, dstKey := k8s.NamespacedName(srcPod.Namespace, srcPod.Name), k8s.NamespacedName(dstPod.Namespace, dstPod.Name)
+		if isDeniedByRule(predictEndpointsRules(currentRules[srcKey], currentRules[dstKey])) {
+			return
+		}
+		rule := predictEndpointsRules(candidateRules[srcKey], candidateRules[dstKey])
+		if !isDeniedByRule(rule) {
+			return
+		}
+		deniedConnections = append(deniedConnections, controlplane.DeniedConnection{
+			Source:        controlplane.PodReference{Namespace: srcPod.Namespace, Name: srcPod.Name},
+			Destination:   controlplane.PodReference{Namespace: dstPod.Namespace, Name: dstPod.Name},
+			NetworkPolicy: *rule.Policy.SourceRef,
+			RuleIndex:     rule.Index,
+			Rule: controlplane.RuleRef{
+				Direction: rule.Rule.Direction,
+				Name:      rule.Rule.Name,
+				Action:    rule.Rule.Action,
+			},
+		})
+	}
+	for _, srcPod := range srcPods {
+		evaluatedDstPods := appliedToDstPods
+		if _, ok := appliedToPods[k8s.NamespacedName(srcPod.Namespace, srcPod.Name)]; ok {
+			evaluatedDstPods = dstPods
+		}
+		for _, dstPod := range evaluatedDstPods {
+			if srcPod != dstPod {
+				evaluate(srcPod, dstPod)
+			}
+		}
+	}
+	return deniedConnections, nil
+}
+
+// getCandidateGroupPods returns the Pods selected by a group of a candidate NetworkPolicy. Unlike
+// getAppliedToWorkloads and getAddressGroupMemberSet, it doesn't require the group to be in the
+// grouping index. Pods which can't be group members are excluded.
+func (eq *EndpointQuerierImpl) getCandidateGroupPods(selector *antreatypes.GroupSelector, sourceGroup string) ([]*v1.Pod, error) {
+	n := eq.networkPolicyController
+	var pods []*v1.Pod
+	if sourceGroup != "" {
+		groupObj, found, _ := n.internalGroupStore.Get(sourceGroup)
+		if !found {
+			return nil, nil
+		}
+		var err error
+		pods, _, err = n.getInternalGroupWorkloads(groupObj.(*antreatypes.Group))
+		if err != nil {
+			return nil, err
+		}
+	} else if selector.NodeSelector == nil {
+		pods, _ = n.groupingInterface.GetEntitiesBySelector(selector)
+	}
+	var selectedPods []*v1.Pod
+	for _, pod := range pods {
+		// Same as getMemberSetForGroupType.
+		if pod.Spec.HostNetwork || k8s.IsPodTerminated(pod) || len(pod.Status.PodIPs) == 0 {
+			continue
+		}
+		selectedPods = append(selectedPods, pod)
+	}
+	return selectedPods, nil
+}
+
+// decodeCandidatePolicy decodes the candidate NetworkPolicy of a NetworkPolicyEvaluation request and
+// applies the defaults which would be set by the K8s API server when creating it.
+func decodeCandidatePolicy(raw *runtime.RawExtension) (runtime.Object, error) {
+	if raw.Object != nil {
+		return raw.Object, nil
+	}
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(raw.Raw, &typeMeta); err != nil {
+		return nil, fmt.Errorf("invalid candidate NetworkPolicy: %w", err)
+	}
+	var policy metav1.Object
+	switch gvk := typeMeta.GroupVersionKind(); gvk {
+	case crdv1beta1.SchemeGroupVersion.WithKind("ClusterNetworkPolicy"):
+		policy = &crdv1beta1.ClusterNetworkPolicy{}
+	case crdv1beta1.SchemeGroupVersion.WithKind("NetworkPolicy"):
+		policy = &crdv1beta1.NetworkPolicy{}
+	case networkingv1.SchemeGroupVersion.WithKind("NetworkPolicy"):
+		policy = &networkingv1.NetworkPolicy{}
+	default:
+		return nil, fmt.Errorf("unsupported candidate NetworkPolicy kind %q", gvk.String())
+	}
+	if err := json.Unmarshal(raw.Raw, policy); err != nil {
+		return nil, fmt.Errorf("invalid candidate NetworkPolicy: %w", err)
+	}
+	switch p := policy.(type) {
+	case *crdv1beta1.NetworkPolicy:
+		if p.Namespace == "" {
+			p.Namespace = metav1.NamespaceDefault
+		}
+	case *networkingv1.NetworkPolicy:
+		if p.Namespace == "" {
+			p.Namespace = metav1.NamespaceDefault
+		}
+		if len(p.Spec.PolicyTypes) == 0 {
+			p.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+			if len(p.Spec.Egress) > 0 {
+				p.Spec.PolicyTypes = append(p.Spec.PolicyTypes, networkingv1.PolicyTypeEgress)
+			}
+		}
+	}
+	return policy.(runtime.Object), nil
+}
+
+// hasClusterSetScopedPeer returns true if any ingress rule has a ClusterSet scoped peer.
+func hasClusterSetScopedPeer(ingressRules []crdv1beta1.Rule) bool {
+	for _, rule := range ingressRules {
+		for _, peer := range rule.From {
+			if peer.Scope == crdv1beta1.ScopeClusterSet {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasServiceSelectorPeer(egressRules []crdv1beta1.Rule) bool {
+	for _, rule := range egressRules {
+		for _, svc := range rule.ToServices {
+			if svc.ServiceSelector != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isSamePolicy returns true if both references point to the same NetworkPolicy, regardless of its UID.
+func isSamePolicy(ref1, ref2 *controlplane.NetworkPolicyReference) bool {
+	return ref1.Type == ref2.Type && ref1.Namespace == ref2.Namespace && ref1.Name == ref2.Name
+}
+
+// filterRulesOfPolicy returns the rules which don't belong to the NetworkPolicy with the provided reference.
+func filterRulesOfPolicy(rules []*antreatypes.RuleInfo, ref *controlplane.NetworkPolicyReference) []*antreatypes.RuleInfo {
+	var filtered []*antreatypes.RuleInfo
+	for _, rule := range rules {
+		if !isSamePolicy(rule.Policy.SourceRef, ref) {
+			filtered = append(filtered, rule)
+		}
+	}
+	return filtered
+}
+
+// isDeniedByRule returns true if the provided effective rule denies the connection. The rules of
+// NetworkPolicies in Audit mode are never effective, see predictEndpointsRules.
+func isDeniedByRule(rule *antreatypes.RuleInfo) bool {
+	if rule == nil {
+		return false
+	}
+	if rule.Rule.Action == nil {
+		// K8s NetworkPolicy isolation rules are identified by a MaxInt32 rule index.
+		return rule.Index == math.MaxInt32
+	}
+	return *rule.Rule.Action == crdv1beta1.RuleActionDrop || *rule.Rule.Action == crdv1beta1.RuleActionReject
+}
+
+// matchPodReference returns true if the Pod matches the provided filter. Empty fields of the filter match
+// any value, and a nil filter matches all Pods.
+func matchPodReference(filter *controlplane.PodReference, pod *v1.Pod) bool {
+	if filter == nil {
+		return true
+	}
+	return (filter.Namespace == "" || filter.Namespace == pod.Namespace) && (filter.Name == "" || filter.Name == pod.Name)
+}
@@ -0,0 +1,326 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	queriermock "antrea.io/antrea/pkg/controller/networkpolicy/testing"
+)
+
+func newImpactTestPod(name, ip string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "impact", Name: name, Labels: map[string]string{"app": name}},
+		Spec:       corev1.PodSpec{NodeName: "nodeA"},
+		Status: corev1.PodStatus{
+			PodIP:  ip,
+			PodIPs: []corev1.PodIP{{IP: ip}},
+		},
+	}
+}
+
+func TestQueryNetworkPolicyImpact(t *testing.T) {
+	impactNamespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "impact", UID: "impactUID"}}
+	webPod, dbPod, clientPod := newImpactTestPod("web", "10.0.0.1"), newImpactTestPod("db", "10.0.0.2"), newImpactTestPod("client", "10.0.0.3")
+	allowWebToDB := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "impact", Name: "allow-web-to-db", UID: "uid-allow-web-to-db"},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From: []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}}},
+			}},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+	existingObjects := []runtime.Object{impactNamespace, webPod, dbPod, clientPod, allowWebToDB}
+	podRef := func(pod *corev1.Pod) controlplane.PodReference {
+		return controlplane.PodReference{Namespace: pod.Namespace, Name: pod.Name}
+	}
+	isolationRule := controlplane.RuleRef{Direction: controlplane.DirectionIn}
+
+	denyAllIngress := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "impact", Name: "deny-all-ingress"},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+	denyAllIngressRef := controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: "impact", Name: "deny-all-ingress"}
+	updatedAllowWebToDB := allowWebToDB.DeepCopy()
+	updatedAllowWebToDB.UID = ""
+	updatedAllowWebToDB.Spec.Ingress[0].From[0].PodSelector.MatchLabels["app"] = "client"
+	allowWebToDBRef := controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: "impact", Name: "allow-web-to-db"}
+	dropFromWeb := &crdv1beta1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "impact", Name: "drop-from-web"},
+		Spec: crdv1beta1.NetworkPolicySpec{
+			Priority:  1,
+			AppliedTo: []crdv1beta1.AppliedTo{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}}},
+			Ingress: []crdv1beta1.Rule{{
+				Name:   "drop-web",
+				Action: ptr.To(crdv1beta1.RuleActionDrop),
+				From:   []crdv1beta1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}}},
+			}},
+		},
+	}
+	auditDropFromWeb := dropFromWeb.DeepCopy()
+	auditDropFromWeb.Spec.EnforcementMode = crdv1beta1.PolicyEnforcementModeAudit
+	allowFromClient := &crdv1beta1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "impact", Name: "allow-from-client"},
+		Spec: crdv1beta1.NetworkPolicySpec{
+			Priority:  1,
+			AppliedTo: []crdv1beta1.AppliedTo{{PodSelector: &metav1.LabelSelector{}}},
+			Ingress: []crdv1beta1.Rule{{
+				Name:   "allow-client",
+				Action: ptr.To(crdv1beta1.RuleActionAllow),
+				From:   []crdv1beta1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "client"}}}},
+			}},
+		},
+	}
+	rejectToDB := &crdv1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "reject-to-db"},
+		Spec: crdv1beta1.ClusterNetworkPolicySpec{
+			Priority:  1,
+			AppliedTo: []crdv1beta1.AppliedTo{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}}},
+			Ingress: []crdv1beta1.Rule{{
+				Name:   "reject-all",
+				Action: ptr.To(crdv1beta1.RuleActionReject),
+				From:   []crdv1beta1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}, NamespaceSelector: &metav1.LabelSelector{}}},
+			}},
+		},
+	}
+
+	testCases := []struct {
+		name                      string
+		candidate                 runtime.Object
+		source                    *controlplane.PodReference
+		destination               *controlplane.PodReference
+		expectedDeniedConnections []controlplane.DeniedConnection
+		expectedErr               string
+	}{
+		{
+			name:      "K8s NetworkPolicy isolating all Pods",
+			candidate: denyAllIngress,
+			expectedDeniedConnections: []controlplane.DeniedConnection{
+				{Source: podRef(clientPod), Destination: podRef(webPod), NetworkPolicy: denyAllIngressRef, RuleIndex: math.MaxInt32, Rule: isolationRule},
+				{Source: podRef(dbPod), Destination: podRef(clientPod), NetworkPolicy: denyAllIngressRef, RuleIndex: math.MaxInt32, Rule: isolationRule},
+				{Source: podRef(dbPod), Destination: podRef(webPod), NetworkPolicy: denyAllIngressRef, RuleIndex: math.MaxInt32, Rule: isolationRule},
+				{Source: podRef(webPod), Destination: podRef(clientPod), NetworkPolicy: denyAllIngressRef, RuleIndex: math.MaxInt32, Rule: isolationRule},
+			},
+		},
+		{
+			name:        "K8s NetworkPolicy isolating all Pods with destination filter",
+			candidate:   denyAllIngress,
+			destination: &controlplane.PodReference{Namespace: "impact", Name: "client"},
+			expectedDeniedConnections: []controlplane.DeniedConnection{
+				{Source: podRef(dbPod), Destination: podRef(clientPod), NetworkPolicy: denyAllIngressRef, RuleIndex: math.MaxInt32, Rule: isolationRule},
+				{Source: podRef(webPod), Destination: podRef(clientPod), NetworkPolicy: denyAllIngressRef, RuleIndex: math.MaxInt32, Rule: isolationRule},
+			},
+		},
+		{
+			name:      "update of existing K8s NetworkPolicy",
+			candidate: updatedAllowWebToDB,
+			expectedDeniedConnections: []controlplane.DeniedConnection{
+				{Source: podRef(webPod), Destination: podRef(dbPod), NetworkPolicy: allowWebToDBRef, RuleIndex: math.MaxInt32, Rule: isolationRule},
+			},
+		},
+		{
+			name:      "Antrea NetworkPolicy dropping traffic",
+			candidate: dropFromWeb,
+			expectedDeniedConnections: []controlplane.DeniedConnection{
+				{
+					Source:        podRef(webPod),
+					Destination:   podRef(dbPod),
+					NetworkPolicy: controlplane.NetworkPolicyReference{Type: controlplane.AntreaNetworkPolicy, Namespace: "impact", Name: "drop-from-web"},
+					Rule:          controlplane.RuleRef{Direction: controlplane.DirectionIn, Name: "drop-web", Action: ptr.To(crdv1beta1.RuleActionDrop)},
+				},
+			},
+		},
+		{
+			name:      "Antrea NetworkPolicy dropping traffic in Audit mode",
+			candidate: auditDropFromWeb,
+		},
+		{
+			name:      "Antrea NetworkPolicy allowing traffic",
+			candidate: allowFromClient,
+		},
+		{
+			name:      "Antrea ClusterNetworkPolicy rejecting traffic with source filter",
+			candidate: rejectToDB,
+			source:    &controlplane.PodReference{Namespace: "impact", Name: "web"},
+			expectedDeniedConnections: []controlplane.DeniedConnection{
+				{
+					Source:        podRef(webPod),
+					Destination:   podRef(dbPod),
+					NetworkPolicy: controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "reject-to-db"},
+					Rule:          controlplane.RuleRef{Direction: controlplane.DirectionIn, Name: "reject-all", Action: ptr.To(crdv1beta1.RuleActionReject)},
+				},
+			},
+		},
+		{
+			name:        "unsupported candidate",
+			candidate:   &corev1.Pod{},
+			expectedErr: "unsupported candidate NetworkPolicy type *v1.Pod",
+		},
+	}
+	endpointQuerier := makeControllerAndEndpointQuerier(existingObjects...)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deniedConnections, err := endpointQuerier.QueryNetworkPolicyImpact(tc.candidate, tc.source, tc.destination)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedDeniedConnections, deniedConnections)
+		})
+	}
+}
+
+func TestDecodeCandidatePolicy(t *testing.T) {
+	testCases := []struct {
+		name           string
+		raw            string
+		expectedPolicy runtime.Object
+		expectedErr    string
+	}{
+		{
+			name: "K8s NetworkPolicy with defaults",
+			raw:  `{"apiVersion":"networking.k8s.io/v1","kind":"NetworkPolicy","metadata":{"name":"np"},"spec":{"podSelector":{},"egress":[{}]}}`,
+			expectedPolicy: &networkingv1.NetworkPolicy{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "networking.k8s.io/v1", Kind: "NetworkPolicy"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "np"},
+				Spec: networkingv1.NetworkPolicySpec{
+					Egress:      []networkingv1.NetworkPolicyEgressRule{{}},
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+				},
+			},
+		},
+		{
+			name: "Antrea ClusterNetworkPolicy",
+			raw:  `{"apiVersion":"crd.antrea.io/v1beta1","kind":"ClusterNetworkPolicy","metadata":{"name":"acnp"},"spec":{"priority":5}}`,
+			expectedPolicy: &crdv1beta1.ClusterNetworkPolicy{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "crd.antrea.io/v1beta1", Kind: "ClusterNetworkPolicy"},
+				ObjectMeta: metav1.ObjectMeta{Name: "acnp"},
+				Spec:       crdv1beta1.ClusterNetworkPolicySpec{Priority: 5},
+			},
+		},
+		{
+			name: "Antrea NetworkPolicy",
+			raw:  `{"apiVersion":"crd.antrea.io/v1beta1","kind":"NetworkPolicy","metadata":{"namespace":"ns1","name":"anp"},"spec":{"priority":5}}`,
+			expectedPolicy: &crdv1beta1.NetworkPolicy{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "crd.antrea.io/v1beta1", Kind: "NetworkPolicy"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "anp"},
+				Spec:       crdv1beta1.NetworkPolicySpec{Priority: 5},
+			},
+		},
+		{
+			name:        "unsupported kind",
+			raw:         `{"apiVersion":"crd.antrea.io/v1beta1","kind":"ClusterGroup","metadata":{"name":"cg"}}`,
+			expectedErr: `unsupported candidate NetworkPolicy kind "crd.antrea.io/v1beta1, Kind=ClusterGroup"`,
+		},
+		{
+			name:        "invalid JSON",
+			raw:         `{"apiVersion"`,
+			expectedErr: "invalid candidate NetworkPolicy",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy, err := decodeCandidatePolicy(&runtime.RawExtension{Raw: []byte(tc.raw)})
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPolicy, policy)
+		})
+	}
+}
+
+func TestQueryNetworkPolicyEvaluationWithCandidate(t *testing.T) {
+	candidate := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "np"}}
+	destination := &controlplane.PodReference{Namespace: "ns1", Name: "pod1"}
+	deniedConnections := []controlplane.DeniedConnection{{
+		Source:        controlplane.PodReference{Namespace: "ns1", Name: "pod2"},
+		Destination:   *destination,
+		NetworkPolicy: controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: "ns1", Name: "np"},
+		RuleIndex:     math.MaxInt32,
+		Rule:          controlplane.RuleRef{Direction: controlplane.DirectionIn},
+	}}
+	testCases := []struct {
+		name             string
+		request          *controlplane.NetworkPolicyEvaluationRequest
+		expectedCall     func(mockQuerier *queriermock.MockEndpointQuerierMockRecorder)
+		expectedResponse *controlplane.NetworkPolicyEvaluationResponse
+		expectedErr      string
+	}{
+		{
+			name: "denied connections",
+			request: &controlplane.NetworkPolicyEvaluationRequest{
+				Destination:     controlplane.Entity{Pod: destination},
+				CandidatePolicy: &runtime.RawExtension{Object: candidate},
+			},
+			expectedCall: func(mockQuerier *queriermock.MockEndpointQuerierMockRecorder) {
+				mockQuerier.QueryNetworkPolicyImpact(candidate, nil, destination).Return(deniedConnections, nil)
+			},
+			expectedResponse: &controlplane.NetworkPolicyEvaluationResponse{DeniedConnections: deniedConnections},
+		},
+		{
+			name: "no denied connection",
+			request: &controlplane.NetworkPolicyEvaluationRequest{
+				CandidatePolicy: &runtime.RawExtension{Object: candidate},
+			},
+			expectedCall: func(mockQuerier *queriermock.MockEndpointQuerierMockRecorder) {
+				mockQuerier.QueryNetworkPolicyImpact(candidate, nil, nil).Return(nil, nil)
+			},
+			expectedResponse: &controlplane.NetworkPolicyEvaluationResponse{},
+		},
+		{
+			name: "invalid candidate",
+			request: &controlplane.NetworkPolicyEvaluationRequest{
+				CandidatePolicy: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"Pod"}`)},
+			},
+			expectedErr: "unsupported candidate NetworkPolicy kind",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockQuerier := queriermock.NewMockEndpointQuerier(mockCtrl)
+			if tc.expectedCall != nil {
+				tc.expectedCall(mockQuerier.EXPECT())
+			}
+			response, err := NewPolicyRuleQuerier(mockQuerier).QueryNetworkPolicyEvaluation(tc.request)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResponse, response)
+		})
+	}
+}
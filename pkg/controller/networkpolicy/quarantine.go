@@ -0,0 +1,211 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+const (
+	// quarantineLabelKey is the label added to quarantined Pods and to the ClusterNetworkPolicies
+	// isolating them. Its value is the UID of the quarantined Pod.
+	quarantineLabelKey = "antrea.io/quarantine"
+	// quarantinedPodAnnotationKey is the annotation of the ClusterNetworkPolicies isolating
+	// quarantined Pods, its value is the Namespace and name of the quarantined Pod.
+	quarantinedPodAnnotationKey = "antrea.io/quarantined-pod"
+	// quarantinePolicyPriority is the priority of the quarantine policies in the Emergency Tier.
+	quarantinePolicyPriority = float64(1)
+	// namespaceNameLabelKey is the label set by K8s on all Namespaces, with their name as value.
+	namespaceNameLabelKey = "kubernetes.io/metadata.name"
+)
+
+// quarantineResourceName returns the name of the ClusterNetworkPolicy and of the PacketCapture
+// created to quarantine a Pod.
+func quarantineResourceName(pod *v1.Pod) string {
+	return "quarantine-" + string(pod.UID)
+}
+
+// newQuarantinePolicy returns a ClusterNetworkPolicy in the Emergency Tier which drops all traffic
+// of the Pod, except the traffic with the allowed Namespaces and CIDRs of the spec.
+func newQuarantinePolicy(pod *v1.Pod, spec *controlplane.PodQuarantineSpec) *crdv1beta1.ClusterNetworkPolicy {
+	var allowedPeers []crdv1beta1.NetworkPolicyPeer
+	if len(spec.AllowedNamespaces) > 0 {
+		allowedPeers = append(allowedPeers, crdv1beta1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: namespaceNameLabelKey, Operator: metav1.LabelSelectorOpIn, Values: spec.AllowedNamespaces},
+				},
+			},
+		})
+	}
+	for _, cidr := range spec.AllowedCIDRs {
+		allowedPeers = append(allowedPeers, crdv1beta1.NetworkPolicyPeer{IPBlock: &crdv1beta1.IPBlock{CIDR: cidr}})
+	}
+	var ingress, egress []crdv1beta1.Rule
+	if len(allowedPeers) > 0 {
+		ingress = append(ingress, crdv1beta1.Rule{Name: "AllowIngress", Action: ptr.To(crdv1beta1.RuleActionAllow), From: allowedPeers})
+		egress = append(egress, crdv1beta1.Rule{Name: "AllowEgress", Action: ptr.To(crdv1beta1.RuleActionAllow), To: allowedPeers})
+	}
+	// Rules without peers match all sources or destinations.
+	ingress = append(ingress, crdv1beta1.Rule{Name: "DropIngress", Action: ptr.To(crdv1beta1.RuleActionDrop)})
+	egress = append(egress, crdv1beta1.Rule{Name: "DropEgress", Action: ptr.To(crdv1beta1.RuleActionDrop)})
+	return &crdv1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        quarantineResourceName(pod),
+			Labels:      map[string]string{quarantineLabelKey: string(pod.UID)},
+			Annotations: map[string]string{quarantinedPodAnnotationKey: k8s.NamespacedName(pod.Namespace, pod.Name)},
+		},
+		Spec: crdv1beta1.ClusterNetworkPolicySpec{
+			Tier:     emergencyTierName,
+			Priority: quarantinePolicyPriority,
+			AppliedTo: []crdv1beta1.AppliedTo{
+				{
+					PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{quarantineLabelKey: string(pod.UID)}},
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabelKey: pod.Namespace}},
+				},
+			},
+			Ingress: ingress,
+			Egress:  egress,
+		},
+	}
+}
+
+// newQuarantinePacketCapture returns a PacketCapture capturing the packets sent and received by the Pod.
+func newQuarantinePacketCapture(pod *v1.Pod, config *controlplane.PodQuarantinePacketCapture) *crdv1alpha1.PacketCapture {
+	pc := &crdv1alpha1.PacketCapture{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   quarantineResourceName(pod),
+			Labels: map[string]string{quarantineLabelKey: string(pod.UID)},
+		},
+		Spec: crdv1alpha1.PacketCaptureSpec{
+			CaptureConfig: crdv1alpha1.CaptureConfig{
+				FirstN: &crdv1alpha1.PacketCaptureFirstNConfig{Number: config.Number},
+			},
+			Source: crdv1alpha1.Source{
+				Pod: &crdv1alpha1.PodReference{Namespace: pod.Namespace, Name: pod.Name},
+			},
+			Direction: crdv1alpha1.CaptureDirectionBoth,
+		},
+	}
+	if config.Timeout > 0 {
+		pc.Spec.Timeout = ptr.To(config.Timeout)
+	}
+	return pc
+}
+
+// patchQuarantineLabel adds the quarantine label to the Pod, or removes it if value is nil.
+func (n *NetworkPolicyController) patchQuarantineLabel(ctx context.Context, namespace, name string, value *string) error {
+	patch, _ := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]*string{quarantineLabelKey: value},
+		},
+	})
+	_, err := n.kubeClient.CoreV1().Pods(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// QuarantinePod isolates a Pod by creating a ClusterNetworkPolicy in the Emergency Tier which
+// drops all its traffic except the traffic with the allowed peers, and by adding the label
+// selected by the policy to the Pod. It also starts a PacketCapture for the Pod if requested.
+// If any step fails, the previous steps are rolled back, so that the request can be retried.
+func (n *NetworkPolicyController) QuarantinePod(ctx context.Context, namespace, name string, spec *controlplane.PodQuarantineSpec) (*controlplane.PodQuarantineStatus, error) {
+	pod, err := n.kubeClient.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// The policy is created before labelling the Pod, so that the Pod is isolated as soon as it
+	// is labelled.
+	policy := newQuarantinePolicy(pod, spec)
+	if _, err := n.crdClient.CrdV1beta1().ClusterNetworkPolicies().Create(ctx, policy, metav1.CreateOptions{}); err != nil {
+		if errors.IsAlreadyExists(err) {
+			return nil, errors.NewConflict(controlplane.Resource("podquarantines"), name, fmt.Errorf("the Pod is already quarantined"))
+		}
+		return nil, fmt.Errorf("error when creating quarantine policy: %w", err)
+	}
+	if err := n.patchQuarantineLabel(ctx, namespace, name, ptr.To(string(pod.UID))); err != nil {
+		n.deleteQuarantinePolicy(ctx, policy.Name)
+		return nil, fmt.Errorf("error when labelling Pod: %w", err)
+	}
+	status := &controlplane.PodQuarantineStatus{PolicyName: policy.Name}
+	if spec.PacketCapture != nil {
+		pc := newQuarantinePacketCapture(pod, spec.PacketCapture)
+		if _, err := n.crdClient.CrdV1alpha1().PacketCaptures().Create(ctx, pc, metav1.CreateOptions{}); err != nil {
+			if patchErr := n.patchQuarantineLabel(ctx, namespace, name, nil); patchErr != nil {
+				klog.ErrorS(patchErr, "Failed to remove quarantine label from Pod", "pod", klog.KObj(pod))
+			} else {
+				n.deleteQuarantinePolicy(ctx, policy.Name)
+			}
+			return nil, fmt.Errorf("error when creating PacketCapture: %w", err)
+		}
+		status.PacketCaptureName = pc.Name
+	}
+	klog.InfoS("Quarantined Pod", "pod", klog.KObj(pod), "policy", policy.Name)
+	return status, nil
+}
+
+// deleteQuarantinePolicy deletes a quarantine policy when rolling back QuarantinePod.
+func (n *NetworkPolicyController) deleteQuarantinePolicy(ctx context.Context, name string) {
+	if err := n.crdClient.CrdV1beta1().ClusterNetworkPolicies().Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+		klog.ErrorS(err, "Failed to delete quarantine policy", "policy", name)
+	}
+}
+
+// ReleasePod undoes QuarantinePod, by removing the quarantine label from the Pod and by deleting
+// the ClusterNetworkPolicies isolating the Pod. The label is removed first, so that the policies
+// can still be found if the request fails and is retried. The PacketCapture started for the Pod,
+// if any, is kept so that the captured packets remain available.
+func (n *NetworkPolicyController) ReleasePod(ctx context.Context, namespace, name string) error {
+	// The policies are looked up by annotation, so that they can be deleted even if the Pod no
+	// longer exists.
+	policies, err := n.acnpLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	podName := k8s.NamespacedName(namespace, name)
+	var quarantinePolicies []string
+	for _, policy := range policies {
+		if _, ok := policy.Labels[quarantineLabelKey]; ok && policy.Annotations[quarantinedPodAnnotationKey] == podName {
+			quarantinePolicies = append(quarantinePolicies, policy.Name)
+		}
+	}
+	if len(quarantinePolicies) == 0 {
+		return errors.NewNotFound(controlplane.Resource("podquarantines"), name)
+	}
+	if err := n.patchQuarantineLabel(ctx, namespace, name, nil); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("error when removing quarantine label from Pod: %w", err)
+	}
+	for _, policyName := range quarantinePolicies {
+		if err := n.crdClient.CrdV1beta1().ClusterNetworkPolicies().Delete(ctx, policyName, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("error when deleting quarantine policy %s: %w", policyName, err)
+		}
+	}
+	klog.InfoS("Released Pod", "pod", klog.KRef(namespace, name))
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	fakeversioned "antrea.io/antrea/pkg/client/clientset/versioned/fake"
+)
+
+func TestNewQuarantinePolicy(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod1", UID: "uid1"}}
+	appliedTo := []crdv1beta1.AppliedTo{
+		{
+			PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{quarantineLabelKey: "uid1"}},
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabelKey: "ns1"}},
+		},
+	}
+	dropIngress := crdv1beta1.Rule{Name: "DropIngress", Action: ptr.To(crdv1beta1.RuleActionDrop)}
+	dropEgress := crdv1beta1.Rule{Name: "DropEgress", Action: ptr.To(crdv1beta1.RuleActionDrop)}
+	allowedPeers := []crdv1beta1.NetworkPolicyPeer{
+		{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: namespaceNameLabelKey, Operator: metav1.LabelSelectorOpIn, Values: []string{"forensics"}},
+				},
+			},
+		},
+		{IPBlock: &crdv1beta1.IPBlock{CIDR: "10.10.0.5/32"}},
+	}
+	tests := []struct {
+		name            string
+		spec            *controlplane.PodQuarantineSpec
+		expectedIngress []crdv1beta1.Rule
+		expectedEgress  []crdv1beta1.Rule
+	}{
+		{
+			name:            "drop all",
+			spec:            &controlplane.PodQuarantineSpec{},
+			expectedIngress: []crdv1beta1.Rule{dropIngress},
+			expectedEgress:  []crdv1beta1.Rule{dropEgress},
+		},
+		{
+			name: "allowed peers",
+			spec: &controlplane.PodQuarantineSpec{
+				AllowedNamespaces: []string{"forensics"},
+				AllowedCIDRs:      []string{"10.10.0.5/32"},
+			},
+			expectedIngress: []crdv1beta1.Rule{
+				{Name: "AllowIngress", Action: ptr.To(crdv1beta1.RuleActionAllow), From: allowedPeers},
+				dropIngress,
+			},
+			expectedEgress: []crdv1beta1.Rule{
+				{Name: "AllowEgress", Action: ptr.To(crdv1beta1.RuleActionAllow), To: allowedPeers},
+				dropEgress,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := newQuarantinePolicy(pod, tt.spec)
+			assert.Equal(t, "quarantine-uid1", policy.Name)
+			assert.Equal(t, map[string]string{quarantineLabelKey: "uid1"}, policy.Labels)
+			assert.Equal(t, map[string]string{quarantinedPodAnnotationKey: "ns1/pod1"}, policy.Annotations)
+			assert.Equal(t, emergencyTierName, policy.Spec.Tier)
+			assert.Equal(t, quarantinePolicyPriority, policy.Spec.Priority)
+			assert.Equal(t, appliedTo, policy.Spec.AppliedTo)
+			assert.Equal(t, tt.expectedIngress, policy.Spec.Ingress)
+			assert.Equal(t, tt.expectedEgress, policy.Spec.Egress)
+		})
+	}
+}
+
+func TestQuarantineAndReleasePod(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod1", UID: "uid1", Labels: map[string]string{"app": "web"}}}
+	_, c := newController([]runtime.Object{pod}, nil)
+	ctx := context.Background()
+
+	status, err := c.QuarantinePod(ctx, "ns1", "pod1", &controlplane.PodQuarantineSpec{
+		PacketCapture: &controlplane.PodQuarantinePacketCapture{Number: 100, Timeout: 60},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &controlplane.PodQuarantineStatus{PolicyName: "quarantine-uid1", PacketCaptureName: "quarantine-uid1"}, status)
+	policy, err := c.crdClient.CrdV1beta1().ClusterNetworkPolicies().Get(ctx, "quarantine-uid1", metav1.GetOptions{})
+	require.NoError(t, err)
+	updatedPod, err := c.kubeClient.CoreV1().Pods("ns1").Get(ctx, "pod1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app": "web", quarantineLabelKey: "uid1"}, updatedPod.Labels)
+	pc, err := c.crdClient.CrdV1alpha1().PacketCaptures().Get(ctx, "quarantine-uid1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, &crdv1alpha1.PodReference{Namespace: "ns1", Name: "pod1"}, pc.Spec.Source.Pod)
+	assert.Equal(t, crdv1alpha1.CaptureDirectionBoth, pc.Spec.Direction)
+	assert.Equal(t, ptr.To[int32](60), pc.Spec.Timeout)
+
+	// Quarantining the Pod again fails.
+	_, err = c.QuarantinePod(ctx, "ns1", "pod1", &controlplane.PodQuarantineSpec{})
+	assert.True(t, errors.IsConflict(err))
+
+	// Releasing another Pod fails.
+	c.acnpStore.Add(policy)
+	err = c.ReleasePod(ctx, "ns1", "pod2")
+	assert.True(t, errors.IsNotFound(err))
+
+	require.NoError(t, c.ReleasePod(ctx, "ns1", "pod1"))
+	_, err = c.crdClient.CrdV1beta1().ClusterNetworkPolicies().Get(ctx, "quarantine-uid1", metav1.GetOptions{})
+	assert.True(t, errors.IsNotFound(err))
+	updatedPod, err = c.kubeClient.CoreV1().Pods("ns1").Get(ctx, "pod1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app": "web"}, updatedPod.Labels)
+	// The PacketCapture is kept.
+	_, err = c.crdClient.CrdV1alpha1().PacketCaptures().Get(ctx, "quarantine-uid1", metav1.GetOptions{})
+	assert.NoError(t, err)
+}
+
+func TestQuarantinePodNotFound(t *testing.T) {
+	_, c := newController(nil, nil)
+	_, err := c.QuarantinePod(context.Background(), "ns1", "pod1", &controlplane.PodQuarantineSpec{})
+	assert.True(t, errors.IsNotFound(err))
+}
+
+// failOnce returns a reactor which fails the first action it handles.
+func failOnce() k8stesting.ReactionFunc {
+	failed := false
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if failed {
+			return false, nil, nil
+		}
+		failed = true
+		return true, nil, fmt.Errorf("error when %s %s", action.GetVerb(), action.GetResource().Resource)
+	}
+}
+
+func TestQuarantinePodPacketCaptureFailure(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod1", UID: "uid1", Labels: map[string]string{"app": "web"}}}
+	_, c := newController([]runtime.Object{pod}, nil)
+	c.crdClient.(*fakeversioned.Clientset).PrependReactor("create", "packetcaptures", failOnce())
+	ctx := context.Background()
+	spec := &controlplane.PodQuarantineSpec{PacketCapture: &controlplane.PodQuarantinePacketCapture{Number: 100}}
+
+	_, err := c.QuarantinePod(ctx, "ns1", "pod1", spec)
+	require.Error(t, err)
+	// The policy and the label are rolled back.
+	_, err = c.crdClient.CrdV1beta1().ClusterNetworkPolicies().Get(ctx, "quarantine-uid1", metav1.GetOptions{})
+	assert.True(t, errors.IsNotFound(err))
+	updatedPod, err := c.kubeClient.CoreV1().Pods("ns1").Get(ctx, "pod1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app": "web"}, updatedPod.Labels)
+
+	// Retrying the request succeeds.
+	status, err := c.QuarantinePod(ctx, "ns1", "pod1", spec)
+	require.NoError(t, err)
+	assert.Equal(t, &controlplane.PodQuarantineStatus{PolicyName: "quarantine-uid1", PacketCaptureName: "quarantine-uid1"}, status)
+}
+
+func TestReleasePodLabelFailure(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod1", UID: "uid1"}}
+	k8sClient, c := newController([]runtime.Object{pod}, nil)
+	ctx := context.Background()
+	_, err := c.QuarantinePod(ctx, "ns1", "pod1", &controlplane.PodQuarantineSpec{})
+	require.NoError(t, err)
+	policy, err := c.crdClient.CrdV1beta1().ClusterNetworkPolicies().Get(ctx, "quarantine-uid1", metav1.GetOptions{})
+	require.NoError(t, err)
+	c.acnpStore.Add(policy)
+
+	k8sClient.PrependReactor("patch", "pods", failOnce())
+	require.Error(t, c.ReleasePod(ctx, "ns1", "pod1"))
+	// The policy is kept, so that the request can be retried.
+	_, err = c.crdClient.CrdV1beta1().ClusterNetworkPolicies().Get(ctx, "quarantine-uid1", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, c.ReleasePod(ctx, "ns1", "pod1"))
+	_, err = c.crdClient.CrdV1beta1().ClusterNetworkPolicies().Get(ctx, "quarantine-uid1", metav1.GetOptions{})
+	assert.True(t, errors.IsNotFound(err))
+	updatedPod, err := c.kubeClient.CoreV1().Pods("ns1").Get(ctx, "pod1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, updatedPod.Labels)
+}
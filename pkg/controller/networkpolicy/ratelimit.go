@@ -0,0 +1,88 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"math"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+// parseBandwidthKbps parses a bandwidth quantity in bits, e.g. "10M", and returns it in kilobits.
+func parseBandwidthKbps(bandwidth string) (int32, error) {
+	quantity, err := resource.ParseQuantity(bandwidth)
+	if err != nil {
+		return 0, err
+	}
+	kbps := quantity.Value() / 1000
+	if kbps < 0 || kbps > math.MaxInt32 {
+		return 0, fmt.Errorf("%s is out of range", bandwidth)
+	}
+	return int32(kbps), nil
+}
+
+// validateRuleRateLimit validates the rate limit of an Antrea-native policy rule.
+func validateRuleRateLimit(rateLimit *crdv1beta1.RuleRateLimit) error {
+	if rateLimit.ConnectionsPerSecond == nil && rateLimit.Bandwidth == nil {
+		return fmt.Errorf("at least one of connectionsPerSecond and bandwidth must be set")
+	}
+	if rateLimit.ConnectionsPerSecond != nil && *rateLimit.ConnectionsPerSecond <= 0 {
+		return fmt.Errorf("connectionsPerSecond must be positive")
+	}
+	if bandwidth := rateLimit.Bandwidth; bandwidth != nil {
+		rate, err := parseBandwidthKbps(bandwidth.Rate)
+		if err != nil {
+			return fmt.Errorf("invalid bandwidth rate: %v", err)
+		}
+		if rate == 0 {
+			return fmt.Errorf("bandwidth rate must be at least 1k")
+		}
+		if _, err := parseBandwidthKbps(bandwidth.Burst); err != nil {
+			return fmt.Errorf("invalid bandwidth burst: %v", err)
+		}
+	}
+	return nil
+}
+
+// toAntreaRateLimitForCRD converts a v1beta1.RuleRateLimit object to an Antrea RuleRateLimit
+// object, with the bandwidth in kilobits.
+func toAntreaRateLimitForCRD(rateLimit *crdv1beta1.RuleRateLimit) *controlplane.RuleRateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+	antreaRateLimit := &controlplane.RuleRateLimit{}
+	if rateLimit.ConnectionsPerSecond != nil {
+		antreaRateLimit.ConnectionsPerSecond = *rateLimit.ConnectionsPerSecond
+	}
+	if bandwidth := rateLimit.Bandwidth; bandwidth != nil {
+		// The rate limit has been validated, errors are not expected here.
+		rate, err := parseBandwidthKbps(bandwidth.Rate)
+		if err != nil {
+			klog.ErrorS(err, "Invalid bandwidth rate configured for rule", "rate", bandwidth.Rate)
+		}
+		burst, err := parseBandwidthKbps(bandwidth.Burst)
+		if err != nil {
+			klog.ErrorS(err, "Invalid bandwidth burst size configured for rule", "burst", bandwidth.Burst)
+		}
+		antreaRateLimit.BandwidthRate = rate
+		antreaRateLimit.BandwidthBurst = burst
+	}
+	return antreaRateLimit
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+func TestValidateRuleRateLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		rateLimit   *crdv1beta1.RuleRateLimit
+		expectedErr string
+	}{
+		{
+			name:      "connections only",
+			rateLimit: &crdv1beta1.RuleRateLimit{ConnectionsPerSecond: ptr.To[int32](100)},
+		},
+		{
+			name:      "bandwidth only",
+			rateLimit: &crdv1beta1.RuleRateLimit{Bandwidth: &crdv1beta1.Bandwidth{Rate: "10M", Burst: "20M"}},
+		},
+		{
+			name:        "empty",
+			rateLimit:   &crdv1beta1.RuleRateLimit{},
+			expectedErr: "at least one of connectionsPerSecond and bandwidth must be set",
+		},
+		{
+			name:        "non-positive connections",
+			rateLimit:   &crdv1beta1.RuleRateLimit{ConnectionsPerSecond: ptr.To[int32](0)},
+			expectedErr: "connectionsPerSecond must be positive",
+		},
+		{
+			name:        "rate too low",
+			rateLimit:   &crdv1beta1.RuleRateLimit{Bandwidth: &crdv1beta1.Bandwidth{Rate: "500", Burst: "1M"}},
+			expectedErr: "bandwidth rate must be at least 1k",
+		},
+		{
+			name:        "negative burst",
+			rateLimit:   &crdv1beta1.RuleRateLimit{Bandwidth: &crdv1beta1.Bandwidth{Rate: "10M", Burst: "-1M"}},
+			expectedErr: "invalid bandwidth burst: -1M is out of range",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRuleRateLimit(tt.rateLimit)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestToAntreaRateLimitForCRD(t *testing.T) {
+	tests := []struct {
+		name      string
+		rateLimit *crdv1beta1.RuleRateLimit
+		expected  *controlplane.RuleRateLimit
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "connections and bandwidth",
+			rateLimit: &crdv1beta1.RuleRateLimit{
+				ConnectionsPerSecond: ptr.To[int32](100),
+				Bandwidth:            &crdv1beta1.Bandwidth{Rate: "10M", Burst: "20M"},
+			},
+			expected: &controlplane.RuleRateLimit{ConnectionsPerSecond: 100, BandwidthRate: 10000, BandwidthBurst: 20000},
+		},
+		{
+			name:      "bandwidth only",
+			rateLimit: &crdv1beta1.RuleRateLimit{Bandwidth: &crdv1beta1.Bandwidth{Rate: "1G", Burst: "500k"}},
+			expected:  &controlplane.RuleRateLimit{BandwidthRate: 1000000, BandwidthBurst: 500},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, toAntreaRateLimitForCRD(tt.rateLimit))
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"time"
+
+	// Embed the IANA Time Zone database, so that the time zones of rule schedules can be loaded
+	// even if the database is not installed in the image.
+	_ "time/tzdata"
+
+	"k8s.io/klog/v2"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+const scheduleTimeLayout = "15:04"
+
+var daysOfWeek = map[crdv1beta1.DayOfWeek]time.Weekday{
+	crdv1beta1.DayOfWeekSunday:    time.Sunday,
+	crdv1beta1.DayOfWeekMonday:    time.Monday,
+	crdv1beta1.DayOfWeekTuesday:   time.Tuesday,
+	crdv1beta1.DayOfWeekWednesday: time.Wednesday,
+	crdv1beta1.DayOfWeekThursday:  time.Thursday,
+	crdv1beta1.DayOfWeekFriday:    time.Friday,
+	crdv1beta1.DayOfWeekSaturday:  time.Saturday,
+}
+
+// ruleScheduleTracker evaluates the schedules of the rules of an Antrea-native policy at a given
+// time. It records whether each rule with a schedule is active, and the earliest time at which
+// any of them is activated or deactivated, so that the policy can be synced again at that time.
+type ruleScheduleTracker struct {
+	now            time.Time
+	statuses       []crdv1beta1.RuleScheduleStatus
+	nextTransition time.Time
+}
+
+func newRuleScheduleTracker(now time.Time) *ruleScheduleTracker {
+	return &ruleScheduleTracker{now: now}
+}
+
+// isActive returns whether the rule should be enforced at the time of the tracker. Rules without a
+// schedule are always active. A rule with an invalid schedule is never active.
+func (t *ruleScheduleTracker) isActive(rule *crdv1beta1.Rule) bool {
+	if rule.Schedule == nil {
+		return true
+	}
+	active, next, err := evaluateRuleSchedule(rule.Schedule, t.now)
+	if err != nil {
+		klog.ErrorS(err, "Invalid rule schedule, the rule will not be enforced", "rule", rule.Name)
+	}
+	t.statuses = append(t.statuses, crdv1beta1.RuleScheduleStatus{Name: rule.Name, Active: active})
+	if !next.IsZero() && (t.nextTransition.IsZero() || next.Before(t.nextTransition)) {
+		t.nextTransition = next
+	}
+	return active
+}
+
+// evaluateRuleSchedule returns whether the given time falls in any window of the schedule, and the
+// earliest time after it at which a window starts or ends. A zero time is returned if no window
+// starts or ends in the next week, which is only possible if the schedule has no window.
+func evaluateRuleSchedule(schedule *crdv1beta1.RuleSchedule, now time.Time) (bool, time.Time, error) {
+	loc := time.UTC
+	if schedule.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(schedule.TimeZone); err != nil {
+			return false, time.Time{}, fmt.Errorf("invalid time zone %q: %w", schedule.TimeZone, err)
+		}
+	}
+	now = now.In(loc)
+	active := false
+	var next time.Time
+	updateNext := func(t time.Time) {
+		if t.After(now) && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+	for _, window := range schedule.Windows {
+		start, end, err := parseScheduleWindow(window)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+		days, err := parseDaysOfWeek(window.DaysOfWeek)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+		// Check the occurrences of the window starting from yesterday, which may still be ongoing
+		// if the window spans midnight, to next week, which must contain the next occurrence.
+		for offset := -1; offset <= 7; offset++ {
+			year, month, day := now.AddDate(0, 0, offset).Date()
+			windowStart := time.Date(year, month, day, start.Hour(), start.Minute(), 0, 0, loc)
+			if len(days) > 0 && !days[windowStart.Weekday()] {
+				continue
+			}
+			windowEnd := time.Date(year, month, day, end.Hour(), end.Minute(), 0, 0, loc)
+			if !windowEnd.After(windowStart) {
+				windowEnd = time.Date(year, month, day+1, end.Hour(), end.Minute(), 0, 0, loc)
+			}
+			if !now.Before(windowStart) && now.Before(windowEnd) {
+				active = true
+			}
+			updateNext(windowStart)
+			updateNext(windowEnd)
+		}
+	}
+	return active, next, nil
+}
+
+// parseScheduleWindow parses the start time and end time of a window.
+func parseScheduleWindow(window crdv1beta1.ScheduleWindow) (time.Time, time.Time, error) {
+	start, err := time.Parse(scheduleTimeLayout, window.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start time %q, must be in HH:MM format", window.Start)
+	}
+	end, err := time.Parse(scheduleTimeLayout, window.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end time %q, must be in HH:MM format", window.End)
+	}
+	return start, end, nil
+}
+
+// parseDaysOfWeek returns the set of weekdays represented by the given days.
+func parseDaysOfWeek(days []crdv1beta1.DayOfWeek) (map[time.Weekday]bool, error) {
+	weekdays := make(map[time.Weekday]bool, len(days))
+	for _, day := range days {
+		weekday, ok := daysOfWeek[day]
+		if !ok {
+			return nil, fmt.Errorf("invalid day of week %q", day)
+		}
+		weekdays[weekday] = true
+	}
+	return weekdays, nil
+}
+
+// validateRuleSchedule validates the schedule of a rule.
+func validateRuleSchedule(schedule *crdv1beta1.RuleSchedule) error {
+	if len(schedule.Windows) == 0 {
+		return fmt.Errorf("at least one window must be set")
+	}
+	_, _, err := evaluateRuleSchedule(schedule, time.Now())
+	return err
+}
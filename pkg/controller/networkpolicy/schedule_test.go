@@ -0,0 +1,207 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+func TestEvaluateRuleSchedule(t *testing.T) {
+	// 2025-03-05 is a Wednesday.
+	mustParse := func(value string) time.Time {
+		tm, err := time.Parse(time.RFC3339, value)
+		require.NoError(t, err)
+		return tm
+	}
+	tests := []struct {
+		name               string
+		schedule           *crdv1beta1.RuleSchedule
+		now                time.Time
+		expectedActive     bool
+		expectedTransition time.Time
+		expectedErr        string
+	}{
+		{
+			name: "in window",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "01:00", End: "03:00"}},
+			},
+			now:                mustParse("2025-03-05T02:00:00Z"),
+			expectedActive:     true,
+			expectedTransition: mustParse("2025-03-05T03:00:00Z"),
+		},
+		{
+			name: "before window",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "01:00", End: "03:00"}},
+			},
+			now:                mustParse("2025-03-05T00:30:00Z"),
+			expectedActive:     false,
+			expectedTransition: mustParse("2025-03-05T01:00:00Z"),
+		},
+		{
+			name: "at the end of window",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "01:00", End: "03:00"}},
+			},
+			now:                mustParse("2025-03-05T03:00:00Z"),
+			expectedActive:     false,
+			expectedTransition: mustParse("2025-03-06T01:00:00Z"),
+		},
+		{
+			name: "in window spanning midnight",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "22:00", End: "02:00"}},
+			},
+			now:                mustParse("2025-03-05T01:00:00Z"),
+			expectedActive:     true,
+			expectedTransition: mustParse("2025-03-05T02:00:00Z"),
+		},
+		{
+			name: "in window with time zone",
+			schedule: &crdv1beta1.RuleSchedule{
+				TimeZone: "America/New_York",
+				Windows:  []crdv1beta1.ScheduleWindow{{Start: "09:00", End: "17:00"}},
+			},
+			// 10:00 in New York.
+			now:                mustParse("2025-03-05T15:00:00Z"),
+			expectedActive:     true,
+			expectedTransition: mustParse("2025-03-05T22:00:00Z"),
+		},
+		{
+			name: "out of window with time zone",
+			schedule: &crdv1beta1.RuleSchedule{
+				TimeZone: "America/New_York",
+				Windows:  []crdv1beta1.ScheduleWindow{{Start: "09:00", End: "17:00"}},
+			},
+			// 08:00 in New York.
+			now:                mustParse("2025-03-05T13:00:00Z"),
+			expectedActive:     false,
+			expectedTransition: mustParse("2025-03-05T14:00:00Z"),
+		},
+		{
+			name: "on excluded day",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "01:00", End: "03:00", DaysOfWeek: []crdv1beta1.DayOfWeek{crdv1beta1.DayOfWeekSaturday, crdv1beta1.DayOfWeekSunday}}},
+			},
+			now:                mustParse("2025-03-05T02:00:00Z"),
+			expectedActive:     false,
+			expectedTransition: mustParse("2025-03-08T01:00:00Z"),
+		},
+		{
+			name: "on the next day of window spanning midnight",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "22:00", End: "02:00", DaysOfWeek: []crdv1beta1.DayOfWeek{crdv1beta1.DayOfWeekTuesday}}},
+			},
+			now:                mustParse("2025-03-05T01:00:00Z"),
+			expectedActive:     true,
+			expectedTransition: mustParse("2025-03-05T02:00:00Z"),
+		},
+		{
+			name: "multiple windows",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{
+					{Start: "01:00", End: "03:00"},
+					{Start: "02:00", End: "04:00"},
+				},
+			},
+			now:                mustParse("2025-03-05T02:30:00Z"),
+			expectedActive:     true,
+			expectedTransition: mustParse("2025-03-05T03:00:00Z"),
+		},
+		{
+			name: "invalid time zone",
+			schedule: &crdv1beta1.RuleSchedule{
+				TimeZone: "Mars/Olympus_Mons",
+				Windows:  []crdv1beta1.ScheduleWindow{{Start: "01:00", End: "03:00"}},
+			},
+			now:         mustParse("2025-03-05T02:00:00Z"),
+			expectedErr: "invalid time zone",
+		},
+		{
+			name: "invalid start time",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "1am", End: "03:00"}},
+			},
+			now:         mustParse("2025-03-05T02:00:00Z"),
+			expectedErr: "invalid start time",
+		},
+		{
+			name: "invalid day of week",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "01:00", End: "03:00", DaysOfWeek: []crdv1beta1.DayOfWeek{"Monday"}}},
+			},
+			now:         mustParse("2025-03-05T02:00:00Z"),
+			expectedErr: "invalid day of week",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			active, transition, err := evaluateRuleSchedule(tt.schedule, tt.now)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedActive, active)
+			assert.True(t, tt.expectedTransition.Equal(transition), "expected transition at %v, got %v", tt.expectedTransition, transition)
+		})
+	}
+}
+
+func TestRuleScheduleTracker(t *testing.T) {
+	now := time.Date(2025, 3, 5, 2, 0, 0, 0, time.UTC)
+	tracker := newRuleScheduleTracker(now)
+	rules := []crdv1beta1.Rule{
+		{Name: "always"},
+		{
+			Name: "active",
+			Schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "01:00", End: "04:00"}},
+			},
+		},
+		{
+			Name: "inactive",
+			Schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "03:00", End: "05:00"}},
+			},
+		},
+		{
+			Name: "invalid",
+			Schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "03:00", End: "25:00"}},
+			},
+		},
+	}
+	var activeRules []string
+	for i := range rules {
+		if tracker.isActive(&rules[i]) {
+			activeRules = append(activeRules, rules[i].Name)
+		}
+	}
+	assert.Equal(t, []string{"always", "active"}, activeRules)
+	assert.Equal(t, []crdv1beta1.RuleScheduleStatus{
+		{Name: "active", Active: true},
+		{Name: "inactive", Active: false},
+		{Name: "invalid", Active: false},
+	}, tracker.statuses)
+	assert.Equal(t, time.Date(2025, 3, 5, 3, 0, 0, 0, time.UTC), tracker.nextTransition)
+}
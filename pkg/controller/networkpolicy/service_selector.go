@@ -0,0 +1,113 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+// addServiceSelector registers a Service selector used in toServices of the given policy with the groupingInterface,
+// so that the policy is resynced when the Services selected by it change, and returns the references of the Services
+// currently selected, sorted by Namespace and name.
+func (n *NetworkPolicyController) addServiceSelector(selector *antreatypes.GroupSelector, policyKey string) []controlplane.ServiceReference {
+	n.serviceSelectorMutex.Lock()
+	defer n.serviceSelectorMutex.Unlock()
+
+	// The group must be added before getting its Services, otherwise an update that happens in between would be missed.
+	n.groupingInterface.AddGroup(serviceGroupType, selector.NormalizedName, selector)
+	if _, exists := n.serviceSelectorPolicies[selector.NormalizedName]; !exists {
+		n.serviceSelectorPolicies[selector.NormalizedName] = sets.New[string]()
+	}
+	n.serviceSelectorPolicies[selector.NormalizedName].Insert(policyKey)
+	if _, exists := n.policyServiceSelectors[policyKey]; !exists {
+		n.policyServiceSelectors[policyKey] = sets.New[string]()
+	}
+	n.policyServiceSelectors[policyKey].Insert(selector.NormalizedName)
+
+	services := n.groupingInterface.GetServices(serviceGroupType, selector.NormalizedName)
+	serviceRefs := make([]controlplane.ServiceReference, 0, len(services))
+	for _, service := range services {
+		serviceRefs = append(serviceRefs, controlplane.ServiceReference{Namespace: service.Namespace, Name: service.Name})
+	}
+	sort.Slice(serviceRefs, func(i, j int) bool {
+		if serviceRefs[i].Namespace != serviceRefs[j].Namespace {
+			return serviceRefs[i].Namespace < serviceRefs[j].Namespace
+		}
+		return serviceRefs[i].Name < serviceRefs[j].Name
+	})
+	return serviceRefs
+}
+
+// removeStaleServiceSelectors removes the Service selectors that are no longer used by the given policy. A selector is
+// deleted from the groupingInterface once no policy uses it. Passing nil selectorKeys removes all selectors of the
+// policy.
+func (n *NetworkPolicyController) removeStaleServiceSelectors(selectorKeys sets.Set[string], policyKey string) {
+	n.serviceSelectorMutex.Lock()
+	defer n.serviceSelectorMutex.Unlock()
+
+	for selectorKey := range n.policyServiceSelectors[policyKey] {
+		if selectorKeys.Has(selectorKey) {
+			continue
+		}
+		n.policyServiceSelectors[policyKey].Delete(selectorKey)
+		n.serviceSelectorPolicies[selectorKey].Delete(policyKey)
+		if len(n.serviceSelectorPolicies[selectorKey]) == 0 {
+			delete(n.serviceSelectorPolicies, selectorKey)
+			n.groupingInterface.DeleteGroup(serviceGroupType, selectorKey)
+		}
+	}
+	if len(n.policyServiceSelectors[policyKey]) == 0 {
+		delete(n.policyServiceSelectors, policyKey)
+	}
+}
+
+// policySelectorKeys contains the keys of the selectors used by a policy, which are registered with the
+// labelIdentityInterface and the groupingInterface when processing the policy.
+type policySelectorKeys struct {
+	clusterSetScope sets.Set[string]
+	service         sets.Set[string]
+}
+
+// removeStalePolicySelectors removes the ClusterSet scoped selectors and the Service selectors which are no longer
+// used by the given policy.
+func (n *NetworkPolicyController) removeStalePolicySelectors(selectorKeys policySelectorKeys, policyKey string) {
+	if n.stretchNPEnabled {
+		n.labelIdentityInterface.RemoveStalePolicySelectors(selectorKeys.clusterSetScope, policyKey)
+	}
+	n.removeStaleServiceSelectors(selectorKeys.service, policyKey)
+}
+
+// triggerPolicyResyncForServiceSelector enqueues the policies using the given Service selector when the Services
+// selected by it are updated.
+func (n *NetworkPolicyController) triggerPolicyResyncForServiceSelector(selectorKey string) {
+	n.serviceSelectorMutex.Lock()
+	policyKeys := sets.List(n.serviceSelectorPolicies[selectorKey])
+	n.serviceSelectorMutex.Unlock()
+
+	for _, policyKey := range policyKeys {
+		klog.V(2).InfoS("Resyncing policy for Service selector events", "policy", policyKey, "selector", selectorKey)
+		internalNPObj, found, _ := n.internalNetworkPolicyStore.Get(policyKey)
+		if !found {
+			continue
+		}
+		n.enqueueInternalNetworkPolicy(internalNPObj.(*antreatypes.NetworkPolicy).SourceRef)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func TestProcessToServicesWithServiceSelector(t *testing.T) {
+	newService := func(namespace, name string, labels map[string]string) *corev1.Service {
+		return &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels}}
+	}
+	dbLabels := map[string]string{"tier": "db"}
+	svcDB1 := newService("ns1", "db1", dbLabels)
+	svcDB2 := newService("ns1", "db2", dbLabels)
+	svcDBInOtherNamespace := newService("ns2", "db1", dbLabels)
+	svcWeb := newService("ns1", "web", map[string]string{"tier": "web"})
+
+	_, npc := newController(nil, nil)
+	for _, svc := range []*corev1.Service{svcDB2, svcDB1, svcDBInOtherNamespace, svcWeb} {
+		npc.groupingInterface.AddService(svc)
+	}
+	annp := &crdv1beta1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "npA", UID: "uidA"},
+		Spec: crdv1beta1.NetworkPolicySpec{
+			AppliedTo: []crdv1beta1.AppliedTo{{PodSelector: &selectorA}},
+			Priority:  10,
+			Egress: []crdv1beta1.Rule{
+				{
+					ToServices: []crdv1beta1.PeerService{{ServiceSelector: &metav1.LabelSelector{MatchLabels: dbLabels}}},
+					Action:     &allowAction,
+				},
+			},
+		},
+	}
+	selectorKey := antreatypes.NewServiceGroupSelector("ns1", &metav1.LabelSelector{MatchLabels: dbLabels}, nil).NormalizedName
+
+	policy, _, addressGroups := npc.processAntreaNetworkPolicy(annp)
+	require.Len(t, policy.Rules, 1)
+	// Services are selected in the policy's Namespace by default, and sorted.
+	assert.Equal(t, []controlplane.ServiceReference{{Namespace: "ns1", Name: "db1"}, {Namespace: "ns1", Name: "db2"}}, policy.Rules[0].To.ToServices)
+	assert.Empty(t, addressGroups)
+	assert.Equal(t, []string{selectorKey}, sets.List(npc.policyServiceSelectors["uidA"]))
+	assert.Equal(t, []string{"uidA"}, sets.List(npc.serviceSelectorPolicies[selectorKey]))
+
+	// The policy is resynced when the Services selected by it change.
+	npc.internalNetworkPolicyStore.Create(policy)
+	npc.triggerPolicyResyncForServiceSelector(selectorKey)
+	require.Equal(t, 1, npc.internalNetworkPolicyQueue.Len())
+	key, _ := npc.internalNetworkPolicyQueue.Get()
+	assert.Equal(t, *policy.SourceRef, key)
+	npc.internalNetworkPolicyQueue.Done(key)
+
+	// Selecting Services from all Namespaces with a namespaceSelector.
+	annp.Spec.Egress[0].ToServices[0].NamespaceSelector = &metav1.LabelSelector{}
+	policy, _, _ = npc.processAntreaNetworkPolicy(annp)
+	assert.Equal(t, []controlplane.ServiceReference{{Namespace: "ns1", Name: "db1"}, {Namespace: "ns1", Name: "db2"}, {Namespace: "ns2", Name: "db1"}}, policy.Rules[0].To.ToServices)
+	newSelectorKey := antreatypes.NewServiceGroupSelector("", &metav1.LabelSelector{MatchLabels: dbLabels}, &metav1.LabelSelector{}).NormalizedName
+	assert.Equal(t, []string{newSelectorKey}, sets.List(npc.policyServiceSelectors["uidA"]))
+	assert.NotContains(t, npc.serviceSelectorPolicies, selectorKey)
+	assert.Nil(t, npc.groupingInterface.GetServices(serviceGroupType, selectorKey))
+
+	// Computing the policy without processing it, e.g. to simulate an update of it, doesn't remove its selectors.
+	annp.Spec.Egress[0].ToServices = []crdv1beta1.PeerService{{Name: "web"}}
+	_, _, _, selectorKeys := npc.computeAntreaNetworkPolicy(annp)
+	assert.Empty(t, selectorKeys.service)
+	assert.Equal(t, []string{newSelectorKey}, sets.List(npc.policyServiceSelectors["uidA"]))
+	assert.Equal(t, []string{"uidA"}, sets.List(npc.serviceSelectorPolicies[newSelectorKey]))
+
+	// Stale selectors are removed once the policy no longer uses them.
+	policy, _, _ = npc.processAntreaNetworkPolicy(annp)
+	assert.Equal(t, []controlplane.ServiceReference{{Namespace: "ns1", Name: "web"}}, policy.Rules[0].To.ToServices)
+	assert.Empty(t, npc.policyServiceSelectors)
+	assert.Empty(t, npc.serviceSelectorPolicies)
+	assert.Nil(t, npc.groupingInterface.GetServices(serviceGroupType, newSelectorKey))
+}
@@ -26,6 +26,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/tools/cache"
@@ -35,6 +36,7 @@ import (
 
 	"antrea.io/antrea/pkg/apis/controlplane"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
 	"antrea.io/antrea/pkg/apiserver/storage"
 	antreaclientset "antrea.io/antrea/pkg/client/clientset/versioned"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1beta1"
@@ -45,6 +47,8 @@ import (
 
 const (
 	statusControllerName = "NetworkPolicyStatusController"
+	// auditedRulesSyncPeriod is the interval at which the hits of the rules of policies in Audit mode are synced.
+	auditedRulesSyncPeriod = 60 * time.Second
 )
 
 var (
@@ -54,6 +58,13 @@ var (
 	maxConditionMessageLength = 256
 )
 
+// RuleStatsProvider provides the aggregated traffic stats of Antrea ClusterNetworkPolicies and Antrea NetworkPolicies
+// from rule perspective.
+type RuleStatsProvider interface {
+	GetAntreaClusterNetworkPolicyStats(name string) (*statsv1alpha1.AntreaClusterNetworkPolicyStats, bool)
+	GetAntreaNetworkPolicyStats(namespace, name string) (*statsv1alpha1.AntreaNetworkPolicyStats, bool)
+}
+
 // StatusController is responsible for synchronizing the status of Antrea ClusterNetworkPolicy and Antrea NetworkPolicy.
 type StatusController struct {
 	// npControlInterface knows how to update Antrea NetworkPolicy status.
@@ -75,9 +86,13 @@ type StatusController struct {
 	acnpListerSynced cache.InformerSynced
 	// annpListerSynced is a function which returns true if the AntreaNetworkPolicies shared informer has been synced at least once.
 	annpListerSynced cache.InformerSynced
+
+	// ruleStatsProvider provides the hits of the rules of policies in Audit mode. It's nil if NetworkPolicyStats is
+	// disabled, in which case the audited rules are not reported.
+	ruleStatsProvider RuleStatsProvider
 }
 
-func NewStatusController(antreaClient antreaclientset.Interface, internalNetworkPolicyStore storage.Interface, acnpInformer crdinformers.ClusterNetworkPolicyInformer, annpInformer crdinformers.NetworkPolicyInformer) *StatusController {
+func NewStatusController(antreaClient antreaclientset.Interface, internalNetworkPolicyStore storage.Interface, acnpInformer crdinformers.ClusterNetworkPolicyInformer, annpInformer crdinformers.NetworkPolicyInformer, ruleStatsProvider RuleStatsProvider) *StatusController {
 	c := &StatusController{
 		npControlInterface: &networkPolicyControl{
 			antreaClient: antreaClient,
@@ -94,6 +109,7 @@ func NewStatusController(antreaClient antreaclientset.Interface, internalNetwork
 		statuses:                   map[string]map[string]*controlplane.NetworkPolicyNodeStatus{},
 		acnpListerSynced:           acnpInformer.Informer().HasSynced,
 		annpListerSynced:           annpInformer.Informer().HasSynced,
+		ruleStatsProvider:          ruleStatsProvider,
 	}
 	// To save a "GET" query before each update, UpdateAntreaClusterNetworkPolicyStatus treats the cache of Lister as
 	// the state of kube-apiserver. In some cases the cache may not be in sync, then we might skip updating a policy's
@@ -203,6 +219,10 @@ func (c *StatusController) Run(stopCh <-chan struct{}) {
 
 	go wait.NonSlidingUntil(c.watchInternalNetworkPolicy, 5*time.Second, stopCh)
 
+	if c.ruleStatsProvider != nil {
+		go wait.Until(c.resyncAuditModePolicies, auditedRulesSyncPeriod, stopCh)
+	}
+
 	for i := 0; i < defaultWorkers; i++ {
 		go wait.Until(c.runWorker, time.Second, stopCh)
 	}
@@ -234,6 +254,16 @@ func (c *StatusController) watchInternalNetworkPolicy() {
 	}
 }
 
+// resyncAuditModePolicies enqueues all policies in Audit mode, so that the hits of their rules are synced periodically.
+func (c *StatusController) resyncAuditModePolicies() {
+	for _, obj := range c.internalNetworkPolicyStore.List() {
+		np := obj.(*antreatypes.NetworkPolicy)
+		if np.EnforcementMode == crdv1beta1.PolicyEnforcementModeAudit {
+			c.queue.Add(np.Name)
+		}
+	}
+}
+
 func (c *StatusController) runWorker() {
 	for c.processNextWorkItem() {
 	}
@@ -282,6 +312,8 @@ func (c *StatusController) syncHandler(key string) error {
 			CurrentNodesRealized: int32(currentNodes),
 			DesiredNodesRealized: int32(desiredNodes),
 			Conditions:           conditions,
+			AuditedRules:         c.getAuditedRules(internalNP),
+			ScheduledRules:       internalNP.ScheduledRules,
 		}
 		klog.V(2).Infof("Updating NetworkPolicy %s status: %v", internalNP.SourceRef.ToString(), status)
 		if internalNP.SourceRef.Type == controlplane.AntreaNetworkPolicy {
@@ -291,6 +323,15 @@ func (c *StatusController) syncHandler(key string) error {
 	}
 
 	conditions := GenerateNetworkPolicyCondition(internalNP.SyncError)
+	if internalNP.Expired {
+		conditions = append(conditions, crdv1beta1.NetworkPolicyCondition{
+			Type:               crdv1beta1.NetworkPolicyConditionExpired,
+			Status:             v1.ConditionTrue,
+			LastTransitionTime: v1.Now(),
+			Reason:             "PolicyExpired",
+			Message:            fmt.Sprintf("The policy expired at %s", internalNP.ExpirationTime.UTC().Format(time.RFC3339)),
+		})
+	}
 	// It means the NetworkPolicy has been processed, and marked as unrealizable. It will enter unrealizable phase
 	// instead of being further realized. Antrea-agents will not process further.
 	if internalNP.SyncError != nil {
@@ -346,6 +387,43 @@ func (c *StatusController) syncHandler(key string) error {
 	return updateStatus(phase, currentNodes, desiredNodes, conditions)
 }
 
+// getAuditedRules returns the hits of the Drop and Reject rules of a policy in Audit mode. It returns nil if the policy
+// is not in Audit mode or the rule stats are not available.
+func (c *StatusController) getAuditedRules(internalNP *antreatypes.NetworkPolicy) []crdv1beta1.RuleAuditStatus {
+	if c.ruleStatsProvider == nil || internalNP.EnforcementMode != crdv1beta1.PolicyEnforcementModeAudit {
+		return nil
+	}
+	var ruleStats []statsv1alpha1.RuleTrafficStats
+	if internalNP.SourceRef.Type == controlplane.AntreaNetworkPolicy {
+		if stats, found := c.ruleStatsProvider.GetAntreaNetworkPolicyStats(internalNP.SourceRef.Namespace, internalNP.SourceRef.Name); found {
+			ruleStats = stats.RuleTrafficStats
+		}
+	} else {
+		if stats, found := c.ruleStatsProvider.GetAntreaClusterNetworkPolicyStats(internalNP.SourceRef.Name); found {
+			ruleStats = stats.RuleTrafficStats
+		}
+	}
+	hits := make(map[string]int64, len(ruleStats))
+	for _, stats := range ruleStats {
+		hits[stats.Name] = stats.TrafficStats.Sessions
+	}
+	var auditedRules []crdv1beta1.RuleAuditStatus
+	// A rule of an ACNP may be converted to multiple internal rules with the same name, e.g. when it selects Namespaces
+	// by label, so deduplicate them.
+	seen := sets.New[string]()
+	for _, rule := range internalNP.Rules {
+		if rule.Action == nil || (*rule.Action != crdv1beta1.RuleActionDrop && *rule.Action != crdv1beta1.RuleActionReject) {
+			continue
+		}
+		if seen.Has(rule.Name) {
+			continue
+		}
+		seen.Insert(rule.Name)
+		auditedRules = append(auditedRules, crdv1beta1.RuleAuditStatus{Name: rule.Name, Hits: hits[rule.Name]})
+	}
+	return auditedRules
+}
+
 // networkPolicyControlInterface is an interface that knows how to update Antrea NetworkPolicy status.
 // It's created as an interface to allow testing.
 type networkPolicyControlInterface interface {
@@ -28,6 +28,7 @@ import (
 
 	"antrea.io/antrea/pkg/apis/controlplane"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
 	"antrea.io/antrea/pkg/apiserver/storage"
 	antreaclientset "antrea.io/antrea/pkg/client/clientset/versioned"
 	antreafakeclientset "antrea.io/antrea/pkg/client/clientset/versioned/fake"
@@ -392,6 +393,100 @@ func TestDeleteAntreaNetworkPolicy(t *testing.T) {
 	assert.Empty(t, statusController.getNodeStatuses(initialNetworkPolicy.Name))
 }
 
+type fakeRuleStatsProvider struct {
+	acnpStats map[string]*statsv1alpha1.AntreaClusterNetworkPolicyStats
+	annpStats map[string]*statsv1alpha1.AntreaNetworkPolicyStats
+}
+
+func (p *fakeRuleStatsProvider) GetAntreaClusterNetworkPolicyStats(name string) (*statsv1alpha1.AntreaClusterNetworkPolicyStats, bool) {
+	stats, found := p.acnpStats[name]
+	return stats, found
+}
+
+func (p *fakeRuleStatsProvider) GetAntreaNetworkPolicyStats(namespace, name string) (*statsv1alpha1.AntreaNetworkPolicyStats, bool) {
+	stats, found := p.annpStats[namespace+"/"+name]
+	return stats, found
+}
+
+func TestGetAuditedRules(t *testing.T) {
+	drop := crdv1beta1.RuleActionDrop
+	reject := crdv1beta1.RuleActionReject
+	allow := crdv1beta1.RuleActionAllow
+	rules := []controlplane.NetworkPolicyRule{
+		{Name: "rule-drop", Action: &drop},
+		{Name: "rule-reject", Action: &reject},
+		{Name: "rule-allow", Action: &allow},
+		// Rules converted from the same ACNP rule share the same name.
+		{Name: "rule-reject", Action: &reject},
+	}
+	ruleStats := []statsv1alpha1.RuleTrafficStats{
+		{Name: "rule-drop", TrafficStats: statsv1alpha1.TrafficStats{Sessions: 10, Packets: 20, Bytes: 2000}},
+		{Name: "rule-allow", TrafficStats: statsv1alpha1.TrafficStats{Sessions: 5, Packets: 5, Bytes: 500}},
+	}
+	provider := &fakeRuleStatsProvider{
+		acnpStats: map[string]*statsv1alpha1.AntreaClusterNetworkPolicyStats{
+			"acnp1": {RuleTrafficStats: ruleStats},
+		},
+		annpStats: map[string]*statsv1alpha1.AntreaNetworkPolicyStats{
+			"ns1/annp1": {RuleTrafficStats: ruleStats},
+		},
+	}
+	newPolicy := func(ref *controlplane.NetworkPolicyReference, mode crdv1beta1.PolicyEnforcementMode) *types.NetworkPolicy {
+		policy := newInternalNetworkPolicy(ref.Name, 1, []string{"node1"}, ref)
+		policy.Rules = rules
+		policy.EnforcementMode = mode
+		return policy
+	}
+	expectedAuditedRules := []crdv1beta1.RuleAuditStatus{
+		{Name: "rule-drop", Hits: 10},
+		{Name: "rule-reject", Hits: 0},
+	}
+	tests := []struct {
+		name                 string
+		ruleStatsProvider    RuleStatsProvider
+		networkPolicy        *types.NetworkPolicy
+		expectedAuditedRules []crdv1beta1.RuleAuditStatus
+	}{
+		{
+			name:                 "acnp in audit mode",
+			ruleStatsProvider:    provider,
+			networkPolicy:        newPolicy(newAntreaClusterNetworkPolicyReference("acnp1"), crdv1beta1.PolicyEnforcementModeAudit),
+			expectedAuditedRules: expectedAuditedRules,
+		},
+		{
+			name:                 "annp in audit mode",
+			ruleStatsProvider:    provider,
+			networkPolicy:        newPolicy(newAntreaNetworkPolicyReference("ns1", "annp1"), crdv1beta1.PolicyEnforcementModeAudit),
+			expectedAuditedRules: expectedAuditedRules,
+		},
+		{
+			name:              "annp in audit mode without stats",
+			ruleStatsProvider: provider,
+			networkPolicy:     newPolicy(newAntreaNetworkPolicyReference("ns2", "annp2"), crdv1beta1.PolicyEnforcementModeAudit),
+			expectedAuditedRules: []crdv1beta1.RuleAuditStatus{
+				{Name: "rule-drop", Hits: 0},
+				{Name: "rule-reject", Hits: 0},
+			},
+		},
+		{
+			name:              "acnp in enforce mode",
+			ruleStatsProvider: provider,
+			networkPolicy:     newPolicy(newAntreaClusterNetworkPolicyReference("acnp1"), crdv1beta1.PolicyEnforcementModeEnforce),
+		},
+		{
+			name:          "stats disabled",
+			networkPolicy: newPolicy(newAntreaClusterNetworkPolicyReference("acnp1"), crdv1beta1.PolicyEnforcementModeAudit),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statusController, _, _, _, _ := newTestStatusController()
+			statusController.ruleStatsProvider = tt.ruleStatsProvider
+			assert.Equal(t, tt.expectedAuditedRules, statusController.getAuditedRules(tt.networkPolicy))
+		})
+	}
+}
+
 // BenchmarkSyncHandler benchmarks syncHandler when the policy spans 1000 Nodes. Its current result is:
 // 70024 ns/op            8338 B/op          8 allocs/op
 func BenchmarkSyncHandler(b *testing.B) {
@@ -120,6 +120,7 @@ func ToNetworkPolicyMsg(in *types.NetworkPolicy, out *controlplane.NetworkPolicy
 	}
 	out.Priority = in.Priority
 	out.TierPriority = in.TierPriority
+	out.EnforcementMode = in.EnforcementMode
 }
 
 // NetworkPolicyKeyFunc knows how to get the key of a NetworkPolicy.
@@ -14,11 +14,11 @@
 //
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: antrea.io/antrea/pkg/controller/networkpolicy (interfaces: EndpointQuerier,PolicyRuleQuerier)
+// Source: antrea.io/antrea/pkg/controller/networkpolicy (interfaces: EndpointQuerier,PolicyRuleQuerier,PolicyAnalyzer)
 //
 // Generated by this command:
 //
-//	mockgen -copyright_file hack/boilerplate/license_header.raw.txt -destination pkg/controller/networkpolicy/testing/mock_networkpolicy.go -package testing antrea.io/antrea/pkg/controller/networkpolicy EndpointQuerier,PolicyRuleQuerier
+//	mockgen -copyright_file hack/boilerplate/license_header.raw.txt -destination pkg/controller/networkpolicy/testing/mock_networkpolicy.go -package testing antrea.io/antrea/pkg/controller/networkpolicy EndpointQuerier,PolicyRuleQuerier,PolicyAnalyzer
 //
 
 // Package testing is a generated GoMock package.
@@ -30,6 +30,8 @@ import (
 	controlplane "antrea.io/antrea/pkg/apis/controlplane"
 	types "antrea.io/antrea/pkg/controller/types"
 	gomock "go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // MockEndpointQuerier is a mock of EndpointQuerier interface.
@@ -56,6 +58,21 @@ func (m *MockEndpointQuerier) EXPECT() *MockEndpointQuerierMockRecorder {
 	return m.recorder
 }
 
+// QueryNetworkPolicyImpact mocks base method.
+func (m *MockEndpointQuerier) QueryNetworkPolicyImpact(policy runtime.Object, source, destination *controlplane.PodReference) ([]controlplane.DeniedConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryNetworkPolicyImpact", policy, source, destination)
+	ret0, _ := ret[0].([]controlplane.DeniedConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryNetworkPolicyImpact indicates an expected call of QueryNetworkPolicyImpact.
+func (mr *MockEndpointQuerierMockRecorder) QueryNetworkPolicyImpact(policy, source, destination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryNetworkPolicyImpact", reflect.TypeOf((*MockEndpointQuerier)(nil).QueryNetworkPolicyImpact), policy, source, destination)
+}
+
 // QueryNetworkPolicyRules mocks base method.
 func (m *MockEndpointQuerier) QueryNetworkPolicyRules(namespace, podName string) (*types.EndpointNetworkPolicyRules, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +88,21 @@ func (mr *MockEndpointQuerierMockRecorder) QueryNetworkPolicyRules(namespace, po
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryNetworkPolicyRules", reflect.TypeOf((*MockEndpointQuerier)(nil).QueryNetworkPolicyRules), namespace, podName)
 }
 
+// QuerySelectedPods mocks base method.
+func (m *MockEndpointQuerier) QuerySelectedPods(selector *controlplane.EntitySelector) ([]*v1.Pod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuerySelectedPods", selector)
+	ret0, _ := ret[0].([]*v1.Pod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySelectedPods indicates an expected call of QuerySelectedPods.
+func (mr *MockEndpointQuerierMockRecorder) QuerySelectedPods(selector any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySelectedPods", reflect.TypeOf((*MockEndpointQuerier)(nil).QuerySelectedPods), selector)
+}
+
 // MockPolicyRuleQuerier is a mock of PolicyRuleQuerier interface.
 type MockPolicyRuleQuerier struct {
 	ctrl     *gomock.Controller
@@ -95,6 +127,21 @@ func (m *MockPolicyRuleQuerier) EXPECT() *MockPolicyRuleQuerierMockRecorder {
 	return m.recorder
 }
 
+// QueryConnectivityMatrix mocks base method.
+func (m *MockPolicyRuleQuerier) QueryConnectivityMatrix(request *controlplane.ConnectivityMatrixRequest) (*controlplane.ConnectivityMatrixResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryConnectivityMatrix", request)
+	ret0, _ := ret[0].(*controlplane.ConnectivityMatrixResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryConnectivityMatrix indicates an expected call of QueryConnectivityMatrix.
+func (mr *MockPolicyRuleQuerierMockRecorder) QueryConnectivityMatrix(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryConnectivityMatrix", reflect.TypeOf((*MockPolicyRuleQuerier)(nil).QueryConnectivityMatrix), request)
+}
+
 // QueryNetworkPolicyEvaluation mocks base method.
 func (m *MockPolicyRuleQuerier) QueryNetworkPolicyEvaluation(entities *controlplane.NetworkPolicyEvaluationRequest) (*controlplane.NetworkPolicyEvaluationResponse, error) {
 	m.ctrl.T.Helper()
@@ -109,3 +156,42 @@ func (mr *MockPolicyRuleQuerierMockRecorder) QueryNetworkPolicyEvaluation(entiti
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryNetworkPolicyEvaluation", reflect.TypeOf((*MockPolicyRuleQuerier)(nil).QueryNetworkPolicyEvaluation), entities)
 }
+
+// MockPolicyAnalyzer is a mock of PolicyAnalyzer interface.
+type MockPolicyAnalyzer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyAnalyzerMockRecorder
+	isgomock struct{}
+}
+
+// MockPolicyAnalyzerMockRecorder is the mock recorder for MockPolicyAnalyzer.
+type MockPolicyAnalyzerMockRecorder struct {
+	mock *MockPolicyAnalyzer
+}
+
+// NewMockPolicyAnalyzer creates a new mock instance.
+func NewMockPolicyAnalyzer(ctrl *gomock.Controller) *MockPolicyAnalyzer {
+	mock := &MockPolicyAnalyzer{ctrl: ctrl}
+	mock.recorder = &MockPolicyAnalyzerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyAnalyzer) EXPECT() *MockPolicyAnalyzerMockRecorder {
+	return m.recorder
+}
+
+// AnalyzePolicies mocks base method.
+func (m *MockPolicyAnalyzer) AnalyzePolicies(request *controlplane.PolicyAnalysisRequest) (*controlplane.PolicyAnalysisResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnalyzePolicies", request)
+	ret0, _ := ret[0].(*controlplane.PolicyAnalysisResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnalyzePolicies indicates an expected call of AnalyzePolicies.
+func (mr *MockPolicyAnalyzerMockRecorder) AnalyzePolicies(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzePolicies", reflect.TypeOf((*MockPolicyAnalyzer)(nil).AnalyzePolicies), request)
+}
@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/miekg/dns"
 	admv1 "k8s.io/api/admission/v1"
 	authenticationv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/api/core/v1"
@@ -86,6 +87,31 @@ var (
 	// allowedFQDNChars validates that the matchPattern field contains only valid DNS characters
 	// and the wildcard '*' character.
 	allowedFQDNChars = regexp.MustCompile("^[-0-9a-zA-Z.*]+$")
+	// allowedGRPCServiceName validates that the gRPC service name is a fully-qualified Protobuf
+	// service name, e.g. "payments.Ledger".
+	allowedGRPCServiceName = regexp.MustCompile(`^[A-Za-z_][0-9A-Za-z_]*(\.[A-Za-z_][0-9A-Za-z_]*)*$`)
+	// allowedGRPCMethodName validates that the gRPC method name is a Protobuf method name.
+	allowedGRPCMethodName = regexp.MustCompile(`^[A-Za-z_][0-9A-Za-z_]*$`)
+	// allowedKafkaTopicName validates that the Kafka topic name is a legal topic name.
+	allowedKafkaTopicName = regexp.MustCompile(`^[0-9A-Za-z._-]{1,249}$`)
+	// allowedL7HeaderName validates that the name of an HTTP header or gRPC metadata is a valid
+	// header name.
+	allowedL7HeaderName = regexp.MustCompile(`^[-0-9A-Za-z_.]+$`)
+	// allowedL7HeaderValue validates that the value of an HTTP header or gRPC metadata only contains
+	// printable ASCII characters, other than the ones delimiting or escaping keyword values in the
+	// L7 engine rules. The "|" character is escaped by the L7 engine when the value is matched.
+	allowedL7HeaderValue = regexp.MustCompile(`^[ !#-:<-\[\]-~]*$`)
+	// allowedL7HeaderRegex validates that the regular expression matching the value of an HTTP header
+	// only contains printable ASCII characters, other than the delimiters of the PCRE expression in the
+	// L7 engine rules. Restricted to these characters, the RE2 syntax is a subset of the PCRE syntax.
+	allowedL7HeaderRegex = regexp.MustCompile(`^[ !#-.0-:<-~]*$`)
+	// allowedHTTPQueryParamName validates that the name of an HTTP query parameter only contains
+	// unreserved URI characters.
+	allowedHTTPQueryParamName = regexp.MustCompile(`^[-0-9A-Za-z_.~]+$`)
+	// allowedHTTPQueryParamValue validates that the value of an HTTP query parameter only contains
+	// printable ASCII characters which neither delimit query parameters nor need to be escaped in
+	// the L7 engine rules.
+	allowedHTTPQueryParamValue = regexp.MustCompile(`^[!$%'-:<-\[\]-~]*$`)
 )
 
 // RegisterAntreaPolicyValidator registers an Antrea-native policy validator
@@ -526,18 +552,26 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) ([]string, st
 	var tier string
 	var ingress, egress []crdv1beta1.Rule
 	var specAppliedTo []crdv1beta1.AppliedTo
+	var expiry *crdv1beta1.PolicyExpiry
 	var warnings []string
+	var clusterScoped, perNamespaceRules bool
+	var namespace string
 	switch curObj := curObj.(type) {
 	case *crdv1beta1.ClusterNetworkPolicy:
+		clusterScoped = true
+		perNamespaceRules = hasPerNamespaceRule(curObj)
 		tier = curObj.Spec.Tier
 		ingress = curObj.Spec.Ingress
 		egress = curObj.Spec.Egress
 		specAppliedTo = curObj.Spec.AppliedTo
+		expiry = curObj.Spec.Expiry
 	case *crdv1beta1.NetworkPolicy:
+		namespace = curObj.Namespace
 		tier = curObj.Spec.Tier
 		ingress = curObj.Spec.Ingress
 		egress = curObj.Spec.Egress
 		specAppliedTo = curObj.Spec.AppliedTo
+		expiry = curObj.Spec.Expiry
 	}
 	reason, allowed := v.validateTierForPolicy(tier)
 	if !allowed {
@@ -558,6 +592,14 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) ([]string, st
 	if !allowed {
 		return warnings, reason, allowed
 	}
+	reason, allowed = v.validateToServices(egress, clusterScoped)
+	if !allowed {
+		return warnings, reason, allowed
+	}
+	reason, allowed = v.validateWorkloadIdentities(namespace, perNamespaceRules, specAppliedTo, ingress, egress)
+	if !allowed {
+		return warnings, reason, allowed
+	}
 	reason, allowed = v.validateAppliedToServiceIngressPeer(specAppliedTo, ingress)
 	if !allowed {
 		return warnings, reason, allowed
@@ -578,6 +620,23 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) ([]string, st
 	if !allowed {
 		return warnings, reason, allowed
 	}
+	reason, allowed = v.validateRuleSchedules(ingress, egress)
+	if !allowed {
+		return warnings, reason, allowed
+	}
+	reason, allowed = v.validateRuleRateLimits(ingress, egress)
+	if !allowed {
+		return warnings, reason, allowed
+	}
+	reason, allowed = v.validateRejectResponses(specAppliedTo, ingress, egress)
+	if !allowed {
+		return warnings, reason, allowed
+	}
+	if expiry != nil {
+		if err := validatePolicyExpiry(expiry); err != nil {
+			return warnings, fmt.Sprintf("invalid expiry: %v", err), false
+		}
+	}
 	if err := v.validatePort(ingress, egress); err != nil {
 		return warnings, err.Error(), false
 	}
@@ -645,6 +704,9 @@ func (v *antreaPolicyValidator) validateAppliedTo(ingress, egress []crdv1beta1.R
 			if eachAppliedTo.ServiceAccount != nil && appliedToFieldsNum > 1 {
 				return "serviceAccount cannot be set with other peers in appliedTo", false
 			}
+			if eachAppliedTo.Identity != nil && appliedToFieldsNum > 1 {
+				return "identity cannot be set with other peers in appliedTo", false
+			}
 			if eachAppliedTo.Service != nil {
 				if appliedToFieldsNum > 1 {
 					return "service cannot be set with other peers in appliedTo", false
@@ -714,6 +776,9 @@ func (v *antreaPolicyValidator) validatePeers(ingress, egress []crdv1beta1.Rule)
 			if peer.ServiceAccount != nil && peerFieldsNum > 1 {
 				return "serviceAccount cannot be set with other peers in rules", false
 			}
+			if peer.Identity != nil && (peerFieldsNum > 2 || peerFieldsNum > 1 && peer.Scope == "") {
+				return "identity cannot be set with other peers except scope in rules", false
+			}
 			if peer.NodeSelector != nil && peerFieldsNum > 1 {
 				return "nodeSelector cannot be set with other peers in rules", false
 			}
@@ -743,6 +808,96 @@ func (v *antreaPolicyValidator) validatePeers(ingress, egress []crdv1beta1.Rule)
 	return "", true
 }
 
+// validateToServices ensures that each PeerService in toServices either references a Service by name, or selects
+// Services by labels.
+func (v *antreaPolicyValidator) validateToServices(egress []crdv1beta1.Rule, clusterScoped bool) (string, bool) {
+	for _, rule := range egress {
+		for _, svc := range rule.ToServices {
+			if svc.ServiceSelector == nil {
+				if svc.Name == "" {
+					return "one of name and serviceSelector must be set in toServices", false
+				}
+				if svc.NamespaceSelector != nil {
+					return "namespaceSelector can only be set together with serviceSelector in toServices", false
+				}
+				if clusterScoped && svc.Namespace == "" {
+					return "namespace must be set for Services referenced by name in toServices of a ClusterNetworkPolicy", false
+				}
+				continue
+			}
+			if svc.Name != "" {
+				return "name and serviceSelector cannot be set at the same time in toServices", false
+			}
+			if svc.Namespace != "" && svc.NamespaceSelector != nil {
+				return "namespace and namespaceSelector cannot be set at the same time in toServices", false
+			}
+			if svc.Scope == crdv1beta1.ScopeClusterSet {
+				return "serviceSelector cannot be used with ClusterSet scope in toServices", false
+			}
+			if reason, allowed := checkSelectorsLabels(svc.ServiceSelector, svc.NamespaceSelector); !allowed {
+				return reason, allowed
+			}
+		}
+	}
+	return "", true
+}
+
+// validateWorkloadIdentities ensures that the workload identities used in appliedTo and rule peers are valid. An
+// Antrea NetworkPolicy can only be applied to workloads in its Namespace, and identities cannot be used in appliedTo of
+// ClusterNetworkPolicies with per-Namespace rules, as they may select both Pods and ExternalEntities in a Namespace.
+func (v *antreaPolicyValidator) validateWorkloadIdentities(policyNamespace string, perNamespaceRules bool, specAppliedTo []crdv1beta1.AppliedTo, ingress, egress []crdv1beta1.Rule) (string, bool) {
+	checkAppliedTo := func(appliedTo []crdv1beta1.AppliedTo) (string, bool) {
+		for _, at := range appliedTo {
+			if at.Identity == nil {
+				continue
+			}
+			if err := validateWorkloadIdentity(at.Identity); err != nil {
+				return err.Error(), false
+			}
+			if perNamespaceRules {
+				return "identity cannot be set in appliedTo of a ClusterNetworkPolicy with per-Namespace rules", false
+			}
+			if policyNamespace != "" {
+				if namespace, _, _ := workloadIdentityToServiceAccount(at.Identity); namespace != policyNamespace {
+					return fmt.Sprintf("identity in appliedTo must be in the Namespace of the policy %s", policyNamespace), false
+				}
+			}
+		}
+		return "", true
+	}
+	checkPeers := func(peers []crdv1beta1.NetworkPolicyPeer) (string, bool) {
+		for _, peer := range peers {
+			if peer.Identity == nil {
+				continue
+			}
+			if err := validateWorkloadIdentity(peer.Identity); err != nil {
+				return err.Error(), false
+			}
+		}
+		return "", true
+	}
+	if reason, allowed := checkAppliedTo(specAppliedTo); !allowed {
+		return reason, allowed
+	}
+	for _, rule := range ingress {
+		if reason, allowed := checkAppliedTo(rule.AppliedTo); !allowed {
+			return reason, allowed
+		}
+		if reason, allowed := checkPeers(rule.From); !allowed {
+			return reason, allowed
+		}
+	}
+	for _, rule := range egress {
+		if reason, allowed := checkAppliedTo(rule.AppliedTo); !allowed {
+			return reason, allowed
+		}
+		if reason, allowed := checkPeers(rule.To); !allowed {
+			return reason, allowed
+		}
+	}
+	return "", true
+}
+
 // validateAppliedToServiceIngressPeer ensures that if a policy or an ingress rule
 // is applied to Services, the ingress rule can only use ipBlock to select workloads.
 func (v *antreaPolicyValidator) validateAppliedToServiceIngressPeer(specAppliedTo []crdv1beta1.AppliedTo, ingress []crdv1beta1.Rule) (string, bool) {
@@ -876,7 +1031,7 @@ func (v *antreaPolicyValidator) validateEgressMulticastAddress(egressRule []crdv
 				unicast = true
 			}
 			if to.PodSelector != nil || to.NamespaceSelector != nil || to.Namespaces != nil ||
-				to.ExternalEntitySelector != nil || to.ServiceAccount != nil || to.NodeSelector != nil {
+				to.ExternalEntitySelector != nil || to.ServiceAccount != nil || to.Identity != nil || to.NodeSelector != nil {
 				otherSelectors = true
 			}
 			if multicast && (*r.Action == crdv1beta1.RuleActionPass || *r.Action == crdv1beta1.RuleActionReject) {
@@ -947,21 +1102,244 @@ func (v *antreaPolicyValidator) validateL7Protocols(ingressRules, egressRules []
 		if len(r.ToServices) != 0 {
 			return "layer 7 protocols can not be used with toServices", false
 		}
-		haveHTTP := false
+		haveHTTP, haveDNS, haveKafka := false, false, false
+		var firstHTTP *crdv1beta1.HTTPProtocol
+		var firstDNS *crdv1beta1.DNSProtocol
 		for _, p := range r.L7Protocols {
 			if p.HTTP != nil {
+				if reason, allowed := validateHTTPProtocol(p.HTTP); !allowed {
+					return reason, allowed
+				}
+				if firstHTTP == nil {
+					firstHTTP = p.HTTP
+				} else if firstHTTP.RejectStatusCode != p.HTTP.RejectStatusCode {
+					return "HTTP rejectStatusCode must be the same in all HTTP protocols of a rule", false
+				}
+			}
+			// gRPC runs over HTTP/2, hence has the same constraints as HTTP.
+			if p.HTTP != nil || p.GRPC != nil {
 				haveHTTP = true
 			}
+			if p.GRPC != nil {
+				if reason, allowed := validateGRPCProtocol(p.GRPC); !allowed {
+					return reason, allowed
+				}
+			}
+			if p.DNS != nil {
+				if reason, allowed := validateDNSProtocol(p.DNS); !allowed {
+					return reason, allowed
+				}
+				if firstDNS == nil {
+					firstDNS = p.DNS
+				} else if getDNSRejectResponseCode(firstDNS) != getDNSRejectResponseCode(p.DNS) {
+					return "DNS rejectResponseCode must be the same in all DNS protocols of a rule", false
+				}
+				haveDNS = true
+			}
+			if p.Kafka != nil {
+				if reason, allowed := validateKafkaProtocol(p.Kafka); !allowed {
+					return reason, allowed
+				}
+				haveKafka = true
+			}
 		}
 		for _, port := range r.Ports {
 			if haveHTTP && (port.Protocol != nil && *port.Protocol != v1.ProtocolTCP) {
 				return "HTTP protocol can only be used when layer 4 protocol is TCP or unset", false
 			}
+			if haveDNS && (port.Protocol != nil && *port.Protocol != v1.ProtocolTCP && *port.Protocol != v1.ProtocolUDP) {
+				return "DNS protocol can only be used when layer 4 protocol is TCP, UDP or unset", false
+			}
+			if haveKafka && (port.Protocol != nil && *port.Protocol != v1.ProtocolTCP) {
+				return "Kafka protocol can only be used when layer 4 protocol is TCP or unset", false
+			}
 		}
 		for _, protocol := range r.Protocols {
 			if haveHTTP && (protocol.IGMP != nil || protocol.ICMP != nil) {
 				return "HTTP protocol can not be used with protocol IGMP or ICMP", false
 			}
+			if haveDNS && (protocol.IGMP != nil || protocol.ICMP != nil) {
+				return "DNS protocol can not be used with protocol IGMP or ICMP", false
+			}
+			if haveKafka && (protocol.IGMP != nil || protocol.ICMP != nil) {
+				return "Kafka protocol can not be used with protocol IGMP or ICMP", false
+			}
+		}
+	}
+	return "", true
+}
+
+// validateHTTPProtocol validates the headers, query parameters, user agent and reject status code of
+// an HTTP protocol are valid.
+func validateHTTPProtocol(http *crdv1beta1.HTTPProtocol) (string, bool) {
+	for _, h := range http.Headers {
+		if !allowedL7HeaderName.MatchString(h.Name) {
+			return fmt.Sprintf("invalid HTTP header name: %s", h.Name), false
+		}
+		if h.Value != "" && h.Regex != "" {
+			return fmt.Sprintf("value and regex cannot be set at the same time for HTTP header %s", h.Name), false
+		}
+		if !allowedL7HeaderValue.MatchString(h.Value) {
+			return fmt.Sprintf("invalid characters in HTTP header value: %s", h.Value), false
+		}
+		if h.Regex != "" {
+			// The regular expression is inserted as is in a PCRE expression delimited by slashes in the
+			// L7 engine rules, so it must not contain control characters or delimiters.
+			if !allowedL7HeaderRegex.MatchString(h.Regex) {
+				return fmt.Sprintf("HTTP header regex can only contain printable ASCII characters other than '/', '\"' and ';': %q", h.Regex), false
+			}
+			if _, err := regexp.Compile(h.Regex); err != nil {
+				return fmt.Sprintf("invalid HTTP header regex %s: %v", h.Regex, err), false
+			}
+		}
+	}
+	for _, q := range http.QueryParams {
+		if !allowedHTTPQueryParamName.MatchString(q.Name) {
+			return fmt.Sprintf("invalid HTTP query parameter name: %s", q.Name), false
+		}
+		if !allowedHTTPQueryParamValue.MatchString(q.Value) {
+			return fmt.Sprintf("invalid characters in HTTP query parameter value: %s", q.Value), false
+		}
+	}
+	if !allowedL7HeaderValue.MatchString(http.UserAgent) {
+		return fmt.Sprintf("invalid characters in HTTP user agent: %s", http.UserAgent), false
+	}
+	if http.RejectStatusCode != 0 && (http.RejectStatusCode < 400 || http.RejectStatusCode > 599) {
+		return fmt.Sprintf("HTTP rejectStatusCode must be a 4xx or 5xx status code: %d", http.RejectStatusCode), false
+	}
+	return "", true
+}
+
+// validateGRPCProtocol validates the service, method and metadata of a gRPC protocol are valid.
+func validateGRPCProtocol(grpc *crdv1beta1.GRPCProtocol) (string, bool) {
+	if grpc.Service != "" && !allowedGRPCServiceName.MatchString(grpc.Service) {
+		return fmt.Sprintf("invalid gRPC service name: %s", grpc.Service), false
+	}
+	if grpc.Method != "" && !allowedGRPCMethodName.MatchString(grpc.Method) {
+		return fmt.Sprintf("invalid gRPC method name: %s", grpc.Method), false
+	}
+	for _, m := range grpc.Metadata {
+		if !allowedL7HeaderName.MatchString(m.Name) {
+			return fmt.Sprintf("invalid gRPC metadata name: %s", m.Name), false
+		}
+		if strings.HasSuffix(strings.ToLower(m.Name), "-bin") {
+			return fmt.Sprintf("binary gRPC metadata is not supported: %s", m.Name), false
+		}
+		if !allowedL7HeaderValue.MatchString(m.Value) {
+			return fmt.Sprintf("invalid characters in gRPC metadata value: %s", m.Value), false
+		}
+	}
+	return "", true
+}
+
+// validateDNSProtocol validates the query name, record types and reject response code of a DNS
+// protocol are valid.
+func validateDNSProtocol(dnsProtocol *crdv1beta1.DNSProtocol) (string, bool) {
+	if dnsProtocol.QueryName != "" {
+		if !allowedFQDNChars.MatchString(dnsProtocol.QueryName) {
+			return fmt.Sprintf("invalid characters in DNS queryName: %s", dnsProtocol.QueryName), false
+		}
+		// Wildcards are only supported as prefix or suffix of the name.
+		if strings.Contains(strings.TrimSuffix(strings.TrimPrefix(dnsProtocol.QueryName, "*"), "*"), "*") {
+			return fmt.Sprintf("wildcard '*' can only be used at the beginning or the end of DNS queryName: %s", dnsProtocol.QueryName), false
+		}
+	}
+	for _, t := range dnsProtocol.RecordTypes {
+		if _, ok := dns.StringToType[t]; !ok {
+			return fmt.Sprintf("invalid DNS record type: %s", t), false
+		}
+	}
+	switch dnsProtocol.RejectResponseCode {
+	case "", crdv1beta1.DNSResponseCodeRefused, crdv1beta1.DNSResponseCodeNXDomain:
+	default:
+		return fmt.Sprintf("DNS rejectResponseCode must be %s or %s: %s", crdv1beta1.DNSResponseCodeRefused, crdv1beta1.DNSResponseCodeNXDomain, dnsProtocol.RejectResponseCode), false
+	}
+	return "", true
+}
+
+// getDNSRejectResponseCode returns the response code used to reject DNS queries not matching a
+// DNS protocol, which defaults to REFUSED.
+func getDNSRejectResponseCode(dnsProtocol *crdv1beta1.DNSProtocol) crdv1beta1.DNSResponseCode {
+	if dnsProtocol.RejectResponseCode == "" {
+		return crdv1beta1.DNSResponseCodeRefused
+	}
+	return dnsProtocol.RejectResponseCode
+}
+
+// validateKafkaProtocol validates the API key and topic of a Kafka protocol are valid.
+func validateKafkaProtocol(kafka *crdv1beta1.KafkaProtocol) (string, bool) {
+	switch kafka.APIKey {
+	case "", crdv1beta1.KafkaAPIKeyProduce, crdv1beta1.KafkaAPIKeyFetch, crdv1beta1.KafkaAPIKeyMetadata:
+	default:
+		return fmt.Sprintf("Kafka apiKey must be %s, %s or %s: %s", crdv1beta1.KafkaAPIKeyProduce, crdv1beta1.KafkaAPIKeyFetch, crdv1beta1.KafkaAPIKeyMetadata, kafka.APIKey), false
+	}
+	if kafka.Topic != "" && !allowedKafkaTopicName.MatchString(kafka.Topic) {
+		return fmt.Sprintf("invalid Kafka topic name: %s", kafka.Topic), false
+	}
+	return "", true
+}
+
+// validateRuleSchedules validates the schedule field set in Antrea-native policy rules.
+func (v *antreaPolicyValidator) validateRuleSchedules(ingressRules, egressRules []crdv1beta1.Rule) (string, bool) {
+	for _, rules := range [][]crdv1beta1.Rule{ingressRules, egressRules} {
+		for _, r := range rules {
+			if r.Schedule == nil {
+				continue
+			}
+			if err := validateRuleSchedule(r.Schedule); err != nil {
+				return fmt.Sprintf("invalid schedule in rule %s: %v", r.Name, err), false
+			}
+		}
+	}
+	return "", true
+}
+
+// validateRuleRateLimits validates the rateLimit field set in Antrea-native policy rules.
+func (v *antreaPolicyValidator) validateRuleRateLimits(ingressRules, egressRules []crdv1beta1.Rule) (string, bool) {
+	for _, rules := range [][]crdv1beta1.Rule{ingressRules, egressRules} {
+		for _, r := range rules {
+			if r.RateLimit == nil {
+				continue
+			}
+			if r.Action == nil || *r.Action != crdv1beta1.RuleActionAllow {
+				return fmt.Sprintf("rateLimit can only be set for rules with Allow action, rule %s", r.Name), false
+			}
+			if err := validateRuleRateLimit(r.RateLimit); err != nil {
+				return fmt.Sprintf("invalid rateLimit in rule %s: %v", r.Name, err), false
+			}
+		}
+	}
+	return "", true
+}
+
+// validateRejectResponses validates the rejectResponse field set in Antrea-native policy rules. The rejectResponse
+// cannot be set for rules applied to Nodes, as the reject responses of Node NetworkPolicies are not customizable.
+func (v *antreaPolicyValidator) validateRejectResponses(specAppliedTo []crdv1beta1.AppliedTo, ingressRules, egressRules []crdv1beta1.Rule) (string, bool) {
+	appliedToNode := func(appliedTos []crdv1beta1.AppliedTo) bool {
+		for _, eachAppliedTo := range appliedTos {
+			if eachAppliedTo.NodeSelector != nil {
+				return true
+			}
+		}
+		return false
+	}
+	for _, rules := range [][]crdv1beta1.Rule{ingressRules, egressRules} {
+		for _, r := range rules {
+			if r.RejectResponse == "" {
+				continue
+			}
+			if r.Action == nil || *r.Action != crdv1beta1.RuleActionReject {
+				return fmt.Sprintf("rejectResponse can only be set for rules with Reject action, rule %s", r.Name), false
+			}
+			switch r.RejectResponse {
+			case crdv1beta1.RejectResponseTCPReset, crdv1beta1.RejectResponseICMPAdminProhibited,
+				crdv1beta1.RejectResponseICMPPortUnreachable, crdv1beta1.RejectResponseICMPHostUnreachable:
+			default:
+				return fmt.Sprintf("invalid rejectResponse %s in rule %s", r.RejectResponse, r.Name), false
+			}
+			if appliedToNode(specAppliedTo) || appliedToNode(r.AppliedTo) {
+				return fmt.Sprintf("rejectResponse cannot be set for rules applied to Nodes, rule %s", r.Name), false
+			}
 		}
 	}
 	return "", true
@@ -1047,7 +1425,7 @@ func (t *tierValidator) deleteValidate(oldObj interface{}, userInfo authenticati
 // validateAntreaClusterGroupSpec ensures that an IPBlock is not set along with namespaceSelector and/or a
 // podSelector. Similarly, ExternalEntitySelector cannot be set with PodSelector.
 func validateAntreaClusterGroupSpec(s crdv1beta1.GroupSpec) (string, bool) {
-	errMsg := "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks or childGroups can be set for a ClusterGroup"
+	errMsg := "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks, ipList or childGroups can be set for a ClusterGroup"
 	setFieldNum := numFieldsSetInStruct(s)
 	if setFieldNum > 2 {
 		return errMsg, false
@@ -1066,7 +1444,7 @@ func validateAntreaClusterGroupSpec(s crdv1beta1.GroupSpec) (string, bool) {
 }
 
 func validateAntreaGroupSpec(s crdv1beta1.GroupSpec) (string, bool) {
-	errMsg := "At most one of podSelector, externalEntitySelector, serviceReference, ipBlocks or childGroups can be set for a Group"
+	errMsg := "At most one of podSelector, externalEntitySelector, serviceReference, ipBlocks, ipList or childGroups can be set for a Group"
 	setFieldNum := numFieldsSetInStruct(s)
 	if setFieldNum > 2 {
 		return errMsg, false
@@ -1159,6 +1537,11 @@ func (g *groupValidator) validateCG(cg *crdv1beta1.ClusterGroup) (string, bool)
 	if !allowed {
 		return reason, allowed
 	}
+	if cg.Spec.IPList != nil {
+		if reason, allowed := validateIPListSource(cg.Spec.IPList, ""); !allowed {
+			return reason, allowed
+		}
+	}
 	return g.validateChildClusterGroup(cg)
 }
 
@@ -1167,6 +1550,11 @@ func (g *groupValidator) validateG(grp *crdv1beta1.Group) (string, bool) {
 	if !allowed {
 		return reason, allowed
 	}
+	if grp.Spec.IPList != nil {
+		if reason, allowed := validateIPListSource(grp.Spec.IPList, grp.Namespace); !allowed {
+			return reason, allowed
+		}
+	}
 	return g.validateChildGroup(grp)
 }
 
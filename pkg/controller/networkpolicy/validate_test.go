@@ -17,6 +17,7 @@ package networkpolicy
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	admv1 "k8s.io/api/admission/v1"
@@ -24,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/component-base/featuregate"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/network-policy-api/apis/v1alpha1"
 
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
@@ -526,6 +528,68 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			operation:      admv1.Create,
 			expectedReason: "",
 		},
+		{
+			name: "acnp-reject-response-appliedto-node",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-reject-response-appliedto-node",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo2": "bar2"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "rejected",
+							Action: ptr.To(crdv1beta1.RuleActionReject),
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									IPBlock: &crdv1beta1.IPBlock{CIDR: "10.0.0.0/24"},
+								},
+							},
+							RejectResponse: crdv1beta1.RejectResponseTCPReset,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rejectResponse cannot be set for rules applied to Nodes, rule rejected",
+		},
+		{
+			name: "acnp-reject-response-rule-appliedto-node",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-reject-response-rule-appliedto-node",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "rejected",
+							Action: ptr.To(crdv1beta1.RuleActionReject),
+							AppliedTo: []crdv1beta1.AppliedTo{
+								{
+									NodeSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"foo2": "bar2"},
+									},
+								},
+							},
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									IPBlock: &crdv1beta1.IPBlock{CIDR: "10.0.0.0/24"},
+								},
+							},
+							RejectResponse: crdv1beta1.RejectResponseTCPReset,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rejectResponse cannot be set for rules applied to Nodes, rule rejected",
+		},
 		{
 			name: "acnp-appliedto-node-with-loglabel",
 			policy: &crdv1beta1.ClusterNetworkPolicy{
@@ -1123,6 +1187,200 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			operation:      admv1.Create,
 			expectedReason: "",
 		},
+		{
+			name: "acnp-toservice-selector",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-toservice-selector",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							ToServices: []crdv1beta1.PeerService{
+								{
+									ServiceSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"tier": "db"},
+									},
+									NamespaceSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"env": "prod"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-toservice-selector-with-name",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-toservice-selector-with-name",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							ToServices: []crdv1beta1.PeerService{
+								{
+									Name: "foo",
+									ServiceSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"tier": "db"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "name and serviceSelector cannot be set at the same time in toServices",
+		},
+		{
+			name: "acnp-toservice-selector-with-namespace-and-namespaceselector",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-toservice-selector-with-namespace-and-namespaceselector",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							ToServices: []crdv1beta1.PeerService{
+								{
+									Namespace: "bar",
+									ServiceSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"tier": "db"},
+									},
+									NamespaceSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"env": "prod"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "namespace and namespaceSelector cannot be set at the same time in toServices",
+		},
+		{
+			name: "acnp-toservice-namespaceselector-without-selector",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-toservice-namespaceselector-without-selector",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							ToServices: []crdv1beta1.PeerService{
+								{
+									Name: "foo",
+									NamespaceSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"env": "prod"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "namespaceSelector can only be set together with serviceSelector in toServices",
+		},
+		{
+			name: "acnp-toservice-name-without-namespace",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-toservice-name-without-namespace",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							ToServices: []crdv1beta1.PeerService{
+								{
+									Name: "foo",
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "namespace must be set for Services referenced by name in toServices of a ClusterNetworkPolicy",
+		},
+		{
+			name: "acnp-toservice-selector-clusterset",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-toservice-selector-clusterset",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							ToServices: []crdv1beta1.PeerService{
+								{
+									ServiceSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"tier": "db"},
+									},
+									Scope: crdv1beta1.ScopeClusterSet,
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "serviceSelector cannot be used with ClusterSet scope in toServices",
+		},
 		{
 			name: "acnp-invalid-fqdn",
 			policy: &crdv1beta1.ClusterNetworkPolicy{
@@ -1652,7 +1910,7 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			expectedReason: "",
 		},
 		{
-			name:         "acnp-l7protocols-used-with-pass",
+			name:         "acnp-l7protocols-grpc",
 			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1661,20 +1919,20 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							Service: &crdv1beta1.NamespacedName{
-								Namespace: "foo1",
-								Name:      "bar1",
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
 							},
 						},
 					},
 					Ingress: []crdv1beta1.Rule{
 						{
-							Action: &passAction,
+							Action: &allowAction,
 							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									HTTP: &crdv1beta1.HTTPProtocol{
-										Host:   "test.com",
-										Method: "GET",
+									GRPC: &crdv1beta1.GRPCProtocol{
+										Service:  "payments.Ledger",
+										Method:   "Read",
+										Metadata: []crdv1beta1.GRPCMetadataMatch{{Name: "x-tenant-id", Value: "foo"}},
 									},
 								},
 							},
@@ -1683,10 +1941,10 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "layer 7 protocols only support Allow",
+			expectedReason: "",
 		},
 		{
-			name:         "acnp-l7protocols-HTTP-used-with-UDP",
+			name:         "acnp-l7protocols-grpc-invalid-service",
 			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1695,25 +1953,18 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							Service: &crdv1beta1.NamespacedName{
-								Namespace: "foo1",
-								Name:      "bar1",
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
 							},
 						},
 					},
 					Ingress: []crdv1beta1.Rule{
 						{
 							Action: &allowAction,
-							Ports: []crdv1beta1.NetworkPolicyPort{
-								{
-									Protocol: &k8sProtocolUDP,
-								},
-							},
 							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									HTTP: &crdv1beta1.HTTPProtocol{
-										Host:   "test.com",
-										Method: "GET",
+									GRPC: &crdv1beta1.GRPCProtocol{
+										Service: "/payments.Ledger/Read",
 									},
 								},
 							},
@@ -1722,10 +1973,10 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "HTTP protocol can only be used when layer 4 protocol is TCP or unset",
+			expectedReason: "invalid gRPC service name: /payments.Ledger/Read",
 		},
 		{
-			name:         "acnp-l7protocols-HTTP-used-with-ICMP",
+			name:         "acnp-l7protocols-grpc-invalid-metadata-value",
 			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1734,25 +1985,18 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							Service: &crdv1beta1.NamespacedName{
-								Namespace: "foo1",
-								Name:      "bar1",
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
 							},
 						},
 					},
 					Ingress: []crdv1beta1.Rule{
 						{
 							Action: &allowAction,
-							Protocols: []crdv1beta1.NetworkPolicyProtocol{
-								{
-									ICMP: &crdv1beta1.ICMPProtocol{},
-								},
-							},
 							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									HTTP: &crdv1beta1.HTTPProtocol{
-										Host:   "test.com",
-										Method: "GET",
+									GRPC: &crdv1beta1.GRPCProtocol{
+										Metadata: []crdv1beta1.GRPCMetadataMatch{{Name: "x-tenant-id", Value: `foo"; sid: 1;`}},
 									},
 								},
 							},
@@ -1761,66 +2005,67 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "HTTP protocol can not be used with protocol IGMP or ICMP",
+			expectedReason: "invalid characters in gRPC metadata value: foo\"; sid: 1;",
 		},
 		{
-			name:         "acnp-l7protocols-used-with-toService",
+			name:         "acnp-l7protocols-http-headers",
 			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "egress-rule-l7protocols",
+					Name: "ingress-rule-l7protocols",
 				},
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							NamespaceSelector: &metav1.LabelSelector{
-								MatchLabels: map[string]string{"foo1": "bar1"},
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
 							},
 						},
 					},
-					Egress: []crdv1beta1.Rule{
+					Ingress: []crdv1beta1.Rule{
 						{
 							Action: &allowAction,
 							L7Protocols: []crdv1beta1.L7Protocol{
 								{
 									HTTP: &crdv1beta1.HTTPProtocol{
-										Host:   "test.com",
-										Method: "GET",
+										Path:             "/api/*",
+										Headers:          []crdv1beta1.HTTPHeaderMatch{{Name: "X-Tenant-ID", Regex: "[0-9a-f]{8}"}, {Name: "X-Request-ID"}},
+										QueryParams:      []crdv1beta1.HTTPQueryParamMatch{{Name: "version", Value: "v2"}},
+										UserAgent:        "curl/*",
+										RejectStatusCode: 403,
 									},
 								},
 							},
-							ToServices: []crdv1beta1.PeerService{
-								{
-									Name:      "foo",
-									Namespace: "bar",
-								},
-							},
 						},
 					},
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "layer 7 protocols can not be used with toServices",
+			expectedReason: "",
 		},
 		{
-			name:         "L7NetworkPolicy-disabled",
-			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: false},
+			name:         "acnp-l7protocols-http-header-value-and-regex",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "egress-rule-l7protocols",
+					Name: "ingress-rule-l7protocols",
 				},
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							NamespaceSelector: &metav1.LabelSelector{},
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
 						},
 					},
-					Egress: []crdv1beta1.Rule{
+					Ingress: []crdv1beta1.Rule{
 						{
 							Action: &allowAction,
 							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									HTTP: nil,
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Headers: []crdv1beta1.HTTPHeaderMatch{{Name: "X-Tenant-ID", Value: "foo", Regex: "foo.*"}},
+									},
 								},
 							},
 						},
@@ -1828,108 +2073,149 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "layer 7 protocols can only be used when L7NetworkPolicy is enabled",
+			expectedReason: "value and regex cannot be set at the same time for HTTP header X-Tenant-ID",
 		},
 		{
-			name: "igmp-icmp-both-specified",
+			name:         "acnp-l7protocols-http-header-invalid-regex",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							NamespaceSelector: &metav1.LabelSelector{},
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
 						},
 					},
 					Ingress: []crdv1beta1.Rule{
 						{
-							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									ICMP: &crdv1beta1.ICMPProtocol{
-										ICMPType: &icmpType8,
-										ICMPCode: &icmpCode0,
-									},
-									IGMP: &crdv1beta1.IGMPProtocol{
-										IGMPType:     &query,
-										GroupAddress: "224.0.0.1",
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Headers: []crdv1beta1.HTTPHeaderMatch{{Name: "Content-Type", Regex: "application/.*"}},
 									},
 								},
 							},
-							Action: &allowAction,
 						},
 					},
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "protocol IGMP can not be used with other protocols or other properties like from, to",
+			expectedReason: `HTTP header regex can only contain printable ASCII characters other than '/', '"' and ';': "application/.*"`,
 		},
 		{
-			name: "only-icmp-specified",
+			name:         "acnp-l7protocols-http-header-regex-control-characters",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							NamespaceSelector: &metav1.LabelSelector{},
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
 						},
 					},
 					Ingress: []crdv1beta1.Rule{
 						{
-							Name: "ingressType8",
-							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									ICMP: &crdv1beta1.ICMPProtocol{
-										ICMPType: &icmpType8,
-										ICMPCode: &icmpCode0,
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Headers: []crdv1beta1.HTTPHeaderMatch{{Name: "Content-Type", Regex: "foo\r\n.*"}},
 									},
 								},
 							},
 						},
 					},
-					Egress: []crdv1beta1.Rule{
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: `HTTP header regex can only contain printable ASCII characters other than '/', '"' and ';': "foo\r\n.*"`,
+		},
+		{
+			name:         "acnp-l7protocols-http-invalid-reject-status-code",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							Name: "egressWithICMP",
-							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									ICMP: &crdv1beta1.ICMPProtocol{},
+									HTTP: &crdv1beta1.HTTPProtocol{
+										RejectStatusCode: 200,
+									},
 								},
 							},
 						},
 					},
 				},
 			},
-			operation: admv1.Create,
+			operation:      admv1.Create,
+			expectedReason: "HTTP rejectStatusCode must be a 4xx or 5xx status code: 200",
 		},
 		{
-			name: "icmp-specified-and-action-set-to-pass",
+			name:         "acnp-l7protocols-http-different-reject-status-codes",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							NamespaceSelector: &metav1.LabelSelector{},
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
 						},
 					},
-					Egress: []crdv1beta1.Rule{
+					Ingress: []crdv1beta1.Rule{
 						{
-							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									IGMP: &crdv1beta1.IGMPProtocol{
-										IGMPType:     &report,
-										GroupAddress: "225.1.2.3",
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Path:             "/foo",
+										RejectStatusCode: 403,
+									},
+								},
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Path:             "/bar",
+										RejectStatusCode: 404,
 									},
 								},
 							},
-							Action: &passAction,
 						},
 					},
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "protocol IGMP does not support Pass or Reject",
+			expectedReason: "HTTP rejectStatusCode must be the same in all HTTP protocols of a rule",
 		},
-		// Update use same validate function as create. Only provide one update case here.
 		{
-			name: "acnp-non-existent-tier",
+			name:         "acnp-l7protocols-dns",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "non-existent-tier",
+					Name: "egress-rule-l7protocols",
 				},
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
@@ -1939,50 +2225,38 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 							},
 						},
 					},
-					Tier: "non-existent-tier",
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolUDP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName:          "*.svc.cluster.local",
+										RecordTypes:        []string{"A", "AAAA"},
+										RejectResponseCode: crdv1beta1.DNSResponseCodeNXDomain,
+									},
+								},
+							},
+						},
+					},
 				},
 			},
-			operation:      admv1.Update,
-			expectedReason: "tier non-existent-tier does not exist",
-		}}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			for feature, value := range tt.featureGates {
-				featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, feature, value)
-			}
-			_, controller := newController(nil, nil)
-			validator := NewNetworkPolicyValidator(controller.NetworkPolicyController)
-			warnings, actualReason, allowed := validator.validateAntreaPolicy(tt.policy, "", tt.operation, authenticationv1.UserInfo{})
-			assert.Equal(t, tt.expectedReason, actualReason)
-			assert.Equal(t, tt.expectedWarnings, warnings)
-			if tt.expectedReason == "" {
-				assert.True(t, allowed)
-			} else {
-				assert.False(t, allowed)
-			}
-		})
-	}
-}
-
-// Antrea NetworkPolicy use the same validator and has fewer cases to validate than
-// Antrea ClusterNetworkPolicy. Only provide one test case for create and update here.
-func TestValidateAntreaNetworkPolicy(t *testing.T) {
-	tests := []struct {
-		name           string
-		featureGates   map[featuregate.Feature]bool
-		policy         *crdv1beta1.NetworkPolicy
-		operation      admv1.Operation
-		expectedReason string
-	}{
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
 		{
-			name: "annp-non-existent-tier",
-			policy: &crdv1beta1.NetworkPolicy{
+			name:         "acnp-l7protocols-dns-invalid-record-type",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "non-existent-tier",
-					Namespace: "x",
+					Name: "egress-rule-l7protocols",
 				},
-				Spec: crdv1beta1.NetworkPolicySpec{
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
 							PodSelector: &metav1.LabelSelector{
@@ -1990,20 +2264,31 @@ func TestValidateAntreaNetworkPolicy(t *testing.T) {
 							},
 						},
 					},
-					Tier: "non-existent-tier",
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										RecordTypes: []string{"A", "FOO"},
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "tier non-existent-tier does not exist",
+			expectedReason: "invalid DNS record type: FOO",
 		},
 		{
-			name: "annp-non-existent-tier",
-			policy: &crdv1beta1.NetworkPolicy{
+			name:         "acnp-l7protocols-dns-invalid-wildcard",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "non-existent-tier",
-					Namespace: "x",
+					Name: "egress-rule-l7protocols",
 				},
-				Spec: crdv1beta1.NetworkPolicySpec{
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
 							PodSelector: &metav1.LabelSelector{
@@ -2011,37 +2296,51 @@ func TestValidateAntreaNetworkPolicy(t *testing.T) {
 							},
 						},
 					},
-					Tier: "non-existent-tier",
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName: "foo.*.com",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
-			operation:      admv1.Update,
-			expectedReason: "tier non-existent-tier does not exist",
+			operation:      admv1.Create,
+			expectedReason: "wildcard '*' can only be used at the beginning or the end of DNS queryName: foo.*.com",
 		},
 		{
-			name: "annp-rule-ipblock-except-outside-of-cidr-range",
-			policy: &crdv1beta1.NetworkPolicy{
+			name:         "acnp-l7protocols-dns-different-reject-response-codes",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "annp-rule-ipblock-except-outside-of-cidr-range",
-					Namespace: "default",
+					Name: "egress-rule-l7protocols",
 				},
-				Spec: crdv1beta1.NetworkPolicySpec{
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
 							PodSelector: &metav1.LabelSelector{
-								MatchLabels: map[string]string{"foo1": "bar1"},
+								MatchLabels: map[string]string{"foo": "bar"},
 							},
 						},
 					},
 					Egress: []crdv1beta1.Rule{
 						{
 							Action: &allowAction,
-							To: []crdv1beta1.NetworkPolicyPeer{
+							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									IPBlock: &crdv1beta1.IPBlock{
-										CIDR: "fd00:192:168:1::/64",
-										Except: []string{
-											"fd00:192:168:2::/64",
-										},
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName: "foo.com",
+									},
+								},
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName:          "bar.com",
+										RejectResponseCode: crdv1beta1.DNSResponseCodeNXDomain,
 									},
 								},
 							},
@@ -2050,7 +2349,1134 @@ func TestValidateAntreaNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "except CIDR fd00:192:168:2::/64 is not a strict subset of CIDR fd00:192:168:1::/64",
+			expectedReason: "DNS rejectResponseCode must be the same in all DNS protocols of a rule",
+		},
+		{
+			name:         "acnp-l7protocols-dns-with-sctp",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolSCTP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName: "foo.com",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "DNS protocol can only be used when layer 4 protocol is TCP, UDP or unset",
+		},
+		{
+			name:         "acnp-l7protocols-kafka",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolTCP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									Kafka: &crdv1beta1.KafkaProtocol{
+										APIKey: crdv1beta1.KafkaAPIKeyProduce,
+										Topic:  "orders",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation: admv1.Create,
+		},
+		{
+			name:         "acnp-l7protocols-kafka-invalid-api-key",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolTCP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									Kafka: &crdv1beta1.KafkaProtocol{
+										APIKey: "delete-topics",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "Kafka apiKey must be produce, fetch or metadata: delete-topics",
+		},
+		{
+			name:         "acnp-l7protocols-kafka-invalid-topic",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolTCP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									Kafka: &crdv1beta1.KafkaProtocol{
+										Topic: "orders/eu",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid Kafka topic name: orders/eu",
+		},
+		{
+			name:         "acnp-l7protocols-kafka-with-udp",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolUDP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									Kafka: &crdv1beta1.KafkaProtocol{
+										Topic: "orders",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "Kafka protocol can only be used when layer 4 protocol is TCP or unset",
+		},
+		{
+			name:         "acnp-l7protocols-used-with-pass",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &passAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Host:   "test.com",
+										Method: "GET",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "layer 7 protocols only support Allow",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-used-with-UDP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolUDP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Host:   "test.com",
+										Method: "GET",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "HTTP protocol can only be used when layer 4 protocol is TCP or unset",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-used-with-ICMP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{},
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Host:   "test.com",
+										Method: "GET",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "HTTP protocol can not be used with protocol IGMP or ICMP",
+		},
+		{
+			name:         "acnp-l7protocols-used-with-toService",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Host:   "test.com",
+										Method: "GET",
+									},
+								},
+							},
+							ToServices: []crdv1beta1.PeerService{
+								{
+									Name:      "foo",
+									Namespace: "bar",
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "layer 7 protocols can not be used with toServices",
+		},
+		{
+			name:         "L7NetworkPolicy-disabled",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: false},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: nil,
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "layer 7 protocols can only be used when L7NetworkPolicy is enabled",
+		},
+		{
+			name: "igmp-icmp-both-specified",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{
+										ICMPType: &icmpType8,
+										ICMPCode: &icmpCode0,
+									},
+									IGMP: &crdv1beta1.IGMPProtocol{
+										IGMPType:     &query,
+										GroupAddress: "224.0.0.1",
+									},
+								},
+							},
+							Action: &allowAction,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "protocol IGMP can not be used with other protocols or other properties like from, to",
+		},
+		{
+			name: "only-icmp-specified",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name: "ingressType8",
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{
+										ICMPType: &icmpType8,
+										ICMPCode: &icmpCode0,
+									},
+								},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Name: "egressWithICMP",
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation: admv1.Create,
+		},
+		{
+			name: "icmp-specified-and-action-set-to-pass",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									IGMP: &crdv1beta1.IGMPProtocol{
+										IGMPType:     &report,
+										GroupAddress: "225.1.2.3",
+									},
+								},
+							},
+							Action: &passAction,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "protocol IGMP does not support Pass or Reject",
+		},
+		{
+			name: "acnp-identity",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-identity",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Identity: &crdv1beta1.WorkloadIdentity{ServiceAccount: &crdv1beta1.NamespacedName{Namespace: "ns1", Name: "db"}},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									Identity: &crdv1beta1.WorkloadIdentity{SPIFFEID: "spiffe://cluster.local/ns/ns2/sa/client"},
+									Scope:    crdv1beta1.ScopeClusterSet,
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-identity-invalid-spiffe-id",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-identity-invalid-spiffe-id",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									Identity: &crdv1beta1.WorkloadIdentity{SPIFFEID: "spiffe://cluster.local/workload/client"},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: `invalid SPIFFE ID "spiffe://cluster.local/workload/client": its path must be of the form /ns/<namespace>/sa/<service-account>`,
+		},
+		{
+			name: "acnp-identity-multiple-fields",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-identity-multiple-fields",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Identity: &crdv1beta1.WorkloadIdentity{
+								SPIFFEID:       "spiffe://cluster.local/ns/ns1/sa/db",
+								ServiceAccount: &crdv1beta1.NamespacedName{Namespace: "ns1", Name: "db"},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "exactly one of spiffeID and serviceAccount must be set in identity",
+		},
+		{
+			name: "acnp-identity-with-other-peers",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-identity-with-other-peers",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							To: []crdv1beta1.NetworkPolicyPeer{
+								{
+									Identity:          &crdv1beta1.WorkloadIdentity{SPIFFEID: "spiffe://cluster.local/ns/ns1/sa/db"},
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "identity cannot be set with other peers except scope in rules",
+		},
+		{
+			name: "acnp-identity-appliedto-per-namespace-rule",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-identity-appliedto-per-namespace-rule",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Identity: &crdv1beta1.WorkloadIdentity{SPIFFEID: "spiffe://cluster.local/ns/ns1/sa/db"},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									Namespaces: &crdv1beta1.PeerNamespaces{Match: crdv1beta1.NamespaceMatchSelf},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "identity cannot be set in appliedTo of a ClusterNetworkPolicy with per-Namespace rules",
+		},
+		// Update use same validate function as create. Only provide one update case here.
+		{
+			name: "acnp-non-existent-tier",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "non-existent-tier",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Tier: "non-existent-tier",
+				},
+			},
+			operation:      admv1.Update,
+			expectedReason: "tier non-existent-tier does not exist",
+		}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for feature, value := range tt.featureGates {
+				featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, feature, value)
+			}
+			_, controller := newController(nil, nil)
+			validator := NewNetworkPolicyValidator(controller.NetworkPolicyController)
+			warnings, actualReason, allowed := validator.validateAntreaPolicy(tt.policy, "", tt.operation, authenticationv1.UserInfo{})
+			assert.Equal(t, tt.expectedReason, actualReason)
+			assert.Equal(t, tt.expectedWarnings, warnings)
+			if tt.expectedReason == "" {
+				assert.True(t, allowed)
+			} else {
+				assert.False(t, allowed)
+			}
+		})
+	}
+}
+
+// Antrea NetworkPolicy use the same validator and has fewer cases to validate than
+// Antrea ClusterNetworkPolicy. Only provide one test case for create and update here.
+func TestValidateAntreaNetworkPolicy(t *testing.T) {
+	tests := []struct {
+		name           string
+		featureGates   map[featuregate.Feature]bool
+		policy         *crdv1beta1.NetworkPolicy
+		operation      admv1.Operation
+		expectedReason string
+	}{
+		{
+			name: "annp-non-existent-tier",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "non-existent-tier",
+					Namespace: "x",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Tier: "non-existent-tier",
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "tier non-existent-tier does not exist",
+		},
+		{
+			name: "annp-non-existent-tier",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "non-existent-tier",
+					Namespace: "x",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Tier: "non-existent-tier",
+				},
+			},
+			operation:      admv1.Update,
+			expectedReason: "tier non-existent-tier does not exist",
+		},
+		{
+			name: "annp-rule-ipblock-except-outside-of-cidr-range",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "annp-rule-ipblock-except-outside-of-cidr-range",
+					Namespace: "default",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							To: []crdv1beta1.NetworkPolicyPeer{
+								{
+									IPBlock: &crdv1beta1.IPBlock{
+										CIDR: "fd00:192:168:1::/64",
+										Except: []string{
+											"fd00:192:168:2::/64",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "except CIDR fd00:192:168:2::/64 is not a strict subset of CIDR fd00:192:168:1::/64",
+		},
+		{
+			name: "annp-rule-schedule-valid",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "annp-rule-schedule-valid",
+					Namespace: "default",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Schedule: &crdv1beta1.RuleSchedule{
+								TimeZone: "Europe/Paris",
+								Windows: []crdv1beta1.ScheduleWindow{
+									{Start: "22:00", End: "06:00", DaysOfWeek: []crdv1beta1.DayOfWeek{crdv1beta1.DayOfWeekSaturday}},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "annp-rule-schedule-invalid-time",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "annp-rule-schedule-invalid-time",
+					Namespace: "default",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Name:   "backup",
+							Action: &allowAction,
+							Schedule: &crdv1beta1.RuleSchedule{
+								Windows: []crdv1beta1.ScheduleWindow{{Start: "22:00", End: "24:00"}},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid schedule in rule backup: invalid end time \"24:00\", must be in HH:MM format",
+		},
+		{
+			name: "annp-rule-schedule-no-window",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "annp-rule-schedule-no-window",
+					Namespace: "default",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:     "backup",
+							Action:   &allowAction,
+							Schedule: &crdv1beta1.RuleSchedule{},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid schedule in rule backup: at least one window must be set",
+		},
+		{
+			name: "annp-ratelimit-valid",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "annp-ratelimit-valid",
+					Namespace: "default",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "limited",
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+							RateLimit: &crdv1beta1.RuleRateLimit{
+								ConnectionsPerSecond: ptr.To[int32](100),
+								Bandwidth:            &crdv1beta1.Bandwidth{Rate: "10M", Burst: "20M"},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "annp-ratelimit-drop-action",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "annp-ratelimit-drop-action",
+					Namespace: "default",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "limited",
+							Action: &dropAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+							RateLimit: &crdv1beta1.RuleRateLimit{ConnectionsPerSecond: ptr.To[int32](100)},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rateLimit can only be set for rules with Allow action, rule limited",
+		},
+		{
+			name: "annp-ratelimit-empty",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "annp-ratelimit-empty",
+					Namespace: "default",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "limited",
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+							RateLimit: &crdv1beta1.RuleRateLimit{},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid rateLimit in rule limited: at least one of connectionsPerSecond and bandwidth must be set",
+		},
+		{
+			name: "annp-reject-response-valid",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "annp-reject-response-valid",
+					Namespace: "default",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "rejected",
+							Action: ptr.To(crdv1beta1.RuleActionReject),
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+							RejectResponse: crdv1beta1.RejectResponseICMPPortUnreachable,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "annp-reject-response-allow-action",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "annp-reject-response-allow-action",
+					Namespace: "default",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "rejected",
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+							RejectResponse: crdv1beta1.RejectResponseICMPAdminProhibited,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rejectResponse can only be set for rules with Reject action, rule rejected",
+		},
+		{
+			name: "annp-reject-response-invalid",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "annp-reject-response-invalid",
+					Namespace: "default",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "rejected",
+							Action: ptr.To(crdv1beta1.RuleActionReject),
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+							RejectResponse: "ICMPNetUnreachable",
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid rejectResponse ICMPNetUnreachable in rule rejected",
+		},
+		{
+			name: "annp-expiry-valid",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "annp-expiry-valid",
+					Namespace: "default",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+						},
+					},
+					Expiry: &crdv1beta1.PolicyExpiry{
+						TTL:            &metav1.Duration{Duration: time.Hour},
+						DeleteOnExpiry: true,
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "annp-expiry-both-set",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "annp-expiry-both-set",
+					Namespace: "default",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+						},
+					},
+					Expiry: &crdv1beta1.PolicyExpiry{
+						ExpirationTime: &metav1.Time{Time: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)},
+						TTL:            &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid expiry: exactly one of expirationTime and ttl must be set",
+		},
+		{
+			name: "annp-expiry-negative-ttl",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "annp-expiry-negative-ttl",
+					Namespace: "default",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+						},
+					},
+					Expiry: &crdv1beta1.PolicyExpiry{
+						TTL: &metav1.Duration{Duration: -time.Minute},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid expiry: ttl must be positive",
+		},
+		{
+			name: "annp-identity-appliedto-other-namespace",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "annp-identity-appliedto-other-namespace",
+					Namespace: "default",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Identity: &crdv1beta1.WorkloadIdentity{SPIFFEID: "spiffe://cluster.local/ns/other/sa/db"},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									Identity: &crdv1beta1.WorkloadIdentity{SPIFFEID: "spiffe://cluster.local/ns/other/sa/client"},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "identity in appliedTo must be in the Namespace of the policy default",
 		},
 	}
 
@@ -2130,7 +3556,7 @@ func TestValidateAntreaClusterGroup(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks or childGroups can be set for a ClusterGroup",
+			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks, ipList or childGroups can be set for a ClusterGroup",
 		},
 		{
 			name: "cg-set-with-psel-and-nssel",
@@ -2182,7 +3608,7 @@ func TestValidateAntreaClusterGroup(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks or childGroups can be set for a ClusterGroup",
+			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks, ipList or childGroups can be set for a ClusterGroup",
 		},
 		{
 			name: "cg-set-with-podselector-and-ipblock",
@@ -2200,7 +3626,7 @@ func TestValidateAntreaClusterGroup(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks or childGroups can be set for a ClusterGroup",
+			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks, ipList or childGroups can be set for a ClusterGroup",
 		},
 		{
 			name: "cg-set-with-ipblock",
@@ -2407,7 +3833,7 @@ func TestValidateAntreaGroup(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlocks or childGroups can be set for a Group",
+			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlocks, ipList or childGroups can be set for a Group",
 		},
 		{
 			name: "group-set-with-psel-and-nssel",
@@ -2462,7 +3888,7 @@ func TestValidateAntreaGroup(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlocks or childGroups can be set for a Group",
+			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlocks, ipList or childGroups can be set for a Group",
 		},
 		{
 			name: "group-set-with-podselector-and-ipblock",
@@ -2481,7 +3907,7 @@ func TestValidateAntreaGroup(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlocks or childGroups can be set for a Group",
+			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlocks, ipList or childGroups can be set for a Group",
 		},
 		{
 			name: "group-set-with-ipblock",
@@ -0,0 +1,111 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+const spiffeIDScheme = "spiffe"
+
+// parseSPIFFEID returns the Namespace and the name of the ServiceAccount a SPIFFE ID is derived from. The SPIFFE ID
+// must follow the format spiffe://<trust-domain>/ns/<namespace>/sa/<service-account>, and the trust domain is ignored.
+func parseSPIFFEID(id string) (string, string, error) {
+	u, err := url.Parse(id)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid SPIFFE ID %q: %w", id, err)
+	}
+	if u.Scheme != spiffeIDScheme || u.Host == "" || u.Port() != "" || u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return "", "", fmt.Errorf("invalid SPIFFE ID %q: it must be of the form spiffe://<trust-domain>/<path>", id)
+	}
+	parts := strings.Split(u.EscapedPath(), "/")
+	if len(parts) != 5 || parts[0] != "" || parts[1] != "ns" || parts[3] != "sa" {
+		return "", "", fmt.Errorf("invalid SPIFFE ID %q: its path must be of the form /ns/<namespace>/sa/<service-account>", id)
+	}
+	return parts[2], parts[4], nil
+}
+
+// workloadIdentityToServiceAccount returns the Namespace and the name of the ServiceAccount a workload identity is
+// derived from.
+func workloadIdentityToServiceAccount(identity *crdv1beta1.WorkloadIdentity) (string, string, error) {
+	if identity.ServiceAccount != nil {
+		return identity.ServiceAccount.Namespace, identity.ServiceAccount.Name, nil
+	}
+	return parseSPIFFEID(identity.SPIFFEID)
+}
+
+// validateWorkloadIdentity ensures that exactly one of the fields of a workload identity is set, and that it refers to
+// a valid ServiceAccount.
+func validateWorkloadIdentity(identity *crdv1beta1.WorkloadIdentity) error {
+	if (identity.SPIFFEID == "") == (identity.ServiceAccount == nil) {
+		return fmt.Errorf("exactly one of spiffeID and serviceAccount must be set in identity")
+	}
+	namespace, name, err := workloadIdentityToServiceAccount(identity)
+	if err != nil {
+		return err
+	}
+	if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return fmt.Errorf("invalid Namespace %q in identity: %s", namespace, strings.Join(errs, "; "))
+	}
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return fmt.Errorf("invalid ServiceAccount name %q in identity: %s", name, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// workloadIdentityToSelector returns the Namespace and the label selector of the workloads with the given identity.
+// The selector can be used as both a PodSelector and an ExternalEntitySelector, as the ServiceAccount of Pods and
+// ExternalEntities is added to their labels by the groupingInterface.
+func workloadIdentityToSelector(identity *crdv1beta1.WorkloadIdentity) (string, *metav1.LabelSelector, error) {
+	namespace, serviceAccount, err := workloadIdentityToServiceAccount(identity)
+	if err != nil {
+		return "", nil, err
+	}
+	return namespace, serviceAccountNameToPodSelector(serviceAccount), nil
+}
+
+// createAddressGroupsForWorkloadIdentity creates the AddressGroups selecting the Pods and the ExternalEntities with
+// the given workload identity.
+func (n *NetworkPolicyController) createAddressGroupsForWorkloadIdentity(identity *crdv1beta1.WorkloadIdentity) ([]*antreatypes.AddressGroup, error) {
+	namespace, selector, err := workloadIdentityToSelector(identity)
+	if err != nil {
+		return nil, err
+	}
+	return []*antreatypes.AddressGroup{
+		n.createAddressGroup(namespace, selector, nil, nil, nil),
+		n.createAddressGroup(namespace, nil, nil, selector, nil),
+	}, nil
+}
+
+// createAppliedToGroupsForWorkloadIdentity creates the AppliedToGroups selecting the Pods and the ExternalEntities
+// with the given workload identity.
+func (n *NetworkPolicyController) createAppliedToGroupsForWorkloadIdentity(identity *crdv1beta1.WorkloadIdentity) ([]*antreatypes.AppliedToGroup, error) {
+	namespace, selector, err := workloadIdentityToSelector(identity)
+	if err != nil {
+		return nil, err
+	}
+	return []*antreatypes.AppliedToGroup{
+		n.createAppliedToGroup(namespace, selector, nil, nil, nil),
+		n.createAppliedToGroup(namespace, nil, nil, selector, nil),
+	}, nil
+}
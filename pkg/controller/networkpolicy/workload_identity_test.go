@@ -0,0 +1,126 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+func TestParseSPIFFEID(t *testing.T) {
+	tests := []struct {
+		name              string
+		id                string
+		expectedNamespace string
+		expectedName      string
+		expectedErr       string
+	}{
+		{
+			name:              "valid",
+			id:                "spiffe://cluster.local/ns/default/sa/web",
+			expectedNamespace: "default",
+			expectedName:      "web",
+		},
+		{
+			name:              "other trust domain",
+			id:                "spiffe://example.org/ns/prod/sa/db",
+			expectedNamespace: "prod",
+			expectedName:      "db",
+		},
+		{
+			name:        "invalid scheme",
+			id:          "https://cluster.local/ns/default/sa/web",
+			expectedErr: `invalid SPIFFE ID "https://cluster.local/ns/default/sa/web": it must be of the form spiffe://<trust-domain>/<path>`,
+		},
+		{
+			name:        "missing trust domain",
+			id:          "spiffe:///ns/default/sa/web",
+			expectedErr: `invalid SPIFFE ID "spiffe:///ns/default/sa/web": it must be of the form spiffe://<trust-domain>/<path>`,
+		},
+		{
+			name:        "port in trust domain",
+			id:          "spiffe://cluster.local:8080/ns/default/sa/web",
+			expectedErr: `invalid SPIFFE ID "spiffe://cluster.local:8080/ns/default/sa/web": it must be of the form spiffe://<trust-domain>/<path>`,
+		},
+		{
+			name:        "invalid path",
+			id:          "spiffe://cluster.local/ns/default/web",
+			expectedErr: `invalid SPIFFE ID "spiffe://cluster.local/ns/default/web": its path must be of the form /ns/<namespace>/sa/<service-account>`,
+		},
+		{
+			name:        "trailing slash",
+			id:          "spiffe://cluster.local/ns/default/sa/web/",
+			expectedErr: `invalid SPIFFE ID "spiffe://cluster.local/ns/default/sa/web/": its path must be of the form /ns/<namespace>/sa/<service-account>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			namespace, name, err := parseSPIFFEID(tt.id)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedNamespace, namespace)
+			assert.Equal(t, tt.expectedName, name)
+		})
+	}
+}
+
+func TestValidateWorkloadIdentity(t *testing.T) {
+	tests := []struct {
+		name        string
+		identity    *crdv1beta1.WorkloadIdentity
+		expectedErr string
+	}{
+		{
+			name:     "valid SPIFFE ID",
+			identity: &crdv1beta1.WorkloadIdentity{SPIFFEID: "spiffe://cluster.local/ns/default/sa/web"},
+		},
+		{
+			name:     "valid ServiceAccount",
+			identity: &crdv1beta1.WorkloadIdentity{ServiceAccount: &crdv1beta1.NamespacedName{Namespace: "default", Name: "web"}},
+		},
+		{
+			name:        "no field set",
+			identity:    &crdv1beta1.WorkloadIdentity{},
+			expectedErr: "exactly one of spiffeID and serviceAccount must be set in identity",
+		},
+		{
+			name:        "invalid Namespace",
+			identity:    &crdv1beta1.WorkloadIdentity{SPIFFEID: "spiffe://cluster.local/ns/Default/sa/web"},
+			expectedErr: `invalid Namespace "Default" in identity: a lowercase RFC 1123 label`,
+		},
+		{
+			name:        "invalid ServiceAccount name",
+			identity:    &crdv1beta1.WorkloadIdentity{ServiceAccount: &crdv1beta1.NamespacedName{Namespace: "default", Name: "web_1"}},
+			expectedErr: `invalid ServiceAccount name "web_1" in identity: a lowercase RFC 1123 subdomain`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateWorkloadIdentity(tt.identity)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	networkinginformers "k8s.io/client-go/informers/networking/v1"
 	"k8s.io/client-go/tools/cache"
@@ -40,6 +41,16 @@ const (
 	GroupNameIndexName = "groupName"
 )
 
+// RuleMetricsRecorder records the traffic stats of NetworkPolicy rules collected from the antrea-agents, e.g. as
+// Prometheus metrics. It is implemented by pkg/controller/metrics.NetworkPolicyRuleMetrics.
+type RuleMetricsRecorder interface {
+	// RecordNetworkPolicyStats records the stats of a NetworkPolicy reported by an antrea-agent. ruleStats is empty
+	// for K8s NetworkPolicies, and for Antrea-native policies if the antrea-agent only reports the overall stats.
+	RecordNetworkPolicyStats(policy *controlplane.NetworkPolicyReference, stats *statsv1alpha1.TrafficStats, ruleStats []statsv1alpha1.RuleTrafficStats)
+	// DeleteNetworkPolicy removes the recorded stats of a deleted NetworkPolicy.
+	DeleteNetworkPolicy(policyType controlplane.NetworkPolicyType, namespace, name string)
+}
+
 // Aggregator collects the stats from the antrea-agents, aggregates them, caches the result, and provides interfaces
 // for Stats API handlers to query them. It implements the following interfaces:
 // - pkg/apiserver/registry/controlplane/nodestatssummary.statsCollector
@@ -47,6 +58,7 @@ const (
 // - pkg/apiserver/registry/stats/antreaclusternetworkpolicystats.statsProvider
 // - pkg/apiserver/registry/stats/antreanetworkpolicystats.statsProvider
 // - pkg/apiserver/registry/stats/multicastgroup.statsProvider
+// - pkg/controller/networkpolicy.RuleHitProvider
 type Aggregator struct {
 	// networkPolicyStats caches the statistics of K8s NetworkPolicies collected from the antrea-agents.
 	networkPolicyStats cache.Indexer
@@ -67,6 +79,15 @@ type Aggregator struct {
 	acnpListerSynced cache.InformerSynced
 	// annpListerSynced is a function which returns true if the Antrea NetworkPolicy shared informer has been synced at least once.
 	annpListerSynced cache.InformerSynced
+	// ruleMetricsRecorder records the stats of NetworkPolicy rules if not nil.
+	ruleMetricsRecorder RuleMetricsRecorder
+	// ruleLastHitTimes caches the last time each rule of Antrea-native policies matched traffic.
+	// The map can be interpreted as
+	// map[UID of policy]map[name of rule]last hit time.
+	// The overall stats reported by antrea-agents which don't report rule stats are stored with
+	// an empty rule name.
+	ruleLastHitTimes      map[types.UID]map[string]time.Time
+	ruleLastHitTimesMutex sync.RWMutex
 }
 
 // uidIndexFunc is an index function that indexes based on an object's UID.
@@ -78,11 +99,13 @@ func uidIndexFunc(obj interface{}) ([]string, error) {
 	return []string{string(meta.GetUID())}, nil
 }
 
-func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInformer, acnpInformer crdinformers.ClusterNetworkPolicyInformer, annpInformer crdinformers.NetworkPolicyInformer) *Aggregator {
+func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInformer, acnpInformer crdinformers.ClusterNetworkPolicyInformer, annpInformer crdinformers.NetworkPolicyInformer, ruleMetricsRecorder RuleMetricsRecorder) *Aggregator {
 	aggregator := &Aggregator{
-		networkPolicyStats: cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc, uidIndex: uidIndexFunc}),
-		dataCh:             make(chan *controlplane.NodeStatsSummary, 1000),
-		npListerSynced:     networkPolicyInformer.Informer().HasSynced,
+		networkPolicyStats:  cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc, uidIndex: uidIndexFunc}),
+		dataCh:              make(chan *controlplane.NodeStatsSummary, 1000),
+		npListerSynced:      networkPolicyInformer.Informer().HasSynced,
+		ruleMetricsRecorder: ruleMetricsRecorder,
+		ruleLastHitTimes:    map[types.UID]map[string]time.Time{},
 	}
 	// Add handlers for NetworkPolicy events.
 	// They are the source of truth of the NetworkPolicyStats, i.e., a NetworkPolicyStats is present only if the
@@ -166,6 +189,9 @@ func (a *Aggregator) deleteNetworkPolicy(obj interface{}) {
 		},
 	}
 	a.networkPolicyStats.Delete(stats)
+	if a.ruleMetricsRecorder != nil {
+		a.ruleMetricsRecorder.DeleteNetworkPolicy(controlplane.K8sNetworkPolicy, np.Namespace, np.Name)
+	}
 }
 
 // addACNP handles ClusterNetworkPolicy ADD events and creates corresponding ClusterNetworkPolicyStats objects.
@@ -205,6 +231,10 @@ func (a *Aggregator) deleteACNP(obj interface{}) {
 		},
 	}
 	a.antreaClusterNetworkPolicyStats.Delete(stats)
+	a.deleteRuleLastHitTimes(acnp.UID)
+	if a.ruleMetricsRecorder != nil {
+		a.ruleMetricsRecorder.DeleteNetworkPolicy(controlplane.AntreaClusterNetworkPolicy, "", acnp.Name)
+	}
 }
 
 // addANNP handles Antrea NetworkPolicy ADD events and creates corresponding AntreaNetworkPolicyStats objects.
@@ -246,6 +276,10 @@ func (a *Aggregator) deleteANNP(obj interface{}) {
 		},
 	}
 	a.antreaNetworkPolicyStats.Delete(stats)
+	a.deleteRuleLastHitTimes(annp.UID)
+	if a.ruleMetricsRecorder != nil {
+		a.ruleMetricsRecorder.DeleteNetworkPolicy(controlplane.AntreaNetworkPolicy, annp.Namespace, annp.Name)
+	}
 }
 
 func (a *Aggregator) ListAntreaClusterNetworkPolicyStats() []statsv1alpha1.AntreaClusterNetworkPolicyStats {
@@ -380,6 +414,10 @@ func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
 			curStats := objs[0].(*statsv1alpha1.NetworkPolicyStats).DeepCopy()
 			addUp(&curStats.TrafficStats, &stats.TrafficStats)
 			a.networkPolicyStats.Update(curStats)
+			if a.ruleMetricsRecorder != nil {
+				policy := &controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: curStats.Namespace, Name: curStats.Name, UID: curStats.UID}
+				a.ruleMetricsRecorder.RecordNetworkPolicyStats(policy, &stats.TrafficStats, nil)
+			}
 		}
 	}
 	if features.DefaultFeatureGate.Enabled(features.Multicast) {
@@ -424,7 +462,12 @@ func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
 				} else {
 					addRulesUp(&curStats.RuleTrafficStats, &curStats.TrafficStats, stats.RuleTrafficStats)
 				}
+				a.updateRuleLastHitTimes(curStats.UID, stats)
 				a.antreaClusterNetworkPolicyStats.Update(curStats)
+				if a.ruleMetricsRecorder != nil {
+					policy := &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: curStats.Name, UID: curStats.UID}
+					a.ruleMetricsRecorder.RecordNetworkPolicyStats(policy, &stats.TrafficStats, stats.RuleTrafficStats)
+				}
 			}
 		}
 
@@ -441,12 +484,59 @@ func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
 				} else {
 					addRulesUp(&curStats.RuleTrafficStats, &curStats.TrafficStats, stats.RuleTrafficStats)
 				}
+				a.updateRuleLastHitTimes(curStats.UID, stats)
 				a.antreaNetworkPolicyStats.Update(curStats)
+				if a.ruleMetricsRecorder != nil {
+					policy := &controlplane.NetworkPolicyReference{Type: controlplane.AntreaNetworkPolicy, Namespace: curStats.Namespace, Name: curStats.Name, UID: curStats.UID}
+					a.ruleMetricsRecorder.RecordNetworkPolicyStats(policy, &stats.TrafficStats, stats.RuleTrafficStats)
+				}
 			}
 		}
 	}
 }
 
+// GetRuleLastHitTime returns the last time the provided rule of the provided Antrea-native policy was
+// reported to have matched traffic. The traffic reported by antrea-agents which don't report rule
+// stats is considered to have matched all the rules of the policy.
+func (a *Aggregator) GetRuleLastHitTime(policy *controlplane.NetworkPolicyReference, ruleName string) time.Time {
+	a.ruleLastHitTimesMutex.RLock()
+	defer a.ruleLastHitTimesMutex.RUnlock()
+	lastHitTime := a.ruleLastHitTimes[policy.UID][ruleName]
+	if policyLastHitTime := a.ruleLastHitTimes[policy.UID][""]; policyLastHitTime.After(lastHitTime) {
+		return policyLastHitTime
+	}
+	return lastHitTime
+}
+
+// updateRuleLastHitTimes records the current time as the last hit time of the rules which matched
+// traffic according to the provided stats.
+func (a *Aggregator) updateRuleLastHitTimes(policyUID types.UID, stats *controlplane.NetworkPolicyStats) {
+	now := time.Now()
+	a.ruleLastHitTimesMutex.Lock()
+	defer a.ruleLastHitTimesMutex.Unlock()
+	record := func(ruleName string) {
+		if _, exists := a.ruleLastHitTimes[policyUID]; !exists {
+			a.ruleLastHitTimes[policyUID] = map[string]time.Time{}
+		}
+		a.ruleLastHitTimes[policyUID][ruleName] = now
+	}
+	if stats.TrafficStats.Bytes > 0 {
+		record("")
+		return
+	}
+	for _, ruleStats := range stats.RuleTrafficStats {
+		if ruleStats.TrafficStats.Packets > 0 || ruleStats.TrafficStats.Sessions > 0 {
+			record(ruleStats.Name)
+		}
+	}
+}
+
+func (a *Aggregator) deleteRuleLastHitTimes(policyUID types.UID) {
+	a.ruleLastHitTimesMutex.Lock()
+	defer a.ruleLastHitTimesMutex.Unlock()
+	delete(a.ruleLastHitTimes, policyUID)
+}
+
 func addUp(stats *statsv1alpha1.TrafficStats, inc *statsv1alpha1.TrafficStats) {
 	stats.Sessions += inc.Sessions
 	stats.Packets += inc.Packets
@@ -16,6 +16,7 @@ package stats
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -522,7 +523,7 @@ func TestAggregatorCollectListGet(t *testing.T) {
 			informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
 			crdClient := fakeversioned.NewSimpleClientset(append(tt.existingAntreaClusterNetworkPolicies, tt.existingAntreaNetworkPolicies...)...)
 			crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
-			a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies())
+			a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies(), nil)
 			informerFactory.Start(stopCh)
 			crdInformerFactory.Start(stopCh)
 			expectedPolicyCount := len(tt.expectedNetworkPolicyStats) + len(tt.expectedAntreaClusterNetworkPolicyStats) + len(tt.expectedAntreaNetworkPolicyStats)
@@ -561,7 +562,7 @@ func TestDeleteNetworkPolicy(t *testing.T) {
 	informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
 	crdClient := fakeversioned.NewSimpleClientset(acnp1, annp1)
 	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
-	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies())
+	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies(), nil)
 	informerFactory.Start(stopCh)
 	crdInformerFactory.Start(stopCh)
 
@@ -627,3 +628,135 @@ func TestDeleteNetworkPolicy(t *testing.T) {
 	})
 	assert.NoError(t, err)
 }
+
+type recordedStats struct {
+	policy    controlplane.NetworkPolicyReference
+	stats     statsv1alpha1.TrafficStats
+	ruleStats []statsv1alpha1.RuleTrafficStats
+}
+
+type fakeRuleMetricsRecorder struct {
+	mutex           sync.Mutex
+	recordedStats   []recordedStats
+	deletedPolicies []controlplane.NetworkPolicyReference
+}
+
+func (r *fakeRuleMetricsRecorder) RecordNetworkPolicyStats(policy *controlplane.NetworkPolicyReference, stats *statsv1alpha1.TrafficStats, ruleStats []statsv1alpha1.RuleTrafficStats) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.recordedStats = append(r.recordedStats, recordedStats{policy: *policy, stats: *stats, ruleStats: ruleStats})
+}
+
+func (r *fakeRuleMetricsRecorder) DeleteNetworkPolicy(policyType controlplane.NetworkPolicyType, namespace, name string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.deletedPolicies = append(r.deletedPolicies, controlplane.NetworkPolicyReference{Type: policyType, Namespace: namespace, Name: name})
+}
+
+func TestAggregatorRuleMetricsRecorder(t *testing.T) {
+	featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.AntreaPolicy, true)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	client := fake.NewSimpleClientset(np1)
+	informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
+	crdClient := fakeversioned.NewSimpleClientset(acnp1, annp1)
+	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
+	recorder := &fakeRuleMetricsRecorder{}
+	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies(), recorder)
+	informerFactory.Start(stopCh)
+	crdInformerFactory.Start(stopCh)
+
+	npStats := statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1, Sessions: 1}
+	acnpRuleStats := []statsv1alpha1.RuleTrafficStats{{Name: "rule1", TrafficStats: statsv1alpha1.TrafficStats{Bytes: 20, Packets: 2, Sessions: 1}}}
+	annpRuleStats := []statsv1alpha1.RuleTrafficStats{{Name: "rule2", TrafficStats: statsv1alpha1.TrafficStats{Bytes: 30, Packets: 3, Sessions: 1}}}
+	summary := &controlplane.NodeStatsSummary{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		NetworkPolicies: []controlplane.NetworkPolicyStats{
+			{NetworkPolicy: controlplane.NetworkPolicyReference{UID: np1.UID}, TrafficStats: npStats},
+			// Stats of removed policies are ignored.
+			{NetworkPolicy: controlplane.NetworkPolicyReference{UID: np2.UID}, TrafficStats: npStats},
+		},
+		AntreaClusterNetworkPolicies: []controlplane.NetworkPolicyStats{
+			{NetworkPolicy: controlplane.NetworkPolicyReference{UID: acnp1.UID}, RuleTrafficStats: acnpRuleStats},
+		},
+		AntreaNetworkPolicies: []controlplane.NetworkPolicyStats{
+			{NetworkPolicy: controlplane.NetworkPolicyReference{UID: annp1.UID}, RuleTrafficStats: annpRuleStats},
+		},
+	}
+	runWrapper(t, a, 3, []*controlplane.NodeStatsSummary{summary})
+
+	assert.Equal(t, []recordedStats{
+		{
+			policy: controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: np1.Namespace, Name: np1.Name, UID: np1.UID},
+			stats:  npStats,
+		},
+		{
+			policy:    controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: acnp1.Name, UID: acnp1.UID},
+			ruleStats: acnpRuleStats,
+		},
+		{
+			policy:    controlplane.NetworkPolicyReference{Type: controlplane.AntreaNetworkPolicy, Namespace: annp1.Namespace, Name: annp1.Name, UID: annp1.UID},
+			ruleStats: annpRuleStats,
+		},
+	}, recorder.recordedStats)
+
+	client.NetworkingV1().NetworkPolicies(np1.Namespace).Delete(context.TODO(), np1.Name, metav1.DeleteOptions{})
+	crdClient.CrdV1beta1().ClusterNetworkPolicies().Delete(context.TODO(), acnp1.Name, metav1.DeleteOptions{})
+	crdClient.CrdV1beta1().NetworkPolicies(annp1.Namespace).Delete(context.TODO(), annp1.Name, metav1.DeleteOptions{})
+	expectedDeletedPolicies := []controlplane.NetworkPolicyReference{
+		{Type: controlplane.K8sNetworkPolicy, Namespace: np1.Namespace, Name: np1.Name},
+		{Type: controlplane.AntreaClusterNetworkPolicy, Name: acnp1.Name},
+		{Type: controlplane.AntreaNetworkPolicy, Namespace: annp1.Namespace, Name: annp1.Name},
+	}
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		recorder.mutex.Lock()
+		defer recorder.mutex.Unlock()
+		assert.ElementsMatch(c, expectedDeletedPolicies, recorder.deletedPolicies)
+	}, time.Second, 100*time.Millisecond)
+}
+
+func TestAggregatorRuleLastHitTime(t *testing.T) {
+	featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.AntreaPolicy, true)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	client := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
+	crdClient := fakeversioned.NewSimpleClientset(acnp1, annp1)
+	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
+	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies(), nil)
+	informerFactory.Start(stopCh)
+	crdInformerFactory.Start(stopCh)
+
+	acnpRef := &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: acnp1.Name, UID: acnp1.UID}
+	annpRef := &controlplane.NetworkPolicyReference{Type: controlplane.AntreaNetworkPolicy, Namespace: annp1.Namespace, Name: annp1.Name, UID: annp1.UID}
+	startTime := time.Now()
+	summary := &controlplane.NodeStatsSummary{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		AntreaClusterNetworkPolicies: []controlplane.NetworkPolicyStats{
+			{
+				NetworkPolicy: controlplane.NetworkPolicyReference{UID: acnp1.UID},
+				RuleTrafficStats: []statsv1alpha1.RuleTrafficStats{
+					{Name: "rule1", TrafficStats: statsv1alpha1.TrafficStats{Bytes: 20, Packets: 2, Sessions: 1}},
+					{Name: "rule2"},
+				},
+			},
+		},
+		AntreaNetworkPolicies: []controlplane.NetworkPolicyStats{
+			// The overall stats reported by an antrea-agent which doesn't report rule stats.
+			{NetworkPolicy: controlplane.NetworkPolicyReference{UID: annp1.UID}, TrafficStats: statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1, Sessions: 1}},
+		},
+	}
+	runWrapper(t, a, 2, []*controlplane.NodeStatsSummary{summary})
+
+	assert.False(t, a.GetRuleLastHitTime(acnpRef, "rule1").Before(startTime))
+	assert.True(t, a.GetRuleLastHitTime(acnpRef, "rule2").IsZero())
+	assert.True(t, a.GetRuleLastHitTime(acnpRef, "rule3").IsZero())
+	assert.False(t, a.GetRuleLastHitTime(annpRef, "rule1").Before(startTime))
+
+	crdClient.CrdV1beta1().ClusterNetworkPolicies().Delete(context.TODO(), acnp1.Name, metav1.DeleteOptions{})
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.True(c, a.GetRuleLastHitTime(acnpRef, "rule1").IsZero())
+	}, time.Second, 100*time.Millisecond)
+}
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 )
 
 // GroupSelector describes how to select GroupMembers.
@@ -54,6 +55,12 @@ type GroupSelector struct {
 	// This is a label selector which selects certain Node IPs. Within a group NodeSelector cannot be set together with
 	// other selectors: Namespace/NamespaceSelector/PodSelector/ExternalEntitySelector.
 	NodeSelector labels.Selector
+
+	// This is a label selector which selects Services. Within a group ServiceSelector cannot be set together with
+	// PodSelector, ExternalEntitySelector or NodeSelector. If Namespace is also set, it selects the Services in the
+	// Namespace. If NamespaceSelector is set instead, it selects the Services in the Namespaces selected by
+	// NamespaceSelector. If Namespace and NamespaceSelector both are unset, it selects the Services in all the Namespaces.
+	ServiceSelector labels.Selector
 }
 
 // NewGroupSelector converts the podSelector, namespaceSelector, externalEntitySelector and nodeSelector
@@ -84,6 +91,28 @@ func NewGroupSelector(namespace string, podSelector, nsSelector, extEntitySelect
 	return &groupSelector
 }
 
+// NewServiceGroupSelector converts the serviceSelector, namespaceSelector and NetworkPolicy Namespace to a
+// networkpolicy.GroupSelector object which selects Services.
+func NewServiceGroupSelector(namespace string, serviceSelector, nsSelector *metav1.LabelSelector) *GroupSelector {
+	groupSelector := GroupSelector{}
+	groupSelector.ServiceSelector, _ = metav1.LabelSelectorAsSelector(serviceSelector)
+	if nsSelector == nil {
+		// No namespaceSelector indicates that the Services must be selected within
+		// the NetworkPolicy's Namespace.
+		groupSelector.Namespace = namespace
+	} else {
+		groupSelector.NamespaceSelector, _ = metav1.LabelSelectorAsSelector(nsSelector)
+	}
+	name := GenerateNormalizedName(groupSelector.Namespace, nil, groupSelector.NamespaceSelector, nil, nil)
+	serviceSelectorName := fmt.Sprintf("serviceSelector=%s", groupSelector.ServiceSelector.String())
+	if name == "" {
+		groupSelector.NormalizedName = serviceSelectorName
+	} else {
+		groupSelector.NormalizedName = name + " And " + serviceSelectorName
+	}
+	return &groupSelector
+}
+
 // GenerateNormalizedName generates a string, based on the selectors, in
 // the following format: "namespace=NamespaceName And podSelector=normalizedPodSelector".
 // Note: Namespace and nsSelector may or may not be set depending on the
@@ -135,4 +164,7 @@ type Group struct {
 	ServiceReference *controlplane.ServiceReference
 	// ChildGroups is the list of Group names that belong to this Group.
 	ChildGroups []string
+	// IPList is the source of the IP list of the Group, if it's defined with one. The IPBlocks
+	// and IPNets are populated from the list when it's read by the controller.
+	IPList *crdv1beta1.IPListSource
 }
@@ -15,10 +15,13 @@
 package types
 
 import (
+	"time"
+
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 )
 
 // SpanMeta describes the span information of an object.
@@ -113,6 +116,21 @@ type NetworkPolicy struct {
 	// TierPriority represents the priority of the Tier associated with this Network
 	// Policy.
 	TierPriority *int32
+	// EnforcementMode specifies how the rules of this NetworkPolicy are enforced. It will
+	// remain empty for K8s NetworkPolicy.
+	EnforcementMode crdv1beta1.PolicyEnforcementMode
+	// ScheduledRules tracks whether each rule with a schedule is currently active. Inactive
+	// rules are not included in Rules.
+	ScheduledRules []crdv1beta1.RuleScheduleStatus
+	// NextScheduleTransition is the time at which any rule with a schedule is activated or
+	// deactivated next. It's zero if there is no upcoming transition.
+	NextScheduleTransition time.Time
+	// Expired is true if the source Antrea-native policy has expired. An expired NetworkPolicy
+	// has no rules and no AppliedToGroups.
+	Expired bool
+	// ExpirationTime is the time at which the source Antrea-native policy expires. It's zero if
+	// the policy never expires.
+	ExpirationTime time.Time
 	// AppliedToPerRule tracks if appliedTo is set per rule basis rather than in policy spec.
 	// Must be false for K8s NetworkPolicy.
 	AppliedToPerRule bool
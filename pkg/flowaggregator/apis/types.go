@@ -17,6 +17,8 @@ package apis
 import (
 	"fmt"
 	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 // FlowRecordsResponse is the response struct of flowrecords command.
@@ -62,12 +64,14 @@ type RecordMetricsResponse struct {
 	NumConnToCollector     int64 `json:"numConnToCollector,omitempty"`
 	WithClickHouseExporter bool  `json:"withClickHouseExporter,omitempty"`
 	WithS3Exporter         bool  `json:"withS3Exporter,omitempty"`
+	WithKafkaExporter      bool  `json:"withKafkaExporter,omitempty"`
+	WithOTLPExporter       bool  `json:"withOTLPExporter,omitempty"`
 	WithLogExporter        bool  `json:"withLogExporter,omitempty"`
 	WithIPFIXExporter      bool  `json:"withIPFIXExporter,omitempty"`
 }
 
 func (r RecordMetricsResponse) GetTableHeader() []string {
-	return []string{"RECORDS-EXPORTED", "RECORDS-RECEIVED", "RECORDS-DROPPED", "FLOWS", "EXPORTERS-CONNECTED", "CLICKHOUSE-EXPORTER", "S3-EXPORTER", "LOG-EXPORTER", "IPFIX-EXPORTER"}
+	return []string{"RECORDS-EXPORTED", "RECORDS-RECEIVED", "RECORDS-DROPPED", "FLOWS", "EXPORTERS-CONNECTED", "CLICKHOUSE-EXPORTER", "S3-EXPORTER", "KAFKA-EXPORTER", "OTLP-EXPORTER", "LOG-EXPORTER", "IPFIX-EXPORTER"}
 }
 
 func (r RecordMetricsResponse) GetTableRow(maxColumnLength int) []string {
@@ -79,6 +83,8 @@ func (r RecordMetricsResponse) GetTableRow(maxColumnLength int) []string {
 		strconv.Itoa(int(r.NumConnToCollector)),
 		strconv.FormatBool(r.WithClickHouseExporter),
 		strconv.FormatBool(r.WithS3Exporter),
+		strconv.FormatBool(r.WithKafkaExporter),
+		strconv.FormatBool(r.WithOTLPExporter),
 		strconv.FormatBool(r.WithLogExporter),
 		strconv.FormatBool(r.WithIPFIXExporter),
 	}
@@ -87,3 +93,42 @@ func (r RecordMetricsResponse) GetTableRow(maxColumnLength int) []string {
 func (r RecordMetricsResponse) SortRows() bool {
 	return true
 }
+
+// PolicyRecommendationsResponse is the response struct of policyrecommendations command. It is a
+// List of Antrea NetworkPolicies and ClusterNetworkPolicies, so that the output of the command in
+// JSON or YAML format can be applied with kubectl.
+type PolicyRecommendationsResponse struct {
+	APIVersion string                   `json:"apiVersion"`
+	Kind       string                   `json:"kind"`
+	Items      []map[string]interface{} `json:"items"`
+}
+
+func (r PolicyRecommendationsResponse) GetTableHeader() []string {
+	return []string{"NETWORK-POLICIES", "CLUSTER-NETWORK-POLICIES", "INGRESS-RULES", "EGRESS-RULES"}
+}
+
+func (r PolicyRecommendationsResponse) GetTableRow(maxColumnLength int) []string {
+	var numNetworkPolicies, numClusterNetworkPolicies, numIngressRules, numEgressRules int
+	for _, item := range r.Items {
+		switch item["kind"] {
+		case "NetworkPolicy":
+			numNetworkPolicies++
+		case "ClusterNetworkPolicy":
+			numClusterNetworkPolicies++
+		}
+		ingress, _, _ := unstructured.NestedSlice(item, "spec", "ingress")
+		numIngressRules += len(ingress)
+		egress, _, _ := unstructured.NestedSlice(item, "spec", "egress")
+		numEgressRules += len(egress)
+	}
+	return []string{
+		strconv.Itoa(numNetworkPolicies),
+		strconv.Itoa(numClusterNetworkPolicies),
+		strconv.Itoa(numIngressRules),
+		strconv.Itoa(numEgressRules),
+	}
+}
+
+func (r PolicyRecommendationsResponse) SortRows() bool {
+	return true
+}
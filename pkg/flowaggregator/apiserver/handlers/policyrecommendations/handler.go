@@ -0,0 +1,76 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyrecommendations
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/flowaggregator/apis"
+	"antrea.io/antrea/pkg/flowaggregator/querier"
+)
+
+// HandleFunc returns the function which can handle the /policyrecommendations API request.
+func HandleFunc(faq querier.FlowAggregatorQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		values := r.URL.Query()
+		namespace := values.Get("namespace")
+		var defaultDeny bool
+		if values.Has("default-deny") {
+			if values.Get("default-deny") != "" {
+				http.Error(w, "invalid query", http.StatusBadRequest)
+				return
+			}
+			defaultDeny = true
+		}
+
+		policies, err := faq.GetPolicyRecommendations(namespace, defaultDeny)
+		if err != nil {
+			if errors.Is(err, querier.ErrPolicyRecommendationNotEnabled) {
+				// The error message must match the "FOO is not enabled" pattern to pass antctl e2e tests.
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp := apis.PolicyRecommendationsResponse{
+			APIVersion: "v1",
+			Kind:       "List",
+			Items:      make([]map[string]interface{}, 0, len(policies)),
+		}
+		for _, policy := range policies {
+			item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policy)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			// The recommended policies have not been created yet, remove the fields which
+			// are only meaningful for existing objects so that the output can be applied as is.
+			unstructured.RemoveNestedField(item, "metadata", "creationTimestamp")
+			unstructured.RemoveNestedField(item, "status")
+			resp.Items = append(resp.Items, item)
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			klog.ErrorS(err, "Error when encoding policy recommendations to json")
+		}
+	}
+}
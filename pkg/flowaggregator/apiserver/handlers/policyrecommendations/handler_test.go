@@ -0,0 +1,197 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyrecommendations
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/flowaggregator/apis"
+	"antrea.io/antrea/pkg/flowaggregator/querier"
+	queriertest "antrea.io/antrea/pkg/flowaggregator/querier/testing"
+)
+
+var (
+	allowPolicy = &crdv1beta1.NetworkPolicy{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "crd.antrea.io/v1beta1", Kind: "NetworkPolicy"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "recommended-allow-a"},
+		Spec: crdv1beta1.NetworkPolicySpec{
+			Tier:      "application",
+			Priority:  5,
+			AppliedTo: []crdv1beta1.AppliedTo{{PodSelector: &metav1.LabelSelector{}}},
+			Ingress: []crdv1beta1.Rule{
+				{
+					Action: ptr.To(crdv1beta1.RuleActionAllow),
+					From:   []crdv1beta1.NetworkPolicyPeer{{IPBlock: &crdv1beta1.IPBlock{CIDR: "192.168.1.10/32"}}},
+				},
+			},
+		},
+	}
+	defaultDenyPolicy = &crdv1beta1.ClusterNetworkPolicy{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "crd.antrea.io/v1beta1", Kind: "ClusterNetworkPolicy"},
+		ObjectMeta: metav1.ObjectMeta{Name: "recommended-default-deny-ns1"},
+		Spec: crdv1beta1.ClusterNetworkPolicySpec{
+			Tier:     "baseline",
+			Priority: 5,
+			AppliedTo: []crdv1beta1.AppliedTo{
+				{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "ns1"}}},
+			},
+			Ingress: []crdv1beta1.Rule{{Action: ptr.To(crdv1beta1.RuleActionDrop)}},
+			Egress:  []crdv1beta1.Rule{{Action: ptr.To(crdv1beta1.RuleActionDrop)}},
+		},
+	}
+
+	allowPolicyItem = map[string]interface{}{
+		"apiVersion": "crd.antrea.io/v1beta1",
+		"kind":       "NetworkPolicy",
+		"metadata":   map[string]interface{}{"namespace": "ns1", "name": "recommended-allow-a"},
+		"spec": map[string]interface{}{
+			"tier":      "application",
+			"priority":  float64(5),
+			"appliedTo": []interface{}{map[string]interface{}{"podSelector": map[string]interface{}{}}},
+			"ingress": []interface{}{
+				map[string]interface{}{
+					"action":        "Allow",
+					"enableLogging": false,
+					"from":          []interface{}{map[string]interface{}{"ipBlock": map[string]interface{}{"cidr": "192.168.1.10/32"}}},
+				},
+			},
+		},
+	}
+	defaultDenyPolicyItem = map[string]interface{}{
+		"apiVersion": "crd.antrea.io/v1beta1",
+		"kind":       "ClusterNetworkPolicy",
+		"metadata":   map[string]interface{}{"name": "recommended-default-deny-ns1"},
+		"spec": map[string]interface{}{
+			"tier":     "baseline",
+			"priority": float64(5),
+			"appliedTo": []interface{}{
+				map[string]interface{}{
+					"namespaceSelector": map[string]interface{}{
+						"matchLabels": map[string]interface{}{"kubernetes.io/metadata.name": "ns1"},
+					},
+				},
+			},
+			"ingress": []interface{}{map[string]interface{}{"action": "Drop", "enableLogging": false}},
+			"egress":  []interface{}{map[string]interface{}{"action": "Drop", "enableLogging": false}},
+		},
+	}
+)
+
+func TestGetPolicyRecommendations(t *testing.T) {
+	testCases := []struct {
+		name               string
+		query              string
+		expectedCall       func(faq *queriertest.MockFlowAggregatorQuerierMockRecorder)
+		expectedStatus     int
+		expectedResponse   *apis.PolicyRecommendationsResponse
+		expectedTableRow   []string
+		expectedErrMessage string
+	}{
+		{
+			name:  "all Namespaces",
+			query: "",
+			expectedCall: func(faq *queriertest.MockFlowAggregatorQuerierMockRecorder) {
+				faq.GetPolicyRecommendations("", false).Return([]runtime.Object{allowPolicy}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: &apis.PolicyRecommendationsResponse{
+				APIVersion: "v1",
+				Kind:       "List",
+				Items:      []map[string]interface{}{allowPolicyItem},
+			},
+			expectedTableRow: []string{"1", "0", "1", "0"},
+		},
+		{
+			name:  "Namespace with default deny",
+			query: "?namespace=ns1&default-deny",
+			expectedCall: func(faq *queriertest.MockFlowAggregatorQuerierMockRecorder) {
+				faq.GetPolicyRecommendations("ns1", true).Return([]runtime.Object{allowPolicy, defaultDenyPolicy}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: &apis.PolicyRecommendationsResponse{
+				APIVersion: "v1",
+				Kind:       "List",
+				Items:      []map[string]interface{}{allowPolicyItem, defaultDenyPolicyItem},
+			},
+			expectedTableRow: []string{"1", "1", "2", "1"},
+		},
+		{
+			name:  "no recommendation",
+			query: "?namespace=ns2",
+			expectedCall: func(faq *queriertest.MockFlowAggregatorQuerierMockRecorder) {
+				faq.GetPolicyRecommendations("ns2", false).Return(nil, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: &apis.PolicyRecommendationsResponse{
+				APIVersion: "v1",
+				Kind:       "List",
+				Items:      []map[string]interface{}{},
+			},
+			expectedTableRow: []string{"0", "0", "0", "0"},
+		},
+		{
+			name:               "invalid default-deny",
+			query:              "?default-deny=false",
+			expectedStatus:     http.StatusBadRequest,
+			expectedErrMessage: "invalid query\n",
+		},
+		{
+			name:  "not enabled",
+			query: "",
+			expectedCall: func(faq *queriertest.MockFlowAggregatorQuerierMockRecorder) {
+				faq.GetPolicyRecommendations("", false).Return(nil, querier.ErrPolicyRecommendationNotEnabled)
+			},
+			expectedStatus:     http.StatusServiceUnavailable,
+			expectedErrMessage: "policy recommendation is not enabled\n",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			faq := queriertest.NewMockFlowAggregatorQuerier(ctrl)
+			if tc.expectedCall != nil {
+				tc.expectedCall(faq.EXPECT())
+			}
+
+			handler := HandleFunc(faq)
+			req, err := http.NewRequest(http.MethodGet, tc.query, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			if tc.expectedStatus != http.StatusOK {
+				assert.Equal(t, tc.expectedErrMessage, recorder.Body.String())
+				return
+			}
+
+			var received apis.PolicyRecommendationsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &received)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedResponse, received)
+			assert.Equal(t, tc.expectedTableRow, received.GetTableRow(0))
+		})
+	}
+}
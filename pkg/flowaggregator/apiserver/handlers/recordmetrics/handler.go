@@ -36,6 +36,8 @@ func HandleFunc(faq querier.FlowAggregatorQuerier) http.HandlerFunc {
 			NumConnToCollector:     metrics.NumConnToCollector,
 			WithClickHouseExporter: metrics.WithClickHouseExporter,
 			WithS3Exporter:         metrics.WithS3Exporter,
+			WithKafkaExporter:      metrics.WithKafkaExporter,
+			WithOTLPExporter:       metrics.WithOTLPExporter,
 			WithLogExporter:        metrics.WithLogExporter,
 			WithIPFIXExporter:      metrics.WithIPFIXExporter,
 		}
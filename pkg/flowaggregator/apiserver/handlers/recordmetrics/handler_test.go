@@ -39,6 +39,8 @@ func TestRecordMetricsQuery(t *testing.T) {
 		NumConnToCollector:     1,
 		WithClickHouseExporter: true,
 		WithS3Exporter:         true,
+		WithKafkaExporter:      true,
+		WithOTLPExporter:       true,
 		WithLogExporter:        true,
 		WithIPFIXExporter:      true,
 	})
@@ -61,10 +63,12 @@ func TestRecordMetricsQuery(t *testing.T) {
 		NumConnToCollector:     1,
 		WithClickHouseExporter: true,
 		WithS3Exporter:         true,
+		WithKafkaExporter:      true,
+		WithOTLPExporter:       true,
 		WithLogExporter:        true,
 		WithIPFIXExporter:      true,
 	}, received)
 
-	assert.Equal(t, received.GetTableRow(0), []string{"20", "15", "5", "30", "1", "true", "true", "true", "true"})
+	assert.Equal(t, received.GetTableRow(0), []string{"20", "15", "5", "30", "1", "true", "true", "true", "true", "true", "true"})
 
 }
@@ -25,12 +25,16 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"sync"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/util/env"
@@ -41,6 +45,10 @@ const (
 
 	CAConfigMapName = "flow-aggregator-ca"
 	CAConfigMapKey  = "ca.crt"
+	// CASecretName is the name of the Secret storing the CA certificate and key, which are
+	// shared by all the Flow Aggregator replicas.
+	// #nosec G101: false positive triggered by variable name which includes "Secret"
+	CASecretName = "flow-aggregator-ca"
 	// #nosec G101: false positive triggered by variable name which includes "Secret"
 	ClientSecretName = "flow-aggregator-client-tls"
 	ServiceName      = "flow-aggregator"
@@ -49,6 +57,12 @@ const (
 var (
 	validFrom = time.Now().Add(-time.Hour) // valid an hour earlier to avoid flakes due to clock skew
 	maxAge    = time.Hour * 24 * 365       // one year self-signed certs
+	// minCAValidity is the minimum remaining validity of the shared CA certificate for it to
+	// be reused. Otherwise, a new CA is generated.
+	minCAValidity = time.Hour * 24 * 30
+	// caWatcherResyncPeriod is the period at which the CA watcher checks the CA Secret even if
+	// it has not changed, to detect that the CA is about to expire.
+	caWatcherResyncPeriod = time.Hour
 )
 
 func getFlowAggregatorNamespace() string {
@@ -91,16 +105,129 @@ func generateCACertKey() (*x509.Certificate, *rsa.PrivateKey, []byte, error) {
 	return cert, caKey, caPEM.Bytes(), err
 }
 
+func parseCACertKey(certPEM, keyPEM []byte) (*x509.Certificate, *rsa.PrivateKey, error) {
+	certBlock, _ := pem.Decode(certPEM)
+	if certBlock == nil {
+		return nil, nil, fmt.Errorf("failed to decode CA certificate")
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyBlock, _ := pem.Decode(keyPEM)
+	if keyBlock == nil {
+		return nil, nil, fmt.Errorf("failed to decode CA key")
+	}
+	key, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, key, nil
+}
+
+// getOrCreateCA returns the CA certificate and key shared by all the Flow Aggregator replicas,
+// so that the Flow Exporters can connect to any of them. They are stored in a Secret, and a
+// new CA is generated if the Secret does not exist or if the CA certificate is about to
+// expire. The returned bool is true if a new CA was generated.
+func getOrCreateCA(k8sClient kubernetes.Interface) (*x509.Certificate, *rsa.PrivateKey, []byte, bool, error) {
+	namespace := getFlowAggregatorNamespace()
+	secret, err := k8sClient.CoreV1().Secrets(namespace).Get(context.TODO(), CASecretName, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, nil, nil, false, fmt.Errorf("error getting Secret %s: %v", CASecretName, err)
+	}
+	exists := err == nil
+	if exists {
+		caPEM := secret.Data[v1.TLSCertKey]
+		cert, key, err := parseCACertKey(caPEM, secret.Data[v1.TLSPrivateKeyKey])
+		if err != nil {
+			klog.ErrorS(err, "Invalid CA in Secret, generating a new one", "secret", klog.KObj(secret))
+		} else if time.Until(cert.NotAfter) < minCAValidity {
+			klog.InfoS("CA in Secret is about to expire, generating a new one", "secret", klog.KObj(secret), "notAfter", cert.NotAfter)
+		} else {
+			klog.InfoS("Using CA from Secret", "secret", klog.KObj(secret))
+			return cert, key, caPEM, false, nil
+		}
+	}
+
+	cert, key, caPEM, err := generateCACertKey()
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+	data := map[string][]byte{
+		v1.TLSCertKey: caPEM,
+		v1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		}),
+	}
+	if exists {
+		secret.Data = data
+		_, err = k8sClient.CoreV1().Secrets(namespace).Update(context.TODO(), secret, metav1.UpdateOptions{})
+	} else {
+		secret = &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      CASecretName,
+				Namespace: namespace,
+				Labels: map[string]string{
+					"app": "flow-aggregator",
+				},
+			},
+			Data: data,
+			Type: v1.SecretTypeTLS,
+		}
+		_, err = k8sClient.CoreV1().Secrets(namespace).Create(context.TODO(), secret, metav1.CreateOptions{})
+	}
+	if errors.IsAlreadyExists(err) || errors.IsConflict(err) {
+		// Another replica has generated a new CA concurrently, use it instead.
+		klog.InfoS("CA Secret was updated by another replica, retrying")
+		return getOrCreateCA(k8sClient)
+	}
+	if err != nil {
+		return nil, nil, nil, false, fmt.Errorf("error storing CA in Secret %s: %v", CASecretName, err)
+	}
+	klog.InfoS("Generated new CA and stored it in Secret", "secret", klog.KObj(secret))
+	return cert, key, caPEM, true, nil
+}
+
+// isClientCertSynced returns true if the CA ConfigMap and the client cert Secret are
+// up-to-date with the provided CA.
+func isClientCertSynced(caCert *x509.Certificate, caPEM []byte, k8sClient kubernetes.Interface) bool {
+	namespace := getFlowAggregatorNamespace()
+	caConfigMap, err := k8sClient.CoreV1().ConfigMaps(namespace).Get(context.TODO(), CAConfigMapName, metav1.GetOptions{})
+	if err != nil || caConfigMap.Data[CAConfigMapKey] != string(caPEM) {
+		return false
+	}
+	secret, err := k8sClient.CoreV1().Secrets(namespace).Get(context.TODO(), ClientSecretName, metav1.GetOptions{})
+	if err != nil {
+		return false
+	}
+	certBlock, _ := pem.Decode(secret.Data[v1.TLSCertKey])
+	if certBlock == nil {
+		return false
+	}
+	clientCert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return false
+	}
+	return clientCert.CheckSignatureFrom(caCert) == nil && time.Until(clientCert.NotAfter) >= minCAValidity
+}
+
 func getFlowAggregatorServerNames() []string {
 	namespace := getFlowAggregatorNamespace()
 	return []string{ServiceName + "." + namespace + ".svc"}
 }
 
 func generateCertKey(caCert *x509.Certificate, caKey *rsa.PrivateKey, isServer bool, flowAggregatorAddress string) ([]byte, []byte, error) {
+	// The CA is shared by all the replicas, so the certificates it issues need unique serial
+	// numbers.
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
 	var cert *x509.Certificate
 	if isServer {
 		cert = &x509.Certificate{
-			SerialNumber: big.NewInt(2),
+			SerialNumber: serialNumber,
 			Subject: pkix.Name{
 				CommonName: fmt.Sprintf("flow-aggregator-server-certificate@%d", time.Now().Unix()),
 			},
@@ -119,7 +246,7 @@ func generateCertKey(caCert *x509.Certificate, caKey *rsa.PrivateKey, isServer b
 		}
 	} else {
 		cert = &x509.Certificate{
-			SerialNumber: big.NewInt(3),
+			SerialNumber: serialNumber,
 			Subject: pkix.Name{
 				CommonName: fmt.Sprintf("flow-aggregator-client-certificate@%d", time.Now().Unix()),
 			},
@@ -223,17 +350,22 @@ func syncCAAndClientCert(caCert, clientCert, clientKey []byte, k8sClient kuberne
 }
 
 // generateCerts generates new certificates for the Flow Aggregator and the Flow Exporter (client),
-// and syncs the CA ConfigMap and the client cert Secret using the provided K8s client.
+// and syncs the CA ConfigMap and the client cert Secret using the provided K8s client. The CA is
+// shared by all the Flow Aggregator replicas, and the client certificate is only regenerated when
+// it was not issued by the current CA.
 // generateCerts returns the CA certificate, the server private key and the server certificate.
 func generateCerts(flowAggregatorAddress string, k8sClient kubernetes.Interface) ([]byte, []byte, []byte, error) {
-	parentCert, privateKey, caCert, err := generateCACertKey()
+	parentCert, privateKey, caCert, created, err := getOrCreateCA(k8sClient)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("error when generating CA certificate: %w", err)
+		return nil, nil, nil, fmt.Errorf("error when getting CA certificate: %w", err)
 	}
 	serverCert, serverKey, err := generateCertKey(parentCert, privateKey, true, flowAggregatorAddress)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("error when creating server certificate: %w", err)
 	}
+	if !created && isClientCertSynced(parentCert, caCert, k8sClient) {
+		return caCert, serverKey, serverCert, nil
+	}
 
 	clientCert, clientKey, err := generateCertKey(parentCert, privateKey, false, "")
 	if err != nil {
@@ -244,3 +376,66 @@ func generateCerts(flowAggregatorAddress string, k8sClient kubernetes.Interface)
 	}
 	return caCert, serverKey, serverCert, nil
 }
+
+// caWatcher watches the CA Secret shared by the Flow Aggregator replicas. Replicas load the CA
+// when they start, so when the CA is rotated by one of them, the other replicas would keep
+// serving certificates the Flow Exporters no longer trust. caWatcher calls onCAChange when the
+// CA stored in the Secret is no longer the one in use, or when the CA in use is about to expire,
+// so that the replica can restart and load the new CA, generating it if needed.
+type caWatcher struct {
+	caPEM      []byte
+	caCert     *x509.Certificate
+	informer   cache.SharedIndexInformer
+	onCAChange func()
+	changeOnce sync.Once
+}
+
+func newCAWatcher(k8sClient kubernetes.Interface, caPEM []byte, resyncPeriod time.Duration, onCAChange func()) (*caWatcher, error) {
+	certBlock, _ := pem.Decode(caPEM)
+	if certBlock == nil {
+		return nil, fmt.Errorf("failed to decode CA certificate")
+	}
+	caCert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	// The informer only watches the CA Secret, which is also required by RBAC.
+	informer := coreinformers.NewFilteredSecretInformer(k8sClient, getFlowAggregatorNamespace(), resyncPeriod, cache.Indexers{}, func(options *metav1.ListOptions) {
+		options.FieldSelector = fields.OneTermEqualSelector("metadata.name", CASecretName).String()
+	})
+	w := &caWatcher{
+		caPEM:      caPEM,
+		caCert:     caCert,
+		informer:   informer,
+		onCAChange: onCAChange,
+	}
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			w.checkCA(obj.(*v1.Secret))
+		},
+		// The Secret is resynced periodically, so that the expiry of the CA is checked even if the
+		// Secret doesn't change.
+		UpdateFunc: func(_, newObj interface{}) {
+			w.checkCA(newObj.(*v1.Secret))
+		},
+	})
+	return w, nil
+}
+
+func (w *caWatcher) checkCA(secret *v1.Secret) {
+	if !bytes.Equal(secret.Data[v1.TLSCertKey], w.caPEM) {
+		klog.InfoS("CA in Secret has been rotated, the new CA must be loaded", "secret", klog.KObj(secret))
+	} else if time.Until(w.caCert.NotAfter) < minCAValidity {
+		klog.InfoS("CA is about to expire, a new CA must be generated", "secret", klog.KObj(secret), "notAfter", w.caCert.NotAfter)
+	} else {
+		return
+	}
+	w.changeOnce.Do(w.onCAChange)
+}
+
+// Run runs the caWatcher until stopCh is closed.
+func (w *caWatcher) Run(stopCh <-chan struct{}) {
+	klog.InfoS("Starting CA watcher")
+	defer klog.InfoS("Stopping CA watcher")
+	w.informer.Run(stopCh)
+}
@@ -0,0 +1,179 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func parseTestCert(t *testing.T, certPEM []byte) *x509.Certificate {
+	block, _ := pem.Decode(certPEM)
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestGenerateCerts(t *testing.T) {
+	ctx := context.Background()
+	k8sClient := fake.NewSimpleClientset()
+
+	caCert1, _, serverCert1, err := generateCerts("", k8sClient)
+	require.NoError(t, err)
+	clientSecret1, err := k8sClient.CoreV1().Secrets(DefaultNamespace).Get(ctx, ClientSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	caConfigMap, err := k8sClient.CoreV1().ConfigMaps(DefaultNamespace).Get(ctx, CAConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, string(caCert1), caConfigMap.Data[CAConfigMapKey])
+
+	// Another replica reuses the CA and the client certificate, and generates its own server
+	// certificate.
+	caCert2, _, serverCert2, err := generateCerts("", k8sClient)
+	require.NoError(t, err)
+	assert.Equal(t, caCert1, caCert2)
+	assert.NotEqual(t, serverCert1, serverCert2)
+	clientSecret2, err := k8sClient.CoreV1().Secrets(DefaultNamespace).Get(ctx, ClientSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, clientSecret1.Data, clientSecret2.Data)
+	ca := parseTestCert(t, caCert1)
+	for _, certPEM := range [][]byte{serverCert1, serverCert2, clientSecret2.Data[v1.TLSCertKey]} {
+		assert.NoError(t, parseTestCert(t, certPEM).CheckSignatureFrom(ca))
+	}
+	assert.NotEqual(t, parseTestCert(t, serverCert1).SerialNumber, parseTestCert(t, serverCert2).SerialNumber)
+
+	// The client certificate is regenerated if it was not issued by the CA.
+	otherCA, otherCAKey, _, err := generateCACertKey()
+	require.NoError(t, err)
+	otherClientCert, otherClientKey, err := generateCertKey(otherCA, otherCAKey, false, "")
+	require.NoError(t, err)
+	clientSecret2.Data[v1.TLSCertKey] = otherClientCert
+	clientSecret2.Data[v1.TLSPrivateKeyKey] = otherClientKey
+	_, err = k8sClient.CoreV1().Secrets(DefaultNamespace).Update(ctx, clientSecret2, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	caCert3, _, _, err := generateCerts("", k8sClient)
+	require.NoError(t, err)
+	assert.Equal(t, caCert1, caCert3)
+	clientSecret3, err := k8sClient.CoreV1().Secrets(DefaultNamespace).Get(ctx, ClientSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotEqual(t, clientSecret1.Data, clientSecret3.Data)
+	assert.NotEqual(t, otherClientCert, clientSecret3.Data[v1.TLSCertKey])
+	assert.NoError(t, parseTestCert(t, clientSecret3.Data[v1.TLSCertKey]).CheckSignatureFrom(ca))
+}
+
+func TestGetOrCreateCA(t *testing.T) {
+	ctx := context.Background()
+	k8sClient := fake.NewSimpleClientset()
+
+	cert, _, caPEM, created, err := getOrCreateCA(k8sClient)
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.True(t, cert.IsCA)
+
+	_, _, caPEM2, created, err := getOrCreateCA(k8sClient)
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, caPEM, caPEM2)
+
+	// A CA which is about to expire is replaced.
+	expiringCert, expiringKey, _, err := generateCACertKey()
+	require.NoError(t, err)
+	expiringCert.NotAfter = time.Now().Add(time.Hour)
+	certBytes, err := x509.CreateCertificate(rand.Reader, expiringCert, expiringCert, &expiringKey.PublicKey, expiringKey)
+	require.NoError(t, err)
+	secret, err := k8sClient.CoreV1().Secrets(DefaultNamespace).Get(ctx, CASecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	expiringPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes})
+	secret.Data[v1.TLSCertKey] = expiringPEM
+	secret.Data[v1.TLSPrivateKeyKey] = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(expiringKey)})
+	_, err = k8sClient.CoreV1().Secrets(DefaultNamespace).Update(ctx, secret, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	_, _, caPEM3, created, err := getOrCreateCA(k8sClient)
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.NotEqual(t, expiringPEM, caPEM3)
+	assert.NotEqual(t, caPEM, caPEM3)
+
+	// An invalid CA is replaced.
+	secret, err = k8sClient.CoreV1().Secrets(DefaultNamespace).Get(ctx, CASecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	secret.Data[v1.TLSPrivateKeyKey] = []byte("invalid")
+	_, err = k8sClient.CoreV1().Secrets(DefaultNamespace).Update(ctx, secret, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	_, _, _, created, err = getOrCreateCA(k8sClient)
+	require.NoError(t, err)
+	assert.True(t, created)
+}
+
+func TestCAWatcher(t *testing.T) {
+	ctx := context.Background()
+	k8sClient := fake.NewSimpleClientset()
+	_, _, caPEM, _, err := getOrCreateCA(k8sClient)
+	require.NoError(t, err)
+
+	changeCh := make(chan struct{}, 2)
+	w, err := newCAWatcher(k8sClient, caPEM, 0, func() { changeCh <- struct{}{} })
+	require.NoError(t, err)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go w.Run(stopCh)
+	require.Eventually(t, w.informer.HasSynced, time.Second, 10*time.Millisecond)
+	assert.Empty(t, changeCh, "The CA in use should not be reported as changed")
+
+	// The CA is rotated by another replica.
+	_, _, newCAPEM, err := generateCACertKey()
+	require.NoError(t, err)
+	secret, err := k8sClient.CoreV1().Secrets(DefaultNamespace).Get(ctx, CASecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	secret.Data[v1.TLSCertKey] = newCAPEM
+	_, err = k8sClient.CoreV1().Secrets(DefaultNamespace).Update(ctx, secret, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	select {
+	case <-changeCh:
+	case <-time.After(time.Second):
+		t.Fatal("CA rotation was not detected")
+	}
+
+	// The change is only reported once.
+	w.checkCA(secret)
+	assert.Empty(t, changeCh)
+}
+
+func TestCAWatcherExpiringCA(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset()
+	_, _, caPEM, _, err := getOrCreateCA(k8sClient)
+	require.NoError(t, err)
+	secret, err := k8sClient.CoreV1().Secrets(DefaultNamespace).Get(context.Background(), CASecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	changed := false
+	w, err := newCAWatcher(k8sClient, caPEM, 0, func() { changed = true })
+	require.NoError(t, err)
+	w.checkCA(secret)
+	assert.False(t, changed)
+	w.caCert.NotAfter = time.Now().Add(time.Hour)
+	w.checkCA(secret)
+	assert.True(t, changed)
+}
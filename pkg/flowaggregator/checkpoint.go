@@ -0,0 +1,111 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/flowaggregator/intermediate"
+)
+
+// checkpointer periodically saves the state of the aggregation process to a local file, and
+// restores it when the Flow Aggregator starts, so that partially correlated flow records are
+// not lost when the Flow Aggregator restarts.
+type checkpointer struct {
+	path         string
+	interval     time.Duration
+	maxStaleness time.Duration
+}
+
+func newCheckpointer(path string, interval, maxStaleness time.Duration) *checkpointer {
+	return &checkpointer{
+		path:         path,
+		interval:     interval,
+		maxStaleness: maxStaleness,
+	}
+}
+
+// restore restores the state of the aggregation process from the checkpoint file, if it
+// exists.
+func (c *checkpointer) restore(aggregationProcess intermediate.AggregationProcess) error {
+	f, err := os.Open(c.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			klog.InfoS("No checkpoint file found, starting with an empty aggregation state", "path", c.path)
+			return nil
+		}
+		return fmt.Errorf("error when opening checkpoint file: %w", err)
+	}
+	defer f.Close()
+	numRestored, numFlushed, err := aggregationProcess.RestoreCheckpoint(f, c.maxStaleness)
+	if err != nil {
+		return err
+	}
+	klog.InfoS("Restored aggregation state from checkpoint file", "path", c.path, "restored", numRestored, "flushed", numFlushed)
+	return nil
+}
+
+// save saves the state of the aggregation process to the checkpoint file. The state is first
+// written to a temporary file, which then replaces the checkpoint file, so that the checkpoint
+// file is never partially written.
+func (c *checkpointer) save(aggregationProcess intermediate.AggregationProcess) error {
+	dir := filepath.Dir(c.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("error when creating checkpoint directory: %w", err)
+	}
+	f, err := os.CreateTemp(dir, filepath.Base(c.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("error when creating temporary checkpoint file: %w", err)
+	}
+	tmpPath := f.Name()
+	defer os.Remove(tmpPath)
+	if err := aggregationProcess.SaveCheckpoint(f); err != nil {
+		f.Close()
+		return fmt.Errorf("error when writing checkpoint: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("error when syncing checkpoint file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error when closing checkpoint file: %w", err)
+	}
+	if err := os.Rename(tmpPath, c.path); err != nil {
+		return fmt.Errorf("error when renaming checkpoint file: %w", err)
+	}
+	return nil
+}
+
+// run saves the state of the aggregation process periodically, until stopCh is closed.
+func (c *checkpointer) run(aggregationProcess intermediate.AggregationProcess, stopCh <-chan struct{}) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			if err := c.save(aggregationProcess); err != nil {
+				klog.ErrorS(err, "Failed to save aggregation state to checkpoint file", "path", c.path)
+			}
+		}
+	}
+}
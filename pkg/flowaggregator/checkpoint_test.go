@@ -0,0 +1,128 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	intermediatetesting "antrea.io/antrea/pkg/flowaggregator/intermediate/testing"
+)
+
+func TestCheckpointer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAggregationProcess := intermediatetesting.NewMockAggregationProcess(ctrl)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "flow-aggregator", "checkpoint")
+	c := newCheckpointer(path, time.Second, 5*time.Minute)
+
+	// No checkpoint file yet.
+	require.NoError(t, c.restore(mockAggregationProcess))
+
+	mockAggregationProcess.EXPECT().SaveCheckpoint(gomock.Any()).DoAndReturn(func(w io.Writer) error {
+		_, err := w.Write([]byte("checkpoint-1"))
+		return err
+	})
+	require.NoError(t, c.save(mockAggregationProcess))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "checkpoint-1", string(data))
+
+	// A failed save does not overwrite the existing checkpoint file.
+	mockAggregationProcess.EXPECT().SaveCheckpoint(gomock.Any()).DoAndReturn(func(w io.Writer) error {
+		_, _ = w.Write([]byte("partial"))
+		return fmt.Errorf("error")
+	})
+	assert.Error(t, c.save(mockAggregationProcess))
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "checkpoint-1", string(data))
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "Temporary checkpoint files should be removed")
+
+	mockAggregationProcess.EXPECT().RestoreCheckpoint(gomock.Any(), 5*time.Minute).DoAndReturn(func(r io.Reader, _ time.Duration) (int, int, error) {
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "checkpoint-1", string(data))
+		return 1, 0, nil
+	})
+	require.NoError(t, c.restore(mockAggregationProcess))
+
+	mockAggregationProcess.EXPECT().RestoreCheckpoint(gomock.Any(), 5*time.Minute).Return(0, 0, fmt.Errorf("error when decoding checkpoint"))
+	assert.ErrorContains(t, c.restore(mockAggregationProcess), "error when decoding checkpoint")
+}
+
+func TestCheckpointerRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAggregationProcess := intermediatetesting.NewMockAggregationProcess(ctrl)
+	path := filepath.Join(t.TempDir(), "checkpoint")
+	c := newCheckpointer(path, 10*time.Millisecond, time.Minute)
+
+	saved := make(chan struct{}, 1)
+	mockAggregationProcess.EXPECT().SaveCheckpoint(gomock.Any()).DoAndReturn(func(w io.Writer) error {
+		select {
+		case saved <- struct{}{}:
+		default:
+		}
+		return nil
+	}).MinTimes(1)
+	stopCh := make(chan struct{})
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		c.run(mockAggregationProcess, stopCh)
+	}()
+	select {
+	case <-saved:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Checkpoint was not saved periodically")
+	}
+	close(stopCh)
+	<-doneCh
+	assert.FileExists(t, path)
+}
+
+func TestFlowAggregator_InitAggregationProcessWithCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint")
+	newFlowAggregator := func() *flowAggregator {
+		return &flowAggregator{
+			activeFlowRecordTimeout:   testActiveTimeout,
+			inactiveFlowRecordTimeout: testInactiveTimeout,
+			recordCh:                  make(chan *flowpb.Flow),
+			checkpointer:              newCheckpointer(path, time.Second, time.Minute),
+		}
+	}
+
+	// A checkpoint which cannot be restored does not prevent initialization.
+	require.NoError(t, os.WriteFile(path, []byte("invalid"), 0o600))
+	fa := newFlowAggregator()
+	require.NoError(t, fa.InitAggregationProcess())
+	assert.EqualValues(t, 0, fa.aggregationProcess.GetNumFlows())
+
+	require.NoError(t, fa.checkpointer.save(fa.aggregationProcess))
+	fa = newFlowAggregator()
+	require.NoError(t, fa.InitAggregationProcess())
+	assert.EqualValues(t, 0, fa.aggregationProcess.GetNumFlows())
+}
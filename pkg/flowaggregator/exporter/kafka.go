@@ -0,0 +1,381 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gammazero/deque"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/compress"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/klog/v2"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+const (
+	kafkaCertDir = "/etc/flow-aggregator/certs/kafka"
+	// kafkaMaxQueueSize is the maximum number of flow records buffered by the KafkaExporter.
+	// When the queue is full, the oldest records are dropped.
+	kafkaMaxQueueSize = 1 << 19
+	// kafkaWriteTimeout is the timeout for publishing a batch of flow records, including
+	// retries.
+	kafkaWriteTimeout = 30 * time.Second
+	// kafkaClusterIDHeader is the header of the Kafka messages which stores the cluster ID, as
+	// the flow records do not include it.
+	kafkaClusterIDHeader = "clusterID"
+)
+
+// kafkaWriter is the interface of the Kafka writer used to publish flow records. It is
+// implemented by *kafka.Writer, and can be mocked for testing.
+type kafkaWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// newKafkaWriter is used for unit testing.
+var newKafkaWriter = func(config flowaggregatorconfig.KafkaConfig) (kafkaWriter, error) {
+	return buildKafkaWriter(config)
+}
+
+type KafkaExporter struct {
+	config       flowaggregatorconfig.KafkaConfig
+	batchTimeout time.Duration
+	clusterID    string
+	writer       kafkaWriter
+	// deque buffers the messages between batches.
+	deque      deque.Deque[kafka.Message]
+	dequeMutex sync.Mutex
+	queueSize  int
+	// batchReadyCh is notified when a full batch of messages is buffered.
+	batchReadyCh chan struct{}
+	stopCh       chan struct{}
+	wg           sync.WaitGroup
+}
+
+func NewKafkaExporter(clusterID string, opt *options.Options) (*KafkaExporter, error) {
+	config := opt.Config.Kafka
+	klog.InfoS("Kafka configuration", "brokers", config.Brokers, "topic", config.Topic, "recordFormat", config.RecordFormat, "partitioning", config.Partitioning,
+		"compression", config.Compression, "batchSize", config.BatchSize, "batchTimeout", opt.KafkaBatchTimeout, "tls", config.TLS.Enable, "saslMechanism", config.SASL.Mechanism)
+	writer, err := newKafkaWriter(config)
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaExporter{
+		config:       config,
+		batchTimeout: opt.KafkaBatchTimeout,
+		clusterID:    clusterID,
+		writer:       writer,
+		queueSize:    kafkaMaxQueueSize,
+		batchReadyCh: make(chan struct{}, 1),
+	}, nil
+}
+
+func buildKafkaWriter(config flowaggregatorconfig.KafkaConfig) (*kafka.Writer, error) {
+	var compression compress.Compression
+	if err := compression.UnmarshalText([]byte(config.Compression)); err != nil {
+		return nil, err
+	}
+	transport := &kafka.Transport{}
+	if config.TLS.Enable {
+		tlsConfig, err := buildKafkaTLSConfig(config.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("error when preparing TLS config for Kafka: %w", err)
+		}
+		transport.TLS = tlsConfig
+	}
+	if config.SASL.Mechanism != "" {
+		mechanism, err := buildKafkaSASLMechanism(config.SASL.Mechanism, os.Getenv("KAFKA_USERNAME"), os.Getenv("KAFKA_PASSWORD"))
+		if err != nil {
+			return nil, fmt.Errorf("error when preparing SASL mechanism for Kafka: %w", err)
+		}
+		transport.SASL = mechanism
+	}
+	return &kafka.Writer{
+		Addr:     kafka.TCP(config.Brokers...),
+		Topic:    config.Topic,
+		Balancer: &kafka.Hash{},
+		// The KafkaExporter takes care of batching the flow records, so the writer publishes
+		// the messages it receives right away.
+		BatchSize:    int(config.BatchSize),
+		BatchTimeout: time.Millisecond,
+		RequiredAcks: kafka.RequireAll,
+		Compression:  compression,
+		Transport:    transport,
+	}, nil
+}
+
+func buildKafkaTLSConfig(config flowaggregatorconfig.KafkaTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{ // #nosec G402: InsecureSkipVerify is only set when requested by the user
+		ServerName:         config.ServerName,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+		MinVersion:         options.TLSVersionOrDie(config.MinVersion),
+	}
+	if config.CASecretName != "" {
+		caPath := filepath.Join(kafkaCertDir, "ca.crt")
+		caBytes, err := afero.ReadFile(defaultFS, caPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading CA cert %q, ensure Secret %q exists in this Namespace and has the 'ca.crt' key: %w", caPath, config.CASecretName, err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caBytes) {
+			return nil, fmt.Errorf("failed to parse CA cert %q", caPath)
+		}
+	}
+	if config.ClientSecretName != "" {
+		certPath := filepath.Join(kafkaCertDir, "tls.crt")
+		certBytes, err := afero.ReadFile(defaultFS, certPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading client cert %q, ensure Secret %q exists in this Namespace and has the 'tls.crt' key: %w", certPath, config.ClientSecretName, err)
+		}
+		keyPath := filepath.Join(kafkaCertDir, "tls.key")
+		keyBytes, err := afero.ReadFile(defaultFS, keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading client key %q, ensure Secret %q exists in this Namespace and has the 'tls.key' key: %w", keyPath, config.ClientSecretName, err)
+		}
+		cert, err := tls.X509KeyPair(certBytes, keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client cert and key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+func buildKafkaSASLMechanism(mechanism flowaggregatorconfig.KafkaSASLMechanism, username, password string) (sasl.Mechanism, error) {
+	if username == "" || password == "" {
+		return nil, fmt.Errorf("username or password missing in Kafka credentials")
+	}
+	switch mechanism {
+	case flowaggregatorconfig.KafkaSASLMechanismPlain:
+		return plain.Mechanism{Username: username, Password: password}, nil
+	case flowaggregatorconfig.KafkaSASLMechanismScramSHA256:
+		return scram.Mechanism(scram.SHA256, username, password)
+	case flowaggregatorconfig.KafkaSASLMechanismScramSHA512:
+		return scram.Mechanism(scram.SHA512, username, password)
+	}
+	return nil, fmt.Errorf("unsupported SASL mechanism %s", mechanism)
+}
+
+// getKafkaMessageKey returns the key of the message for a flow record, which determines the
+// partition it is published to. A nil key means that the message can be published to any
+// partition.
+func getKafkaMessageKey(record *flowpb.Flow, partitioning flowaggregatorconfig.KafkaPartitioning, clusterID string) []byte {
+	switch partitioning {
+	case flowaggregatorconfig.KafkaPartitioningCluster:
+		return []byte(clusterID)
+	case flowaggregatorconfig.KafkaPartitioningNamespace:
+		if record.K8S == nil {
+			return nil
+		}
+		if record.K8S.SourcePodNamespace != "" {
+			return []byte(record.K8S.SourcePodNamespace)
+		}
+		if record.K8S.DestinationPodNamespace != "" {
+			return []byte(record.K8S.DestinationPodNamespace)
+		}
+		return nil
+	case flowaggregatorconfig.KafkaPartitioningFiveTuple:
+		if record.Ip == nil || record.Transport == nil {
+			return nil
+		}
+		// The endpoints are sorted, so that both directions of a connection have the same key.
+		srcIP, dstIP := record.Ip.Source, record.Ip.Destination
+		srcPort, dstPort := record.Transport.SourcePort, record.Transport.DestinationPort
+		if c := bytes.Compare(srcIP, dstIP); c > 0 || (c == 0 && srcPort > dstPort) {
+			srcIP, dstIP = dstIP, srcIP
+			srcPort, dstPort = dstPort, srcPort
+		}
+		src := net.JoinHostPort(net.IP(srcIP).String(), strconv.FormatUint(uint64(srcPort), 10))
+		dst := net.JoinHostPort(net.IP(dstIP).String(), strconv.FormatUint(uint64(dstPort), 10))
+		return fmt.Appendf(nil, "%d/%s/%s", record.Transport.ProtocolNumber, src, dst)
+	}
+	return nil
+}
+
+func (e *KafkaExporter) marshalRecord(record *flowpb.Flow) ([]byte, error) {
+	if e.config.RecordFormat == flowaggregatorconfig.KafkaRecordFormatJSON {
+		return protojson.Marshal(record)
+	}
+	return proto.Marshal(record)
+}
+
+func (e *KafkaExporter) AddRecord(record *flowpb.Flow, isRecordIPv6 bool) error {
+	value, err := e.marshalRecord(record)
+	if err != nil {
+		return fmt.Errorf("error when marshalling flow record: %w", err)
+	}
+	msg := kafka.Message{
+		Key:   getKafkaMessageKey(record, e.config.Partitioning, e.clusterID),
+		Value: value,
+		Headers: []kafka.Header{
+			{Key: kafkaClusterIDHeader, Value: []byte(e.clusterID)},
+		},
+	}
+
+	e.dequeMutex.Lock()
+	defer e.dequeMutex.Unlock()
+	for e.deque.Len() >= e.queueSize {
+		e.deque.PopFront()
+	}
+	e.deque.PushBack(msg)
+	if e.deque.Len() >= int(e.config.BatchSize) {
+		select {
+		case e.batchReadyCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (e *KafkaExporter) Start() {
+	e.start()
+}
+
+func (e *KafkaExporter) Stop() {
+	e.stop()
+	if err := e.writer.Close(); err != nil {
+		klog.ErrorS(err, "Error when closing Kafka writer")
+	}
+}
+
+func (e *KafkaExporter) start() {
+	e.stopCh = make(chan struct{})
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		e.publishLoop(e.stopCh)
+	}()
+}
+
+// stop stops the publishing goroutine, after it has published the buffered flow records.
+func (e *KafkaExporter) stop() {
+	close(e.stopCh)
+	e.wg.Wait()
+}
+
+func (e *KafkaExporter) publishLoop(stopCh <-chan struct{}) {
+	klog.InfoS("Starting Kafka exporting process")
+	ticker := time.NewTicker(e.batchTimeout)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			klog.InfoS("Stopping Kafka exporting process")
+			e.publishAll()
+			return
+		case <-ticker.C:
+			e.publishAll()
+		case <-e.batchReadyCh:
+			e.publishBatch()
+		}
+	}
+}
+
+// publishAll publishes all the buffered messages, in batches of at most BatchSize messages. It
+// stops at the first batch which cannot be published.
+func (e *KafkaExporter) publishAll() {
+	for {
+		if e.publishBatch() == 0 {
+			return
+		}
+	}
+}
+
+// publishBatch publishes a batch of at most BatchSize buffered messages. It returns the number
+// of messages published. The messages are pushed back to the queue if they cannot be published.
+func (e *KafkaExporter) publishBatch() int {
+	e.dequeMutex.Lock()
+	size := min(e.deque.Len(), int(e.config.BatchSize))
+	msgs := make([]kafka.Message, 0, size)
+	for range size {
+		msgs = append(msgs, e.deque.PopFront())
+	}
+	e.dequeMutex.Unlock()
+	if len(msgs) == 0 {
+		return 0
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), kafkaWriteTimeout)
+	defer cancel()
+	if err := e.writer.WriteMessages(ctx, msgs...); err != nil {
+		klog.ErrorS(err, "Error when publishing flow records to Kafka", "count", len(msgs))
+		e.pushMessagesToFrontOfQueue(msgs)
+		return 0
+	}
+	klog.V(4).InfoS("Published flow records to Kafka", "count", len(msgs))
+	return len(msgs)
+}
+
+// pushMessagesToFrontOfQueue pushes messages to the front of deque without exceeding its
+// capacity. Older messages will be dropped first if deque is to be filled.
+func (e *KafkaExporter) pushMessagesToFrontOfQueue(msgs []kafka.Message) {
+	e.dequeMutex.Lock()
+	defer e.dequeMutex.Unlock()
+	for i := len(msgs) - 1; i >= 0; i-- {
+		if e.deque.Len() >= e.queueSize {
+			break
+		}
+		e.deque.PushFront(msgs[i])
+	}
+}
+
+func (e *KafkaExporter) UpdateOptions(opt *options.Options) {
+	config := opt.Config.Kafka
+	if reflect.DeepEqual(e.config, config) && e.batchTimeout == opt.KafkaBatchTimeout {
+		return
+	}
+	klog.InfoS("Updating Kafka")
+	writer, err := newKafkaWriter(config)
+	if err != nil {
+		klog.ErrorS(err, "Error when creating Kafka writer, keeping the previous configuration")
+		return
+	}
+	e.stop()
+	if err := e.writer.Close(); err != nil {
+		klog.ErrorS(err, "Error when closing Kafka writer")
+	}
+	// Messages already buffered keep the key and the encoding computed with the previous
+	// configuration.
+	e.config = config
+	e.batchTimeout = opt.KafkaBatchTimeout
+	e.writer = writer
+	klog.InfoS("New Kafka configuration", "brokers", config.Brokers, "topic", config.Topic, "recordFormat", config.RecordFormat, "partitioning", config.Partitioning,
+		"compression", config.Compression, "batchSize", config.BatchSize, "batchTimeout", opt.KafkaBatchTimeout, "tls", config.TLS.Enable, "saslMechanism", config.SASL.Mechanism)
+	e.start()
+}
+
+func (e *KafkaExporter) Flush() error {
+	return nil
+}
@@ -0,0 +1,309 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
+)
+
+type fakeKafkaWriter struct {
+	mutex  sync.Mutex
+	config flowaggregatorconfig.KafkaConfig
+	msgs   []kafka.Message
+	// errs are returned by the successive calls to WriteMessages.
+	errs   []error
+	closed bool
+}
+
+func (w *fakeKafkaWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if len(w.errs) > 0 {
+		err := w.errs[0]
+		w.errs = w.errs[1:]
+		if err != nil {
+			return err
+		}
+	}
+	w.msgs = append(w.msgs, msgs...)
+	return nil
+}
+
+func (w *fakeKafkaWriter) Close() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.closed = true
+	return nil
+}
+
+func (w *fakeKafkaWriter) getMessages() []kafka.Message {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.msgs
+}
+
+func mockKafkaWriters(t *testing.T, errs ...error) *[]*fakeKafkaWriter {
+	writers := []*fakeKafkaWriter{}
+	newKafkaWriterSaved := newKafkaWriter
+	t.Cleanup(func() {
+		newKafkaWriter = newKafkaWriterSaved
+	})
+	newKafkaWriter = func(config flowaggregatorconfig.KafkaConfig) (kafkaWriter, error) {
+		writer := &fakeKafkaWriter{config: config, errs: errs}
+		writers = append(writers, writer)
+		return writer, nil
+	}
+	return &writers
+}
+
+func newKafkaTestOptions(recordFormat flowaggregatorconfig.KafkaRecordFormat, batchSize int32, batchTimeout time.Duration) *options.Options {
+	return &options.Options{
+		Config: &flowaggregatorconfig.FlowAggregatorConfig{
+			Kafka: flowaggregatorconfig.KafkaConfig{
+				Enable:       true,
+				Brokers:      []string{"kafka:9092"},
+				Topic:        "flows",
+				RecordFormat: recordFormat,
+				Partitioning: flowaggregatorconfig.KafkaPartitioningFiveTuple,
+				Compression:  "none",
+				BatchSize:    batchSize,
+			},
+		},
+		KafkaBatchTimeout: batchTimeout,
+	}
+}
+
+func TestGetKafkaMessageKey(t *testing.T) {
+	newRecord := func(srcIP, dstIP string, srcPort, dstPort uint32, srcNamespace, dstNamespace string) *flowpb.Flow {
+		return &flowpb.Flow{
+			Ip: &flowpb.IP{
+				Source:      netip.MustParseAddr(srcIP).AsSlice(),
+				Destination: netip.MustParseAddr(dstIP).AsSlice(),
+			},
+			Transport: &flowpb.Transport{
+				ProtocolNumber:  6,
+				SourcePort:      srcPort,
+				DestinationPort: dstPort,
+			},
+			K8S: &flowpb.Kubernetes{
+				SourcePodNamespace:      srcNamespace,
+				DestinationPodNamespace: dstNamespace,
+			},
+		}
+	}
+	tests := []struct {
+		name         string
+		record       *flowpb.Flow
+		partitioning flowaggregatorconfig.KafkaPartitioning
+		expectedKey  []byte
+	}{
+		{
+			name:         "cluster",
+			record:       newRecord("10.0.0.1", "10.0.0.2", 35000, 80, "ns1", "ns2"),
+			partitioning: flowaggregatorconfig.KafkaPartitioningCluster,
+			expectedKey:  []byte("cluster-a"),
+		},
+		{
+			name:         "source Namespace",
+			record:       newRecord("10.0.0.1", "10.0.0.2", 35000, 80, "ns1", "ns2"),
+			partitioning: flowaggregatorconfig.KafkaPartitioningNamespace,
+			expectedKey:  []byte("ns1"),
+		},
+		{
+			name:         "destination Namespace",
+			record:       newRecord("10.0.0.1", "10.0.0.2", 35000, 80, "", "ns2"),
+			partitioning: flowaggregatorconfig.KafkaPartitioningNamespace,
+			expectedKey:  []byte("ns2"),
+		},
+		{
+			name:         "no Namespace",
+			record:       newRecord("10.0.0.1", "10.0.0.2", 35000, 80, "", ""),
+			partitioning: flowaggregatorconfig.KafkaPartitioningNamespace,
+			expectedKey:  nil,
+		},
+		{
+			name:         "5-tuple",
+			record:       newRecord("10.0.0.1", "10.0.0.2", 35000, 80, "ns1", "ns2"),
+			partitioning: flowaggregatorconfig.KafkaPartitioningFiveTuple,
+			expectedKey:  []byte("6/10.0.0.1:35000/10.0.0.2:80"),
+		},
+		{
+			name:         "reverse 5-tuple",
+			record:       newRecord("10.0.0.2", "10.0.0.1", 80, 35000, "ns2", "ns1"),
+			partitioning: flowaggregatorconfig.KafkaPartitioningFiveTuple,
+			expectedKey:  []byte("6/10.0.0.1:35000/10.0.0.2:80"),
+		},
+		{
+			name:         "5-tuple with same IPs",
+			record:       newRecord("10.0.0.1", "10.0.0.1", 35000, 80, "ns1", "ns1"),
+			partitioning: flowaggregatorconfig.KafkaPartitioningFiveTuple,
+			expectedKey:  []byte("6/10.0.0.1:80/10.0.0.1:35000"),
+		},
+		{
+			name:         "IPv6 5-tuple",
+			record:       newRecord("2001:db8::2", "2001:db8::1", 80, 35000, "ns1", "ns2"),
+			partitioning: flowaggregatorconfig.KafkaPartitioningFiveTuple,
+			expectedKey:  []byte("6/[2001:db8::1]:35000/[2001:db8::2]:80"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedKey, getKafkaMessageKey(tt.record, tt.partitioning, "cluster-a"))
+		})
+	}
+}
+
+func TestKafka_AddRecord(t *testing.T) {
+	for _, recordFormat := range []flowaggregatorconfig.KafkaRecordFormat{flowaggregatorconfig.KafkaRecordFormatProtobuf, flowaggregatorconfig.KafkaRecordFormatJSON} {
+		t.Run(string(recordFormat), func(t *testing.T) {
+			writers := mockKafkaWriters(t)
+			kafkaExporter, err := NewKafkaExporter("cluster-a", newKafkaTestOptions(recordFormat, 2, time.Hour))
+			require.NoError(t, err)
+			require.Len(t, *writers, 1)
+			writer := (*writers)[0]
+			kafkaExporter.Start()
+
+			records := []*flowpb.Flow{
+				flowaggregatortesting.PrepareTestFlowRecord(true),
+				flowaggregatortesting.PrepareTestFlowRecord(false),
+				flowaggregatortesting.PrepareTestFlowRecord(true),
+			}
+			for _, record := range records {
+				require.NoError(t, kafkaExporter.AddRecord(record, false))
+			}
+			// The first 2 records form a full batch, which is published right away.
+			assert.EventuallyWithT(t, func(c *assert.CollectT) {
+				assert.Len(c, writer.getMessages(), 2)
+			}, 2*time.Second, 10*time.Millisecond)
+			// The last record is published when the exporter is stopped.
+			kafkaExporter.Stop()
+			msgs := writer.getMessages()
+			require.Len(t, msgs, 3)
+			assert.True(t, writer.closed)
+
+			for i, msg := range msgs {
+				assert.Equal(t, getKafkaMessageKey(records[i], flowaggregatorconfig.KafkaPartitioningFiveTuple, "cluster-a"), msg.Key)
+				assert.Equal(t, []kafka.Header{{Key: "clusterID", Value: []byte("cluster-a")}}, msg.Headers)
+				record := &flowpb.Flow{}
+				if recordFormat == flowaggregatorconfig.KafkaRecordFormatJSON {
+					require.NoError(t, protojson.Unmarshal(msg.Value, record))
+				} else {
+					require.NoError(t, proto.Unmarshal(msg.Value, record))
+				}
+				assert.Empty(t, cmp.Diff(records[i], record, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestKafka_PublishFailure(t *testing.T) {
+	writers := mockKafkaWriters(t, fmt.Errorf("broker unavailable"))
+	kafkaExporter, err := NewKafkaExporter("cluster-a", newKafkaTestOptions(flowaggregatorconfig.KafkaRecordFormatProtobuf, 10, time.Hour))
+	require.NoError(t, err)
+	writer := (*writers)[0]
+
+	for range 3 {
+		require.NoError(t, kafkaExporter.AddRecord(flowaggregatortesting.PrepareTestFlowRecord(true), false))
+	}
+	// The records are kept in the queue when they cannot be published.
+	assert.Equal(t, 0, kafkaExporter.publishBatch())
+	assert.Equal(t, 3, kafkaExporter.deque.Len())
+	assert.Equal(t, 3, kafkaExporter.publishBatch())
+	assert.Equal(t, 0, kafkaExporter.deque.Len())
+	assert.Len(t, writer.getMessages(), 3)
+}
+
+func TestKafka_QueueSize(t *testing.T) {
+	mockKafkaWriters(t)
+	kafkaExporter, err := NewKafkaExporter("cluster-a", newKafkaTestOptions(flowaggregatorconfig.KafkaRecordFormatProtobuf, 10, time.Hour))
+	require.NoError(t, err)
+	kafkaExporter.queueSize = 2
+
+	records := []*flowpb.Flow{
+		flowaggregatortesting.PrepareTestFlowRecord(true),
+		flowaggregatortesting.PrepareTestFlowRecord(false),
+		flowaggregatortesting.PrepareTestFlowRecord(true),
+	}
+	for _, record := range records {
+		require.NoError(t, kafkaExporter.AddRecord(record, false))
+	}
+	// The oldest record is dropped.
+	require.Equal(t, 2, kafkaExporter.deque.Len())
+	assert.Equal(t, getKafkaMessageKey(records[1], flowaggregatorconfig.KafkaPartitioningFiveTuple, "cluster-a"), kafkaExporter.deque.Front().Key)
+}
+
+func TestKafka_UpdateOptions(t *testing.T) {
+	writers := mockKafkaWriters(t)
+	opt := newKafkaTestOptions(flowaggregatorconfig.KafkaRecordFormatProtobuf, 10, time.Hour)
+	kafkaExporter, err := NewKafkaExporter("cluster-a", opt)
+	require.NoError(t, err)
+	kafkaExporter.Start()
+	require.NoError(t, kafkaExporter.AddRecord(flowaggregatortesting.PrepareTestFlowRecord(true), false))
+
+	// The writer is not re-created when the configuration is unchanged.
+	kafkaExporter.UpdateOptions(newKafkaTestOptions(flowaggregatorconfig.KafkaRecordFormatProtobuf, 10, time.Hour))
+	require.Len(t, *writers, 1)
+
+	newOpt := newKafkaTestOptions(flowaggregatorconfig.KafkaRecordFormatJSON, 10, time.Hour)
+	newOpt.Config.Kafka.Topic = "new-flows"
+	kafkaExporter.UpdateOptions(newOpt)
+	require.Len(t, *writers, 2)
+	oldWriter, newWriter := (*writers)[0], (*writers)[1]
+	// The buffered record is published with the previous writer.
+	assert.Len(t, oldWriter.getMessages(), 1)
+	assert.True(t, oldWriter.closed)
+	assert.Equal(t, "new-flows", newWriter.config.Topic)
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	require.NoError(t, kafkaExporter.AddRecord(record, false))
+	kafkaExporter.Stop()
+	msgs := newWriter.getMessages()
+	require.Len(t, msgs, 1)
+	received := &flowpb.Flow{}
+	require.NoError(t, protojson.Unmarshal(msgs[0].Value, received))
+	assert.Empty(t, cmp.Diff(record, received, protocmp.Transform()))
+}
+
+func TestBuildKafkaSASLMechanism(t *testing.T) {
+	for _, mechanism := range []flowaggregatorconfig.KafkaSASLMechanism{
+		flowaggregatorconfig.KafkaSASLMechanismPlain,
+		flowaggregatorconfig.KafkaSASLMechanismScramSHA256,
+		flowaggregatorconfig.KafkaSASLMechanismScramSHA512,
+	} {
+		m, err := buildKafkaSASLMechanism(mechanism, "user", "password")
+		require.NoError(t, err)
+		assert.Equal(t, string(mechanism), m.Name())
+	}
+	_, err := buildKafkaSASLMechanism(flowaggregatorconfig.KafkaSASLMechanismPlain, "user", "")
+	assert.Error(t, err)
+}
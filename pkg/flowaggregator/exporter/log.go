@@ -15,9 +15,7 @@
 package exporter
 
 import (
-	"math"
 	"reflect"
-	"slices"
 	"sync"
 
 	"k8s.io/klog/v2"
@@ -29,14 +27,8 @@ import (
 	"antrea.io/antrea/pkg/flowaggregator/options"
 )
 
-type flowFilter struct {
-	IngressNetworkPolicyRuleActions []uint8
-	EgressNetworkPolicyRuleActions  []uint8
-}
-
 type LogExporter struct {
 	config     flowaggregatorconfig.FlowLoggerConfig
-	filters    []flowFilter
 	flowLogger *flowlogger.FlowLogger
 	stopCh     chan struct{}
 	wg         sync.WaitGroup
@@ -48,75 +40,17 @@ func NewLogExporter(opt *options.Options) (*LogExporter, error) {
 	exporter := &LogExporter{
 		config: config,
 	}
-	exporter.buildFilters()
 	return exporter, nil
 }
 
-func (e *LogExporter) buildFilters() {
-	ruleActionToUint8 := func(a flowaggregatorconfig.NetworkPolicyRuleAction) uint8 {
-		switch a {
-		case flowaggregatorconfig.NetworkPolicyRuleActionNone:
-			return uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_NO_ACTION)
-		case flowaggregatorconfig.NetworkPolicyRuleActionAllow:
-			return uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_ALLOW)
-		case flowaggregatorconfig.NetworkPolicyRuleActionDrop:
-			return uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_DROP)
-		case flowaggregatorconfig.NetworkPolicyRuleActionReject:
-			return uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_REJECT)
-		default: // invalid case
-			return math.MaxUint8
-		}
-	}
-	convertFilter := func(in *flowaggregatorconfig.FlowFilter) flowFilter {
-		ingressNetworkPolicyRuleActions := make([]uint8, len(in.IngressNetworkPolicyRuleActions))
-		for idx, a := range in.IngressNetworkPolicyRuleActions {
-			ingressNetworkPolicyRuleActions[idx] = ruleActionToUint8(a)
-		}
-		egressNetworkPolicyRuleActions := make([]uint8, len(in.EgressNetworkPolicyRuleActions))
-		for idx, a := range in.EgressNetworkPolicyRuleActions {
-			egressNetworkPolicyRuleActions[idx] = ruleActionToUint8(a)
-		}
-		return flowFilter{
-			IngressNetworkPolicyRuleActions: ingressNetworkPolicyRuleActions,
-			EgressNetworkPolicyRuleActions:  egressNetworkPolicyRuleActions,
-		}
-	}
-	e.filters = make([]flowFilter, 0, len(e.config.Filters))
-	for idx := range e.config.Filters {
-		e.filters = append(e.filters, convertFilter(&e.config.Filters[idx]))
-	}
-}
-
 func (e *LogExporter) AddRecord(record *flowpb.Flow, isRecordIPv6 bool) error {
 	r, err := flowrecord.GetFlowRecord(record)
 	if err != nil {
 		return err
 	}
-	if !e.applyFilters(r) {
-		klog.V(5).InfoS("Ignoring record in FlowLogger because filters do not match")
-		return nil
-	}
 	return e.flowLogger.WriteRecord(r, *e.config.PrettyPrint)
 }
 
-func (e *LogExporter) applyFilters(r *flowrecord.FlowRecord) bool {
-	if len(e.filters) == 0 {
-		return true
-	}
-	for idx := range e.filters {
-		filter := &e.filters[idx]
-		if len(filter.IngressNetworkPolicyRuleActions) > 0 && !slices.Contains(filter.IngressNetworkPolicyRuleActions, r.IngressNetworkPolicyRuleAction) {
-			continue
-		}
-		if len(filter.EgressNetworkPolicyRuleActions) > 0 && !slices.Contains(filter.EgressNetworkPolicyRuleActions, r.EgressNetworkPolicyRuleAction) {
-			continue
-		}
-		// both conditions match
-		return true
-	}
-	return false
-}
-
 func (e *LogExporter) Start() {
 	e.start()
 }
@@ -158,7 +92,6 @@ func (e *LogExporter) UpdateOptions(opt *options.Options) {
 	e.stop()
 	e.config = config
 	klog.InfoS("New FlowLogger configuration", "path", config.Path, "maxSize", config.MaxSize, "maxBackups", config.MaxBackups, "maxAge", config.MaxAge, "compress", *config.Compress, "prettyPrint", *config.PrettyPrint)
-	e.buildFilters()
 	e.start()
 }
 
@@ -0,0 +1,729 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gammazero/deque"
+	"github.com/spf13/afero"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/klog/v2"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+	"antrea.io/antrea/pkg/version"
+)
+
+const (
+	otlpCertDir = "/etc/flow-aggregator/certs/otlp"
+	// otlpMaxQueueSize is the maximum number of log records buffered by the OTLPExporter.
+	// When the queue is full, the oldest records are dropped.
+	otlpMaxQueueSize = 1 << 19
+	// otlpExportTimeout is the timeout for a single OTLP export request.
+	otlpExportTimeout = 30 * time.Second
+	// Paths of the OTLP/HTTP endpoints, as defined by the OTLP specification.
+	otlpHTTPLogsPath    = "/v1/logs"
+	otlpHTTPMetricsPath = "/v1/metrics"
+
+	otlpServiceName = "antrea-flow-aggregator"
+	otlpScopeName   = "antrea.io/antrea/pkg/flowaggregator"
+
+	otlpBytesMetricName   = "antrea.flow.bytes"
+	otlpPacketsMetricName = "antrea.flow.packets"
+)
+
+// otlpClient sends OTLP export requests to a collector. It is implemented for the gRPC and
+// HTTP transports.
+type otlpClient interface {
+	exportLogs(ctx context.Context, request *collogspb.ExportLogsServiceRequest) error
+	exportMetrics(ctx context.Context, request *colmetricspb.ExportMetricsServiceRequest) error
+	close() error
+}
+
+type otlpGRPCClient struct {
+	conn          *grpc.ClientConn
+	logsClient    collogspb.LogsServiceClient
+	metricsClient colmetricspb.MetricsServiceClient
+}
+
+func (c *otlpGRPCClient) exportLogs(ctx context.Context, request *collogspb.ExportLogsServiceRequest) error {
+	response, err := c.logsClient.Export(ctx, request)
+	if err != nil {
+		return err
+	}
+	if partialSuccess := response.GetPartialSuccess(); partialSuccess.GetRejectedLogRecords() > 0 {
+		klog.InfoS("Some log records were rejected by the OTLP collector", "count", partialSuccess.RejectedLogRecords, "message", partialSuccess.ErrorMessage)
+	}
+	return nil
+}
+
+func (c *otlpGRPCClient) exportMetrics(ctx context.Context, request *colmetricspb.ExportMetricsServiceRequest) error {
+	response, err := c.metricsClient.Export(ctx, request)
+	if err != nil {
+		return err
+	}
+	if partialSuccess := response.GetPartialSuccess(); partialSuccess.GetRejectedDataPoints() > 0 {
+		klog.InfoS("Some data points were rejected by the OTLP collector", "count", partialSuccess.RejectedDataPoints, "message", partialSuccess.ErrorMessage)
+	}
+	return nil
+}
+
+func (c *otlpGRPCClient) close() error {
+	return c.conn.Close()
+}
+
+type otlpHTTPClient struct {
+	client     *http.Client
+	logsURL    string
+	metricsURL string
+}
+
+// post sends an OTLP request with the binary Protobuf encoding, and decodes the response into
+// the provided message.
+func (c *otlpHTTPClient) post(ctx context.Context, url string, request, response proto.Message) error {
+	body, err := proto.Marshal(request)
+	if err != nil {
+		return err
+	}
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-protobuf")
+	httpResponse, err := c.client.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+	responseBody, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return err
+	}
+	if httpResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response from OTLP collector: %s", httpResponse.Status)
+	}
+	return proto.Unmarshal(responseBody, response)
+}
+
+func (c *otlpHTTPClient) exportLogs(ctx context.Context, request *collogspb.ExportLogsServiceRequest) error {
+	response := &collogspb.ExportLogsServiceResponse{}
+	if err := c.post(ctx, c.logsURL, request, response); err != nil {
+		return err
+	}
+	if partialSuccess := response.GetPartialSuccess(); partialSuccess.GetRejectedLogRecords() > 0 {
+		klog.InfoS("Some log records were rejected by the OTLP collector", "count", partialSuccess.RejectedLogRecords, "message", partialSuccess.ErrorMessage)
+	}
+	return nil
+}
+
+func (c *otlpHTTPClient) exportMetrics(ctx context.Context, request *colmetricspb.ExportMetricsServiceRequest) error {
+	response := &colmetricspb.ExportMetricsServiceResponse{}
+	if err := c.post(ctx, c.metricsURL, request, response); err != nil {
+		return err
+	}
+	if partialSuccess := response.GetPartialSuccess(); partialSuccess.GetRejectedDataPoints() > 0 {
+		klog.InfoS("Some data points were rejected by the OTLP collector", "count", partialSuccess.RejectedDataPoints, "message", partialSuccess.ErrorMessage)
+	}
+	return nil
+}
+
+func (c *otlpHTTPClient) close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
+
+func buildOTLPClient(config flowaggregatorconfig.OTLPConfig) (otlpClient, error) {
+	var tlsConfig *tls.Config
+	if config.TLS.Enable {
+		var err error
+		tlsConfig, err = buildOTLPTLSConfig(config.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("error when preparing TLS config for OTLP: %w", err)
+		}
+	}
+	switch config.Protocol {
+	case flowaggregatorconfig.OTLPProtocolGRPC:
+		creds := insecure.NewCredentials()
+		if tlsConfig != nil {
+			creds = credentials.NewTLS(tlsConfig)
+		}
+		// The connection is established lazily, so this does not fail if the collector is
+		// unreachable.
+		conn, err := grpc.NewClient(config.Endpoint, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return nil, fmt.Errorf("error when creating gRPC client for OTLP: %w", err)
+		}
+		return &otlpGRPCClient{
+			conn:          conn,
+			logsClient:    collogspb.NewLogsServiceClient(conn),
+			metricsClient: colmetricspb.NewMetricsServiceClient(conn),
+		}, nil
+	case flowaggregatorconfig.OTLPProtocolHTTP:
+		scheme := "http"
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		if tlsConfig != nil {
+			scheme = "https"
+			transport.TLSClientConfig = tlsConfig
+		}
+		baseURL := fmt.Sprintf("%s://%s", scheme, config.Endpoint)
+		return &otlpHTTPClient{
+			client:     &http.Client{Transport: transport},
+			logsURL:    baseURL + otlpHTTPLogsPath,
+			metricsURL: baseURL + otlpHTTPMetricsPath,
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported OTLP protocol %s", config.Protocol)
+}
+
+func buildOTLPTLSConfig(config flowaggregatorconfig.OTLPTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{ // #nosec G402: InsecureSkipVerify is only set when requested by the user
+		ServerName:         config.ServerName,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+		MinVersion:         options.TLSVersionOrDie(config.MinVersion),
+	}
+	if config.CASecretName != "" {
+		caPath := filepath.Join(otlpCertDir, "ca.crt")
+		caBytes, err := afero.ReadFile(defaultFS, caPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading CA cert %q, ensure Secret %q exists in this Namespace and has the 'ca.crt' key: %w", caPath, config.CASecretName, err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caBytes) {
+			return nil, fmt.Errorf("failed to parse CA cert %q", caPath)
+		}
+	}
+	if config.ClientSecretName != "" {
+		certPath := filepath.Join(otlpCertDir, "tls.crt")
+		certBytes, err := afero.ReadFile(defaultFS, certPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading client cert %q, ensure Secret %q exists in this Namespace and has the 'tls.crt' key: %w", certPath, config.ClientSecretName, err)
+		}
+		keyPath := filepath.Join(otlpCertDir, "tls.key")
+		keyBytes, err := afero.ReadFile(defaultFS, keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading client key %q, ensure Secret %q exists in this Namespace and has the 'tls.key' key: %w", keyPath, config.ClientSecretName, err)
+		}
+		cert, err := tls.X509KeyPair(certBytes, keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client cert and key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// namespacePair identifies the source and destination Namespaces of flows. An empty Namespace
+// means that the endpoint is not a Pod.
+type namespacePair struct {
+	source      string
+	destination string
+}
+
+type otlpFlowCounters struct {
+	bytes   uint64
+	packets uint64
+}
+
+type OTLPExporter struct {
+	config         flowaggregatorconfig.OTLPConfig
+	exportInterval time.Duration
+	client         otlpClient
+	resource       *resourcepb.Resource
+	scope          *commonpb.InstrumentationScope
+	// deque buffers the log records between exports.
+	deque      deque.Deque[*logspb.LogRecord]
+	dequeMutex sync.Mutex
+	queueSize  int
+	// counters accumulates the bytes and packets of flows per Namespace pair, since
+	// countersStartTime. They are reset every time they are exported as delta metrics.
+	counters          map[namespacePair]*otlpFlowCounters
+	countersStartTime time.Time
+	countersMutex     sync.Mutex
+	// batchReadyCh is notified when a full batch of log records is buffered.
+	batchReadyCh chan struct{}
+	stopCh       chan struct{}
+	wg           sync.WaitGroup
+}
+
+func NewOTLPExporter(clusterID string, opt *options.Options) (*OTLPExporter, error) {
+	config := opt.Config.OTLP
+	klog.InfoS("OTLP configuration", "endpoint", config.Endpoint, "protocol", config.Protocol, "exportInterval", opt.OTLPExportInterval,
+		"batchSize", config.BatchSize, "metrics", config.Metrics.Enable, "tls", config.TLS.Enable)
+	client, err := buildOTLPClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &OTLPExporter{
+		config:         config,
+		exportInterval: opt.OTLPExportInterval,
+		client:         client,
+		resource: &resourcepb.Resource{
+			Attributes: []*commonpb.KeyValue{
+				otlpStringAttribute("service.name", otlpServiceName),
+				otlpStringAttribute("service.version", version.GetFullVersion()),
+				otlpStringAttribute("k8s.cluster.uid", clusterID),
+			},
+		},
+		scope: &commonpb.InstrumentationScope{
+			Name:    otlpScopeName,
+			Version: version.GetFullVersion(),
+		},
+		queueSize:         otlpMaxQueueSize,
+		counters:          make(map[namespacePair]*otlpFlowCounters),
+		countersStartTime: time.Now(),
+		batchReadyCh:      make(chan struct{}, 1),
+	}, nil
+}
+
+func otlpStringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
+
+func otlpIntAttribute(key string, value int64) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: value}}}
+}
+
+// otlpAttributes builds the attributes of a log record, omitting the empty ones.
+type otlpAttributes []*commonpb.KeyValue
+
+func (a *otlpAttributes) addString(key, value string) {
+	if value != "" {
+		*a = append(*a, otlpStringAttribute(key, value))
+	}
+}
+
+func (a *otlpAttributes) addInt(key string, value int64) {
+	if value != 0 {
+		*a = append(*a, otlpIntAttribute(key, value))
+	}
+}
+
+func (a *otlpAttributes) addIP(key string, ip []byte) {
+	if len(ip) > 0 {
+		*a = append(*a, otlpStringAttribute(key, net.IP(ip).String()))
+	}
+}
+
+func (a *otlpAttributes) addLabels(prefix string, labels *flowpb.Labels) {
+	for _, key := range slices.Sorted(maps.Keys(labels.GetLabels())) {
+		*a = append(*a, otlpStringAttribute(prefix+key, labels.Labels[key]))
+	}
+}
+
+func (a *otlpAttributes) addStats(prefix string, stats *flowpb.Stats) {
+	if stats == nil {
+		return
+	}
+	*a = append(*a,
+		otlpIntAttribute(prefix+"packet_total_count", int64(stats.PacketTotalCount)),
+		otlpIntAttribute(prefix+"packet_delta_count", int64(stats.PacketDeltaCount)),
+		otlpIntAttribute(prefix+"octet_total_count", int64(stats.OctetTotalCount)),
+		otlpIntAttribute(prefix+"octet_delta_count", int64(stats.OctetDeltaCount)),
+	)
+}
+
+func getOTLPNetworkTransport(protocolNumber uint32) string {
+	switch protocolNumber {
+	case 6:
+		return "tcp"
+	case 17:
+		return "udp"
+	case 132:
+		return "sctp"
+	}
+	return ""
+}
+
+// getOTLPLogAttributes returns the attributes of the log record for a flow record. OpenTelemetry
+// semantic conventions are used for the network and Kubernetes attributes when they exist, with
+// "source." and "destination." prefixes for the Kubernetes attributes of each endpoint. The other
+// fields use the "antrea." prefix.
+func getOTLPLogAttributes(record *flowpb.Flow) []*commonpb.KeyValue {
+	attributes := otlpAttributes{}
+	attributes.addString("antrea.flow.id", record.Id)
+	if record.Ip != nil {
+		switch record.Ip.Version {
+		case flowpb.IPVersion_IP_VERSION_4:
+			attributes.addString("network.type", "ipv4")
+		case flowpb.IPVersion_IP_VERSION_6:
+			attributes.addString("network.type", "ipv6")
+		}
+		attributes.addIP("source.address", record.Ip.Source)
+		attributes.addIP("destination.address", record.Ip.Destination)
+	}
+	if record.Transport != nil {
+		attributes.addString("network.transport", getOTLPNetworkTransport(record.Transport.ProtocolNumber))
+		attributes.addInt("antrea.flow.protocol_number", int64(record.Transport.ProtocolNumber))
+		attributes.addInt("source.port", int64(record.Transport.SourcePort))
+		attributes.addInt("destination.port", int64(record.Transport.DestinationPort))
+		attributes.addString("antrea.flow.tcp_state", record.Transport.GetTCP().GetStateName())
+	}
+	attributes.addString("antrea.flow.end_reason", record.EndReason.String())
+	if k8s := record.K8S; k8s != nil {
+		attributes.addString("antrea.flow.type", k8s.FlowType.String())
+		attributes.addString("source.k8s.namespace.name", k8s.SourcePodNamespace)
+		attributes.addString("source.k8s.pod.name", k8s.SourcePodName)
+		attributes.addString("source.k8s.pod.uid", k8s.SourcePodUid)
+		attributes.addLabels("source.k8s.pod.label.", k8s.SourcePodLabels)
+		attributes.addString("source.k8s.node.name", k8s.SourceNodeName)
+		attributes.addString("destination.k8s.namespace.name", k8s.DestinationPodNamespace)
+		attributes.addString("destination.k8s.pod.name", k8s.DestinationPodName)
+		attributes.addString("destination.k8s.pod.uid", k8s.DestinationPodUid)
+		attributes.addLabels("destination.k8s.pod.label.", k8s.DestinationPodLabels)
+		attributes.addString("destination.k8s.node.name", k8s.DestinationNodeName)
+		attributes.addIP("destination.k8s.service.cluster_ip", k8s.DestinationClusterIp)
+		attributes.addInt("destination.k8s.service.port", int64(k8s.DestinationServicePort))
+		attributes.addString("destination.k8s.service.port_name", k8s.DestinationServicePortName)
+		if k8s.IngressNetworkPolicyName != "" {
+			attributes.addString("antrea.ingress_network_policy.type", k8s.IngressNetworkPolicyType.String())
+			attributes.addString("antrea.ingress_network_policy.namespace", k8s.IngressNetworkPolicyNamespace)
+			attributes.addString("antrea.ingress_network_policy.name", k8s.IngressNetworkPolicyName)
+			attributes.addString("antrea.ingress_network_policy.rule_name", k8s.IngressNetworkPolicyRuleName)
+			attributes.addString("antrea.ingress_network_policy.rule_action", k8s.IngressNetworkPolicyRuleAction.String())
+		}
+		if k8s.EgressNetworkPolicyName != "" {
+			attributes.addString("antrea.egress_network_policy.type", k8s.EgressNetworkPolicyType.String())
+			attributes.addString("antrea.egress_network_policy.namespace", k8s.EgressNetworkPolicyNamespace)
+			attributes.addString("antrea.egress_network_policy.name", k8s.EgressNetworkPolicyName)
+			attributes.addString("antrea.egress_network_policy.rule_name", k8s.EgressNetworkPolicyRuleName)
+			attributes.addString("antrea.egress_network_policy.rule_action", k8s.EgressNetworkPolicyRuleAction.String())
+		}
+		attributes.addString("antrea.egress.name", k8s.EgressName)
+		attributes.addIP("antrea.egress.ip", k8s.EgressIp)
+		attributes.addString("antrea.egress.node.name", k8s.EgressNodeName)
+	}
+	attributes.addStats("antrea.flow.", record.Stats)
+	attributes.addStats("antrea.flow.reverse_", record.ReverseStats)
+	attributes.addString("antrea.flow.app_protocol", record.App.GetProtocolName())
+	return attributes
+}
+
+// buildLogRecord returns the log record for a flow record. Its body is the complete flow record
+// encoded as JSON, following the Flow message schema, while its attributes only include the most
+// useful fields, so that they can be used for indexing.
+func buildLogRecord(record *flowpb.Flow, observedTime time.Time) (*logspb.LogRecord, error) {
+	body, err := protojson.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	logRecord := &logspb.LogRecord{
+		ObservedTimeUnixNano: uint64(observedTime.UnixNano()),
+		SeverityNumber:       logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+		SeverityText:         "INFO",
+		Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: string(body)}},
+		Attributes:           getOTLPLogAttributes(record),
+	}
+	if record.EndTs != nil {
+		logRecord.TimeUnixNano = uint64(record.EndTs.AsTime().UnixNano())
+	}
+	return logRecord, nil
+}
+
+func (e *OTLPExporter) AddRecord(record *flowpb.Flow, isRecordIPv6 bool) error {
+	logRecord, err := buildLogRecord(record, time.Now())
+	if err != nil {
+		return fmt.Errorf("error when marshalling flow record: %w", err)
+	}
+	if e.config.Metrics.Enable {
+		e.updateCounters(record)
+	}
+
+	e.dequeMutex.Lock()
+	defer e.dequeMutex.Unlock()
+	for e.deque.Len() >= e.queueSize {
+		e.deque.PopFront()
+	}
+	e.deque.PushBack(logRecord)
+	if e.deque.Len() >= int(e.config.BatchSize) {
+		select {
+		case e.batchReadyCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// updateCounters adds the bytes and packets exchanged in both directions since the last export
+// of a flow record to the counters of its Namespace pair.
+func (e *OTLPExporter) updateCounters(record *flowpb.Flow) {
+	pair := namespacePair{
+		source:      record.K8S.GetSourcePodNamespace(),
+		destination: record.K8S.GetDestinationPodNamespace(),
+	}
+	e.countersMutex.Lock()
+	defer e.countersMutex.Unlock()
+	counters, ok := e.counters[pair]
+	if !ok {
+		counters = &otlpFlowCounters{}
+		e.counters[pair] = counters
+	}
+	counters.bytes += record.Stats.GetOctetDeltaCount() + record.ReverseStats.GetOctetDeltaCount()
+	counters.packets += record.Stats.GetPacketDeltaCount() + record.ReverseStats.GetPacketDeltaCount()
+}
+
+func (e *OTLPExporter) Start() {
+	e.start()
+}
+
+func (e *OTLPExporter) Stop() {
+	e.stop()
+	if err := e.client.close(); err != nil {
+		klog.ErrorS(err, "Error when closing OTLP client")
+	}
+}
+
+func (e *OTLPExporter) start() {
+	e.stopCh = make(chan struct{})
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		e.exportLoop(e.stopCh)
+	}()
+}
+
+// stop stops the exporting goroutine, after it has exported the buffered log records and the
+// metrics.
+func (e *OTLPExporter) stop() {
+	close(e.stopCh)
+	e.wg.Wait()
+}
+
+func (e *OTLPExporter) exportLoop(stopCh <-chan struct{}) {
+	klog.InfoS("Starting OTLP exporting process")
+	ticker := time.NewTicker(e.exportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			klog.InfoS("Stopping OTLP exporting process")
+			e.exportAll()
+			return
+		case <-ticker.C:
+			e.exportAll()
+		case <-e.batchReadyCh:
+			e.exportLogBatch()
+		}
+	}
+}
+
+// exportAll exports all the buffered log records, in batches of at most BatchSize records, and
+// the metrics if enabled. It stops exporting log records at the first batch which cannot be
+// exported.
+func (e *OTLPExporter) exportAll() {
+	for {
+		if e.exportLogBatch() == 0 {
+			break
+		}
+	}
+	if e.config.Metrics.Enable {
+		e.exportMetrics()
+	}
+}
+
+// exportLogBatch exports a batch of at most BatchSize buffered log records. It returns the
+// number of records exported. The records are pushed back to the queue if they cannot be
+// exported.
+func (e *OTLPExporter) exportLogBatch() int {
+	e.dequeMutex.Lock()
+	size := min(e.deque.Len(), int(e.config.BatchSize))
+	logRecords := make([]*logspb.LogRecord, 0, size)
+	for range size {
+		logRecords = append(logRecords, e.deque.PopFront())
+	}
+	e.dequeMutex.Unlock()
+	if len(logRecords) == 0 {
+		return 0
+	}
+
+	request := &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: e.resource,
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      e.scope,
+				LogRecords: logRecords,
+			}},
+		}},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+	defer cancel()
+	if err := e.client.exportLogs(ctx, request); err != nil {
+		klog.ErrorS(err, "Error when exporting flow records to OTLP collector", "count", len(logRecords))
+		e.pushLogRecordsToFrontOfQueue(logRecords)
+		return 0
+	}
+	klog.V(4).InfoS("Exported flow records to OTLP collector", "count", len(logRecords))
+	return len(logRecords)
+}
+
+// pushLogRecordsToFrontOfQueue pushes log records to the front of deque without exceeding its
+// capacity. Older records will be dropped first if deque is to be filled.
+func (e *OTLPExporter) pushLogRecordsToFrontOfQueue(logRecords []*logspb.LogRecord) {
+	e.dequeMutex.Lock()
+	defer e.dequeMutex.Unlock()
+	for i := len(logRecords) - 1; i >= 0; i-- {
+		if e.deque.Len() >= e.queueSize {
+			break
+		}
+		e.deque.PushFront(logRecords[i])
+	}
+}
+
+// exportMetrics exports the counters accumulated since the last export as delta sums, with one
+// data point per Namespace pair. If the export fails, the counters are merged back, so that they
+// are included in the next export.
+func (e *OTLPExporter) exportMetrics() {
+	e.countersMutex.Lock()
+	counters, startTime := e.counters, e.countersStartTime
+	endTime := time.Now()
+	e.counters = make(map[namespacePair]*otlpFlowCounters)
+	e.countersStartTime = endTime
+	e.countersMutex.Unlock()
+	if len(counters) == 0 {
+		return
+	}
+
+	request := &colmetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricspb.ResourceMetrics{{
+			Resource: e.resource,
+			ScopeMetrics: []*metricspb.ScopeMetrics{{
+				Scope:   e.scope,
+				Metrics: buildOTLPMetrics(counters, startTime, endTime),
+			}},
+		}},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+	defer cancel()
+	if err := e.client.exportMetrics(ctx, request); err != nil {
+		klog.ErrorS(err, "Error when exporting metrics to OTLP collector")
+		e.countersMutex.Lock()
+		defer e.countersMutex.Unlock()
+		for pair, c := range counters {
+			if current, ok := e.counters[pair]; ok {
+				c.bytes += current.bytes
+				c.packets += current.packets
+			}
+			e.counters[pair] = c
+		}
+		e.countersStartTime = startTime
+		return
+	}
+	klog.V(4).InfoS("Exported metrics to OTLP collector", "namespacePairs", len(counters))
+}
+
+func buildOTLPMetrics(counters map[namespacePair]*otlpFlowCounters, startTime, endTime time.Time) []*metricspb.Metric {
+	pairs := make([]namespacePair, 0, len(counters))
+	for pair := range counters {
+		pairs = append(pairs, pair)
+	}
+	slices.SortFunc(pairs, func(a, b namespacePair) int {
+		if c := strings.Compare(a.source, b.source); c != 0 {
+			return c
+		}
+		return strings.Compare(a.destination, b.destination)
+	})
+	bytesDataPoints := make([]*metricspb.NumberDataPoint, 0, len(pairs))
+	packetsDataPoints := make([]*metricspb.NumberDataPoint, 0, len(pairs))
+	for _, pair := range pairs {
+		attributes := otlpAttributes{}
+		attributes.addString("source.k8s.namespace.name", pair.source)
+		attributes.addString("destination.k8s.namespace.name", pair.destination)
+		bytesDataPoints = append(bytesDataPoints, &metricspb.NumberDataPoint{
+			Attributes:        attributes,
+			StartTimeUnixNano: uint64(startTime.UnixNano()),
+			TimeUnixNano:      uint64(endTime.UnixNano()),
+			Value:             &metricspb.NumberDataPoint_AsInt{AsInt: int64(counters[pair].bytes)},
+		})
+		packetsDataPoints = append(packetsDataPoints, &metricspb.NumberDataPoint{
+			Attributes:        attributes,
+			StartTimeUnixNano: uint64(startTime.UnixNano()),
+			TimeUnixNano:      uint64(endTime.UnixNano()),
+			Value:             &metricspb.NumberDataPoint_AsInt{AsInt: int64(counters[pair].packets)},
+		})
+	}
+	newSum := func(dataPoints []*metricspb.NumberDataPoint) *metricspb.Metric_Sum {
+		return &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+			DataPoints:             dataPoints,
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+			IsMonotonic:            true,
+		}}
+	}
+	return []*metricspb.Metric{
+		{
+			Name:        otlpBytesMetricName,
+			Description: "Number of bytes exchanged by the flows between a source and a destination Namespace, in both directions.",
+			Unit:        "By",
+			Data:        newSum(bytesDataPoints),
+		},
+		{
+			Name:        otlpPacketsMetricName,
+			Description: "Number of packets exchanged by the flows between a source and a destination Namespace, in both directions.",
+			Unit:        "{packet}",
+			Data:        newSum(packetsDataPoints),
+		},
+	}
+}
+
+func (e *OTLPExporter) UpdateOptions(opt *options.Options) {
+	config := opt.Config.OTLP
+	if reflect.DeepEqual(e.config, config) && e.exportInterval == opt.OTLPExportInterval {
+		return
+	}
+	klog.InfoS("Updating OTLP")
+	client, err := buildOTLPClient(config)
+	if err != nil {
+		klog.ErrorS(err, "Error when creating OTLP client, keeping the previous configuration")
+		return
+	}
+	e.stop()
+	if err := e.client.close(); err != nil {
+		klog.ErrorS(err, "Error when closing OTLP client")
+	}
+	e.config = config
+	e.exportInterval = opt.OTLPExportInterval
+	e.client = client
+	klog.InfoS("New OTLP configuration", "endpoint", config.Endpoint, "protocol", config.Protocol, "exportInterval", opt.OTLPExportInterval,
+		"batchSize", config.BatchSize, "metrics", config.Metrics.Enable, "tls", config.TLS.Enable)
+	e.start()
+}
+
+func (e *OTLPExporter) Flush() error {
+	return nil
+}
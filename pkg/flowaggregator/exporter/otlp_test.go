@@ -0,0 +1,353 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
+)
+
+// otlpReceiver is an in-process OTLP receiver, which records the export requests it receives
+// over gRPC or HTTP.
+type otlpReceiver struct {
+	collogspb.UnimplementedLogsServiceServer
+	mutex      sync.Mutex
+	logRecords []*logspb.LogRecord
+	metrics    []*metricspb.Metric
+	// failures is the number of export requests which fail before requests succeed.
+	failures int
+}
+
+func (r *otlpReceiver) Export(ctx context.Context, request *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.failures > 0 {
+		r.failures--
+		return nil, fmt.Errorf("collector unavailable")
+	}
+	for _, resourceLogs := range request.ResourceLogs {
+		for _, scopeLogs := range resourceLogs.ScopeLogs {
+			r.logRecords = append(r.logRecords, scopeLogs.LogRecords...)
+		}
+	}
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+// otlpMetricsReceiver implements the metrics service of otlpReceiver, as its Export method
+// conflicts with the one of the logs service.
+type otlpMetricsReceiver struct {
+	colmetricspb.UnimplementedMetricsServiceServer
+	*otlpReceiver
+}
+
+func (r otlpMetricsReceiver) Export(ctx context.Context, request *colmetricspb.ExportMetricsServiceRequest) (*colmetricspb.ExportMetricsServiceResponse, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, resourceMetrics := range request.ResourceMetrics {
+		for _, scopeMetrics := range resourceMetrics.ScopeMetrics {
+			r.metrics = append(r.metrics, scopeMetrics.Metrics...)
+		}
+	}
+	return &colmetricspb.ExportMetricsServiceResponse{}, nil
+}
+
+func (r *otlpReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil || req.Header.Get("Content-Type") != "application/x-protobuf" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	var response proto.Message
+	switch req.URL.Path {
+	case "/v1/logs":
+		request := &collogspb.ExportLogsServiceRequest{}
+		if err := proto.Unmarshal(body, request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if response, err = r.Export(req.Context(), request); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+	case "/v1/metrics":
+		request := &colmetricspb.ExportMetricsServiceRequest{}
+		if err := proto.Unmarshal(body, request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		response, _ = otlpMetricsReceiver{otlpReceiver: r}.Export(req.Context(), request)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	responseBody, _ := proto.Marshal(response)
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Write(responseBody)
+}
+
+func (r *otlpReceiver) getLogRecords() []*logspb.LogRecord {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.logRecords
+}
+
+func (r *otlpReceiver) getMetrics() []*metricspb.Metric {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.metrics
+}
+
+// startGRPCReceiver starts an OTLP/gRPC receiver and returns its endpoint.
+func startGRPCReceiver(t *testing.T, receiver *otlpReceiver) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	collogspb.RegisterLogsServiceServer(server, receiver)
+	colmetricspb.RegisterMetricsServiceServer(server, otlpMetricsReceiver{otlpReceiver: receiver})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+func newOTLPTestOptions(endpoint string, protocol flowaggregatorconfig.OTLPProtocol, batchSize int32, exportInterval time.Duration) *options.Options {
+	return &options.Options{
+		Config: &flowaggregatorconfig.FlowAggregatorConfig{
+			OTLP: flowaggregatorconfig.OTLPConfig{
+				Enable:    true,
+				Endpoint:  endpoint,
+				Protocol:  protocol,
+				BatchSize: batchSize,
+				Metrics:   flowaggregatorconfig.OTLPMetricsConfig{Enable: true},
+			},
+		},
+		OTLPExportInterval: exportInterval,
+	}
+}
+
+func getOTLPAttribute(attributes []*commonpb.KeyValue, key string) *commonpb.AnyValue {
+	for _, attribute := range attributes {
+		if attribute.Key == key {
+			return attribute.Value
+		}
+	}
+	return nil
+}
+
+func TestGetOTLPLogAttributes(t *testing.T) {
+	attributes := getOTLPLogAttributes(flowaggregatortesting.PrepareTestFlowRecord(true))
+	expectedStringAttributes := map[string]string{
+		"network.type":                             "ipv4",
+		"network.transport":                        "tcp",
+		"source.address":                           "10.10.0.79",
+		"destination.address":                      "10.10.0.80",
+		"antrea.flow.tcp_state":                    "TIME_WAIT",
+		"antrea.flow.type":                         "FLOW_TYPE_INTER_NODE",
+		"antrea.flow.end_reason":                   "FLOW_END_REASON_END_OF_FLOW",
+		"source.k8s.namespace.name":                "antrea-test",
+		"source.k8s.pod.name":                      "perftest-a",
+		"source.k8s.pod.label.app":                 "iperf",
+		"source.k8s.node.name":                     "k8s-node-control-plane",
+		"destination.k8s.namespace.name":           "antrea-test-b",
+		"destination.k8s.pod.name":                 "perftest-b",
+		"destination.k8s.pod.label.antrea-e2e":     "perftest-b",
+		"destination.k8s.service.cluster_ip":       "10.10.1.10",
+		"destination.k8s.service.port_name":        "perftest",
+		"antrea.ingress_network_policy.name":       "test-flow-aggregator-networkpolicy-ingress-allow",
+		"antrea.ingress_network_policy.type":       "NETWORK_POLICY_TYPE_K8S",
+		"antrea.egress_network_policy.namespace":   "antrea-test-ns-e",
+		"antrea.egress_network_policy.rule_action": "NETWORK_POLICY_RULE_ACTION_ALLOW",
+		"antrea.egress.ip":                         "172.18.0.1",
+		"antrea.flow.app_protocol":                 "http",
+	}
+	for key, value := range expectedStringAttributes {
+		assert.Equal(t, value, getOTLPAttribute(attributes, key).GetStringValue(), "Unexpected value for attribute %s", key)
+	}
+	expectedIntAttributes := map[string]int64{
+		"source.port":                            44752,
+		"destination.port":                       5201,
+		"antrea.flow.protocol_number":            6,
+		"destination.k8s.service.port":           5202,
+		"antrea.flow.octet_delta_count":          8982624938,
+		"antrea.flow.reverse_packet_delta_count": 136211,
+	}
+	for key, value := range expectedIntAttributes {
+		assert.Equal(t, value, getOTLPAttribute(attributes, key).GetIntValue(), "Unexpected value for attribute %s", key)
+	}
+	// Empty fields are omitted.
+	assert.Nil(t, getOTLPAttribute(attributes, "source.k8s.pod.uid"))
+	assert.Nil(t, getOTLPAttribute(attributes, "antrea.flow.id"))
+}
+
+func TestOTLP_Export(t *testing.T) {
+	for _, protocol := range []flowaggregatorconfig.OTLPProtocol{flowaggregatorconfig.OTLPProtocolGRPC, flowaggregatorconfig.OTLPProtocolHTTP} {
+		t.Run(string(protocol), func(t *testing.T) {
+			receiver := &otlpReceiver{}
+			var endpoint string
+			if protocol == flowaggregatorconfig.OTLPProtocolGRPC {
+				endpoint = startGRPCReceiver(t, receiver)
+			} else {
+				server := httptest.NewServer(receiver)
+				t.Cleanup(server.Close)
+				endpoint = server.Listener.Addr().String()
+			}
+			otlpExporter, err := NewOTLPExporter("cluster-a", newOTLPTestOptions(endpoint, protocol, 2, time.Hour))
+			require.NoError(t, err)
+			otlpExporter.Start()
+
+			records := []*flowpb.Flow{
+				flowaggregatortesting.PrepareTestFlowRecord(true),
+				flowaggregatortesting.PrepareTestFlowRecord(false),
+				flowaggregatortesting.PrepareTestFlowRecord(true),
+			}
+			records[2].K8S.DestinationPodNamespace = ""
+			for _, record := range records {
+				require.NoError(t, otlpExporter.AddRecord(record, false))
+			}
+			// The first 2 records form a full batch, which is exported right away.
+			assert.EventuallyWithT(t, func(c *assert.CollectT) {
+				assert.Len(c, receiver.getLogRecords(), 2)
+			}, 2*time.Second, 10*time.Millisecond)
+			assert.Empty(t, receiver.getMetrics())
+			// The last record and the metrics are exported when the exporter is stopped.
+			otlpExporter.Stop()
+			logRecords := receiver.getLogRecords()
+			require.Len(t, logRecords, 3)
+			for i, logRecord := range logRecords {
+				assert.Equal(t, uint64(1637706973*time.Second), logRecord.TimeUnixNano)
+				assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_INFO, logRecord.SeverityNumber)
+				record := &flowpb.Flow{}
+				require.NoError(t, protojson.Unmarshal([]byte(logRecord.Body.GetStringValue()), record))
+				assert.Empty(t, cmp.Diff(records[i], record, protocmp.Transform()))
+				assert.Empty(t, cmp.Diff(getOTLPLogAttributes(records[i]), logRecord.Attributes, protocmp.Transform()))
+			}
+
+			metrics := receiver.getMetrics()
+			require.Len(t, metrics, 2)
+			assert.Equal(t, "antrea.flow.bytes", metrics[0].Name)
+			assert.Equal(t, "antrea.flow.packets", metrics[1].Name)
+			bytesDataPoints := metrics[0].GetSum().DataPoints
+			require.Len(t, bytesDataPoints, 2)
+			// Data points are sorted by Namespace pair, and empty Namespaces are omitted.
+			assert.Empty(t, cmp.Diff([]*commonpb.KeyValue{
+				otlpStringAttribute("source.k8s.namespace.name", "antrea-test"),
+			}, bytesDataPoints[0].Attributes, protocmp.Transform()))
+			assert.Equal(t, int64(8982624938+7083284), bytesDataPoints[0].GetAsInt())
+			assert.Empty(t, cmp.Diff([]*commonpb.KeyValue{
+				otlpStringAttribute("source.k8s.namespace.name", "antrea-test"),
+				otlpStringAttribute("destination.k8s.namespace.name", "antrea-test-b"),
+			}, bytesDataPoints[1].Attributes, protocmp.Transform()))
+			assert.Equal(t, int64(2*(8982624938+7083284)), bytesDataPoints[1].GetAsInt())
+			packetsDataPoints := metrics[1].GetSum().DataPoints
+			require.Len(t, packetsDataPoints, 2)
+			assert.Equal(t, int64(2*(241333+136211)), packetsDataPoints[1].GetAsInt())
+			assert.Equal(t, metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA, metrics[1].GetSum().AggregationTemporality)
+		})
+	}
+}
+
+func TestOTLP_ExportFailure(t *testing.T) {
+	receiver := &otlpReceiver{failures: 1}
+	endpoint := startGRPCReceiver(t, receiver)
+	otlpExporter, err := NewOTLPExporter("cluster-a", newOTLPTestOptions(endpoint, flowaggregatorconfig.OTLPProtocolGRPC, 2, 50*time.Millisecond))
+	require.NoError(t, err)
+	otlpExporter.Start()
+	defer otlpExporter.Stop()
+
+	require.NoError(t, otlpExporter.AddRecord(flowaggregatortesting.PrepareTestFlowRecord(true), false))
+	require.NoError(t, otlpExporter.AddRecord(flowaggregatortesting.PrepareTestFlowRecord(false), false))
+	// The records are kept in the queue after the first failure, and exported on the next tick.
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Len(c, receiver.getLogRecords(), 2)
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestOTLP_HTTPS(t *testing.T) {
+	receiver := &otlpReceiver{}
+	server := httptest.NewTLSServer(receiver)
+	t.Cleanup(server.Close)
+	defaultFS = afero.NewMemMapFs()
+	t.Cleanup(func() { defaultFS = afero.NewOsFs() })
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, afero.WriteFile(defaultFS, filepath.Join(otlpCertDir, "ca.crt"), caPEM, 0644))
+
+	opt := newOTLPTestOptions(server.Listener.Addr().String(), flowaggregatorconfig.OTLPProtocolHTTP, 1, time.Hour)
+	opt.Config.OTLP.TLS = flowaggregatorconfig.OTLPTLSConfig{
+		Enable:       true,
+		CASecretName: "otlp-ca",
+	}
+	otlpExporter, err := NewOTLPExporter("cluster-a", opt)
+	require.NoError(t, err)
+	otlpExporter.Start()
+	require.NoError(t, otlpExporter.AddRecord(flowaggregatortesting.PrepareTestFlowRecord(true), false))
+	otlpExporter.Stop()
+	assert.Len(t, receiver.getLogRecords(), 1)
+	assert.Len(t, receiver.getMetrics(), 2)
+
+	opt.Config.OTLP.TLS.CASecretName = "missing-ca"
+	defaultFS = afero.NewMemMapFs()
+	_, err = NewOTLPExporter("cluster-a", opt)
+	assert.ErrorContains(t, err, "ensure Secret \"missing-ca\" exists")
+}
+
+func TestOTLP_UpdateOptions(t *testing.T) {
+	receiver1, receiver2 := &otlpReceiver{}, &otlpReceiver{}
+	endpoint1, endpoint2 := startGRPCReceiver(t, receiver1), startGRPCReceiver(t, receiver2)
+	opt := newOTLPTestOptions(endpoint1, flowaggregatorconfig.OTLPProtocolGRPC, 10, time.Hour)
+	otlpExporter, err := NewOTLPExporter("cluster-a", opt)
+	require.NoError(t, err)
+	otlpExporter.Start()
+	defer otlpExporter.Stop()
+
+	require.NoError(t, otlpExporter.AddRecord(flowaggregatortesting.PrepareTestFlowRecord(true), false))
+	// Nothing changes with the same options.
+	otlpExporter.UpdateOptions(newOTLPTestOptions(endpoint1, flowaggregatorconfig.OTLPProtocolGRPC, 10, time.Hour))
+	assert.Empty(t, receiver1.getLogRecords())
+
+	// Buffered records are exported to the previous endpoint when the endpoint is updated.
+	otlpExporter.UpdateOptions(newOTLPTestOptions(endpoint2, flowaggregatorconfig.OTLPProtocolGRPC, 1, time.Hour))
+	assert.Len(t, receiver1.getLogRecords(), 1)
+	require.NoError(t, otlpExporter.AddRecord(flowaggregatortesting.PrepareTestFlowRecord(true), false))
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Len(c, receiver2.getLogRecords(), 1)
+	}, 2*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,322 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	utilip "antrea.io/antrea/pkg/util/ip"
+)
+
+var protocolNumbers = map[string]uint32{
+	"TCP":    utilip.TCPProtocol,
+	"UDP":    utilip.UDPProtocol,
+	"SCTP":   utilip.SCTPProtocol,
+	"ICMP":   utilip.ICMPProtocol,
+	"ICMPV6": utilip.ICMPv6Protocol,
+}
+
+var flowTypes = map[flowaggregatorconfig.FlowType]flowpb.FlowType{
+	flowaggregatorconfig.FlowTypeIntraNode:    flowpb.FlowType_FLOW_TYPE_INTRA_NODE,
+	flowaggregatorconfig.FlowTypeInterNode:    flowpb.FlowType_FLOW_TYPE_INTER_NODE,
+	flowaggregatorconfig.FlowTypeToExternal:   flowpb.FlowType_FLOW_TYPE_TO_EXTERNAL,
+	flowaggregatorconfig.FlowTypeFromExternal: flowpb.FlowType_FLOW_TYPE_FROM_EXTERNAL,
+}
+
+var ruleActions = map[flowaggregatorconfig.NetworkPolicyRuleAction]flowpb.NetworkPolicyRuleAction{
+	flowaggregatorconfig.NetworkPolicyRuleActionNone:   flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_NO_ACTION,
+	flowaggregatorconfig.NetworkPolicyRuleActionAllow:  flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_ALLOW,
+	flowaggregatorconfig.NetworkPolicyRuleActionDrop:   flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_DROP,
+	flowaggregatorconfig.NetworkPolicyRuleActionReject: flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_REJECT,
+	flowaggregatorconfig.NetworkPolicyRuleActionAudit:  flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_AUDIT,
+}
+
+// flowFilter is the validated version of flowaggregatorconfig.FlowFilter. Empty fields match
+// all flows.
+type flowFilter struct {
+	sourcePodNamespaces             []string
+	destinationPodNamespaces        []string
+	sourcePodSelector               labels.Selector
+	destinationPodSelector          labels.Selector
+	protocols                       []uint32
+	sourcePorts                     []uint32
+	destinationPorts                []uint32
+	sourceCIDRs                     []netip.Prefix
+	destinationCIDRs                []netip.Prefix
+	flowTypes                       []flowpb.FlowType
+	ingressNetworkPolicyRuleActions []flowpb.NetworkPolicyRuleAction
+	egressNetworkPolicyRuleActions  []flowpb.NetworkPolicyRuleAction
+}
+
+// Selector determines which flow records should be sent to a given exporter, by applying
+// filters and sampling. A nil Selector selects all flow records.
+type Selector struct {
+	include      []flowFilter
+	exclude      []flowFilter
+	samplingRate uint64
+	samplingMode flowaggregatorconfig.FlowSamplingMode
+	// count is used to implement Count sampling.
+	count atomic.Uint64
+}
+
+// NewSelector validates the provided configuration and builds the corresponding Selector. If
+// the configuration does not filter nor sample any flow record, it returns nil.
+func NewSelector(config *flowaggregatorconfig.FlowRecordSelectionConfig) (*Selector, error) {
+	include, err := buildFilters(config.Filters)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	exclude, err := buildFilters(config.ExcludeFilters)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exclude filter: %w", err)
+	}
+	if config.Sampling.Rate < 0 {
+		return nil, fmt.Errorf("sampling rate cannot be negative")
+	}
+	samplingMode := config.Sampling.Mode
+	switch samplingMode {
+	case "":
+		samplingMode = flowaggregatorconfig.DefaultFlowSamplingMode
+	case flowaggregatorconfig.FlowSamplingModeHash, flowaggregatorconfig.FlowSamplingModeCount:
+	default:
+		return nil, fmt.Errorf("unsupported sampling mode %s", samplingMode)
+	}
+	samplingRate := uint64(config.Sampling.Rate)
+	if len(include) == 0 && len(exclude) == 0 && samplingRate <= 1 {
+		return nil, nil
+	}
+	return &Selector{
+		include:      include,
+		exclude:      exclude,
+		samplingRate: samplingRate,
+		samplingMode: samplingMode,
+	}, nil
+}
+
+func buildFilters(in []flowaggregatorconfig.FlowFilter) ([]flowFilter, error) {
+	filters := make([]flowFilter, 0, len(in))
+	for idx := range in {
+		filter, err := buildFilter(&in[idx])
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+func buildFilter(in *flowaggregatorconfig.FlowFilter) (flowFilter, error) {
+	out := flowFilter{
+		sourcePodNamespaces:      in.SourcePodNamespaces,
+		destinationPodNamespaces: in.DestinationPodNamespaces,
+	}
+	var err error
+	if in.SourcePodSelector != "" {
+		if out.sourcePodSelector, err = labels.Parse(in.SourcePodSelector); err != nil {
+			return out, fmt.Errorf("invalid sourcePodSelector %q: %w", in.SourcePodSelector, err)
+		}
+	}
+	if in.DestinationPodSelector != "" {
+		if out.destinationPodSelector, err = labels.Parse(in.DestinationPodSelector); err != nil {
+			return out, fmt.Errorf("invalid destinationPodSelector %q: %w", in.DestinationPodSelector, err)
+		}
+	}
+	for _, p := range in.Protocols {
+		protocol, ok := protocolNumbers[strings.ToUpper(p)]
+		if !ok {
+			return out, fmt.Errorf("unsupported protocol %s", p)
+		}
+		out.protocols = append(out.protocols, protocol)
+	}
+	parsePorts := func(ports []int32) ([]uint32, error) {
+		var out []uint32
+		for _, port := range ports {
+			if port < 0 || port > 65535 {
+				return nil, fmt.Errorf("invalid port %d", port)
+			}
+			out = append(out, uint32(port))
+		}
+		return out, nil
+	}
+	if out.sourcePorts, err = parsePorts(in.SourcePorts); err != nil {
+		return out, err
+	}
+	if out.destinationPorts, err = parsePorts(in.DestinationPorts); err != nil {
+		return out, err
+	}
+	parseCIDRs := func(cidrs []string) ([]netip.Prefix, error) {
+		var out []netip.Prefix
+		for _, cidr := range cidrs {
+			prefix, err := netip.ParsePrefix(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR %s: %w", cidr, err)
+			}
+			out = append(out, prefix.Masked())
+		}
+		return out, nil
+	}
+	if out.sourceCIDRs, err = parseCIDRs(in.SourceCIDRs); err != nil {
+		return out, err
+	}
+	if out.destinationCIDRs, err = parseCIDRs(in.DestinationCIDRs); err != nil {
+		return out, err
+	}
+	for _, t := range in.FlowTypes {
+		flowType, ok := flowTypes[t]
+		if !ok {
+			return out, fmt.Errorf("unsupported flow type %s", t)
+		}
+		out.flowTypes = append(out.flowTypes, flowType)
+	}
+	parseRuleActions := func(actions []flowaggregatorconfig.NetworkPolicyRuleAction) ([]flowpb.NetworkPolicyRuleAction, error) {
+		var out []flowpb.NetworkPolicyRuleAction
+		for _, a := range actions {
+			action, ok := ruleActions[a]
+			if !ok {
+				return nil, fmt.Errorf("unsupported NetworkPolicy rule action %s", a)
+			}
+			out = append(out, action)
+		}
+		return out, nil
+	}
+	if out.ingressNetworkPolicyRuleActions, err = parseRuleActions(in.IngressNetworkPolicyRuleActions); err != nil {
+		return out, err
+	}
+	if out.egressNetworkPolicyRuleActions, err = parseRuleActions(in.EgressNetworkPolicyRuleActions); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+func matchCIDRs(cidrs []netip.Prefix, ip []byte) bool {
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return false
+	}
+	addr = addr.Unmap()
+	return slices.ContainsFunc(cidrs, func(prefix netip.Prefix) bool {
+		return prefix.Contains(addr)
+	})
+}
+
+func matchPodSelector(selector labels.Selector, podName string, podLabels *flowpb.Labels) bool {
+	// A selector never matches a flow endpoint which is not a Pod, even if the selector is
+	// empty or only includes negative requirements.
+	if podName == "" {
+		return false
+	}
+	return selector.Matches(labels.Set(podLabels.GetLabels()))
+}
+
+func (f *flowFilter) match(record *flowpb.Flow) bool {
+	k8s := record.K8S
+	if len(f.sourcePodNamespaces) > 0 && !slices.Contains(f.sourcePodNamespaces, k8s.GetSourcePodNamespace()) {
+		return false
+	}
+	if len(f.destinationPodNamespaces) > 0 && !slices.Contains(f.destinationPodNamespaces, k8s.GetDestinationPodNamespace()) {
+		return false
+	}
+	if f.sourcePodSelector != nil && !matchPodSelector(f.sourcePodSelector, k8s.GetSourcePodName(), k8s.GetSourcePodLabels()) {
+		return false
+	}
+	if f.destinationPodSelector != nil && !matchPodSelector(f.destinationPodSelector, k8s.GetDestinationPodName(), k8s.GetDestinationPodLabels()) {
+		return false
+	}
+	if len(f.protocols) > 0 && !slices.Contains(f.protocols, record.Transport.GetProtocolNumber()) {
+		return false
+	}
+	if len(f.sourcePorts) > 0 && !slices.Contains(f.sourcePorts, record.Transport.GetSourcePort()) {
+		return false
+	}
+	if len(f.destinationPorts) > 0 && !slices.Contains(f.destinationPorts, record.Transport.GetDestinationPort()) {
+		return false
+	}
+	if len(f.sourceCIDRs) > 0 && !matchCIDRs(f.sourceCIDRs, record.Ip.GetSource()) {
+		return false
+	}
+	if len(f.destinationCIDRs) > 0 && !matchCIDRs(f.destinationCIDRs, record.Ip.GetDestination()) {
+		return false
+	}
+	if len(f.flowTypes) > 0 && !slices.Contains(f.flowTypes, k8s.GetFlowType()) {
+		return false
+	}
+	if len(f.ingressNetworkPolicyRuleActions) > 0 && !slices.Contains(f.ingressNetworkPolicyRuleActions, k8s.GetIngressNetworkPolicyRuleAction()) {
+		return false
+	}
+	if len(f.egressNetworkPolicyRuleActions) > 0 && !slices.Contains(f.egressNetworkPolicyRuleActions, k8s.GetEgressNetworkPolicyRuleAction()) {
+		return false
+	}
+	return true
+}
+
+// Select returns true if the flow record should be exported.
+func (s *Selector) Select(record *flowpb.Flow) bool {
+	if s == nil {
+		return true
+	}
+	if len(s.include) > 0 && !slices.ContainsFunc(s.include, func(f flowFilter) bool { return f.match(record) }) {
+		return false
+	}
+	if slices.ContainsFunc(s.exclude, func(f flowFilter) bool { return f.match(record) }) {
+		return false
+	}
+	if s.samplingRate <= 1 {
+		return true
+	}
+	if s.samplingMode == flowaggregatorconfig.FlowSamplingModeCount {
+		return (s.count.Add(1)-1)%s.samplingRate == 0
+	}
+	return hashConnection(record)%s.samplingRate == 0
+}
+
+// hashConnection computes a hash of the flow's 5-tuple. The endpoints are sorted, so that
+// records for both directions of a connection have the same hash.
+func hashConnection(record *flowpb.Flow) uint64 {
+	srcIP, dstIP := record.Ip.GetSource(), record.Ip.GetDestination()
+	srcPort, dstPort := record.Transport.GetSourcePort(), record.Transport.GetDestinationPort()
+	if c := bytes.Compare(srcIP, dstIP); c > 0 || (c == 0 && srcPort > dstPort) {
+		srcIP, dstIP = dstIP, srcIP
+		srcPort, dstPort = dstPort, srcPort
+	}
+	h := fnv.New64a()
+	h.Write(srcIP)
+	h.Write(dstIP)
+	var b [5]byte
+	binary.BigEndian.PutUint16(b[0:2], uint16(srcPort))
+	binary.BigEndian.PutUint16(b[2:4], uint16(dstPort))
+	b[4] = uint8(record.Transport.GetProtocolNumber())
+	h.Write(b[:])
+	// FNV-1a does not distribute low-order bits well for inputs which only differ slightly
+	// (e.g., consecutive ports), so we use the MurmurHash3 finalizer to mix the bits before
+	// the hash is used with a modulo.
+	x := h.Sum64()
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
@@ -0,0 +1,459 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+)
+
+type testRecord struct {
+	name string
+	*flowpb.Flow
+}
+
+func newTestRecord(name string, srcIP, dstIP string, srcPort, dstPort uint32, mutate func(*flowpb.Flow)) *testRecord {
+	record := &flowpb.Flow{
+		Ip: &flowpb.IP{
+			Version:     flowpb.IPVersion_IP_VERSION_4,
+			Source:      net.ParseIP(srcIP).To4(),
+			Destination: net.ParseIP(dstIP).To4(),
+		},
+		Transport: &flowpb.Transport{
+			ProtocolNumber:  6,
+			SourcePort:      srcPort,
+			DestinationPort: dstPort,
+		},
+		K8S: &flowpb.Kubernetes{},
+	}
+	if mutate != nil {
+		mutate(record)
+	}
+	return &testRecord{name: name, Flow: record}
+}
+
+func TestSelector_NetworkPolicyRuleActions(t *testing.T) {
+	unprotectedRec := newTestRecord("unprotected", "10.0.0.1", "10.0.0.2", 40000, 80, nil)
+	droppedByEgressRec := newTestRecord("dropped-by-egress", "10.0.0.1", "10.0.0.2", 40000, 80, func(r *flowpb.Flow) {
+		r.K8S.EgressNetworkPolicyRuleAction = flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_DROP
+	})
+	rejectedByIngressRec := newTestRecord("rejected-by-ingress", "10.0.0.1", "10.0.0.2", 40000, 80, func(r *flowpb.Flow) {
+		r.K8S.IngressNetworkPolicyRuleAction = flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_REJECT
+	})
+	allowedByBothRec := newTestRecord("allowed-by-both-sides", "10.0.0.1", "10.0.0.2", 40000, 80, func(r *flowpb.Flow) {
+		r.K8S.IngressNetworkPolicyRuleAction = flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_ALLOW
+		r.K8S.EgressNetworkPolicyRuleAction = flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_ALLOW
+	})
+	testCases := []struct {
+		name        string
+		filters     []flowaggregatorconfig.FlowFilter
+		testRecords map[*testRecord]bool
+	}{
+		{
+			name:    "no filter",
+			filters: []flowaggregatorconfig.FlowFilter{},
+			testRecords: map[*testRecord]bool{
+				unprotectedRec:       true,
+				droppedByEgressRec:   true,
+				rejectedByIngressRec: true,
+				allowedByBothRec:     true,
+			},
+		},
+		{
+			name: "ingress and egress unprotected",
+			filters: []flowaggregatorconfig.FlowFilter{
+				{
+					IngressNetworkPolicyRuleActions: []flowaggregatorconfig.NetworkPolicyRuleAction{flowaggregatorconfig.NetworkPolicyRuleActionNone},
+					EgressNetworkPolicyRuleActions:  []flowaggregatorconfig.NetworkPolicyRuleAction{flowaggregatorconfig.NetworkPolicyRuleActionNone},
+				},
+			},
+			testRecords: map[*testRecord]bool{
+				unprotectedRec:       true,
+				droppedByEgressRec:   false,
+				rejectedByIngressRec: false,
+				allowedByBothRec:     false,
+			},
+		},
+		{
+			name: "denied only",
+			filters: []flowaggregatorconfig.FlowFilter{
+				{
+					IngressNetworkPolicyRuleActions: []flowaggregatorconfig.NetworkPolicyRuleAction{flowaggregatorconfig.NetworkPolicyRuleActionDrop, flowaggregatorconfig.NetworkPolicyRuleActionReject},
+				},
+				{
+					EgressNetworkPolicyRuleActions: []flowaggregatorconfig.NetworkPolicyRuleAction{flowaggregatorconfig.NetworkPolicyRuleActionDrop, flowaggregatorconfig.NetworkPolicyRuleActionReject},
+				},
+			},
+			testRecords: map[*testRecord]bool{
+				unprotectedRec:       false,
+				droppedByEgressRec:   true,
+				rejectedByIngressRec: true,
+				allowedByBothRec:     false,
+			},
+		},
+		{
+			name: "ingress and / or egress unprotected",
+			filters: []flowaggregatorconfig.FlowFilter{
+				{
+					IngressNetworkPolicyRuleActions: []flowaggregatorconfig.NetworkPolicyRuleAction{flowaggregatorconfig.NetworkPolicyRuleActionNone},
+				},
+				{
+					EgressNetworkPolicyRuleActions: []flowaggregatorconfig.NetworkPolicyRuleAction{flowaggregatorconfig.NetworkPolicyRuleActionNone},
+				},
+			},
+			testRecords: map[*testRecord]bool{
+				unprotectedRec:       true,
+				droppedByEgressRec:   true,
+				rejectedByIngressRec: true,
+				allowedByBothRec:     false,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selector, err := NewSelector(&flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: tc.filters,
+			})
+			require.NoError(t, err)
+			for record, expected := range tc.testRecords {
+				assert.Equal(t, expected, selector.Select(record.Flow), "unexpected result for record %s", record.name)
+			}
+		})
+	}
+}
+
+func TestSelector_Filters(t *testing.T) {
+	healthCheckRec := newTestRecord("health-check", "192.168.0.1", "10.0.1.2", 40000, 8080, func(r *flowpb.Flow) {
+		r.K8S.FlowType = flowpb.FlowType_FLOW_TYPE_FROM_EXTERNAL
+		r.K8S.DestinationPodName = "web"
+		r.K8S.DestinationPodNamespace = "prod"
+		r.K8S.DestinationPodLabels = &flowpb.Labels{Labels: map[string]string{"app": "web"}}
+	})
+	podToPodRec := newTestRecord("pod-to-pod", "10.0.0.2", "10.0.1.2", 40000, 80, func(r *flowpb.Flow) {
+		r.K8S.FlowType = flowpb.FlowType_FLOW_TYPE_INTER_NODE
+		r.K8S.SourcePodName = "client"
+		r.K8S.SourcePodNamespace = "dev"
+		r.K8S.SourcePodLabels = &flowpb.Labels{Labels: map[string]string{"app": "client"}}
+		r.K8S.DestinationPodName = "web"
+		r.K8S.DestinationPodNamespace = "prod"
+		r.K8S.DestinationPodLabels = &flowpb.Labels{Labels: map[string]string{"app": "web"}}
+	})
+	dnsRec := newTestRecord("dns", "10.0.0.2", "10.96.0.10", 40000, 53, func(r *flowpb.Flow) {
+		r.Transport.ProtocolNumber = 17
+		r.K8S.FlowType = flowpb.FlowType_FLOW_TYPE_INTRA_NODE
+		r.K8S.SourcePodName = "client"
+		r.K8S.SourcePodNamespace = "dev"
+		r.K8S.SourcePodLabels = &flowpb.Labels{Labels: map[string]string{"app": "client"}}
+		r.K8S.DestinationPodName = "coredns"
+		r.K8S.DestinationPodNamespace = "kube-system"
+	})
+	externalRec := newTestRecord("external", "10.0.0.2", "8.8.8.8", 40000, 443, func(r *flowpb.Flow) {
+		r.K8S.FlowType = flowpb.FlowType_FLOW_TYPE_TO_EXTERNAL
+		r.K8S.SourcePodName = "client"
+		r.K8S.SourcePodNamespace = "dev"
+		r.K8S.SourcePodLabels = &flowpb.Labels{Labels: map[string]string{"app": "client"}}
+	})
+	allRecords := []*testRecord{healthCheckRec, podToPodRec, dnsRec, externalRec}
+
+	testCases := []struct {
+		name            string
+		config          flowaggregatorconfig.FlowRecordSelectionConfig
+		expectedRecords []*testRecord
+	}{
+		{
+			name:            "no filter",
+			expectedRecords: allRecords,
+		},
+		{
+			name: "source namespace",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{SourcePodNamespaces: []string{"dev"}}},
+			},
+			expectedRecords: []*testRecord{podToPodRec, dnsRec, externalRec},
+		},
+		{
+			name: "destination namespace",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{DestinationPodNamespaces: []string{"prod", "kube-system"}}},
+			},
+			expectedRecords: []*testRecord{healthCheckRec, podToPodRec, dnsRec},
+		},
+		{
+			name: "destination Pod selector",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{DestinationPodSelector: "app=web"}},
+			},
+			expectedRecords: []*testRecord{healthCheckRec, podToPodRec},
+		},
+		{
+			name: "negative source Pod selector does not match non-Pod source",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{SourcePodSelector: "app!=web"}},
+			},
+			expectedRecords: []*testRecord{podToPodRec, dnsRec, externalRec},
+		},
+		{
+			name: "protocol",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{Protocols: []string{"udp"}}},
+			},
+			expectedRecords: []*testRecord{dnsRec},
+		},
+		{
+			name: "destination ports",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{DestinationPorts: []int32{80, 443}}},
+			},
+			expectedRecords: []*testRecord{podToPodRec, externalRec},
+		},
+		{
+			name: "source port",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{SourcePorts: []int32{53}}},
+			},
+			expectedRecords: []*testRecord{},
+		},
+		{
+			name: "CIDRs",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{
+					SourceCIDRs:      []string{"10.0.0.0/24"},
+					DestinationCIDRs: []string{"10.0.0.0/16", "10.96.0.0/12"},
+				}},
+			},
+			expectedRecords: []*testRecord{podToPodRec, dnsRec},
+		},
+		{
+			name: "flow types",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{
+					FlowTypes: []flowaggregatorconfig.FlowType{flowaggregatorconfig.FlowTypeToExternal, flowaggregatorconfig.FlowTypeFromExternal},
+				}},
+			},
+			expectedRecords: []*testRecord{healthCheckRec, externalRec},
+		},
+		{
+			name: "exclude health checks",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				ExcludeFilters: []flowaggregatorconfig.FlowFilter{{
+					SourceCIDRs:      []string{"192.168.0.0/24"},
+					DestinationPorts: []int32{8080},
+				}},
+			},
+			expectedRecords: []*testRecord{podToPodRec, dnsRec, externalRec},
+		},
+		{
+			name: "include and exclude",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{SourcePodNamespaces: []string{"dev"}}},
+				ExcludeFilters: []flowaggregatorconfig.FlowFilter{
+					{DestinationPodNamespaces: []string{"kube-system"}},
+					{FlowTypes: []flowaggregatorconfig.FlowType{flowaggregatorconfig.FlowTypeToExternal}},
+				},
+			},
+			expectedRecords: []*testRecord{podToPodRec},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selector, err := NewSelector(&tc.config)
+			require.NoError(t, err)
+			var selectedRecords []*testRecord
+			for _, record := range allRecords {
+				if selector.Select(record.Flow) {
+					selectedRecords = append(selectedRecords, record)
+				}
+			}
+			assert.ElementsMatch(t, tc.expectedRecords, selectedRecords)
+		})
+	}
+}
+
+func TestSelector_Sampling(t *testing.T) {
+	const numConnections = 1000
+	const rate = 10
+	records := make([]*flowpb.Flow, 0, numConnections)
+	reverseRecords := make([]*flowpb.Flow, 0, numConnections)
+	for i := range numConnections {
+		srcIP := fmt.Sprintf("10.0.%d.%d", i/250, i%250+1)
+		srcPort := uint32(30000 + i)
+		records = append(records, newTestRecord("", srcIP, "10.0.10.1", srcPort, 80, nil).Flow)
+		reverseRecords = append(reverseRecords, newTestRecord("", "10.0.10.1", srcIP, 80, srcPort, nil).Flow)
+	}
+
+	t.Run("hash", func(t *testing.T) {
+		selector, err := NewSelector(&flowaggregatorconfig.FlowRecordSelectionConfig{
+			Sampling: flowaggregatorconfig.FlowSamplingConfig{
+				Rate: rate,
+				Mode: flowaggregatorconfig.FlowSamplingModeHash,
+			},
+		})
+		require.NoError(t, err)
+		numSelected := 0
+		for i := range records {
+			selected := selector.Select(records[i])
+			// Sampling decisions are consistent across records for the same connection,
+			// regardless of direction.
+			assert.Equal(t, selected, selector.Select(records[i]))
+			assert.Equal(t, selected, selector.Select(reverseRecords[i]))
+			if selected {
+				numSelected++
+			}
+		}
+		assert.InDelta(t, numConnections/rate, numSelected, numConnections/rate/2)
+	})
+
+	t.Run("count", func(t *testing.T) {
+		selector, err := NewSelector(&flowaggregatorconfig.FlowRecordSelectionConfig{
+			Sampling: flowaggregatorconfig.FlowSamplingConfig{
+				Rate: rate,
+				Mode: flowaggregatorconfig.FlowSamplingModeCount,
+			},
+		})
+		require.NoError(t, err)
+		for i := range records {
+			assert.Equal(t, i%rate == 0, selector.Select(records[i]))
+		}
+	})
+
+	t.Run("after filters", func(t *testing.T) {
+		selector, err := NewSelector(&flowaggregatorconfig.FlowRecordSelectionConfig{
+			Filters: []flowaggregatorconfig.FlowFilter{{DestinationPorts: []int32{80}}},
+			Sampling: flowaggregatorconfig.FlowSamplingConfig{
+				Rate: 2,
+				Mode: flowaggregatorconfig.FlowSamplingModeCount,
+			},
+		})
+		require.NoError(t, err)
+		// Records which are filtered out do not count towards sampling.
+		assert.True(t, selector.Select(records[0]))
+		assert.False(t, selector.Select(reverseRecords[0]))
+		assert.False(t, selector.Select(records[1]))
+		assert.True(t, selector.Select(records[2]))
+	})
+}
+
+func TestNewSelector(t *testing.T) {
+	testCases := []struct {
+		name          string
+		config        flowaggregatorconfig.FlowRecordSelectionConfig
+		expectNil     bool
+		expectedError string
+	}{
+		{
+			name:      "empty",
+			expectNil: true,
+		},
+		{
+			name: "sampling rate of 1",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Sampling: flowaggregatorconfig.FlowSamplingConfig{Rate: 1},
+			},
+			expectNil: true,
+		},
+		{
+			name: "valid",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{
+					DestinationPodSelector: "app in (web, api),!canary",
+					Protocols:              []string{"TCP", "ICMPv6"},
+					SourceCIDRs:            []string{"fd00::/64"},
+				}},
+				Sampling: flowaggregatorconfig.FlowSamplingConfig{Rate: 100},
+			},
+		},
+		{
+			name: "invalid Pod selector",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{SourcePodSelector: "app in web"}},
+			},
+			expectedError: "invalid filter: invalid sourcePodSelector",
+		},
+		{
+			name: "invalid protocol",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{Protocols: []string{"GRE"}}},
+			},
+			expectedError: "invalid filter: unsupported protocol GRE",
+		},
+		{
+			name: "invalid port",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				ExcludeFilters: []flowaggregatorconfig.FlowFilter{{DestinationPorts: []int32{65536}}},
+			},
+			expectedError: "invalid exclude filter: invalid port 65536",
+		},
+		{
+			name: "invalid CIDR",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{DestinationCIDRs: []string{"10.0.0.0"}}},
+			},
+			expectedError: "invalid filter: invalid CIDR 10.0.0.0",
+		},
+		{
+			name: "invalid flow type",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{FlowTypes: []flowaggregatorconfig.FlowType{"Foo"}}},
+			},
+			expectedError: "invalid filter: unsupported flow type Foo",
+		},
+		{
+			name: "invalid rule action",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Filters: []flowaggregatorconfig.FlowFilter{{IngressNetworkPolicyRuleActions: []flowaggregatorconfig.NetworkPolicyRuleAction{"Pass"}}},
+			},
+			expectedError: "invalid filter: unsupported NetworkPolicy rule action Pass",
+		},
+		{
+			name: "negative sampling rate",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Sampling: flowaggregatorconfig.FlowSamplingConfig{Rate: -1},
+			},
+			expectedError: "sampling rate cannot be negative",
+		},
+		{
+			name: "invalid sampling mode",
+			config: flowaggregatorconfig.FlowRecordSelectionConfig{
+				Sampling: flowaggregatorconfig.FlowSamplingConfig{Rate: 10, Mode: "Random"},
+			},
+			expectedError: "unsupported sampling mode Random",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selector, err := NewSelector(&tc.config)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			if tc.expectNil {
+				assert.Nil(t, selector)
+			} else {
+				assert.NotNil(t, selector)
+			}
+		})
+	}
+}
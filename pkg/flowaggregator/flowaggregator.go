@@ -29,6 +29,7 @@ import (
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
@@ -37,10 +38,13 @@ import (
 	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
 	"antrea.io/antrea/pkg/flowaggregator/collector"
 	"antrea.io/antrea/pkg/flowaggregator/exporter"
+	"antrea.io/antrea/pkg/flowaggregator/filter"
 	"antrea.io/antrea/pkg/flowaggregator/intermediate"
 	"antrea.io/antrea/pkg/flowaggregator/options"
 	"antrea.io/antrea/pkg/flowaggregator/querier"
+	"antrea.io/antrea/pkg/flowaggregator/recommendation"
 	"antrea.io/antrea/pkg/ipfix"
+	"antrea.io/antrea/pkg/signals"
 	"antrea.io/antrea/pkg/util/objectstore"
 )
 
@@ -57,6 +61,12 @@ var (
 	newS3Exporter = func(clusterUUID uuid.UUID, opt *options.Options) (exporter.Interface, error) {
 		return exporter.NewS3Exporter(clusterUUID, opt)
 	}
+	newKafkaExporter = func(clusterID string, opt *options.Options) (exporter.Interface, error) {
+		return exporter.NewKafkaExporter(clusterID, opt)
+	}
+	newOTLPExporter = func(clusterID string, opt *options.Options) (exporter.Interface, error) {
+		return exporter.NewOTLPExporter(clusterID, opt)
+	}
 	newLogExporter = func(opt *options.Options) (exporter.Interface, error) {
 		return exporter.NewLogExporter(opt)
 	}
@@ -72,6 +82,9 @@ type flowAggregator struct {
 	aggregationProcess          intermediate.AggregationProcess
 	activeFlowRecordTimeout     time.Duration
 	inactiveFlowRecordTimeout   time.Duration
+	checkpointConfig            flowaggregatorconfig.CheckpointConfig
+	checkpointer                *checkpointer
+	caWatcher                   *caWatcher
 	registry                    ipfix.IPFIXRegistry
 	flowAggregatorAddress       string
 	includePodLabels            bool
@@ -90,7 +103,16 @@ type flowAggregator struct {
 	ipfixExporter               exporter.Interface
 	clickHouseExporter          exporter.Interface
 	s3Exporter                  exporter.Interface
+	kafkaExporter               exporter.Interface
+	otlpExporter                exporter.Interface
 	logExporter                 exporter.Interface
+	ipfixSelector               *filter.Selector
+	clickHouseSelector          *filter.Selector
+	s3Selector                  *filter.Selector
+	kafkaSelector               *filter.Selector
+	otlpSelector                *filter.Selector
+	logSelector                 *filter.Selector
+	recommender                 *recommendation.Recommender
 	logTickerDuration           time.Duration
 	recordCh                    chan *flowpb.Flow
 	exportersMutex              sync.Mutex
@@ -143,6 +165,7 @@ func NewFlowAggregator(
 		aggregatorTransportProtocol: opt.AggregatorTransportProtocol,
 		activeFlowRecordTimeout:     opt.ActiveFlowRecordTimeout,
 		inactiveFlowRecordTimeout:   opt.InactiveFlowRecordTimeout,
+		checkpointConfig:            opt.Config.Checkpoint,
 		registry:                    registry,
 		flowAggregatorAddress:       opt.Config.FlowAggregatorAddress,
 		includePodLabels:            opt.Config.RecordContents.PodLabels,
@@ -163,6 +186,9 @@ func NewFlowAggregator(
 	if err := fa.InitCollectors(); err != nil {
 		return nil, fmt.Errorf("error when creating collectors: %w", err)
 	}
+	if opt.Config.Checkpoint.Enable {
+		fa.checkpointer = newCheckpointer(opt.Config.Checkpoint.Path, opt.CheckpointInterval, opt.CheckpointMaxStaleness)
+	}
 	if opt.AggregatorMode == flowaggregatorconfig.AggregatorModeAggregate {
 		if err := fa.InitAggregationProcess(); err != nil {
 			return nil, fmt.Errorf("error when creating aggregation process: %w", err)
@@ -174,6 +200,7 @@ func NewFlowAggregator(
 		if err != nil {
 			return nil, fmt.Errorf("error when creating ClickHouse export process: %v", err)
 		}
+		fa.clickHouseSelector = opt.ClickHouseSelector
 	}
 	if opt.Config.S3Uploader.Enable {
 		var err error
@@ -181,6 +208,23 @@ func NewFlowAggregator(
 		if err != nil {
 			return nil, fmt.Errorf("error when creating S3 export process: %v", err)
 		}
+		fa.s3Selector = opt.S3UploaderSelector
+	}
+	if opt.Config.Kafka.Enable {
+		var err error
+		fa.kafkaExporter, err = newKafkaExporter(clusterID, opt)
+		if err != nil {
+			return nil, fmt.Errorf("error when creating Kafka export process: %v", err)
+		}
+		fa.kafkaSelector = opt.KafkaSelector
+	}
+	if opt.Config.OTLP.Enable {
+		var err error
+		fa.otlpExporter, err = newOTLPExporter(clusterID, opt)
+		if err != nil {
+			return nil, fmt.Errorf("error when creating OTLP export process: %v", err)
+		}
+		fa.otlpSelector = opt.OTLPSelector
 	}
 	if opt.Config.FlowLogger.Enable {
 		var err error
@@ -188,9 +232,14 @@ func NewFlowAggregator(
 		if err != nil {
 			return nil, fmt.Errorf("error when creating log export process: %v", err)
 		}
+		fa.logSelector = opt.FlowLoggerSelector
 	}
 	if opt.Config.FlowCollector.Enable {
 		fa.ipfixExporter = newIPFIXExporter(clusterUUID, clusterID, opt, registry)
+		fa.ipfixSelector = opt.FlowCollectorSelector
+	}
+	if opt.Config.PolicyRecommendation.Enable {
+		fa.recommender = recommendation.NewRecommender(podStore)
 	}
 	klog.InfoS("FlowAggregator initialized", "mode", opt.AggregatorMode, "clusterID", fa.clusterID)
 	return fa, nil
@@ -201,6 +250,12 @@ func (fa *flowAggregator) InitCollectors() error {
 	if err != nil {
 		return fmt.Errorf("failed to generate certificates: %w", err)
 	}
+	// When the CA is rotated, the FlowAggregator is stopped gracefully, so that it's restarted
+	// with the new CA.
+	fa.caWatcher, err = newCAWatcher(fa.k8sClient, caCert, caWatcherResyncPeriod, signals.GenerateStopSignal)
+	if err != nil {
+		return fmt.Errorf("failed to create CA watcher: %w", err)
+	}
 	grpcCollector, err := collector.NewGRPCCollector(fa.recordCh, caCert, serverKey, serverCert)
 	if err != nil {
 		return fmt.Errorf("failed to create gRPC collector: %w", err)
@@ -231,7 +286,17 @@ func (fa *flowAggregator) InitAggregationProcess() error {
 		InactiveExpiryTimeout: fa.inactiveFlowRecordTimeout,
 	}
 	fa.aggregationProcess, err = intermediate.InitAggregationProcess(apInput)
-	return err
+	if err != nil {
+		return err
+	}
+	if fa.checkpointer != nil {
+		// A checkpoint which cannot be restored should not prevent the FlowAggregator from
+		// starting: the aggregation state is lost, as if checkpointing was disabled.
+		if err := fa.checkpointer.restore(fa.aggregationProcess); err != nil {
+			klog.ErrorS(err, "Failed to restore aggregation state from checkpoint file", "path", fa.checkpointer.path)
+		}
+	}
+	return nil
 }
 
 func (fa *flowAggregator) Run(stopCh <-chan struct{}) {
@@ -267,6 +332,13 @@ func (fa *flowAggregator) Run(stopCh <-chan struct{}) {
 			fa.ipfixCollector.Run(stopCh)
 		}()
 	}
+	if fa.caWatcher != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fa.caWatcher.Run(stopCh)
+		}()
+	}
 	if fa.aggregationProcess != nil {
 		wg.Add(1)
 		go func() {
@@ -274,6 +346,13 @@ func (fa *flowAggregator) Run(stopCh <-chan struct{}) {
 			// blocking function, will return when fa.aggregationProcess.Stop() is called
 			fa.aggregationProcess.Start()
 		}()
+		if fa.checkpointer != nil {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				fa.checkpointer.run(fa.aggregationProcess, stopCh)
+			}()
+		}
 	}
 
 	if fa.ipfixExporter != nil {
@@ -285,6 +364,12 @@ func (fa *flowAggregator) Run(stopCh <-chan struct{}) {
 	if fa.s3Exporter != nil {
 		fa.s3Exporter.Start()
 	}
+	if fa.kafkaExporter != nil {
+		fa.kafkaExporter.Start()
+	}
+	if fa.otlpExporter != nil {
+		fa.otlpExporter.Start()
+	}
 	if fa.logExporter != nil {
 		fa.logExporter.Start()
 	}
@@ -315,6 +400,13 @@ func (fa *flowAggregator) Run(stopCh <-chan struct{}) {
 		fa.aggregationProcess.Stop()
 	}
 	wg.Wait()
+	if fa.aggregationProcess != nil && fa.checkpointer != nil {
+		// Save the final aggregation state, once the aggregation workers and the flow
+		// export loop have stopped and the state can no longer change.
+		if err := fa.checkpointer.save(fa.aggregationProcess); err != nil {
+			klog.ErrorS(err, "Failed to save aggregation state to checkpoint file", "path", fa.checkpointer.path)
+		}
+	}
 }
 
 // flowExportLoop is the main loop for the FlowAggregator. It runs in a single
@@ -334,6 +426,12 @@ func (fa *flowAggregator) flowExportLoop(stopCh <-chan struct{}) {
 		if fa.s3Exporter != nil {
 			fa.s3Exporter.Stop()
 		}
+		if fa.kafkaExporter != nil {
+			fa.kafkaExporter.Stop()
+		}
+		if fa.otlpExporter != nil {
+			fa.otlpExporter.Stop()
+		}
 		if fa.logExporter != nil {
 			fa.logExporter.Stop()
 		}
@@ -483,26 +581,39 @@ func (fa *flowAggregator) flowExportLoopAggregate(stopCh <-chan struct{}) {
 }
 
 func (fa *flowAggregator) sendRecord(record *flowpb.Flow, isRecordIPv6 bool) error {
-	if fa.ipfixExporter != nil {
+	if fa.ipfixExporter != nil && fa.ipfixSelector.Select(record) {
 		if err := fa.ipfixExporter.AddRecord(record, isRecordIPv6); err != nil {
 			return err
 		}
 	}
-	if fa.clickHouseExporter != nil {
+	if fa.clickHouseExporter != nil && fa.clickHouseSelector.Select(record) {
 		if err := fa.clickHouseExporter.AddRecord(record, isRecordIPv6); err != nil {
 			return err
 		}
 	}
-	if fa.s3Exporter != nil {
+	if fa.s3Exporter != nil && fa.s3Selector.Select(record) {
 		if err := fa.s3Exporter.AddRecord(record, isRecordIPv6); err != nil {
 			return err
 		}
 	}
-	if fa.logExporter != nil {
+	if fa.kafkaExporter != nil && fa.kafkaSelector.Select(record) {
+		if err := fa.kafkaExporter.AddRecord(record, isRecordIPv6); err != nil {
+			return err
+		}
+	}
+	if fa.otlpExporter != nil && fa.otlpSelector.Select(record) {
+		if err := fa.otlpExporter.AddRecord(record, isRecordIPv6); err != nil {
+			return err
+		}
+	}
+	if fa.logExporter != nil && fa.logSelector.Select(record) {
 		if err := fa.logExporter.AddRecord(record, isRecordIPv6); err != nil {
 			return err
 		}
 	}
+	if fa.recommender != nil {
+		fa.recommender.AddRecord(record)
+	}
 	fa.numRecordsExported.Add(1)
 	return nil
 }
@@ -687,11 +798,23 @@ func (fa *flowAggregator) GetRecordMetrics() querier.Metrics {
 	defer fa.exportersMutex.Unlock()
 	metrics.WithClickHouseExporter = fa.clickHouseExporter != nil
 	metrics.WithS3Exporter = fa.s3Exporter != nil
+	metrics.WithKafkaExporter = fa.kafkaExporter != nil
+	metrics.WithOTLPExporter = fa.otlpExporter != nil
 	metrics.WithLogExporter = fa.logExporter != nil
 	metrics.WithIPFIXExporter = fa.ipfixExporter != nil
 	return metrics
 }
 
+func (fa *flowAggregator) GetPolicyRecommendations(namespace string, defaultDeny bool) ([]runtime.Object, error) {
+	fa.exportersMutex.Lock()
+	recommender := fa.recommender
+	fa.exportersMutex.Unlock()
+	if recommender == nil {
+		return nil, querier.ErrPolicyRecommendationNotEnabled
+	}
+	return recommender.Recommend(namespace, defaultDeny), nil
+}
+
 func (fa *flowAggregator) watchConfiguration(stopCh <-chan struct{}) {
 	klog.InfoS("Watching for FlowAggregator configuration file")
 	for {
@@ -744,8 +867,9 @@ func (fa *flowAggregator) handleWatcherEvent() error {
 
 func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 	// This function potentially modifies the exporter pointer fields (e.g.,
-	// fa.ipfixExporter). We protect these writes by locking fa.exportersMutex, so that
-	// GetRecordMetrics() can safely read the fields (by also locking the mutex).
+	// fa.ipfixExporter) and fa.recommender. We protect these writes by locking
+	// fa.exportersMutex, so that GetRecordMetrics() and GetPolicyRecommendations() can safely
+	// read the fields (by also locking the mutex).
 	fa.exportersMutex.Lock()
 	defer fa.exportersMutex.Unlock()
 	// If user tries to change the mode dynamically, it makes sense to error out immediately and
@@ -764,11 +888,13 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 		} else {
 			fa.ipfixExporter.UpdateOptions(opt)
 		}
+		fa.ipfixSelector = opt.FlowCollectorSelector
 	} else {
 		if fa.ipfixExporter != nil {
 			klog.InfoS("Disabling Flow-Collector")
 			fa.ipfixExporter.Stop()
 			fa.ipfixExporter = nil
+			fa.ipfixSelector = nil
 			klog.InfoS("Disabled Flow-Collector")
 		}
 	}
@@ -786,11 +912,13 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 		} else {
 			fa.clickHouseExporter.UpdateOptions(opt)
 		}
+		fa.clickHouseSelector = opt.ClickHouseSelector
 	} else {
 		if fa.clickHouseExporter != nil {
 			klog.InfoS("Disabling ClickHouse")
 			fa.clickHouseExporter.Stop()
 			fa.clickHouseExporter = nil
+			fa.clickHouseSelector = nil
 			klog.InfoS("Disabled ClickHouse")
 		}
 	}
@@ -808,14 +936,64 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 		} else {
 			fa.s3Exporter.UpdateOptions(opt)
 		}
+		fa.s3Selector = opt.S3UploaderSelector
 	} else {
 		if fa.s3Exporter != nil {
 			klog.InfoS("Disabling S3Uploader")
 			fa.s3Exporter.Stop()
 			fa.s3Exporter = nil
+			fa.s3Selector = nil
 			klog.InfoS("Disabled S3Uploader")
 		}
 	}
+	if opt.Config.Kafka.Enable {
+		if fa.kafkaExporter == nil {
+			klog.InfoS("Enabling Kafka")
+			var err error
+			fa.kafkaExporter, err = newKafkaExporter(fa.clusterID, opt)
+			if err != nil {
+				klog.ErrorS(err, "Error when creating Kafka export process")
+				return
+			}
+			fa.kafkaExporter.Start()
+			klog.InfoS("Enabled Kafka")
+		} else {
+			fa.kafkaExporter.UpdateOptions(opt)
+		}
+		fa.kafkaSelector = opt.KafkaSelector
+	} else {
+		if fa.kafkaExporter != nil {
+			klog.InfoS("Disabling Kafka")
+			fa.kafkaExporter.Stop()
+			fa.kafkaExporter = nil
+			fa.kafkaSelector = nil
+			klog.InfoS("Disabled Kafka")
+		}
+	}
+	if opt.Config.OTLP.Enable {
+		if fa.otlpExporter == nil {
+			klog.InfoS("Enabling OTLP")
+			var err error
+			fa.otlpExporter, err = newOTLPExporter(fa.clusterID, opt)
+			if err != nil {
+				klog.ErrorS(err, "Error when creating OTLP export process")
+				return
+			}
+			fa.otlpExporter.Start()
+			klog.InfoS("Enabled OTLP")
+		} else {
+			fa.otlpExporter.UpdateOptions(opt)
+		}
+		fa.otlpSelector = opt.OTLPSelector
+	} else {
+		if fa.otlpExporter != nil {
+			klog.InfoS("Disabling OTLP")
+			fa.otlpExporter.Stop()
+			fa.otlpExporter = nil
+			fa.otlpSelector = nil
+			klog.InfoS("Disabled OTLP")
+		}
+	}
 	if opt.Config.FlowLogger.Enable {
 		if fa.logExporter == nil {
 			klog.InfoS("Enabling FlowLogger")
@@ -830,14 +1008,27 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 		} else {
 			fa.logExporter.UpdateOptions(opt)
 		}
+		fa.logSelector = opt.FlowLoggerSelector
 	} else {
 		if fa.logExporter != nil {
 			klog.InfoS("Disabling FlowLogger")
 			fa.logExporter.Stop()
 			fa.logExporter = nil
+			fa.logSelector = nil
 			klog.InfoS("Disabled FlowLogger")
 		}
 	}
+	if opt.Config.PolicyRecommendation.Enable {
+		if fa.recommender == nil {
+			fa.recommender = recommendation.NewRecommender(fa.podStore)
+			klog.InfoS("Enabled PolicyRecommendation")
+		}
+	} else {
+		if fa.recommender != nil {
+			fa.recommender = nil
+			klog.InfoS("Disabled PolicyRecommendation")
+		}
+	}
 	if opt.Config.RecordContents.PodLabels != fa.includePodLabels {
 		fa.includePodLabels = opt.Config.RecordContents.PodLabels
 		klog.InfoS("Updated recordContents.podLabels configuration", "value", fa.includePodLabels)
@@ -857,6 +1048,9 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 	if opt.InactiveFlowRecordTimeout != fa.inactiveFlowRecordTimeout {
 		unsupportedUpdates = append(unsupportedUpdates, "inactiveFlowRecordTimeout")
 	}
+	if opt.Config.Checkpoint != fa.checkpointConfig {
+		unsupportedUpdates = append(unsupportedUpdates, "checkpoint")
+	}
 	if opt.AggregatorTransportProtocol != fa.aggregatorTransportProtocol {
 		unsupportedUpdates = append(unsupportedUpdates, "aggregatorTransportProtocol")
 	}
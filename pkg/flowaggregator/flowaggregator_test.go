@@ -44,10 +44,12 @@ import (
 	collectortesting "antrea.io/antrea/pkg/flowaggregator/collector/testing"
 	"antrea.io/antrea/pkg/flowaggregator/exporter"
 	exportertesting "antrea.io/antrea/pkg/flowaggregator/exporter/testing"
+	"antrea.io/antrea/pkg/flowaggregator/filter"
 	"antrea.io/antrea/pkg/flowaggregator/intermediate"
 	intermediatetesting "antrea.io/antrea/pkg/flowaggregator/intermediate/testing"
 	"antrea.io/antrea/pkg/flowaggregator/options"
 	"antrea.io/antrea/pkg/flowaggregator/querier"
+	"antrea.io/antrea/pkg/flowaggregator/recommendation"
 	"antrea.io/antrea/pkg/ipfix"
 	ipfixtesting "antrea.io/antrea/pkg/ipfix/testing"
 	objectstoretest "antrea.io/antrea/pkg/util/objectstore/testing"
@@ -310,6 +312,64 @@ func TestFlowAggregator_proxyRecord(t *testing.T) {
 	}
 }
 
+func TestFlowAggregator_sendRecord(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClickHouseExporter := exportertesting.NewMockInterface(ctrl)
+	mockKafkaExporter := exportertesting.NewMockInterface(ctrl)
+	mockLogExporter := exportertesting.NewMockInterface(ctrl)
+
+	clickHouseSelector, err := filter.NewSelector(&flowaggregatorconfig.FlowRecordSelectionConfig{
+		ExcludeFilters: []flowaggregatorconfig.FlowFilter{{
+			DestinationPorts: []int32{8080},
+		}},
+	})
+	require.NoError(t, err)
+	logSelector, err := filter.NewSelector(&flowaggregatorconfig.FlowRecordSelectionConfig{
+		Filters: []flowaggregatorconfig.FlowFilter{{
+			IngressNetworkPolicyRuleActions: []flowaggregatorconfig.NetworkPolicyRuleAction{flowaggregatorconfig.NetworkPolicyRuleActionDrop},
+		}},
+	})
+	require.NoError(t, err)
+
+	fa := &flowAggregator{
+		clickHouseExporter: mockClickHouseExporter,
+		clickHouseSelector: clickHouseSelector,
+		kafkaExporter:      mockKafkaExporter,
+		logExporter:        mockLogExporter,
+		logSelector:        logSelector,
+	}
+
+	newRecord := func(destinationPort uint32, ingressRuleAction flowpb.NetworkPolicyRuleAction) *flowpb.Flow {
+		return &flowpb.Flow{
+			Ip: &flowpb.IP{
+				Version:     flowpb.IPVersion_IP_VERSION_4,
+				Source:      netip.MustParseAddr("10.0.0.1").AsSlice(),
+				Destination: netip.MustParseAddr("10.0.0.2").AsSlice(),
+			},
+			Transport: &flowpb.Transport{
+				ProtocolNumber:  6,
+				SourcePort:      40000,
+				DestinationPort: destinationPort,
+			},
+			K8S: &flowpb.Kubernetes{
+				IngressNetworkPolicyRuleAction: ingressRuleAction,
+			},
+		}
+	}
+	healthCheckRecord := newRecord(8080, flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_NO_ACTION)
+	droppedRecord := newRecord(80, flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_DROP)
+
+	mockKafkaExporter.EXPECT().AddRecord(healthCheckRecord, false)
+	require.NoError(t, fa.sendRecord(healthCheckRecord, false))
+
+	mockClickHouseExporter.EXPECT().AddRecord(droppedRecord, false)
+	mockKafkaExporter.EXPECT().AddRecord(droppedRecord, false)
+	mockLogExporter.EXPECT().AddRecord(droppedRecord, false)
+	require.NoError(t, fa.sendRecord(droppedRecord, false))
+
+	assert.Equal(t, int64(2), fa.numRecordsExported.Load())
+}
+
 func TestFlowAggregator_watchConfiguration(t *testing.T) {
 	opt := options.Options{
 		Config: &flowaggregatorconfig.FlowAggregatorConfig{
@@ -400,20 +460,28 @@ func mockExporters(t *testing.T, ctrl *gomock.Controller, expectedClusterUUID *u
 	*exportertesting.MockInterface,
 	*exportertesting.MockInterface,
 	*exportertesting.MockInterface,
+	*exportertesting.MockInterface,
+	*exportertesting.MockInterface,
 ) {
 	mockIPFIXExporter := exportertesting.NewMockInterface(ctrl)
 	mockClickHouseExporter := exportertesting.NewMockInterface(ctrl)
 	mockS3Exporter := exportertesting.NewMockInterface(ctrl)
+	mockKafkaExporter := exportertesting.NewMockInterface(ctrl)
+	mockOTLPExporter := exportertesting.NewMockInterface(ctrl)
 	mockLogExporter := exportertesting.NewMockInterface(ctrl)
 
 	newIPFIXExporterSaved := newIPFIXExporter
 	newClickHouseExporterSaved := newClickHouseExporter
 	newS3ExporterSaved := newS3Exporter
+	newKafkaExporterSaved := newKafkaExporter
+	newOTLPExporterSaved := newOTLPExporter
 	newLogExporterSaved := newLogExporter
 	t.Cleanup(func() {
 		newIPFIXExporter = newIPFIXExporterSaved
 		newClickHouseExporter = newClickHouseExporterSaved
 		newS3Exporter = newS3ExporterSaved
+		newKafkaExporter = newKafkaExporterSaved
+		newOTLPExporter = newOTLPExporterSaved
 		newLogExporter = newLogExporterSaved
 	})
 	newIPFIXExporter = func(clusterUUID uuid.UUID, clusterID string, opts *options.Options, registry ipfix.IPFIXRegistry) exporter.Interface {
@@ -437,17 +505,29 @@ func mockExporters(t *testing.T, ctrl *gomock.Controller, expectedClusterUUID *u
 		}
 		return mockS3Exporter, nil
 	}
+	newKafkaExporter = func(clusterID string, opts *options.Options) (exporter.Interface, error) {
+		if expectedClusterID != nil {
+			assert.Equal(t, *expectedClusterID, clusterID)
+		}
+		return mockKafkaExporter, nil
+	}
+	newOTLPExporter = func(clusterID string, opts *options.Options) (exporter.Interface, error) {
+		if expectedClusterID != nil {
+			assert.Equal(t, *expectedClusterID, clusterID)
+		}
+		return mockOTLPExporter, nil
+	}
 	newLogExporter = func(opt *options.Options) (exporter.Interface, error) {
 		return mockLogExporter, nil
 	}
 
-	return mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockLogExporter
+	return mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockKafkaExporter, mockOTLPExporter, mockLogExporter
 }
 
 func TestFlowAggregator_updateFlowAggregator(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
-	mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockLogExporter := mockExporters(t, ctrl, nil, nil)
+	mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockKafkaExporter, mockOTLPExporter, mockLogExporter := mockExporters(t, ctrl, nil, nil)
 
 	t.Run("updateIPFIX", func(t *testing.T) {
 		flowAggregator := &flowAggregator{
@@ -561,6 +641,90 @@ func TestFlowAggregator_updateFlowAggregator(t *testing.T) {
 		mockS3Exporter.EXPECT().UpdateOptions(opt)
 		flowAggregator.updateFlowAggregator(opt)
 	})
+	t.Run("enableKafka", func(t *testing.T) {
+		flowAggregator := &flowAggregator{}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				Kafka: flowaggregatorconfig.KafkaConfig{
+					Enable:  true,
+					Brokers: []string{"kafka:9092"},
+				},
+			},
+		}
+		mockKafkaExporter.EXPECT().Start()
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("disableKafka", func(t *testing.T) {
+		flowAggregator := &flowAggregator{
+			kafkaExporter: mockKafkaExporter,
+		}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				Kafka: flowaggregatorconfig.KafkaConfig{
+					Enable: false,
+				},
+			},
+		}
+		mockKafkaExporter.EXPECT().Stop()
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("updateKafka", func(t *testing.T) {
+		flowAggregator := &flowAggregator{
+			kafkaExporter: mockKafkaExporter,
+		}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				Kafka: flowaggregatorconfig.KafkaConfig{
+					Enable:  true,
+					Brokers: []string{"kafka:9092"},
+				},
+			},
+		}
+		mockKafkaExporter.EXPECT().UpdateOptions(opt)
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("enableOTLP", func(t *testing.T) {
+		flowAggregator := &flowAggregator{}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				OTLP: flowaggregatorconfig.OTLPConfig{
+					Enable:   true,
+					Endpoint: "otel-collector:4317",
+				},
+			},
+		}
+		mockOTLPExporter.EXPECT().Start()
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("disableOTLP", func(t *testing.T) {
+		flowAggregator := &flowAggregator{
+			otlpExporter: mockOTLPExporter,
+		}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				OTLP: flowaggregatorconfig.OTLPConfig{
+					Enable: false,
+				},
+			},
+		}
+		mockOTLPExporter.EXPECT().Stop()
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("updateOTLP", func(t *testing.T) {
+		flowAggregator := &flowAggregator{
+			otlpExporter: mockOTLPExporter,
+		}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				OTLP: flowaggregatorconfig.OTLPConfig{
+					Enable:   true,
+					Endpoint: "otel-collector:4317",
+				},
+			},
+		}
+		mockOTLPExporter.EXPECT().UpdateOptions(opt)
+		flowAggregator.updateFlowAggregator(opt)
+	})
 	t.Run("enableFlowLogger", func(t *testing.T) {
 		flowAggregator := &flowAggregator{}
 		opt := &options.Options{
@@ -603,6 +767,28 @@ func TestFlowAggregator_updateFlowAggregator(t *testing.T) {
 		mockLogExporter.EXPECT().UpdateOptions(opt)
 		flowAggregator.updateFlowAggregator(opt)
 	})
+	t.Run("enablePolicyRecommendation", func(t *testing.T) {
+		flowAggregator := &flowAggregator{}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				PolicyRecommendation: flowaggregatorconfig.PolicyRecommendationConfig{
+					Enable: true,
+				},
+			},
+		}
+		flowAggregator.updateFlowAggregator(opt)
+		assert.NotNil(t, flowAggregator.recommender)
+	})
+	t.Run("disablePolicyRecommendation", func(t *testing.T) {
+		flowAggregator := &flowAggregator{
+			recommender: recommendation.NewRecommender(nil),
+		}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{},
+		}
+		flowAggregator.updateFlowAggregator(opt)
+		assert.Nil(t, flowAggregator.recommender)
+	})
 	t.Run("includePodLabels", func(t *testing.T) {
 		flowAggregator := &flowAggregator{}
 		require.False(t, flowAggregator.includePodLabels)
@@ -642,7 +828,7 @@ func TestFlowAggregator_Run(t *testing.T) {
 	mockNodeStore.EXPECT().HasSynced().Return(true)
 	mockServiceStore := objectstoretest.NewMockServiceStore(ctrl)
 	mockServiceStore.EXPECT().HasSynced().Return(true)
-	mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockLogExporter := mockExporters(t, ctrl, nil, nil)
+	mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockKafkaExporter, mockOTLPExporter, mockLogExporter := mockExporters(t, ctrl, nil, nil)
 	mockCollector := collectortesting.NewMockInterface(ctrl)
 	mockAggregationProcess := intermediatetesting.NewMockAggregationProcess(ctrl)
 
@@ -688,6 +874,10 @@ func TestFlowAggregator_Run(t *testing.T) {
 	mockClickHouseExporter.EXPECT().Stop()
 	mockS3Exporter.EXPECT().Start()
 	mockS3Exporter.EXPECT().Stop()
+	mockKafkaExporter.EXPECT().Start()
+	mockKafkaExporter.EXPECT().Stop()
+	mockOTLPExporter.EXPECT().Start()
+	mockOTLPExporter.EXPECT().Stop()
 	mockLogExporter.EXPECT().Start()
 	mockLogExporter.EXPECT().Stop()
 
@@ -697,6 +887,8 @@ func TestFlowAggregator_Run(t *testing.T) {
 	mockIPFIXExporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
 	mockClickHouseExporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
 	mockS3Exporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
+	mockKafkaExporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
+	mockOTLPExporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
 	mockLogExporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
 
 	stopCh := make(chan struct{})
@@ -746,6 +938,26 @@ func TestFlowAggregator_Run(t *testing.T) {
 			Enable: false,
 		},
 	})
+	enableKafkaOptions := makeOptions(&flowaggregatorconfig.FlowAggregatorConfig{
+		Kafka: flowaggregatorconfig.KafkaConfig{
+			Enable: true,
+		},
+	})
+	disableKafkaOptions := makeOptions(&flowaggregatorconfig.FlowAggregatorConfig{
+		Kafka: flowaggregatorconfig.KafkaConfig{
+			Enable: false,
+		},
+	})
+	enableOTLPOptions := makeOptions(&flowaggregatorconfig.FlowAggregatorConfig{
+		OTLP: flowaggregatorconfig.OTLPConfig{
+			Enable: true,
+		},
+	})
+	disableOTLPOptions := makeOptions(&flowaggregatorconfig.FlowAggregatorConfig{
+		OTLP: flowaggregatorconfig.OTLPConfig{
+			Enable: false,
+		},
+	})
 	enableFlowLoggerOptions := makeOptions(&flowaggregatorconfig.FlowAggregatorConfig{
 		FlowLogger: flowaggregatorconfig.FlowLoggerConfig{
 			Enable: true,
@@ -765,15 +977,23 @@ func TestFlowAggregator_Run(t *testing.T) {
 	// 4. The ClickHouseExporter is then disabled, so we expect a call to mockClickHouseExporter.Stop()
 	// 5. The S3Uploader is then enabled, so we expect a call to mockS3Exporter.Start()
 	// 6. The S3Uploader is then disabled, so we expect a call to mockS3Exporter.Stop()
-	// 7. The FlowLogger is then enabled, so we expect a call to mockLogExporter.Start()
-	// 8. The FlowLogger is then disabled, so we expect a call to mockLogExporter.Stop()
-	// 9. The IPFIXExporter is then re-enabled, so we expect a second call to mockIPFIXExporter.Start()
-	// 10. Finally, when Run() is stopped, we expect a second call to mockIPFIXExporter.Stop()
+	// 7. The KafkaExporter is then enabled, so we expect a call to mockKafkaExporter.Start()
+	// 8. The KafkaExporter is then disabled, so we expect a call to mockKafkaExporter.Stop()
+	// 9. The OTLPExporter is then enabled, so we expect a call to mockOTLPExporter.Start()
+	// 10. The OTLPExporter is then disabled, so we expect a call to mockOTLPExporter.Stop()
+	// 11. The FlowLogger is then enabled, so we expect a call to mockLogExporter.Start()
+	// 12. The FlowLogger is then disabled, so we expect a call to mockLogExporter.Stop()
+	// 13. The IPFIXExporter is then re-enabled, so we expect a second call to mockIPFIXExporter.Start()
+	// 14. Finally, when Run() is stopped, we expect a second call to mockIPFIXExporter.Stop()
 	updateOptions(disableIPFIXOptions)
 	updateOptions(enableClickHouseOptions)
 	updateOptions(disableClickHouseOptions)
 	updateOptions(enableS3UploaderOptions)
 	updateOptions(disableS3UploaderOptions)
+	updateOptions(enableKafkaOptions)
+	updateOptions(disableKafkaOptions)
+	updateOptions(enableOTLPOptions)
+	updateOptions(disableOTLPOptions)
 	updateOptions(enableFlowLoggerOptions)
 	updateOptions(disableFlowLoggerOptions)
 	updateOptions(enableIPFIXOptions)
@@ -912,6 +1132,8 @@ func TestFlowAggregator_GetRecordMetrics(t *testing.T) {
 	mockIPFIXExporter := exportertesting.NewMockInterface(ctrl)
 	mockClickHouseExporter := exportertesting.NewMockInterface(ctrl)
 	mockS3Exporter := exportertesting.NewMockInterface(ctrl)
+	mockKafkaExporter := exportertesting.NewMockInterface(ctrl)
+	mockOTLPExporter := exportertesting.NewMockInterface(ctrl)
 	mockLogExporter := exportertesting.NewMockInterface(ctrl)
 	want := querier.Metrics{
 		NumRecordsExported:     10,
@@ -921,6 +1143,8 @@ func TestFlowAggregator_GetRecordMetrics(t *testing.T) {
 		NumConnToCollector:     1,
 		WithClickHouseExporter: true,
 		WithS3Exporter:         true,
+		WithKafkaExporter:      true,
+		WithOTLPExporter:       true,
 		WithLogExporter:        true,
 		WithIPFIXExporter:      true,
 	}
@@ -930,6 +1154,8 @@ func TestFlowAggregator_GetRecordMetrics(t *testing.T) {
 		aggregationProcess: mockAggregationProcess,
 		clickHouseExporter: mockClickHouseExporter,
 		s3Exporter:         mockS3Exporter,
+		kafkaExporter:      mockKafkaExporter,
+		otlpExporter:       mockOTLPExporter,
 		logExporter:        mockLogExporter,
 		ipfixExporter:      mockIPFIXExporter,
 	}
@@ -944,6 +1170,29 @@ func TestFlowAggregator_GetRecordMetrics(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestFlowAggregator_GetPolicyRecommendations(t *testing.T) {
+	fa := &flowAggregator{}
+	_, err := fa.GetPolicyRecommendations("", false)
+	assert.ErrorIs(t, err, querier.ErrPolicyRecommendationNotEnabled)
+
+	fa.recommender = recommendation.NewRecommender(nil)
+	fa.recommender.AddRecord(&flowpb.Flow{
+		StartTs: timestamppb.Now(),
+		Ip: &flowpb.IP{
+			Source:      netip.MustParseAddr("192.168.1.10").AsSlice(),
+			Destination: netip.MustParseAddr("10.10.0.1").AsSlice(),
+		},
+		Transport: &flowpb.Transport{ProtocolNumber: 6, DestinationPort: 80},
+		K8S: &flowpb.Kubernetes{
+			DestinationPodNamespace: "ns1",
+			DestinationPodLabels:    &flowpb.Labels{Labels: map[string]string{"app": "web"}},
+		},
+	})
+	policies, err := fa.GetPolicyRecommendations("ns1", true)
+	require.NoError(t, err)
+	assert.Len(t, policies, 2)
+}
+
 func TestFlowAggregator_InitCollectors(t *testing.T) {
 	tests := []struct {
 		name                        string
@@ -1093,6 +1342,14 @@ func TestNewFlowAggregator(t *testing.T) {
 				Enable:     true,
 				BucketName: "test-bucket-name",
 			},
+			Kafka: flowaggregatorconfig.KafkaConfig{
+				Enable:  true,
+				Brokers: []string{"kafka:9092"},
+			},
+			OTLP: flowaggregatorconfig.OTLPConfig{
+				Enable:   true,
+				Endpoint: "otel-collector:4317",
+			},
 			FlowLogger: flowaggregatorconfig.FlowLoggerConfig{
 				Enable: true,
 				Path:   "/tmp/antrea-flows.log",
@@ -17,6 +17,7 @@ package flowlogger
 import (
 	"github.com/vmware/go-ipfix/pkg/registry"
 
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
 	"antrea.io/antrea/pkg/util/ip"
 )
 
@@ -30,6 +31,8 @@ func PrettyPrintRuleAction(action uint8) string {
 		return "Drop"
 	case registry.NetworkPolicyRuleActionReject:
 		return "Reject"
+	case uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_AUDIT):
+		return "Audit"
 	default:
 		return "Invalid"
 	}
@@ -0,0 +1,151 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intermediate
+
+import (
+	"container/heap"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"k8s.io/klog/v2"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+)
+
+// checkpointVersion is the version of the checkpoint format. It must be incremented for any
+// incompatible change to checkpointData or checkpointRecord.
+const checkpointVersion = 1
+
+type checkpointData struct {
+	Version int
+	Records []checkpointRecord
+}
+
+type checkpointRecord struct {
+	FlowKey FlowKey
+	// Record is the flow record, serialized with protobuf.
+	Record                    []byte
+	ReadyToSend               bool
+	WaitForReadyToSendRetries int
+	AreCorrelatedFieldsFilled bool
+	AreExternalFieldsFilled   bool
+	IsIPv4                    bool
+	ActiveExpireTime          time.Time
+	InactiveExpireTime        time.Time
+	// LastUpdateTime is the last time a record was received for this flow.
+	LastUpdateTime time.Time
+}
+
+// SaveCheckpoint writes all the flow records stored in the aggregation process, along with
+// their correlation and expiry state, to w.
+func (a *aggregationProcess) SaveCheckpoint(w io.Writer) error {
+	a.mutex.RLock()
+	data := checkpointData{
+		Version: checkpointVersion,
+		Records: make([]checkpointRecord, 0, len(a.flowKeyRecordMap)),
+	}
+	for flowKey, aggregationRecord := range a.flowKeyRecordMap {
+		recordBytes, err := proto.Marshal(aggregationRecord.Record)
+		if err != nil {
+			a.mutex.RUnlock()
+			return fmt.Errorf("error when serializing flow record with key %v: %w", flowKey, err)
+		}
+		pqItem := aggregationRecord.PriorityQueueItem
+		data.Records = append(data.Records, checkpointRecord{
+			FlowKey:                   flowKey,
+			Record:                    recordBytes,
+			ReadyToSend:               aggregationRecord.ReadyToSend,
+			WaitForReadyToSendRetries: aggregationRecord.waitForReadyToSendRetries,
+			AreCorrelatedFieldsFilled: aggregationRecord.areCorrelatedFieldsFilled,
+			AreExternalFieldsFilled:   aggregationRecord.areExternalFieldsFilled,
+			IsIPv4:                    aggregationRecord.isIPv4,
+			ActiveExpireTime:          pqItem.activeExpireTime,
+			InactiveExpireTime:        pqItem.inactiveExpireTime,
+			// The inactive expire time is reset every time a record is received for the flow.
+			LastUpdateTime: pqItem.inactiveExpireTime.Add(-a.inactiveExpiryTimeout),
+		})
+	}
+	a.mutex.RUnlock()
+	return gob.NewEncoder(w).Encode(&data)
+}
+
+// RestoreCheckpoint adds the flow records saved with SaveCheckpoint to the aggregation process.
+// It is meant to be called before Start. Records which were last updated within maxStaleness
+// are restored with their correlation state, so that they can be correlated with subsequent
+// records. If they were due to expire, their expiry timeouts are restarted. Older records
+// cannot be correlated anymore: instead of being dropped, they are marked as ready to send
+// and expire immediately, so that they are exported as they are. RestoreCheckpoint returns
+// the number of records which were restored for correlation and the number of records which
+// were flushed.
+func (a *aggregationProcess) RestoreCheckpoint(r io.Reader, maxStaleness time.Duration) (int, int, error) {
+	var data checkpointData
+	if err := gob.NewDecoder(r).Decode(&data); err != nil {
+		return 0, 0, fmt.Errorf("error when decoding checkpoint: %w", err)
+	}
+	if data.Version != checkpointVersion {
+		return 0, 0, fmt.Errorf("unsupported checkpoint version %d", data.Version)
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	currTime := a.clock.Now()
+	numRestored, numFlushed := 0, 0
+	for _, cr := range data.Records {
+		if _, exists := a.flowKeyRecordMap[cr.FlowKey]; exists {
+			continue
+		}
+		record := &flowpb.Flow{}
+		if err := proto.Unmarshal(cr.Record, record); err != nil {
+			klog.ErrorS(err, "Ignoring invalid flow record in checkpoint", "flowKey", cr.FlowKey)
+			continue
+		}
+		flowKey := cr.FlowKey
+		aggregationRecord := &AggregationFlowRecord{
+			Record:                    record,
+			ReadyToSend:               cr.ReadyToSend,
+			waitForReadyToSendRetries: cr.WaitForReadyToSendRetries,
+			areCorrelatedFieldsFilled: cr.AreCorrelatedFieldsFilled,
+			areExternalFieldsFilled:   cr.AreExternalFieldsFilled,
+			isIPv4:                    cr.IsIPv4,
+		}
+		pqItem := &ItemToExpire{
+			flowKey:            &flowKey,
+			flowRecord:         aggregationRecord,
+			activeExpireTime:   cr.ActiveExpireTime,
+			inactiveExpireTime: cr.InactiveExpireTime,
+		}
+		if currTime.Sub(cr.LastUpdateTime) > maxStaleness {
+			aggregationRecord.ReadyToSend = true
+			pqItem.activeExpireTime = currTime
+			pqItem.inactiveExpireTime = currTime
+			numFlushed++
+		} else {
+			if pqItem.activeExpireTime.Before(currTime) {
+				pqItem.activeExpireTime = currTime.Add(a.activeExpiryTimeout)
+			}
+			if pqItem.inactiveExpireTime.Before(currTime) {
+				pqItem.inactiveExpireTime = currTime.Add(a.inactiveExpiryTimeout)
+			}
+			numRestored++
+		}
+		aggregationRecord.PriorityQueueItem = pqItem
+		heap.Push(&a.expirePriorityQueue, pqItem)
+		a.flowKeyRecordMap[flowKey] = aggregationRecord
+	}
+	return numRestored, numFlushed, nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intermediate
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+)
+
+func newTestAggregationProcess(t *testing.T, clock *clocktesting.FakeClock) *aggregationProcess {
+	input := AggregationInput{
+		RecordChan:            make(chan *flowpb.Flow),
+		WorkerNum:             2,
+		ActiveExpiryTimeout:   testActiveExpiry,
+		InactiveExpiryTimeout: testInactiveExpiry,
+	}
+	ap, err := initAggregationProcessWithClock(input, clock)
+	require.NoError(t, err)
+	return ap
+}
+
+// saveTestCheckpoint adds a partially correlated Inter-Node flow record (IPv4) and a fully
+// correlated Inter-Node flow record (IPv6) to a new aggregation process, and returns its
+// checkpoint.
+func saveTestCheckpoint(t *testing.T, startTime time.Time) (*bytes.Buffer, *aggregationProcess) {
+	ap := newTestAggregationProcess(t, clocktesting.NewFakeClock(startTime))
+	for _, record := range []*flowpb.Flow{
+		createFlowRecordForSrc(false, flowpb.FlowType_FLOW_TYPE_INTER_NODE, false, flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_NO_ACTION),
+		createFlowRecordForSrc(true, flowpb.FlowType_FLOW_TYPE_INTER_NODE, false, flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_NO_ACTION),
+		createFlowRecordForDst(true, flowpb.FlowType_FLOW_TYPE_INTER_NODE, false, flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_NO_ACTION),
+	} {
+		flowKey, isIPv4 := getFlowKeyFromRecord(record)
+		ap.addOrUpdateRecordInMap(flowKey, record, isIPv4)
+	}
+	require.Len(t, ap.flowKeyRecordMap, 2)
+	var buf bytes.Buffer
+	require.NoError(t, ap.SaveCheckpoint(&buf))
+	return &buf, ap
+}
+
+func TestRestoreCheckpoint(t *testing.T) {
+	startTime := time.Now()
+	buf, savedAP := saveTestCheckpoint(t, startTime)
+
+	// Restart after 1s: the expiry timeouts have elapsed, but the records are not stale.
+	clock := clocktesting.NewFakeClock(startTime.Add(time.Second))
+	ap := newTestAggregationProcess(t, clock)
+	numRestored, numFlushed, err := ap.RestoreCheckpoint(buf, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 2, numRestored)
+	assert.Equal(t, 0, numFlushed)
+	require.Len(t, ap.flowKeyRecordMap, 2)
+	assert.Equal(t, 2, ap.expirePriorityQueue.Len())
+	for flowKey, savedRecord := range savedAP.flowKeyRecordMap {
+		aggregationRecord, ok := ap.flowKeyRecordMap[flowKey]
+		require.True(t, ok)
+		assert.True(t, proto.Equal(savedRecord.Record, aggregationRecord.Record))
+		assert.Equal(t, savedRecord.ReadyToSend, aggregationRecord.ReadyToSend)
+		assert.Equal(t, savedRecord.areCorrelatedFieldsFilled, aggregationRecord.areCorrelatedFieldsFilled)
+		assert.Equal(t, savedRecord.isIPv4, aggregationRecord.isIPv4)
+		pqItem := aggregationRecord.PriorityQueueItem
+		assert.Same(t, aggregationRecord, pqItem.flowRecord)
+		assert.Equal(t, flowKey, *pqItem.flowKey)
+		assert.Equal(t, clock.Now().Add(testActiveExpiry), pqItem.activeExpireTime)
+		assert.Equal(t, clock.Now().Add(testInactiveExpiry), pqItem.inactiveExpireTime)
+	}
+
+	// The restored IPv4 record can be correlated with the record from the destination Node.
+	record := createFlowRecordForDst(false, flowpb.FlowType_FLOW_TYPE_INTER_NODE, false, flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_NO_ACTION)
+	flowKey, isIPv4 := getFlowKeyFromRecord(record)
+	assert.False(t, ap.flowKeyRecordMap[*flowKey].ReadyToSend)
+	ap.addOrUpdateRecordInMap(flowKey, record, isIPv4)
+	aggregationRecord := ap.flowKeyRecordMap[*flowKey]
+	assert.True(t, aggregationRecord.ReadyToSend)
+	assert.True(t, aggregationRecord.areCorrelatedFieldsFilled)
+	assert.Equal(t, "pod1", aggregationRecord.Record.K8S.SourcePodName)
+	assert.Equal(t, "pod2", aggregationRecord.Record.K8S.DestinationPodName)
+}
+
+func TestRestoreCheckpointStaleRecords(t *testing.T) {
+	startTime := time.Now()
+	buf, _ := saveTestCheckpoint(t, startTime)
+
+	clock := clocktesting.NewFakeClock(startTime.Add(10 * time.Minute))
+	ap := newTestAggregationProcess(t, clock)
+	numRestored, numFlushed, err := ap.RestoreCheckpoint(buf, 5*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 0, numRestored)
+	assert.Equal(t, 2, numFlushed)
+	assert.Zero(t, ap.GetExpiryFromExpirePriorityQueue())
+
+	// Stale records are exported, including the partially correlated one, and then deleted.
+	clock.Step(time.Millisecond)
+	var exportedKeys []FlowKey
+	require.NoError(t, ap.ForAllExpiredFlowRecordsDo(func(key FlowKey, record *AggregationFlowRecord) error {
+		exportedKeys = append(exportedKeys, key)
+		return nil
+	}))
+	assert.Len(t, exportedKeys, 2)
+	assert.Empty(t, ap.flowKeyRecordMap)
+	assert.Equal(t, 0, ap.expirePriorityQueue.Len())
+}
+
+func TestRestoreCheckpointInvalid(t *testing.T) {
+	ap := newTestAggregationProcess(t, clocktesting.NewFakeClock(time.Now()))
+	_, _, err := ap.RestoreCheckpoint(bytes.NewBufferString("invalid"), time.Minute)
+	assert.ErrorContains(t, err, "error when decoding checkpoint")
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(&checkpointData{Version: checkpointVersion + 1}))
+	_, _, err = ap.RestoreCheckpoint(&buf, time.Minute)
+	assert.ErrorContains(t, err, "unsupported checkpoint version")
+	assert.Empty(t, ap.flowKeyRecordMap)
+}
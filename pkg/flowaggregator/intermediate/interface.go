@@ -15,6 +15,7 @@
 package intermediate
 
 import (
+	"io"
 	"time"
 
 	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
@@ -33,4 +34,6 @@ type AggregationProcess interface {
 	SetExternalFieldsFilled(record *AggregationFlowRecord, isFilled bool)
 	AreExternalFieldsFilled(record AggregationFlowRecord) bool
 	GetNumFlows() int64
+	SaveCheckpoint(w io.Writer) error
+	RestoreCheckpoint(r io.Reader, maxStaleness time.Duration) (int, int, error)
 }
@@ -25,6 +25,7 @@
 package testing
 
 import (
+	io "io"
 	reflect "reflect"
 	time "time"
 
@@ -169,6 +170,36 @@ func (mr *MockAggregationProcessMockRecorder) ResetStatAndThroughputElementsInRe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetStatAndThroughputElementsInRecord", reflect.TypeOf((*MockAggregationProcess)(nil).ResetStatAndThroughputElementsInRecord), record)
 }
 
+// RestoreCheckpoint mocks base method.
+func (m *MockAggregationProcess) RestoreCheckpoint(r io.Reader, maxStaleness time.Duration) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCheckpoint", r, maxStaleness)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RestoreCheckpoint indicates an expected call of RestoreCheckpoint.
+func (mr *MockAggregationProcessMockRecorder) RestoreCheckpoint(r, maxStaleness any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCheckpoint", reflect.TypeOf((*MockAggregationProcess)(nil).RestoreCheckpoint), r, maxStaleness)
+}
+
+// SaveCheckpoint mocks base method.
+func (m *MockAggregationProcess) SaveCheckpoint(w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCheckpoint", w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCheckpoint indicates an expected call of SaveCheckpoint.
+func (mr *MockAggregationProcessMockRecorder) SaveCheckpoint(w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCheckpoint", reflect.TypeOf((*MockAggregationProcess)(nil).SaveCheckpoint), w)
+}
+
 // SetCorrelatedFieldsFilled mocks base method.
 func (m *MockAggregationProcess) SetCorrelatedFieldsFilled(record *intermediate.AggregationFlowRecord, isFilled bool) {
 	m.ctrl.T.Helper()
@@ -22,6 +22,7 @@ import (
 	"k8s.io/klog/v2"
 
 	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/filter"
 	"antrea.io/antrea/pkg/util/flowexport"
 	"antrea.io/antrea/pkg/util/yaml"
 )
@@ -35,6 +36,10 @@ type Options struct {
 	ActiveFlowRecordTimeout time.Duration
 	// Expiration timeout for inactive flow records in the flow aggregator
 	InactiveFlowRecordTimeout time.Duration
+	// Interval at which the aggregation state is saved to the checkpoint file
+	CheckpointInterval time.Duration
+	// Maximum age of the flow records restored from the checkpoint file for correlation
+	CheckpointMaxStaleness time.Duration
 	// Transport protocol over which the aggregator collects IPFIX records from all Agents
 	AggregatorTransportProtocol flowaggregatorconfig.AggregatorTransportProtocol
 	// IPFIX flow collector address
@@ -47,6 +52,18 @@ type Options struct {
 	ClickHouseCommitInterval time.Duration
 	// Flow records batch upload interval from flow aggregator to S3 bucket
 	S3UploadInterval time.Duration
+	// Maximum duration for which flow records are buffered before being published to Kafka
+	KafkaBatchTimeout time.Duration
+	// Interval at which flow records and metrics are exported to the OTLP collector
+	OTLPExportInterval time.Duration
+	// Selectors determining which flow records are sent to each exporter. A nil selector
+	// selects all flow records.
+	FlowCollectorSelector *filter.Selector
+	ClickHouseSelector    *filter.Selector
+	S3UploaderSelector    *filter.Selector
+	KafkaSelector         *filter.Selector
+	OTLPSelector          *filter.Selector
+	FlowLoggerSelector    *filter.Selector
 }
 
 func LoadConfig(configBytes []byte) (*Options, error) {
@@ -62,7 +79,13 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 	if opt.Config.S3Uploader.Enable && opt.Config.S3Uploader.BucketName == "" {
 		return nil, fmt.Errorf("s3Uploader enabled without specifying bucket name")
 	}
-	if !opt.Config.FlowCollector.Enable && !opt.Config.ClickHouse.Enable && !opt.Config.S3Uploader.Enable && !opt.Config.FlowLogger.Enable {
+	if opt.Config.Kafka.Enable && len(opt.Config.Kafka.Brokers) == 0 {
+		return nil, fmt.Errorf("kafka enabled without specifying brokers")
+	}
+	if opt.Config.OTLP.Enable && opt.Config.OTLP.Endpoint == "" {
+		return nil, fmt.Errorf("otlp enabled without specifying endpoint")
+	}
+	if !opt.Config.FlowCollector.Enable && !opt.Config.ClickHouse.Enable && !opt.Config.S3Uploader.Enable && !opt.Config.Kafka.Enable && !opt.Config.OTLP.Enable && !opt.Config.FlowLogger.Enable {
 		klog.InfoS("No collector / sink has been configured, so no flow data will be exported")
 	}
 	// Validate common parameters
@@ -71,7 +94,7 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 	}
 	opt.AggregatorMode = opt.Config.Mode
 	if opt.AggregatorMode == flowaggregatorconfig.AggregatorModeProxy {
-		if opt.Config.ClickHouse.Enable || opt.Config.S3Uploader.Enable || opt.Config.FlowLogger.Enable {
+		if opt.Config.ClickHouse.Enable || opt.Config.S3Uploader.Enable || opt.Config.Kafka.Enable || opt.Config.OTLP.Enable || opt.Config.FlowLogger.Enable {
 			return nil, fmt.Errorf("only flow collector is supported in Proxy mode")
 		}
 	}
@@ -84,6 +107,27 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Validate checkpoint specific parameters
+	if opt.Config.Checkpoint.Enable {
+		if opt.AggregatorMode != flowaggregatorconfig.AggregatorModeAggregate {
+			return nil, fmt.Errorf("checkpoint is only supported in Aggregate mode")
+		}
+		opt.CheckpointInterval, err = time.ParseDuration(opt.Config.Checkpoint.Interval)
+		if err != nil {
+			return nil, err
+		}
+		if opt.CheckpointInterval < flowaggregatorconfig.MinCheckpointInterval {
+			return nil, fmt.Errorf("checkpoint interval %s is too small: shortest supported interval is %v",
+				opt.Config.Checkpoint.Interval, flowaggregatorconfig.MinCheckpointInterval)
+		}
+		opt.CheckpointMaxStaleness, err = time.ParseDuration(opt.Config.Checkpoint.MaxStaleness)
+		if err != nil {
+			return nil, err
+		}
+		if opt.CheckpointMaxStaleness <= 0 {
+			return nil, fmt.Errorf("checkpoint maxStaleness must be a positive duration")
+		}
+	}
 	opt.AggregatorTransportProtocol, err = flowexport.ParseTransportProtocol(opt.Config.AggregatorTransportProtocol)
 	if err != nil {
 		return nil, err
@@ -153,11 +197,132 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 				opt.Config.S3Uploader.UploadInterval, flowaggregatorconfig.MinS3CommitInterval)
 		}
 	}
+	// Validate Kafka specific parameters
+	if opt.Config.Kafka.Enable {
+		if err := validateKafkaConfig(&opt.Config.Kafka); err != nil {
+			return nil, err
+		}
+		opt.KafkaBatchTimeout, err = time.ParseDuration(opt.Config.Kafka.BatchTimeout)
+		if err != nil {
+			return nil, err
+		}
+		if opt.KafkaBatchTimeout < flowaggregatorconfig.MinKafkaBatchTimeout {
+			return nil, fmt.Errorf("batchTimeout %s is too small: shortest supported timeout is %v",
+				opt.Config.Kafka.BatchTimeout, flowaggregatorconfig.MinKafkaBatchTimeout)
+		}
+	}
+	// Validate OTLP specific parameters
+	if opt.Config.OTLP.Enable {
+		if err := validateOTLPConfig(&opt.Config.OTLP); err != nil {
+			return nil, err
+		}
+		opt.OTLPExportInterval, err = time.ParseDuration(opt.Config.OTLP.ExportInterval)
+		if err != nil {
+			return nil, err
+		}
+		if opt.OTLPExportInterval < flowaggregatorconfig.MinOTLPExportInterval {
+			return nil, fmt.Errorf("exportInterval %s is too small: shortest supported interval is %v",
+				opt.Config.OTLP.ExportInterval, flowaggregatorconfig.MinOTLPExportInterval)
+		}
+	}
 	// Validate FlowLogger specific parameters
 	if opt.Config.FlowLogger.Enable {
 		if opt.Config.FlowLogger.RecordFormat != "CSV" {
 			return nil, fmt.Errorf("record format %s is not supported", opt.Config.FlowLogger.RecordFormat)
 		}
 	}
+	// Build the flow record selectors for all enabled exporters
+	for _, s := range []struct {
+		name     string
+		enable   bool
+		config   *flowaggregatorconfig.FlowRecordSelectionConfig
+		selector **filter.Selector
+	}{
+		{"flowCollector", opt.Config.FlowCollector.Enable, &opt.Config.FlowCollector.FlowRecordSelectionConfig, &opt.FlowCollectorSelector},
+		{"clickHouse", opt.Config.ClickHouse.Enable, &opt.Config.ClickHouse.FlowRecordSelectionConfig, &opt.ClickHouseSelector},
+		{"s3Uploader", opt.Config.S3Uploader.Enable, &opt.Config.S3Uploader.FlowRecordSelectionConfig, &opt.S3UploaderSelector},
+		{"kafka", opt.Config.Kafka.Enable, &opt.Config.Kafka.FlowRecordSelectionConfig, &opt.KafkaSelector},
+		{"otlp", opt.Config.OTLP.Enable, &opt.Config.OTLP.FlowRecordSelectionConfig, &opt.OTLPSelector},
+		{"flowLogger", opt.Config.FlowLogger.Enable, &opt.Config.FlowLogger.FlowRecordSelectionConfig, &opt.FlowLoggerSelector},
+	} {
+		if !s.enable {
+			continue
+		}
+		if !opt.Config.RecordContents.PodLabels && usesPodSelector(s.config) {
+			return nil, fmt.Errorf("%s filters use Pod selectors, which require recordContents.podLabels to be true", s.name)
+		}
+		*s.selector, err = filter.NewSelector(s.config)
+		if err != nil {
+			return nil, fmt.Errorf("invalid flow record selection for %s: %w", s.name, err)
+		}
+	}
 	return &opt, nil
 }
+
+func usesPodSelector(config *flowaggregatorconfig.FlowRecordSelectionConfig) bool {
+	for _, filters := range [][]flowaggregatorconfig.FlowFilter{config.Filters, config.ExcludeFilters} {
+		for idx := range filters {
+			if filters[idx].SourcePodSelector != "" || filters[idx].DestinationPodSelector != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func validateKafkaConfig(config *flowaggregatorconfig.KafkaConfig) error {
+	for _, broker := range config.Brokers {
+		if _, _, err := net.SplitHostPort(broker); err != nil {
+			return fmt.Errorf("invalid Kafka broker address %s: %w", broker, err)
+		}
+	}
+	switch config.RecordFormat {
+	case flowaggregatorconfig.KafkaRecordFormatProtobuf, flowaggregatorconfig.KafkaRecordFormatJSON:
+	default:
+		return fmt.Errorf("record format %s is not supported", config.RecordFormat)
+	}
+	switch config.Partitioning {
+	case flowaggregatorconfig.KafkaPartitioningCluster, flowaggregatorconfig.KafkaPartitioningNamespace, flowaggregatorconfig.KafkaPartitioningFiveTuple:
+	default:
+		return fmt.Errorf("partitioning %s is not supported", config.Partitioning)
+	}
+	switch config.Compression {
+	case "none", "gzip", "snappy", "lz4", "zstd":
+	default:
+		return fmt.Errorf("compression %s is not supported", config.Compression)
+	}
+	if config.BatchSize < 0 {
+		return fmt.Errorf("batchSize cannot be negative")
+	}
+	switch config.SASL.Mechanism {
+	case "", flowaggregatorconfig.KafkaSASLMechanismPlain, flowaggregatorconfig.KafkaSASLMechanismScramSHA256, flowaggregatorconfig.KafkaSASLMechanismScramSHA512:
+	default:
+		return fmt.Errorf("SASL mechanism %s is not supported", config.SASL.Mechanism)
+	}
+	if config.TLS.Enable {
+		if _, err := TLSVersion(config.TLS.MinVersion); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateOTLPConfig(config *flowaggregatorconfig.OTLPConfig) error {
+	if _, _, err := net.SplitHostPort(config.Endpoint); err != nil {
+		return fmt.Errorf("invalid OTLP endpoint %s: %w", config.Endpoint, err)
+	}
+	switch config.Protocol {
+	case flowaggregatorconfig.OTLPProtocolGRPC, flowaggregatorconfig.OTLPProtocolHTTP:
+	default:
+		return fmt.Errorf("OTLP protocol %s is not supported", config.Protocol)
+	}
+	if config.BatchSize < 0 {
+		return fmt.Errorf("batchSize cannot be negative")
+	}
+	if config.TLS.Enable {
+		if _, err := TLSVersion(config.TLS.MinVersion); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -15,9 +15,17 @@
 package querier
 
 import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
 	"antrea.io/antrea/pkg/flowaggregator/intermediate"
 )
 
+// ErrPolicyRecommendationNotEnabled is returned by GetPolicyRecommendations when policy
+// recommendation is not enabled in the Flow Aggregator configuration.
+var ErrPolicyRecommendationNotEnabled = errors.New("policy recommendation is not enabled")
+
 type Metrics struct {
 	NumRecordsExported     int64
 	NumRecordsReceived     int64
@@ -26,6 +34,8 @@ type Metrics struct {
 	NumConnToCollector     int64
 	WithClickHouseExporter bool
 	WithS3Exporter         bool
+	WithKafkaExporter      bool
+	WithOTLPExporter       bool
 	WithLogExporter        bool
 	WithIPFIXExporter      bool
 }
@@ -33,6 +43,7 @@ type Metrics struct {
 type FlowAggregatorQuerier interface {
 	GetFlowRecords(flowKey *intermediate.FlowKey) []map[string]interface{}
 	GetRecordMetrics() Metrics
+	GetPolicyRecommendations(namespace string, defaultDeny bool) ([]runtime.Object, error)
 }
 
 type ExternalFlowCollectorAddr struct {
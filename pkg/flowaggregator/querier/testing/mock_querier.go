@@ -30,6 +30,7 @@ import (
 	intermediate "antrea.io/antrea/pkg/flowaggregator/intermediate"
 	querier "antrea.io/antrea/pkg/flowaggregator/querier"
 	gomock "go.uber.org/mock/gomock"
+	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // MockFlowAggregatorQuerier is a mock of FlowAggregatorQuerier interface.
@@ -70,6 +71,21 @@ func (mr *MockFlowAggregatorQuerierMockRecorder) GetFlowRecords(flowKey any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlowRecords", reflect.TypeOf((*MockFlowAggregatorQuerier)(nil).GetFlowRecords), flowKey)
 }
 
+// GetPolicyRecommendations mocks base method.
+func (m *MockFlowAggregatorQuerier) GetPolicyRecommendations(namespace string, defaultDeny bool) ([]runtime.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyRecommendations", namespace, defaultDeny)
+	ret0, _ := ret[0].([]runtime.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyRecommendations indicates an expected call of GetPolicyRecommendations.
+func (mr *MockFlowAggregatorQuerierMockRecorder) GetPolicyRecommendations(namespace, defaultDeny any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyRecommendations", reflect.TypeOf((*MockFlowAggregatorQuerier)(nil).GetPolicyRecommendations), namespace, defaultDeny)
+}
+
 // GetRecordMetrics mocks base method.
 func (m *MockFlowAggregatorQuerier) GetRecordMetrics() querier.Metrics {
 	m.ctrl.T.Helper()
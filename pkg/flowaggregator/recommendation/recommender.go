@@ -0,0 +1,424 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package recommendation recommends Antrea-native policies based on the connections observed
+// in the flow records processed by the Flow Aggregator.
+package recommendation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/netip"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	"antrea.io/antrea/pkg/util/objectstore"
+)
+
+const (
+	// defaultMaxConnections is the maximum number of distinct connections stored by the
+	// Recommender. Connections observed after the limit is reached are ignored.
+	defaultMaxConnections = 100000
+
+	protocolTCP  = 6
+	protocolUDP  = 17
+	protocolSCTP = 132
+
+	allowPolicyNamePrefix = "recommended-allow-"
+	denyPolicyNamePrefix  = "recommended-default-deny-"
+	// allowPolicyPriority is the priority of the recommended Antrea NetworkPolicies in the
+	// application Tier.
+	allowPolicyPriority = 5
+	// denyPolicyPriority is the priority of the recommended default-deny
+	// ClusterNetworkPolicies in the baseline Tier.
+	denyPolicyPriority = 5
+)
+
+var (
+	// volatileLabelKeys are the keys of the labels which are set by workload controllers and
+	// differ between the Pods of a workload or between revisions of a workload. They are not
+	// used in the recommended Pod selectors.
+	volatileLabelKeys = []string{
+		"pod-template-hash",
+		"controller-revision-hash",
+		"pod-template-generation",
+		"statefulset.kubernetes.io/pod-name",
+		"apps.kubernetes.io/pod-index",
+		"controller-uid",
+		"batch.kubernetes.io/controller-uid",
+		"batch.kubernetes.io/job-completion-index",
+	}
+
+	protocolNames = map[uint8]corev1.Protocol{
+		protocolTCP:  corev1.ProtocolTCP,
+		protocolUDP:  corev1.ProtocolUDP,
+		protocolSCTP: corev1.ProtocolSCTP,
+	}
+)
+
+// endpoint is the source or the destination of a connection.
+type endpoint struct {
+	// namespace is the Namespace of the Pod. It is empty if the endpoint is not a Pod.
+	namespace string
+	// labels is the string representation of the stable labels of the Pod.
+	labels string
+	// ip is the IP address of the endpoint if it is not a Pod.
+	ip string
+}
+
+type port struct {
+	protocol uint8
+	port     uint16
+}
+
+type connection struct {
+	source      endpoint
+	destination endpoint
+	port        port
+}
+
+// workload is a group of Pods in a Namespace with the same stable labels.
+type workload struct {
+	namespace string
+	labels    string
+}
+
+type workloadPeers struct {
+	ingress map[endpoint]map[port]struct{}
+	egress  map[endpoint]map[port]struct{}
+}
+
+// Recommender stores the connections observed in the flow records and recommends Antrea-native
+// policies which allow these connections.
+type Recommender struct {
+	podStore       objectstore.PodStore
+	maxConnections int
+
+	mutex       sync.RWMutex
+	connections map[connection]struct{}
+	// limitReached is used to log only once when maxConnections is reached.
+	limitReached bool
+}
+
+func NewRecommender(podStore objectstore.PodStore) *Recommender {
+	return &Recommender{
+		podStore:       podStore,
+		maxConnections: defaultMaxConnections,
+		connections:    map[connection]struct{}{},
+	}
+}
+
+// AddRecord stores the connection of a flow record. Connections which were denied by a
+// NetworkPolicy rule, or which don't use TCP, UDP or SCTP, are ignored.
+func (r *Recommender) AddRecord(record *flowpb.Flow) {
+	if record.K8S == nil || record.Ip == nil || record.Transport == nil {
+		return
+	}
+	if isDenied(record.K8S.IngressNetworkPolicyRuleAction) || isDenied(record.K8S.EgressNetworkPolicyRuleAction) {
+		return
+	}
+	if _, ok := protocolNames[uint8(record.Transport.ProtocolNumber)]; !ok {
+		return
+	}
+	startTime := record.StartTs.AsTime()
+	source, ok := r.getEndpoint(record.Ip.Source, record.K8S.SourcePodNamespace, record.K8S.SourcePodLabels, startTime)
+	if !ok {
+		return
+	}
+	destination, ok := r.getEndpoint(record.Ip.Destination, record.K8S.DestinationPodNamespace, record.K8S.DestinationPodLabels, startTime)
+	if !ok {
+		return
+	}
+	if source.namespace == "" && destination.namespace == "" {
+		return
+	}
+	conn := connection{
+		source:      source,
+		destination: destination,
+		port: port{
+			protocol: uint8(record.Transport.ProtocolNumber),
+			port:     uint16(record.Transport.DestinationPort),
+		},
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, exists := r.connections[conn]; exists {
+		return
+	}
+	if len(r.connections) >= r.maxConnections {
+		if !r.limitReached {
+			klog.InfoS("Reached the maximum number of connections for policy recommendation, new connections will be ignored", "maxConnections", r.maxConnections)
+			r.limitReached = true
+		}
+		return
+	}
+	r.connections[conn] = struct{}{}
+}
+
+func isDenied(action flowpb.NetworkPolicyRuleAction) bool {
+	return action == flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_DROP ||
+		action == flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_REJECT
+}
+
+// getEndpoint returns the endpoint of a connection. If the labels of a Pod are not included in
+// the flow record, they are retrieved from the podStore. It returns false if the labels of a
+// Pod cannot be found.
+func (r *Recommender) getEndpoint(ip []byte, namespace string, podLabels *flowpb.Labels, startTime time.Time) (endpoint, bool) {
+	if namespace == "" {
+		addr, ok := netip.AddrFromSlice(ip)
+		if !ok {
+			return endpoint{}, false
+		}
+		return endpoint{ip: addr.Unmap().String()}, true
+	}
+	var l map[string]string
+	if podLabels != nil {
+		l = podLabels.Labels
+	} else {
+		addr, ok := netip.AddrFromSlice(ip)
+		if !ok {
+			return endpoint{}, false
+		}
+		pod, exists := r.podStore.GetPodByIPAndTime(addr.Unmap().String(), startTime)
+		if !exists {
+			klog.V(4).InfoS("Cannot find Pod for policy recommendation", "ip", addr, "namespace", namespace)
+			return endpoint{}, false
+		}
+		l = pod.Labels
+	}
+	return endpoint{namespace: namespace, labels: stableLabels(l).String()}, true
+}
+
+func stableLabels(l map[string]string) labels.Set {
+	set := make(labels.Set, len(l))
+	for k, v := range l {
+		set[k] = v
+	}
+	for _, k := range volatileLabelKeys {
+		delete(set, k)
+	}
+	return set
+}
+
+// Recommend returns the recommended policies which allow the observed connections of the
+// Pods in the provided Namespace, or of all Pods if namespace is empty. For each workload, an
+// Antrea NetworkPolicy in the application Tier allows its ingress and egress connections. If
+// defaultDeny is true, a ClusterNetworkPolicy in the baseline Tier is also recommended for each
+// Namespace, to deny all other connections of its Pods except DNS queries.
+func (r *Recommender) Recommend(namespace string, defaultDeny bool) []runtime.Object {
+	workloads := map[workload]*workloadPeers{}
+	getPeers := func(w workload) *workloadPeers {
+		peers, ok := workloads[w]
+		if !ok {
+			peers = &workloadPeers{
+				ingress: map[endpoint]map[port]struct{}{},
+				egress:  map[endpoint]map[port]struct{}{},
+			}
+			workloads[w] = peers
+		}
+		return peers
+	}
+	addPort := func(peers map[endpoint]map[port]struct{}, peer endpoint, p port) {
+		if peers[peer] == nil {
+			peers[peer] = map[port]struct{}{}
+		}
+		peers[peer][p] = struct{}{}
+	}
+
+	r.mutex.RLock()
+	for conn := range r.connections {
+		if src := conn.source; src.namespace != "" && (namespace == "" || src.namespace == namespace) {
+			addPort(getPeers(workload{namespace: src.namespace, labels: src.labels}).egress, conn.destination, conn.port)
+		}
+		if dst := conn.destination; dst.namespace != "" && (namespace == "" || dst.namespace == namespace) {
+			addPort(getPeers(workload{namespace: dst.namespace, labels: dst.labels}).ingress, conn.source, conn.port)
+		}
+	}
+	r.mutex.RUnlock()
+
+	sortedWorkloads := make([]workload, 0, len(workloads))
+	for w := range workloads {
+		sortedWorkloads = append(sortedWorkloads, w)
+	}
+	sort.Slice(sortedWorkloads, func(i, j int) bool {
+		if sortedWorkloads[i].namespace != sortedWorkloads[j].namespace {
+			return sortedWorkloads[i].namespace < sortedWorkloads[j].namespace
+		}
+		return sortedWorkloads[i].labels < sortedWorkloads[j].labels
+	})
+
+	var policies []runtime.Object
+	for i, w := range sortedWorkloads {
+		policies = append(policies, newAllowPolicy(w, workloads[w]))
+		// Add the default-deny policy after the last workload of each Namespace.
+		if defaultDeny && (i == len(sortedWorkloads)-1 || sortedWorkloads[i+1].namespace != w.namespace) {
+			policies = append(policies, newDefaultDenyPolicy(w.namespace))
+		}
+	}
+	return policies
+}
+
+func newAllowPolicy(w workload, peers *workloadPeers) *crdv1beta1.NetworkPolicy {
+	hash := sha256.Sum256([]byte(w.labels))
+	policy := &crdv1beta1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: crdv1beta1.SchemeGroupVersion.String(),
+			Kind:       "NetworkPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: w.namespace,
+			Name:      allowPolicyNamePrefix + hex.EncodeToString(hash[:])[:10],
+		},
+		Spec: crdv1beta1.NetworkPolicySpec{
+			Tier:     "application",
+			Priority: allowPolicyPriority,
+			AppliedTo: []crdv1beta1.AppliedTo{
+				{PodSelector: &metav1.LabelSelector{MatchLabels: toLabelsMap(w.labels)}},
+			},
+		},
+	}
+	for _, peer := range sortedPeers(peers.ingress) {
+		policy.Spec.Ingress = append(policy.Spec.Ingress, crdv1beta1.Rule{
+			Action: ptr.To(crdv1beta1.RuleActionAllow),
+			From:   []crdv1beta1.NetworkPolicyPeer{toPolicyPeer(peer, w.namespace)},
+			Ports:  toPolicyPorts(peers.ingress[peer]),
+		})
+	}
+	for _, peer := range sortedPeers(peers.egress) {
+		policy.Spec.Egress = append(policy.Spec.Egress, crdv1beta1.Rule{
+			Action: ptr.To(crdv1beta1.RuleActionAllow),
+			To:     []crdv1beta1.NetworkPolicyPeer{toPolicyPeer(peer, w.namespace)},
+			Ports:  toPolicyPorts(peers.egress[peer]),
+		})
+	}
+	return policy
+}
+
+func newDefaultDenyPolicy(namespace string) *crdv1beta1.ClusterNetworkPolicy {
+	dnsPort := intstr.FromInt32(53)
+	return &crdv1beta1.ClusterNetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: crdv1beta1.SchemeGroupVersion.String(),
+			Kind:       "ClusterNetworkPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: denyPolicyNamePrefix + namespace,
+		},
+		Spec: crdv1beta1.ClusterNetworkPolicySpec{
+			Tier:     "baseline",
+			Priority: denyPolicyPriority,
+			AppliedTo: []crdv1beta1.AppliedTo{
+				{NamespaceSelector: namespaceSelector(namespace)},
+			},
+			Ingress: []crdv1beta1.Rule{
+				{Action: ptr.To(crdv1beta1.RuleActionDrop)},
+			},
+			Egress: []crdv1beta1.Rule{
+				{
+					Action: ptr.To(crdv1beta1.RuleActionAllow),
+					To: []crdv1beta1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: namespaceSelector(metav1.NamespaceSystem),
+							PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": "kube-dns"}},
+						},
+					},
+					Ports: []crdv1beta1.NetworkPolicyPort{
+						{Protocol: ptr.To(corev1.ProtocolUDP), Port: &dnsPort},
+						{Protocol: ptr.To(corev1.ProtocolTCP), Port: &dnsPort},
+					},
+				},
+				{Action: ptr.To(crdv1beta1.RuleActionDrop)},
+			},
+		},
+	}
+}
+
+func sortedPeers(peers map[endpoint]map[port]struct{}) []endpoint {
+	sorted := make([]endpoint, 0, len(peers))
+	for peer := range peers {
+		sorted = append(sorted, peer)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].namespace != sorted[j].namespace {
+			return sorted[i].namespace < sorted[j].namespace
+		}
+		if sorted[i].labels != sorted[j].labels {
+			return sorted[i].labels < sorted[j].labels
+		}
+		return sorted[i].ip < sorted[j].ip
+	})
+	return sorted
+}
+
+// toPolicyPeer returns the peer of a rule in a policy in the provided Namespace.
+func toPolicyPeer(peer endpoint, namespace string) crdv1beta1.NetworkPolicyPeer {
+	if peer.namespace == "" {
+		addr := netip.MustParseAddr(peer.ip)
+		return crdv1beta1.NetworkPolicyPeer{
+			IPBlock: &crdv1beta1.IPBlock{CIDR: netip.PrefixFrom(addr, addr.BitLen()).String()},
+		}
+	}
+	policyPeer := crdv1beta1.NetworkPolicyPeer{
+		PodSelector: &metav1.LabelSelector{MatchLabels: toLabelsMap(peer.labels)},
+	}
+	if peer.namespace != namespace {
+		policyPeer.NamespaceSelector = namespaceSelector(peer.namespace)
+	}
+	return policyPeer
+}
+
+func toPolicyPorts(ports map[port]struct{}) []crdv1beta1.NetworkPolicyPort {
+	sorted := make([]port, 0, len(ports))
+	for p := range ports {
+		sorted = append(sorted, p)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].protocol != sorted[j].protocol {
+			return sorted[i].protocol < sorted[j].protocol
+		}
+		return sorted[i].port < sorted[j].port
+	})
+	policyPorts := make([]crdv1beta1.NetworkPolicyPort, 0, len(sorted))
+	for _, p := range sorted {
+		policyPort := intstr.FromInt32(int32(p.port))
+		policyPorts = append(policyPorts, crdv1beta1.NetworkPolicyPort{
+			Protocol: ptr.To(protocolNames[p.protocol]),
+			Port:     &policyPort,
+		})
+	}
+	return policyPorts
+}
+
+func namespaceSelector(namespace string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: namespace}}
+}
+
+func toLabelsMap(s string) map[string]string {
+	// The string was generated by labels.Set.String() from valid labels and can always be
+	// converted back.
+	l, _ := labels.ConvertSelectorToLabelsMap(s)
+	return l
+}
@@ -0,0 +1,300 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recommendation
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	objectstoretest "antrea.io/antrea/pkg/util/objectstore/testing"
+)
+
+var (
+	startTime = time.Unix(1700000000, 0).UTC()
+
+	port80   = intstr.FromInt32(80)
+	port443  = intstr.FromInt32(443)
+	port5432 = intstr.FromInt32(5432)
+	port53   = intstr.FromInt32(53)
+)
+
+type flowBuilder struct {
+	flow *flowpb.Flow
+}
+
+func newFlow(srcIP, dstIP string, protocol, dstPort uint32) *flowBuilder {
+	return &flowBuilder{flow: &flowpb.Flow{
+		StartTs: timestamppb.New(startTime),
+		Ip: &flowpb.IP{
+			Source:      net.ParseIP(srcIP),
+			Destination: net.ParseIP(dstIP),
+		},
+		Transport: &flowpb.Transport{
+			ProtocolNumber:  protocol,
+			SourcePort:      34567,
+			DestinationPort: dstPort,
+		},
+		K8S: &flowpb.Kubernetes{},
+	}}
+}
+
+func (b *flowBuilder) source(namespace string, podLabels map[string]string) *flowBuilder {
+	b.flow.K8S.SourcePodNamespace = namespace
+	if podLabels != nil {
+		b.flow.K8S.SourcePodLabels = &flowpb.Labels{Labels: podLabels}
+	}
+	return b
+}
+
+func (b *flowBuilder) destination(namespace string, podLabels map[string]string) *flowBuilder {
+	b.flow.K8S.DestinationPodNamespace = namespace
+	if podLabels != nil {
+		b.flow.K8S.DestinationPodLabels = &flowpb.Labels{Labels: podLabels}
+	}
+	return b
+}
+
+func (b *flowBuilder) ingressAction(action flowpb.NetworkPolicyRuleAction) *flowBuilder {
+	b.flow.K8S.IngressNetworkPolicyRuleAction = action
+	return b
+}
+
+func allowPolicy(namespace, name string, podLabels map[string]string, ingress, egress []crdv1beta1.Rule) *crdv1beta1.NetworkPolicy {
+	return &crdv1beta1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "crd.antrea.io/v1beta1",
+			Kind:       "NetworkPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: crdv1beta1.NetworkPolicySpec{
+			Tier:      "application",
+			Priority:  5,
+			AppliedTo: []crdv1beta1.AppliedTo{{PodSelector: &metav1.LabelSelector{MatchLabels: podLabels}}},
+			Ingress:   ingress,
+			Egress:    egress,
+		},
+	}
+}
+
+func allowRule(peer crdv1beta1.NetworkPolicyPeer, ingress bool, ports ...crdv1beta1.NetworkPolicyPort) crdv1beta1.Rule {
+	rule := crdv1beta1.Rule{
+		Action: ptr.To(crdv1beta1.RuleActionAllow),
+		Ports:  ports,
+	}
+	if ingress {
+		rule.From = []crdv1beta1.NetworkPolicyPeer{peer}
+	} else {
+		rule.To = []crdv1beta1.NetworkPolicyPeer{peer}
+	}
+	return rule
+}
+
+func tcpPort(port *intstr.IntOrString) crdv1beta1.NetworkPolicyPort {
+	return crdv1beta1.NetworkPolicyPort{Protocol: ptr.To(corev1.ProtocolTCP), Port: port}
+}
+
+func podPeer(namespace string, podLabels map[string]string) crdv1beta1.NetworkPolicyPeer {
+	peer := crdv1beta1.NetworkPolicyPeer{PodSelector: &metav1.LabelSelector{MatchLabels: podLabels}}
+	if namespace != "" {
+		peer.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": namespace}}
+	}
+	return peer
+}
+
+func TestRecommend(t *testing.T) {
+	webLabels := map[string]string{"app": "web", "pod-template-hash": "5d8f7b9c4"}
+	dbLabels := map[string]string{"app": "db", "statefulset.kubernetes.io/pod-name": "db-0"}
+	clientLabels := map[string]string{"app": "client"}
+	// The policy names are derived from the hash of the stable labels.
+	webPolicyName := "recommended-allow-f95e1400b2"
+	dbPolicyName := "recommended-allow-90a9f2f38a"
+	clientPolicyName := "recommended-allow-a032dce496"
+
+	defaultDenyPolicy := &crdv1beta1.ClusterNetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "crd.antrea.io/v1beta1",
+			Kind:       "ClusterNetworkPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "recommended-default-deny-shop"},
+		Spec: crdv1beta1.ClusterNetworkPolicySpec{
+			Tier:     "baseline",
+			Priority: 5,
+			AppliedTo: []crdv1beta1.AppliedTo{
+				{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "shop"}}},
+			},
+			Ingress: []crdv1beta1.Rule{{Action: ptr.To(crdv1beta1.RuleActionDrop)}},
+			Egress: []crdv1beta1.Rule{
+				{
+					Action: ptr.To(crdv1beta1.RuleActionAllow),
+					To:     []crdv1beta1.NetworkPolicyPeer{podPeer("kube-system", map[string]string{"k8s-app": "kube-dns"})},
+					Ports: []crdv1beta1.NetworkPolicyPort{
+						{Protocol: ptr.To(corev1.ProtocolUDP), Port: &port53},
+						{Protocol: ptr.To(corev1.ProtocolTCP), Port: &port53},
+					},
+				},
+				{Action: ptr.To(crdv1beta1.RuleActionDrop)},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		records          []*flowpb.Flow
+		expectedPodCalls func(mockPodStore *objectstoretest.MockPodStore)
+		namespace        string
+		defaultDeny      bool
+		expectedPolicies []runtime.Object
+	}{
+		{
+			name: "intra-Namespace and external connections",
+			records: []*flowpb.Flow{
+				newFlow("10.10.0.1", "10.10.0.2", 6, 5432).source("shop", webLabels).destination("shop", dbLabels).flow,
+				// A connection from another Pod of the same workload.
+				newFlow("10.10.0.3", "10.10.0.2", 6, 5432).source("shop", webLabels).destination("shop", dbLabels).flow,
+				newFlow("192.168.1.10", "10.10.0.1", 6, 443).destination("shop", webLabels).flow,
+				newFlow("192.168.1.10", "10.10.0.1", 6, 80).destination("shop", webLabels).flow,
+				// ICMP connections are ignored.
+				newFlow("192.168.1.11", "10.10.0.1", 1, 0).destination("shop", webLabels).flow,
+				// Denied connections are ignored.
+				newFlow("192.168.1.12", "10.10.0.1", 6, 22).destination("shop", webLabels).
+					ingressAction(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_DROP).flow,
+			},
+			expectedPolicies: []runtime.Object{
+				allowPolicy("shop", dbPolicyName, map[string]string{"app": "db"},
+					[]crdv1beta1.Rule{
+						allowRule(podPeer("", map[string]string{"app": "web"}), true, tcpPort(&port5432)),
+					},
+					nil,
+				),
+				allowPolicy("shop", webPolicyName, map[string]string{"app": "web"},
+					[]crdv1beta1.Rule{
+						allowRule(crdv1beta1.NetworkPolicyPeer{IPBlock: &crdv1beta1.IPBlock{CIDR: "192.168.1.10/32"}}, true, tcpPort(&port80), tcpPort(&port443)),
+					},
+					[]crdv1beta1.Rule{
+						allowRule(podPeer("", map[string]string{"app": "db"}), false, tcpPort(&port5432)),
+					},
+				),
+			},
+		},
+		{
+			name: "inter-Namespace connection with default deny",
+			records: []*flowpb.Flow{
+				newFlow("10.10.1.1", "10.10.0.1", 6, 80).source("test", clientLabels).destination("shop", webLabels).flow,
+			},
+			defaultDeny: true,
+			expectedPolicies: []runtime.Object{
+				allowPolicy("shop", webPolicyName, map[string]string{"app": "web"},
+					[]crdv1beta1.Rule{
+						allowRule(podPeer("test", map[string]string{"app": "client"}), true, tcpPort(&port80)),
+					},
+					nil,
+				),
+				defaultDenyPolicy,
+				allowPolicy("test", clientPolicyName, map[string]string{"app": "client"},
+					nil,
+					[]crdv1beta1.Rule{
+						allowRule(podPeer("shop", map[string]string{"app": "web"}), false, tcpPort(&port80)),
+					},
+				),
+				&crdv1beta1.ClusterNetworkPolicy{
+					TypeMeta:   defaultDenyPolicy.TypeMeta,
+					ObjectMeta: metav1.ObjectMeta{Name: "recommended-default-deny-test"},
+					Spec: func() crdv1beta1.ClusterNetworkPolicySpec {
+						spec := *defaultDenyPolicy.Spec.DeepCopy()
+						spec.AppliedTo[0].NamespaceSelector.MatchLabels["kubernetes.io/metadata.name"] = "test"
+						return spec
+					}(),
+				},
+			},
+		},
+		{
+			name: "filter by Namespace",
+			records: []*flowpb.Flow{
+				newFlow("10.10.1.1", "10.10.0.1", 6, 80).source("test", clientLabels).destination("shop", webLabels).flow,
+			},
+			namespace:   "shop",
+			defaultDeny: true,
+			expectedPolicies: []runtime.Object{
+				allowPolicy("shop", webPolicyName, map[string]string{"app": "web"},
+					[]crdv1beta1.Rule{
+						allowRule(podPeer("test", map[string]string{"app": "client"}), true, tcpPort(&port80)),
+					},
+					nil,
+				),
+				defaultDenyPolicy,
+			},
+		},
+		{
+			name: "Pod labels from PodStore",
+			records: []*flowpb.Flow{
+				newFlow("10.10.1.1", "10.10.0.1", 6, 80).source("test", nil).destination("shop", nil).flow,
+				newFlow("10.10.1.2", "10.10.0.1", 6, 80).source("test", nil).destination("shop", nil).flow,
+			},
+			expectedPodCalls: func(mockPodStore *objectstoretest.MockPodStore) {
+				mockPodStore.EXPECT().GetPodByIPAndTime("10.10.1.1", startTime).Return(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: clientLabels}}, true)
+				mockPodStore.EXPECT().GetPodByIPAndTime("10.10.0.1", startTime).Return(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: webLabels}}, true)
+				// The connection is ignored as the labels of the source Pod are unknown.
+				mockPodStore.EXPECT().GetPodByIPAndTime("10.10.1.2", startTime).Return(nil, false)
+			},
+			namespace: "shop",
+			expectedPolicies: []runtime.Object{
+				allowPolicy("shop", webPolicyName, map[string]string{"app": "web"},
+					[]crdv1beta1.Rule{
+						allowRule(podPeer("test", map[string]string{"app": "client"}), true, tcpPort(&port80)),
+					},
+					nil,
+				),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPodStore := objectstoretest.NewMockPodStore(ctrl)
+			if tc.expectedPodCalls != nil {
+				tc.expectedPodCalls(mockPodStore)
+			}
+			r := NewRecommender(mockPodStore)
+			for _, record := range tc.records {
+				r.AddRecord(record)
+			}
+			assert.Equal(t, tc.expectedPolicies, r.Recommend(tc.namespace, tc.defaultDeny))
+		})
+	}
+}
+
+func TestAddRecordMaxConnections(t *testing.T) {
+	r := NewRecommender(nil)
+	r.maxConnections = 2
+	r.AddRecord(newFlow("192.168.1.10", "10.10.0.1", 6, 80).destination("shop", map[string]string{}).flow)
+	r.AddRecord(newFlow("192.168.1.10", "10.10.0.1", 6, 443).destination("shop", map[string]string{}).flow)
+	r.AddRecord(newFlow("192.168.1.10", "10.10.0.1", 6, 8080).destination("shop", map[string]string{}).flow)
+	// Connections which have already been stored are not affected by the limit.
+	r.AddRecord(newFlow("192.168.1.10", "10.10.0.1", 6, 80).destination("shop", map[string]string{}).flow)
+	assert.Len(t, r.connections, 2)
+	assert.True(t, r.limitReached)
+}
@@ -413,6 +413,8 @@ type PacketOutBuilder interface {
 	SetUDPSrcPort(port uint16) PacketOutBuilder
 	SetUDPDstPort(port uint16) PacketOutBuilder
 	SetUDPData(data []byte) PacketOutBuilder
+	SetSCTPSrcPort(port uint16) PacketOutBuilder
+	SetSCTPDstPort(port uint16) PacketOutBuilder
 	SetICMPType(icmpType uint8) PacketOutBuilder
 	SetICMPCode(icmpCode uint8) PacketOutBuilder
 	SetICMPID(id uint16) PacketOutBuilder
@@ -90,6 +90,25 @@ func GetUDPHeaderData(ipPkt util.Message) (udpSrcPort, udpDstPort uint16, err er
 	return udpIn.PortSrc, udpIn.PortDst, nil
 }
 
+// GetSCTPHeaderData gets the source and destination ports from the SCTP common header of an IP message.
+func GetSCTPHeaderData(ipPkt util.Message) (sctpSrcPort, sctpDstPort uint16, err error) {
+	var sctpBytes []byte
+	switch typedIPPkt := ipPkt.(type) {
+	case *protocol.IPv4:
+		sctpBytes, err = typedIPPkt.Data.MarshalBinary()
+	case *protocol.IPv6:
+		sctpBytes, err = typedIPPkt.Data.MarshalBinary()
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	sctpIn := new(SCTP)
+	if err := sctpIn.UnmarshalBinary(sctpBytes); err != nil {
+		return 0, 0, err
+	}
+	return sctpIn.PortSrc, sctpIn.PortDst, nil
+}
+
 func getICMPHeaderData(ipPkt util.Message) (icmpType, icmpCode uint8, icmpEchoID, icmpEchoSeq uint16, err error) {
 	switch typedIPPkt := ipPkt.(type) {
 	case *protocol.IPv4:
@@ -155,6 +174,8 @@ func ParsePacketIn(pktIn *ofctrl.PacketIn) (*Packet, error) {
 		packet.SourcePort, packet.DestinationPort, _, _, _, packet.TCPFlags, _, err = GetTCPHeaderData(ethernetData.Data)
 	case protocol.Type_UDP:
 		packet.SourcePort, packet.DestinationPort, err = GetUDPHeaderData(ethernetData.Data)
+	case sctpProtocol:
+		packet.SourcePort, packet.DestinationPort, err = GetSCTPHeaderData(ethernetData.Data)
 	case protocol.Type_ICMP, protocol.Type_IPv6ICMP:
 		_, _, packet.ICMPEchoID, packet.ICMPEchoSeq, err = getICMPHeaderData(ethernetData.Data)
 	}
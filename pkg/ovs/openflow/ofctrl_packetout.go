@@ -33,6 +33,8 @@ type ofPacketOutBuilder struct {
 	pktOut  *ofctrl.PacketOut
 	icmpID  *uint16
 	icmpSeq *uint16
+	// sctpHeader is set as the IP payload in Done, as ofctrl.PacketOut doesn't have an SCTP header.
+	sctpHeader *SCTP
 }
 
 // SetSrcMAC sets the packet's source MAC with the provided value.
@@ -252,6 +254,24 @@ func (b *ofPacketOutBuilder) SetUDPDstPort(port uint16) PacketOutBuilder {
 	return b
 }
 
+// SetSCTPSrcPort sets the source port in the packet's SCTP header.
+func (b *ofPacketOutBuilder) SetSCTPSrcPort(port uint16) PacketOutBuilder {
+	if b.sctpHeader == nil {
+		b.sctpHeader = new(SCTP)
+	}
+	b.sctpHeader.PortSrc = port
+	return b
+}
+
+// SetSCTPDstPort sets the destination port in the packet's SCTP header.
+func (b *ofPacketOutBuilder) SetSCTPDstPort(port uint16) PacketOutBuilder {
+	if b.sctpHeader == nil {
+		b.sctpHeader = new(SCTP)
+	}
+	b.sctpHeader.PortDst = port
+	return b
+}
+
 // SetICMPType sets the type in the packet's ICMP header.
 func (b *ofPacketOutBuilder) SetICMPType(icmpType uint8) PacketOutBuilder {
 	if b.pktOut.ICMPHeader == nil {
@@ -393,6 +413,10 @@ func (b *ofPacketOutBuilder) Done() *ofctrl.PacketOut {
 			b.pktOut.UDPHeader.Length = b.pktOut.UDPHeader.Len()
 			b.pktOut.UDPHeader.Checksum = b.udpHeaderChecksum()
 			b.pktOut.IPHeader.Length = 20 + b.pktOut.UDPHeader.Len()
+		} else if b.sctpHeader != nil {
+			b.setSCTPChunks()
+			b.pktOut.IPHeader.Data = b.sctpHeader
+			b.pktOut.IPHeader.Length = 20 + b.sctpHeader.Len()
 		} else if b.pktOut.IPHeader.Protocol == protocol.Type_IGMP {
 			if igmpv1or2, ok := b.pktOut.IPHeader.Data.(*protocol.IGMPv1or2); ok {
 				igmpv1or2.Checksum = 0
@@ -438,6 +462,10 @@ func (b *ofPacketOutBuilder) Done() *ofctrl.PacketOut {
 			b.pktOut.UDPHeader.Length = b.pktOut.UDPHeader.Len()
 			b.pktOut.UDPHeader.Checksum = b.udpHeaderChecksum()
 			b.pktOut.IPv6Header.Length = b.pktOut.UDPHeader.Len()
+		} else if b.sctpHeader != nil {
+			b.setSCTPChunks()
+			b.pktOut.IPv6Header.Data = b.sctpHeader
+			b.pktOut.IPv6Header.Length = b.sctpHeader.Len()
 		}
 		// Set IPv6 version in the IP Header.
 		b.pktOut.IPv6Header.Version = 0x6
@@ -456,6 +484,17 @@ func (b *ofPacketOutBuilder) setICMPData() {
 	b.pktOut.ICMPHeader.Data = data
 }
 
+// setSCTPChunks sets an INIT chunk in the SCTP packet if no chunk is provided. The verification tag
+// of a packet carrying an INIT chunk must be 0.
+func (b *ofPacketOutBuilder) setSCTPChunks() {
+	if len(b.sctpHeader.Chunks) != 0 {
+		return
+	}
+	b.sctpHeader.VerificationTag = 0
+	// #nosec G404: random number generator not used for security purposes
+	b.sctpHeader.Chunks = newSCTPInitChunk(pktRand.Uint32())
+}
+
 func (b *ofPacketOutBuilder) ipHeaderChecksum() uint16 {
 	ipHeader := *b.pktOut.IPHeader
 	ipHeader.Checksum = 0
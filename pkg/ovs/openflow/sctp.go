@@ -0,0 +1,91 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openflow
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+)
+
+const (
+	// sctpProtocol is the IP protocol number of SCTP.
+	sctpProtocol uint8 = 132
+	// sctpCommonHdrLen is the length of the SCTP common header.
+	sctpCommonHdrLen = 12
+	// sctpChunkTypeInit is the type of SCTP INIT chunks.
+	sctpChunkTypeInit uint8 = 1
+	// sctpInitChunkLen is the length of an SCTP INIT chunk without optional parameters.
+	sctpInitChunkLen = 20
+)
+
+var sctpChecksumTable = crc32.MakeTable(crc32.Castagnoli)
+
+// SCTP is an SCTP packet, with the common header and the raw chunks. The checksum is computed when
+// the packet is marshaled. libOpenflow doesn't provide an SCTP message, so it is implemented here to
+// generate SCTP packet-out messages.
+type SCTP struct {
+	PortSrc         uint16
+	PortDst         uint16
+	VerificationTag uint32
+	Checksum        uint32
+	Chunks          []byte
+}
+
+func (s *SCTP) Len() uint16 {
+	return uint16(sctpCommonHdrLen + len(s.Chunks))
+}
+
+func (s *SCTP) MarshalBinary() ([]byte, error) {
+	data := make([]byte, s.Len())
+	binary.BigEndian.PutUint16(data[0:2], s.PortSrc)
+	binary.BigEndian.PutUint16(data[2:4], s.PortDst)
+	binary.BigEndian.PutUint32(data[4:8], s.VerificationTag)
+	copy(data[sctpCommonHdrLen:], s.Chunks)
+	// The CRC32c checksum is computed with the checksum field set to 0, and is stored in
+	// little-endian byte order (RFC 9260, Appendix A).
+	s.Checksum = crc32.Checksum(data, sctpChecksumTable)
+	binary.LittleEndian.PutUint32(data[8:12], s.Checksum)
+	return data, nil
+}
+
+func (s *SCTP) UnmarshalBinary(data []byte) error {
+	if len(data) < sctpCommonHdrLen {
+		return errors.New("the []byte is too short to unmarshal a full SCTP message")
+	}
+	s.PortSrc = binary.BigEndian.Uint16(data[0:2])
+	s.PortDst = binary.BigEndian.Uint16(data[2:4])
+	s.VerificationTag = binary.BigEndian.Uint32(data[4:8])
+	s.Checksum = binary.LittleEndian.Uint32(data[8:12])
+	s.Chunks = data[sctpCommonHdrLen:]
+	return nil
+}
+
+// newSCTPInitChunk returns an SCTP INIT chunk, which starts an association and is the first packet
+// of any SCTP connection.
+func newSCTPInitChunk(initiateTag uint32) []byte {
+	chunk := make([]byte, sctpInitChunkLen)
+	chunk[0] = sctpChunkTypeInit
+	binary.BigEndian.PutUint16(chunk[2:4], sctpInitChunkLen)
+	binary.BigEndian.PutUint32(chunk[4:8], initiateTag)
+	// Advertised receiver window credit.
+	binary.BigEndian.PutUint32(chunk[8:12], 65535)
+	// Number of outbound and inbound streams.
+	binary.BigEndian.PutUint16(chunk[12:14], 1)
+	binary.BigEndian.PutUint16(chunk[14:16], 1)
+	// Initial TSN.
+	binary.BigEndian.PutUint32(chunk[16:20], initiateTag)
+	return chunk
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openflow
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSCTPMarshalBinary(t *testing.T) {
+	sctp := &SCTP{
+		PortSrc: 36412,
+		PortDst: 38412,
+		Chunks:  newSCTPInitChunk(0x01020304),
+	}
+	assert.Equal(t, uint16(32), sctp.Len())
+	data, err := sctp.MarshalBinary()
+	require.NoError(t, err)
+	require.Len(t, data, 32)
+	assert.Equal(t, uint16(36412), binary.BigEndian.Uint16(data[0:2]))
+	assert.Equal(t, uint16(38412), binary.BigEndian.Uint16(data[2:4]))
+	assert.Equal(t, uint32(0), binary.BigEndian.Uint32(data[4:8]))
+	assert.Equal(t, sctpChunkTypeInit, data[12])
+	assert.Equal(t, uint16(sctpInitChunkLen), binary.BigEndian.Uint16(data[14:16]))
+	assert.Equal(t, uint32(0x01020304), binary.BigEndian.Uint32(data[16:20]))
+
+	// The checksum is computed over the packet with a zero checksum field.
+	zeroed := append([]byte{}, data...)
+	copy(zeroed[8:12], []byte{0, 0, 0, 0})
+	expectedChecksum := crc32.Checksum(zeroed, crc32.MakeTable(crc32.Castagnoli))
+	assert.Equal(t, expectedChecksum, binary.LittleEndian.Uint32(data[8:12]))
+	assert.Equal(t, expectedChecksum, sctp.Checksum)
+
+	parsed := new(SCTP)
+	require.NoError(t, parsed.UnmarshalBinary(data))
+	assert.Equal(t, sctp, parsed)
+}
+
+func TestSCTPUnmarshalBinary(t *testing.T) {
+	err := new(SCTP).UnmarshalBinary([]byte{0x8e, 0x3c, 0x96, 0x0c})
+	assert.EqualError(t, err, "the []byte is too short to unmarshal a full SCTP message")
+}
@@ -2728,6 +2728,34 @@ func (mr *MockPacketOutBuilderMockRecorder) SetOutport(outport any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOutport", reflect.TypeOf((*MockPacketOutBuilder)(nil).SetOutport), outport)
 }
 
+// SetSCTPDstPort mocks base method.
+func (m *MockPacketOutBuilder) SetSCTPDstPort(port uint16) openflow.PacketOutBuilder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSCTPDstPort", port)
+	ret0, _ := ret[0].(openflow.PacketOutBuilder)
+	return ret0
+}
+
+// SetSCTPDstPort indicates an expected call of SetSCTPDstPort.
+func (mr *MockPacketOutBuilderMockRecorder) SetSCTPDstPort(port any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSCTPDstPort", reflect.TypeOf((*MockPacketOutBuilder)(nil).SetSCTPDstPort), port)
+}
+
+// SetSCTPSrcPort mocks base method.
+func (m *MockPacketOutBuilder) SetSCTPSrcPort(port uint16) openflow.PacketOutBuilder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSCTPSrcPort", port)
+	ret0, _ := ret[0].(openflow.PacketOutBuilder)
+	return ret0
+}
+
+// SetSCTPSrcPort indicates an expected call of SetSCTPSrcPort.
+func (mr *MockPacketOutBuilderMockRecorder) SetSCTPSrcPort(port any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSCTPSrcPort", reflect.TypeOf((*MockPacketOutBuilder)(nil).SetSCTPSrcPort), port)
+}
+
 // SetSrcIP mocks base method.
 func (m *MockPacketOutBuilder) SetSrcIP(ip net.IP) openflow.PacketOutBuilder {
 	m.ctrl.T.Helper()
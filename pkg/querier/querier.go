@@ -121,17 +121,18 @@ type NetworkPolicyQueryFilter struct {
 	SourceName string
 	// The namespace of the original Namespace that the internal NetworkPolicy is created for.
 	Namespace string
-	// The type of the original NetworkPolicy that the internal NetworkPolicy is created for.(K8sNP, ACNP, ANNP, ANP and BANP)
+	// The type of the original NetworkPolicy that the internal NetworkPolicy is created for.(K8sNP, ACNP, ANNP, ANP, BANP and NSProfile)
 	SourceType cpv1beta.NetworkPolicyType
 }
 
 // From user shorthand input to cpv1beta1.NetworkPolicyType
 var NetworkPolicyTypeMap = map[string]cpv1beta.NetworkPolicyType{
-	"K8SNP": cpv1beta.K8sNetworkPolicy,
-	"ACNP":  cpv1beta.AntreaClusterNetworkPolicy,
-	"ANNP":  cpv1beta.AntreaNetworkPolicy,
-	"ANP":   cpv1beta.AdminNetworkPolicy,
-	"BANP":  cpv1beta.BaselineAdminNetworkPolicy,
+	"K8SNP":     cpv1beta.K8sNetworkPolicy,
+	"ACNP":      cpv1beta.AntreaClusterNetworkPolicy,
+	"ANNP":      cpv1beta.AntreaNetworkPolicy,
+	"ANP":       cpv1beta.AdminNetworkPolicy,
+	"BANP":      cpv1beta.BaselineAdminNetworkPolicy,
+	"NSPROFILE": cpv1beta.NamespaceProfile,
 }
 
 func GetNetworkPolicyTypeShorthands() []string {
@@ -142,7 +143,7 @@ func GetNetworkPolicyTypeShorthands() []string {
 	return validTypes
 }
 
-var NamespaceScopedPolicyTypes = sets.New[string]("ANNP", "K8SNP")
+var NamespaceScopedPolicyTypes = sets.New[string]("ANNP", "K8SNP", "NSPROFILE")
 
 // ServiceExternalIPStatusQuerier queries the Service external IP status for debugging purposes.
 // Ideally, every Node should have consistent results eventually. This should only be used when
@@ -142,6 +142,55 @@ func MergeCIDRs(cidrBlocks []*net.IPNet) []*net.IPNet {
 	return cidrBlocks
 }
 
+// AggregatePrefixes returns the smallest list of prefixes covering exactly the same addresses as
+// the provided ones, by removing the prefixes covered by other prefixes and by merging adjacent
+// prefixes recursively. Unlike MergeCIDRs, its complexity is O(n*log(n)), so it can be used with
+// large lists. The returned prefixes are masked and sorted. Input array can be modified.
+func AggregatePrefixes(prefixes []netip.Prefix) []netip.Prefix {
+	for i, prefix := range prefixes {
+		// IPv4-mapped IPv6 prefixes are converted to IPv4 prefixes, so they can be merged with them.
+		if prefix.Addr().Is4In6() {
+			prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+		}
+		prefixes[i] = prefix.Masked()
+	}
+	// Sort the list by address and, for the same address, by prefix length in ascending order, so
+	// that a prefix can only be covered by the last prefix kept before it.
+	sort.Slice(prefixes, func(i, j int) bool {
+		if c := prefixes[i].Addr().Compare(prefixes[j].Addr()); c != 0 {
+			return c < 0
+		}
+		return prefixes[i].Bits() < prefixes[j].Bits()
+	})
+	aggregated := make([]netip.Prefix, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		if !prefix.IsValid() {
+			continue
+		}
+		if len(aggregated) > 0 {
+			last := aggregated[len(aggregated)-1]
+			if last.Bits() <= prefix.Bits() && last.Contains(prefix.Addr()) {
+				continue
+			}
+		}
+		aggregated = append(aggregated, prefix)
+		// Merge the last 2 prefixes as long as they are the 2 halves of the same prefix.
+		for len(aggregated) >= 2 {
+			last, previous := aggregated[len(aggregated)-1], aggregated[len(aggregated)-2]
+			if last.Bits() != previous.Bits() || last.Bits() == 0 || last.Addr().Is4() != previous.Addr().Is4() {
+				break
+			}
+			parent := netip.PrefixFrom(previous.Addr(), previous.Bits()-1).Masked()
+			if parent.Addr() != previous.Addr() || !parent.Contains(last.Addr()) {
+				break
+			}
+			aggregated = aggregated[:len(aggregated)-2]
+			aggregated = append(aggregated, parent)
+		}
+	}
+	return aggregated
+}
+
 // IPNetToNetIPNet converts Antrea IPNet to *net.IPNet.
 // Note that K8s allows non-standard CIDRs to be specified (e.g. 10.0.1.1/16, fe80::7015:efff:fe9a:146b/64). However,
 // OVS will report OFPBMC_BAD_WILDCARDS error if using them in the OpenFlow messages. The function will normalize the
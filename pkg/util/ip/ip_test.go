@@ -112,6 +112,68 @@ func TestMergeCIDRs(t *testing.T) {
 	assert.ElementsMatch(t, correctList4, ipNetList4)
 }
 
+func TestAggregatePrefixes(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefixes []string
+		expected []string
+	}{
+		{
+			name:     "empty",
+			prefixes: []string{},
+			expected: []string{},
+		},
+		{
+			name:     "duplicated and covered prefixes",
+			prefixes: []string{"10.20.1.2/32", "10.10.0.0/16", "10.20.0.0/16", "10.10.0.0/16", "10.20.1.3/32"},
+			expected: []string{"10.10.0.0/16", "10.20.0.0/16"},
+		},
+		{
+			name:     "adjacent prefixes",
+			prefixes: []string{"10.0.0.3/32", "10.0.0.0/31", "10.0.0.2/32", "10.0.1.0/24"},
+			expected: []string{"10.0.0.0/30", "10.0.1.0/24"},
+		},
+		{
+			name:     "recursive merge",
+			prefixes: []string{"10.0.0.0/25", "10.0.0.128/26", "10.0.0.192/27", "10.0.0.224/27", "10.0.1.0/24"},
+			expected: []string{"10.0.0.0/23"},
+		},
+		{
+			name:     "non-aligned adjacent prefixes",
+			prefixes: []string{"10.0.1.0/24", "10.0.2.0/24"},
+			expected: []string{"10.0.1.0/24", "10.0.2.0/24"},
+		},
+		{
+			name:     "unmasked prefixes",
+			prefixes: []string{"10.0.0.1/24", "10.0.1.1/24"},
+			expected: []string{"10.0.0.0/23"},
+		},
+		{
+			name:     "dual-stack",
+			prefixes: []string{"2001:db8::/33", "10.0.0.0/8", "2001:db8:8000::/33", "::ffff:12.0.0.0/104"},
+			expected: []string{"10.0.0.0/8", "12.0.0.0/8", "2001:db8::/32"},
+		},
+		{
+			name:     "address families are not merged",
+			prefixes: []string{"0.0.0.0/0", "::/0"},
+			expected: []string{"0.0.0.0/0", "::/0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefixes := make([]netip.Prefix, 0, len(tt.prefixes))
+			for _, p := range tt.prefixes {
+				prefixes = append(prefixes, netip.MustParsePrefix(p))
+			}
+			expected := make([]netip.Prefix, 0, len(tt.expected))
+			for _, p := range tt.expected {
+				expected = append(expected, netip.MustParsePrefix(p))
+			}
+			assert.Equal(t, expected, AggregatePrefixes(prefixes))
+		})
+	}
+}
+
 func TestIPNetToNetIPNet(t *testing.T) {
 	tests := []struct {
 		name  string